@@ -16,6 +16,7 @@ type Access interface {
 	IsAuthorized(string) bool
 	IsAdmin() bool
 	IsSystem() bool
+	IsAPIToken() bool
 	TeamNames() []string
 	TeamRoles() map[string][]string
 	Claims() Claims
@@ -28,6 +29,7 @@ type Claims struct {
 	UserName  string
 	Email     string
 	Connector string
+	Groups    []string
 }
 
 type Verification struct {
@@ -36,9 +38,17 @@ type Verification struct {
 	RawClaims    map[string]interface{}
 }
 
+// Action is the API action being accessed, along with the pipeline it acts
+// on, if any. Custom team roles are evaluated against it.
+type Action struct {
+	Name         string
+	PipelineName string
+}
+
 type access struct {
 	verification      Verification
 	requiredRole      string
+	action            Action
 	systemClaimKey    string
 	systemClaimValues []string
 	teams             []db.Team
@@ -47,6 +57,7 @@ type access struct {
 func NewAccessor(
 	verification Verification,
 	requiredRole string,
+	action Action,
 	systemClaimKey string,
 	systemClaimValues []string,
 	teams []db.Team,
@@ -54,6 +65,7 @@ func NewAccessor(
 	return &access{
 		verification:      verification,
 		requiredRole:      requiredRole,
+		action:            action,
 		systemClaimKey:    systemClaimKey,
 		systemClaimValues: systemClaimValues,
 		teams:             teams,
@@ -90,7 +102,7 @@ func (a *access) TeamNames() []string {
 	isAdmin := a.IsAdmin()
 
 	for _, team := range a.teams {
-		if isAdmin || a.hasRequiredRole(team.Auth()) {
+		if isAdmin || a.hasRequiredRole(team) {
 			teamNames = append(teamNames, team.Name())
 		}
 	}
@@ -98,9 +110,9 @@ func (a *access) TeamNames() []string {
 	return teamNames
 }
 
-func (a *access) hasRequiredRole(auth atc.TeamAuth) bool {
-	for _, teamRole := range a.rolesForTeam(auth) {
-		if a.hasPermission(teamRole) {
+func (a *access) hasRequiredRole(team db.Team) bool {
+	for _, teamRole := range a.rolesOnTeam(team) {
+		if a.hasPermission(teamRole) || a.hasCustomPermission(team.Roles(), teamRole) {
 			return true
 		}
 	}
@@ -112,7 +124,7 @@ func (a *access) teamRoles() map[string][]string {
 	teamRoles := map[string][]string{}
 
 	for _, team := range a.teams {
-		if roles := a.rolesForTeam(team.Auth()); len(roles) > 0 {
+		if roles := a.rolesOnTeam(team); len(roles) > 0 {
 			teamRoles[team.Name()] = roles
 		}
 	}
@@ -120,6 +132,28 @@ func (a *access) teamRoles() map[string][]string {
 	return teamRoles
 }
 
+// rolesOnTeam returns the roles the user has on the team. A request made
+// with an API token only has the role the token was created with, on the
+// token's team, whatever other roles its user may have. A user's token loses
+// its role once the team's auth no longer grants the user that role or one
+// above it.
+func (a *access) rolesOnTeam(team db.Team) []string {
+	if tokenTeam, tokenRole, ok := a.apiToken(); ok {
+		if tokenTeam != team.Name() {
+			return nil
+		}
+
+		if a.connectorID() != ServiceAccountConnector &&
+			!atc.RolesCover(a.rolesForTeam(team.Auth()), tokenRole) {
+			return nil
+		}
+
+		return []string{tokenRole}
+	}
+
+	return a.rolesForTeam(team.Auth())
+}
+
 func (a *access) rolesForTeam(auth atc.TeamAuth) []string {
 
 	roleSet := map[string]bool{}
@@ -184,6 +218,28 @@ func (a *access) hasPermission(role string) bool {
 	}
 }
 
+// hasCustomPermission returns whether a custom role defined by the team
+// permits the action, either by naming it or by naming a built-in role which
+// does.
+func (a *access) hasCustomPermission(roles atc.TeamRoles, roleName string) bool {
+	role, found := roles.Lookup(roleName)
+	if !found || !role.AppliesTo(a.action.PipelineName) {
+		return false
+	}
+
+	for _, action := range role.Actions {
+		if action == a.action.Name {
+			return true
+		}
+
+		if atc.IsBuiltinRole(action) && a.hasPermission(action) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (a *access) claims() map[string]interface{} {
 	if a.IsAuthenticated() {
 		return a.verification.RawClaims
@@ -218,6 +274,23 @@ func (a *access) claim(name string) string {
 	return ""
 }
 
+func (a *access) apiToken() (string, string, bool) {
+	raw, ok := a.claims()[APITokenClaim]
+	if !ok {
+		return "", "", false
+	}
+
+	claim, ok := raw.(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+
+	team, _ := claim["team"].(string)
+	role, _ := claim["role"].(string)
+
+	return team, role, true
+}
+
 func (a *access) UserName() string {
 	return a.federatedClaim("user_name")
 }
@@ -231,7 +304,7 @@ func (a *access) connectorID() string {
 }
 
 func (a *access) groups() []string {
-	groups := []string{}
+	var groups []string
 	if raw, ok := a.claims()["groups"]; ok {
 		if rawGroups, ok := raw.([]interface{}); ok {
 			for _, rawGroup := range rawGroups {
@@ -281,6 +354,13 @@ func (a *access) IsSystem() bool {
 	return false
 }
 
+// IsAPIToken returns whether the request was made with an API token rather
+// than a user's login.
+func (a *access) IsAPIToken() bool {
+	_, _, ok := a.apiToken()
+	return ok
+}
+
 func (a *access) TeamRoles() map[string][]string {
 	return a.teamRoles()
 }
@@ -293,5 +373,6 @@ func (a *access) Claims() Claims {
 		UserID:    a.userID(),
 		UserName:  a.UserName(),
 		Connector: a.connectorID(),
+		Groups:    a.groups(),
 	}
 }
@@ -19,6 +19,6 @@ type accessFactory struct {
 	systemClaimValues []string
 }
 
-func (a *accessFactory) Create(role string, verification Verification, teams []db.Team) Access {
-	return NewAccessor(verification, role, a.systemClaimKey, a.systemClaimValues, teams)
+func (a *accessFactory) Create(role string, action Action, verification Verification, teams []db.Team) Access {
+	return NewAccessor(verification, role, action, a.systemClaimKey, a.systemClaimValues, teams)
 }
@@ -15,6 +15,7 @@ var _ = Describe("Accessor", func() {
 	var (
 		verification accessor.Verification
 		requiredRole string
+		action       accessor.Action
 		teams        []db.Team
 		access       accessor.Access
 
@@ -37,7 +38,7 @@ var _ = Describe("Accessor", func() {
 	})
 
 	JustBeforeEach(func() {
-		access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+		access = accessor.NewAccessor(verification, requiredRole, action, "sub", []string{"system"}, teams)
 	})
 
 	Describe("HasToken", func() {
@@ -190,7 +191,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+			access = accessor.NewAccessor(verification, requiredRole, action, "sub", []string{"system"}, teams)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -236,7 +237,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+			access = accessor.NewAccessor(verification, requiredRole, action, "sub", []string{"system"}, teams)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -262,6 +263,181 @@ var _ = Describe("Accessor", func() {
 		Entry("owner attempting owner action", "owner", "owner", true),
 	)
 
+	DescribeTable("IsAuthorized for custom roles",
+		func(actionName string, pipelineName string, requiredRole string, expected bool) {
+
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+			}
+
+			fakeTeam1.NameReturns("some-team")
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"releaser": map[string][]string{
+					"users": []string{"some-connector:some-user-id"},
+				},
+			})
+			fakeTeam1.RolesReturns(atc.TeamRoles{
+				{
+					Name:      "releaser",
+					Actions:   []string{atc.CreateJobBuild, atc.PinResourceVersion, atc.ViewerRole},
+					Pipelines: []string{"release-*"},
+				},
+			})
+
+			action = accessor.Action{Name: actionName, PipelineName: pipelineName}
+
+			access = accessor.NewAccessor(verification, requiredRole, action, "sub", []string{"system"}, teams)
+			result := access.IsAuthorized("some-team")
+			Expect(expected).Should(Equal(result))
+		},
+
+		Entry("triggering a job of a matching pipeline", atc.CreateJobBuild, "release-1.0", "member", true),
+		Entry("pinning a resource of a matching pipeline", atc.PinResourceVersion, "release-1.0", "pipeline-operator", true),
+		Entry("viewing a matching pipeline through a built-in role", atc.GetPipeline, "release-1.0", "viewer", true),
+		Entry("saving the config of a matching pipeline", atc.SaveConfig, "release-1.0", "member", false),
+		Entry("unpausing a matching pipeline", atc.UnpausePipeline, "release-1.0", "pipeline-operator", false),
+		Entry("triggering a job of another pipeline", atc.CreateJobBuild, "some-pipeline", "member", false),
+		Entry("acting on the team rather than a pipeline", atc.ListPipelines, "", "viewer", false),
+	)
+
+	Context("when a user has a role the team does not define", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+			}
+
+			fakeTeam1.NameReturns("some-team")
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"releaser": map[string][]string{
+					"users": []string{"some-connector:some-user-id"},
+				},
+			})
+
+			requiredRole = "viewer"
+			action = accessor.Action{Name: atc.GetPipeline, PipelineName: "some-pipeline"}
+		})
+
+		It("is not authorized", func() {
+			Expect(access.IsAuthorized("some-team")).To(BeFalse())
+		})
+	})
+
+	Context("when the request was made with an API token", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"sub": "some-sub",
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+				"api_token": map[string]interface{}{
+					"team": "some-team-1",
+					"role": "viewer",
+				},
+			}
+
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"owner": map[string][]string{
+					"users": []string{"some-connector:some-user-id"},
+				},
+			})
+			fakeTeam2.AuthReturns(atc.TeamAuth{
+				"owner": map[string][]string{
+					"users": []string{"some-connector:some-user-id"},
+				},
+			})
+		})
+
+		It("only has the token's role on the token's team", func() {
+			Expect(access.TeamRoles()).To(Equal(map[string][]string{
+				"some-team-1": []string{"viewer"},
+			}))
+		})
+
+		Context("when the action needs a role above the token's", func() {
+			BeforeEach(func() {
+				requiredRole = "member"
+			})
+
+			It("is not authorized", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+			})
+		})
+
+		Context("when the action needs the token's role", func() {
+			BeforeEach(func() {
+				requiredRole = "viewer"
+			})
+
+			It("is authorized on the token's team only", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+				Expect(access.IsAuthorized("some-team-2")).To(BeFalse())
+			})
+		})
+
+		Context("when the team's auth grants the user the role through a group", func() {
+			BeforeEach(func() {
+				verification.RawClaims["groups"] = []interface{}{"some-group"}
+
+				fakeTeam1.AuthReturns(atc.TeamAuth{
+					"member": map[string][]string{
+						"groups": []string{"some-connector:some-group"},
+					},
+				})
+			})
+
+			It("keeps the token's role", func() {
+				Expect(access.TeamRoles()).To(Equal(map[string][]string{
+					"some-team-1": []string{"viewer"},
+				}))
+			})
+		})
+
+		Context("when the team's auth no longer grants the user a role covering the token's", func() {
+			BeforeEach(func() {
+				fakeTeam1.AuthReturns(atc.TeamAuth{
+					"owner": map[string][]string{
+						"users": []string{"some-connector:some-other-user-id"},
+					},
+					"releaser": map[string][]string{
+						"users": []string{"some-connector:some-user-id"},
+					},
+				})
+			})
+
+			It("has no role on the token's team", func() {
+				Expect(access.TeamRoles()).To(BeEmpty())
+			})
+		})
+
+		Context("when the token belongs to a service account", func() {
+			BeforeEach(func() {
+				verification.RawClaims["federated_claims"] = map[string]interface{}{
+					"connector_id": "service-account",
+					"user_name":    "ci-bot",
+				}
+			})
+
+			It("has the token's role on the token's team", func() {
+				Expect(access.TeamRoles()).To(Equal(map[string][]string{
+					"some-team-1": []string{"viewer"},
+				}))
+			})
+		})
+	})
+
 	Describe("TeamNames", func() {
 		var result []string
 
@@ -551,6 +727,63 @@ var _ = Describe("Accessor", func() {
 		})
 	})
 
+	Describe("IsAPIToken", func() {
+		var result bool
+
+		JustBeforeEach(func() {
+			result = access.IsAPIToken()
+		})
+
+		Context("when the token is a user's login", func() {
+			BeforeEach(func() {
+				verification.HasToken = true
+				verification.IsTokenValid = true
+				verification.RawClaims = map[string]interface{}{
+					"sub": "some-sub",
+				}
+			})
+
+			It("returns false", func() {
+				Expect(result).To(BeFalse())
+			})
+		})
+
+		Context("when the token is an API token", func() {
+			BeforeEach(func() {
+				verification.HasToken = true
+				verification.IsTokenValid = true
+				verification.RawClaims = map[string]interface{}{
+					"sub": "some-sub",
+					"api_token": map[string]interface{}{
+						"team": "some-team-1",
+						"role": "viewer",
+					},
+				}
+			})
+
+			It("returns true", func() {
+				Expect(result).To(BeTrue())
+			})
+		})
+
+		Context("when the token is invalid", func() {
+			BeforeEach(func() {
+				verification.HasToken = true
+				verification.IsTokenValid = false
+				verification.RawClaims = map[string]interface{}{
+					"api_token": map[string]interface{}{
+						"team": "some-team-1",
+						"role": "viewer",
+					},
+				}
+			})
+
+			It("returns false", func() {
+				Expect(result).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Claims", func() {
 		var result accessor.Claims
 
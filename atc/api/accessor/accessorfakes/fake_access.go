@@ -28,6 +28,16 @@ type FakeAccess struct {
 	hasTokenReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsAPITokenStub        func() bool
+	isAPITokenMutex       sync.RWMutex
+	isAPITokenArgsForCall []struct {
+	}
+	isAPITokenReturns struct {
+		result1 bool
+	}
+	isAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsAdminStub        func() bool
 	isAdminMutex       sync.RWMutex
 	isAdminArgsForCall []struct {
@@ -197,6 +207,58 @@ func (fake *FakeAccess) HasTokenReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) IsAPIToken() bool {
+	fake.isAPITokenMutex.Lock()
+	ret, specificReturn := fake.isAPITokenReturnsOnCall[len(fake.isAPITokenArgsForCall)]
+	fake.isAPITokenArgsForCall = append(fake.isAPITokenArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsAPIToken", []interface{}{})
+	fake.isAPITokenMutex.Unlock()
+	if fake.IsAPITokenStub != nil {
+		return fake.IsAPITokenStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isAPITokenReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsAPITokenCallCount() int {
+	fake.isAPITokenMutex.RLock()
+	defer fake.isAPITokenMutex.RUnlock()
+	return len(fake.isAPITokenArgsForCall)
+}
+
+func (fake *FakeAccess) IsAPITokenCalls(stub func() bool) {
+	fake.isAPITokenMutex.Lock()
+	defer fake.isAPITokenMutex.Unlock()
+	fake.IsAPITokenStub = stub
+}
+
+func (fake *FakeAccess) IsAPITokenReturns(result1 bool) {
+	fake.isAPITokenMutex.Lock()
+	defer fake.isAPITokenMutex.Unlock()
+	fake.IsAPITokenStub = nil
+	fake.isAPITokenReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsAPITokenReturnsOnCall(i int, result1 bool) {
+	fake.isAPITokenMutex.Lock()
+	defer fake.isAPITokenMutex.Unlock()
+	fake.IsAPITokenStub = nil
+	if fake.isAPITokenReturnsOnCall == nil {
+		fake.isAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) IsAdmin() bool {
 	fake.isAdminMutex.Lock()
 	ret, specificReturn := fake.isAdminReturnsOnCall[len(fake.isAdminArgsForCall)]
@@ -524,6 +586,8 @@ func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	defer fake.claimsMutex.RUnlock()
 	fake.hasTokenMutex.RLock()
 	defer fake.hasTokenMutex.RUnlock()
+	fake.isAPITokenMutex.RLock()
+	defer fake.isAPITokenMutex.RUnlock()
 	fake.isAdminMutex.RLock()
 	defer fake.isAdminMutex.RUnlock()
 	fake.isAuthenticatedMutex.RLock()
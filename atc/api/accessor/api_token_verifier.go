@@ -0,0 +1,95 @@
+package accessor
+
+import (
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// APITokenClaim holds the team and role of the API token a request was made
+// with.
+const APITokenClaim = "api_token"
+
+// ServiceAccountConnector is the connector of the claims of a service
+// account's API token.
+const ServiceAccountConnector = "service-account"
+
+func NewAPITokenVerifier(
+	logger lager.Logger,
+	apiTokenFactory db.APITokenFactory,
+	verifier TokenVerifier,
+) TokenVerifier {
+	return &apiTokenVerifier{
+		logger:          logger,
+		apiTokenFactory: apiTokenFactory,
+		verifier:        verifier,
+	}
+}
+
+// apiTokenVerifier verifies API tokens against the db, leaving any other
+// token to the verifier it wraps.
+type apiTokenVerifier struct {
+	logger          lager.Logger
+	apiTokenFactory db.APITokenFactory
+	verifier        TokenVerifier
+}
+
+func (v *apiTokenVerifier) Verify(r *http.Request) (map[string]interface{}, error) {
+	parts := strings.Split(r.Header.Get("Authorization"), " ")
+	if len(parts) != 2 || !strings.EqualFold(parts[0], "bearer") || !atc.IsAPIToken(parts[1]) {
+		return v.verifier.Verify(r)
+	}
+
+	token, found, err := v.apiTokenFactory.UseAPIToken(parts[1])
+	if err != nil {
+		v.logger.Error("failed-to-find-api-token", err)
+		return nil, ErrVerificationFailed
+	}
+
+	if !found {
+		return nil, ErrVerificationInvalidToken
+	}
+
+	return apiTokenClaims(token), nil
+}
+
+func apiTokenClaims(token db.APIToken) map[string]interface{} {
+	tokenClaim := map[string]interface{}{
+		"team": token.TeamName(),
+		"role": token.Role(),
+	}
+
+	if token.ServiceAccount() != "" {
+		return map[string]interface{}{
+			"sub":  ServiceAccountConnector + ":" + token.TeamName() + ":" + token.ServiceAccount(),
+			"name": token.ServiceAccount(),
+			"federated_claims": map[string]interface{}{
+				"connector_id": ServiceAccountConnector,
+				"user_name":    token.ServiceAccount(),
+			},
+			APITokenClaim: tokenClaim,
+		}
+	}
+
+	// the user's id and groups are what the team's auth is matched against
+	// when capping the token's role
+	groups := []interface{}{}
+	for _, group := range token.UserGroups() {
+		groups = append(groups, group)
+	}
+
+	return map[string]interface{}{
+		"sub":    token.UserSub(),
+		"name":   token.UserName(),
+		"groups": groups,
+		"federated_claims": map[string]interface{}{
+			"connector_id": token.UserConnector(),
+			"user_id":      token.UserID(),
+			"user_name":    token.UserName(),
+		},
+		APITokenClaim: tokenClaim,
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/auditor"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
 )
 
 //go:generate counterfeiter net/http.Handler
@@ -14,7 +15,7 @@ import (
 //go:generate counterfeiter . AccessFactory
 
 type AccessFactory interface {
-	Create(string, Verification, []db.Team) Access
+	Create(string, Action, Verification, []db.Team) Access
 }
 
 //go:generate counterfeiter . TokenVerifier
@@ -85,7 +86,12 @@ func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		requiredRole = DefaultRoles[h.action]
 	}
 
-	acc := h.accessFactory.Create(requiredRole, h.verifyToken(r), teams)
+	action := Action{
+		Name:         h.action,
+		PipelineName: rata.Param(r, "pipeline_name"),
+	}
+
+	acc := h.accessFactory.Create(requiredRole, action, h.verifyToken(r), teams)
 
 	claims := acc.Claims()
 
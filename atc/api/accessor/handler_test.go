@@ -103,10 +103,25 @@ var _ = Describe("Handler", func() {
 
 			It("creates an accessor with the given teams", func() {
 				Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-				_, _, teams := fakeAccessorFactory.CreateArgsForCall(0)
+				_, _, _, teams := fakeAccessorFactory.CreateArgsForCall(0)
 				Expect(teams).To(Equal(fakeTeams))
 			})
 
+			Context("when the route is for a pipeline", func() {
+				BeforeEach(func() {
+					r.URL.RawQuery = ":pipeline_name=some-pipeline"
+				})
+
+				It("creates an accessor for the action on the pipeline", func() {
+					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
+					_, acc, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					Expect(acc).To(Equal(accessor.Action{
+						Name:         "some-action",
+						PipelineName: "some-pipeline",
+					}))
+				})
+			})
+
 			Context("when there's a default role for the given action", func() {
 				BeforeEach(func() {
 					action = atc.SaveConfig
@@ -119,7 +134,7 @@ var _ = Describe("Handler", func() {
 
 					It("finds the role", func() {
 						Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-						role, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+						role, _, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
 						Expect(role).To(Equal(accessor.MemberRole))
 					})
 				})
@@ -133,7 +148,7 @@ var _ = Describe("Handler", func() {
 
 					It("finds the role", func() {
 						Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-						role, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+						role, _, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
 						Expect(role).To(Equal(accessor.ViewerRole))
 					})
 				})
@@ -151,7 +166,7 @@ var _ = Describe("Handler", func() {
 
 					It("sends a blank role (admin roles don't have defaults)", func() {
 						Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-						role, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
+						role, _, _, _ := fakeAccessorFactory.CreateArgsForCall(0)
 						Expect(role).To(BeEmpty())
 					})
 				})
@@ -164,7 +179,7 @@ var _ = Describe("Handler", func() {
 
 				It("creates an accessor with a verification result that has no token", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(verification.HasToken).To(BeFalse())
 					Expect(verification.IsTokenValid).To(BeFalse())
 				})
@@ -177,7 +192,7 @@ var _ = Describe("Handler", func() {
 
 				It("creates an accessor with a verification result that has an invalid token", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(verification.HasToken).To(BeTrue())
 					Expect(verification.IsTokenValid).To(BeFalse())
 				})
@@ -196,7 +211,7 @@ var _ = Describe("Handler", func() {
 
 				It("creates an accessor with a successful verification", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, verification, _ := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(verification.HasToken).To(BeTrue())
 					Expect(verification.IsTokenValid).To(BeTrue())
 					Expect(verification.RawClaims).To(Equal(claims))
@@ -5,15 +5,18 @@ import (
 )
 
 const (
-	MemberRole   = "member"
-	OwnerRole    = "owner"
-	OperatorRole = "pipeline-operator"
-	ViewerRole   = "viewer"
+	MemberRole   = atc.MemberRole
+	OwnerRole    = atc.OwnerRole
+	OperatorRole = atc.OperatorRole
+	ViewerRole   = atc.ViewerRole
 )
 
 var DefaultRoles = map[string]string{
 	atc.SaveConfig:                    MemberRole,
 	atc.GetConfig:                     ViewerRole,
+	atc.ListConfigVersions:            ViewerRole,
+	atc.GetConfigVersion:              ViewerRole,
+	atc.DiffConfigVersions:            ViewerRole,
 	atc.GetCC:                         ViewerRole,
 	atc.GetBuild:                      ViewerRole,
 	atc.GetCheck:                      ViewerRole,
@@ -24,6 +27,7 @@ var DefaultRoles = map[string]string{
 	atc.BuildResources:                ViewerRole,
 	atc.AbortBuild:                    OperatorRole,
 	atc.GetBuildPreparation:           ViewerRole,
+	atc.ListBuildNotifications:        ViewerRole,
 	atc.GetJob:                        ViewerRole,
 	atc.CreateJobBuild:                OperatorRole,
 	atc.RerunJobBuild:                 OperatorRole,
@@ -31,6 +35,7 @@ var DefaultRoles = map[string]string{
 	atc.ListJobs:                      ViewerRole,
 	atc.ListJobBuilds:                 ViewerRole,
 	atc.ListJobInputs:                 ViewerRole,
+	atc.GetJobTaskPlan:                ViewerRole,
 	atc.GetJobBuild:                   ViewerRole,
 	atc.PauseJob:                      OperatorRole,
 	atc.UnpauseJob:                    OperatorRole,
@@ -73,6 +78,8 @@ var DefaultRoles = map[string]string{
 	atc.RegisterWorker:                MemberRole,
 	atc.LandWorker:                    MemberRole,
 	atc.RetireWorker:                  MemberRole,
+	atc.CordonWorker:                  MemberRole,
+	atc.UncordonWorker:                MemberRole,
 	atc.PruneWorker:                   MemberRole,
 	atc.HeartbeatWorker:               MemberRole,
 	atc.ListWorkers:                   ViewerRole,
@@ -85,6 +92,8 @@ var DefaultRoles = map[string]string{
 	atc.ListContainers:                ViewerRole,
 	atc.GetContainer:                  ViewerRole,
 	atc.HijackContainer:               MemberRole,
+	atc.RetainBuildContainers:         MemberRole,
+	atc.ReleaseBuildContainers:        MemberRole,
 	atc.ListDestroyingContainers:      ViewerRole,
 	atc.ReportWorkerContainers:        MemberRole,
 	atc.ListVolumes:                   ViewerRole,
@@ -96,8 +105,12 @@ var DefaultRoles = map[string]string{
 	atc.RenameTeam:                    OwnerRole,
 	atc.DestroyTeam:                   OwnerRole,
 	atc.ListTeamBuilds:                ViewerRole,
+	atc.ListAPITokens:                 ViewerRole,
+	atc.CreateAPIToken:                ViewerRole,
+	atc.RevokeAPIToken:                ViewerRole,
 	atc.CreateArtifact:                MemberRole,
 	atc.GetArtifact:                   MemberRole,
 	atc.ListBuildArtifacts:            ViewerRole,
 	atc.GetWall:                       ViewerRole,
+	atc.GetTeamQuota:                  ViewerRole,
 }
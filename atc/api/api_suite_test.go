@@ -51,9 +51,13 @@ var (
 	build                   *dbfakes.FakeBuild
 	dbBuildFactory          *dbfakes.FakeBuildFactory
 	dbUserFactory           *dbfakes.FakeUserFactory
+	dbNotificationFactory   *dbfakes.FakeBuildNotificationFactory
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
+	dbAuditEventRepository  *dbfakes.FakeAuditEventRepository
+	dbQuotaRepository       *dbfakes.FakeQuotaRepository
+	dbBuildQueue            *dbfakes.FakeBuildQueue
 	fakeSecretManager       *credsfakes.FakeSecrets
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	credsManagers           creds.Managers
@@ -98,8 +102,12 @@ var _ = BeforeEach(func() {
 	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 	dbUserFactory = new(dbfakes.FakeUserFactory)
+	dbNotificationFactory = new(dbfakes.FakeBuildNotificationFactory)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
+	dbAuditEventRepository = new(dbfakes.FakeAuditEventRepository)
+	dbQuotaRepository = new(dbfakes.FakeQuotaRepository)
+	dbBuildQueue = new(dbfakes.FakeBuildQueue)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
@@ -179,6 +187,7 @@ var _ = BeforeEach(func() {
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		dbUserFactory,
+		dbNotificationFactory,
 
 		constructedEventHandler.Construct,
 
@@ -197,6 +206,9 @@ var _ = BeforeEach(func() {
 		interceptTimeoutFactory,
 		time.Second,
 		dbWall,
+		dbAuditEventRepository,
+		dbQuotaRepository,
+		dbBuildQueue,
 		fakeClock,
 
 		true, /* enableArchivePipeline */
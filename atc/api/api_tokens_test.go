@@ -0,0 +1,362 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("API Tokens API", func() {
+	var (
+		requestGenerator *rata.RequestGenerator
+		fakeTeam         *dbfakes.FakeTeam
+
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		requestGenerator = rata.NewRequestGenerator(server.URL, atc.Routes)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.NameReturns("a-team")
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+		fakeAccess.IsAuthenticatedReturns(true)
+		fakeAccess.IsAuthorizedReturns(true)
+		fakeAccess.ClaimsReturns(accessor.Claims{
+			Sub:       "some-sub",
+			UserID:    "some-user-id",
+			UserName:  "some-user",
+			Connector: "github",
+			Groups:    []string{"some-org:some-team"},
+		})
+	})
+
+	request := func(name string, params rata.Params, body interface{}) {
+		params["team_name"] = "a-team"
+
+		payload, err := json.Marshal(body)
+		Expect(err).NotTo(HaveOccurred())
+
+		req, err := requestGenerator.CreateRequest(name, params, bytes.NewBuffer(payload))
+		Expect(err).NotTo(HaveOccurred())
+
+		response, err = client.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Describe("GET /api/v1/teams/:team_name/tokens", func() {
+		JustBeforeEach(func() {
+			request(atc.ListAPITokens, rata.Params{}, nil)
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when the team has tokens", func() {
+			BeforeEach(func() {
+				userToken := new(dbfakes.FakeAPIToken)
+				userToken.NameReturns("laptop")
+				userToken.TeamNameReturns("a-team")
+				userToken.RoleReturns("viewer")
+				userToken.UserNameReturns("some-user")
+				userToken.UserConnectorReturns("github")
+				userToken.CreatedAtReturns(time.Unix(100, 0))
+				userToken.ExpiresAtReturns(time.Unix(300, 0))
+
+				serviceAccountToken := new(dbfakes.FakeAPIToken)
+				serviceAccountToken.NameReturns("ci")
+				serviceAccountToken.TeamNameReturns("a-team")
+				serviceAccountToken.RoleReturns("member")
+				serviceAccountToken.ServiceAccountReturns("ci-bot")
+				serviceAccountToken.CreatedAtReturns(time.Unix(100, 0))
+				serviceAccountToken.ExpiresAtReturns(time.Unix(300, 0))
+				serviceAccountToken.LastUsedAtReturns(time.Unix(200, 0))
+
+				fakeTeam.APITokensReturns([]db.APIToken{serviceAccountToken, userToken}, nil)
+			})
+
+			Context("when the user owns the team", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{"a-team": {"owner"}})
+				})
+
+				It("returns them all without their secrets", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).Should(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[
+						{"name": "ci", "team_name": "a-team", "role": "member", "service_account": "ci-bot", "created_at": 100, "expires_at": 300, "last_used_at": 200},
+						{"name": "laptop", "team_name": "a-team", "role": "viewer", "user": "github:some-user", "created_at": 100, "expires_at": 300}
+					]`))
+				})
+			})
+
+			Context("when the user does not own the team", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{"a-team": {"viewer"}})
+				})
+
+				It("only returns the user's own tokens", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[
+						{"name": "laptop", "team_name": "a-team", "role": "viewer", "user": "github:some-user", "created_at": 100, "expires_at": 300}
+					]`))
+				})
+			})
+		})
+
+		Context("when getting the tokens fails", func() {
+			BeforeEach(func() {
+				fakeTeam.APITokensReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/tokens", func() {
+		var requested atc.APIToken
+
+		BeforeEach(func() {
+			requested = atc.APIToken{
+				Name:      "laptop",
+				Role:      "member",
+				ExpiresAt: time.Now().Add(time.Hour).Unix(),
+			}
+
+			fakeAccess.TeamRolesReturns(map[string][]string{"a-team": {"member"}})
+
+			createdToken := new(dbfakes.FakeAPIToken)
+			createdToken.NameReturns("laptop")
+			createdToken.TeamNameReturns("a-team")
+			createdToken.RoleReturns("member")
+			fakeTeam.CreateAPITokenReturns(createdToken, "concourse_some-secret", nil)
+		})
+
+		JustBeforeEach(func() {
+			request(atc.CreateAPIToken, rata.Params{}, requested)
+		})
+
+		Context("when creating a token for the user", func() {
+			It("returns 201 with the secret", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+				var token atc.APIToken
+				Expect(json.NewDecoder(response.Body).Decode(&token)).To(Succeed())
+				Expect(token.Name).To(Equal("laptop"))
+				Expect(token.Token).To(Equal("concourse_some-secret"))
+			})
+
+			It("creates the token for the user", func() {
+				Expect(fakeTeam.CreateAPITokenCallCount()).To(Equal(1))
+
+				spec := fakeTeam.CreateAPITokenArgsForCall(0)
+				Expect(spec.Name).To(Equal("laptop"))
+				Expect(spec.Role).To(Equal("member"))
+				Expect(spec.UserName).To(Equal("some-user"))
+				Expect(spec.UserConnector).To(Equal("github"))
+				Expect(spec.UserSub).To(Equal("some-sub"))
+				Expect(spec.UserID).To(Equal("some-user-id"))
+				Expect(spec.UserGroups).To(Equal([]string{"some-org:some-team"}))
+				Expect(spec.ServiceAccount).To(BeEmpty())
+			})
+
+			Context("when the role is below the user's", func() {
+				BeforeEach(func() {
+					requested.Role = "viewer"
+				})
+
+				It("returns 201", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				})
+			})
+
+			Context("when the role is above the user's", func() {
+				BeforeEach(func() {
+					requested.Role = "owner"
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the role is not one of the team's", func() {
+				BeforeEach(func() {
+					requested.Role = "releaser"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal("team 'a-team' has no role 'releaser'"))
+				})
+			})
+
+			Context("when the token has already expired", func() {
+				BeforeEach(func() {
+					requested.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when a token with the name already exists", func() {
+				BeforeEach(func() {
+					fakeTeam.CreateAPITokenReturns(nil, "", db.ErrAPITokenAlreadyExists)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+		})
+
+		Context("when creating a token for a service account", func() {
+			BeforeEach(func() {
+				requested.ServiceAccount = "ci-bot"
+			})
+
+			Context("when the user owns the team", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{"a-team": {"owner"}})
+				})
+
+				It("creates the token for the service account", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+					spec := fakeTeam.CreateAPITokenArgsForCall(0)
+					Expect(spec.ServiceAccount).To(Equal("ci-bot"))
+					Expect(spec.UserName).To(BeEmpty())
+				})
+			})
+
+			Context("when the user is only a member", func() {
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the request was made with an owner's API token", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{"a-team": {"owner"}})
+					fakeAccess.IsAPITokenReturns(true)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the request was made with an API token", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAPITokenReturns(true)
+			})
+
+			It("returns 403 without creating a token", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.CreateAPITokenCallCount()).To(BeZero())
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(body)).To(Equal("api tokens can only be created by logging in"))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/tokens/:token_name", func() {
+		var fakeToken *dbfakes.FakeAPIToken
+
+		BeforeEach(func() {
+			fakeToken = new(dbfakes.FakeAPIToken)
+			fakeToken.UserNameReturns("other-user")
+			fakeToken.UserConnectorReturns("github")
+			fakeTeam.APITokenReturns(fakeToken, true, nil)
+
+			fakeAccess.TeamRolesReturns(map[string][]string{"a-team": {"member"}})
+		})
+
+		JustBeforeEach(func() {
+			request(atc.RevokeAPIToken, rata.Params{"token_name": "laptop"}, nil)
+		})
+
+		It("looks up the token by name", func() {
+			Expect(fakeTeam.APITokenArgsForCall(0)).To(Equal("laptop"))
+		})
+
+		Context("when the token belongs to the user", func() {
+			BeforeEach(func() {
+				fakeToken.UserNameReturns("some-user")
+			})
+
+			It("revokes it", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(fakeToken.RevokeCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the token belongs to someone else", func() {
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeToken.RevokeCallCount()).To(BeZero())
+			})
+
+			Context("when the user owns the team", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{"a-team": {"owner"}})
+				})
+
+				It("revokes it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(fakeToken.RevokeCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				fakeTeam.APITokenReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+})
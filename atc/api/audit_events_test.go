@@ -0,0 +1,234 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Events API", func() {
+	var (
+		response *http.Response
+		query    url.Values
+	)
+
+	BeforeEach(func() {
+		query = url.Values{}
+	})
+
+	Describe("GET /api/v1/audit-events", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/audit-events", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.URL.RawQuery = query.Encode()
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not look up any events", func() {
+				Expect(dbAuditEventRepository.AuditEventsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbAuditEventRepository.AuditEventsReturns([]atc.AuditEvent{
+					{
+						ID:           2,
+						Time:         1594719003,
+						Action:       atc.PauseJob,
+						UserName:     "some-user",
+						TeamName:     "some-team",
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						SourceIP:     "10.0.0.1",
+					},
+					{
+						ID:       1,
+						Time:     1594719000,
+						Action:   atc.SetLogLevel,
+						UserName: "some-user",
+					},
+				}, db.Pagination{}, nil)
+			})
+
+			It("returns 200 with the events", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 2,
+						"time": 1594719003,
+						"action": "PauseJob",
+						"user_name": "some-user",
+						"team_name": "some-team",
+						"pipeline_name": "some-pipeline",
+						"job_name": "some-job",
+						"source_ip": "10.0.0.1"
+					},
+					{
+						"id": 1,
+						"time": 1594719000,
+						"action": "SetLogLevel",
+						"user_name": "some-user"
+					}
+				]`))
+			})
+
+			It("lists every team's events with the default page", func() {
+				Expect(dbAuditEventRepository.AuditEventsCallCount()).To(Equal(1))
+
+				filter, page := dbAuditEventRepository.AuditEventsArgsForCall(0)
+				Expect(filter).To(Equal(db.AuditEventFilter{}))
+				Expect(page).To(Equal(db.Page{Limit: atc.PaginationAPIDefaultLimit}))
+			})
+
+			Context("when filters are given", func() {
+				BeforeEach(func() {
+					query.Set("team", "some-team")
+					query.Set("user", "some-user")
+					query.Set("action", atc.PauseJob)
+					query.Set("pipeline", "some-pipeline")
+					query.Set("job", "some-job")
+					query.Set("resource", "some-resource")
+					query.Set("build", "42")
+					query.Set("from", "1594719000")
+					query.Set("to", "1594719003")
+					query.Set("since", "10")
+					query.Set("limit", "2")
+				})
+
+				It("filters and pages the events", func() {
+					filter, page := dbAuditEventRepository.AuditEventsArgsForCall(0)
+					Expect(filter).To(Equal(db.AuditEventFilter{
+						TeamName:     "some-team",
+						UserName:     "some-user",
+						Action:       atc.PauseJob,
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						ResourceName: "some-resource",
+						BuildID:      42,
+						From:         time.Unix(1594719000, 0),
+						To:           time.Unix(1594719003, 0),
+					}))
+					Expect(page).To(Equal(db.Page{Since: 10, Limit: 2}))
+				})
+			})
+
+			Context("when the build is not a number", func() {
+				BeforeEach(func() {
+					query.Set("build", "nope")
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when a timestamp is not a number", func() {
+				BeforeEach(func() {
+					query.Set("from", "yesterday")
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when there are more pages", func() {
+				BeforeEach(func() {
+					query.Set("team", "some-team")
+
+					dbAuditEventRepository.AuditEventsReturns([]atc.AuditEvent{}, db.Pagination{
+						Previous: &db.Page{Until: 4, Limit: 2},
+						Next:     &db.Page{Since: 3, Limit: 2},
+					}, nil)
+				})
+
+				It("links to them, keeping the filters", func() {
+					Expect(response.Header["Link"]).To(ConsistOf([]string{
+						`<https://example.com/api/v1/audit-events?limit=2&since=3&team=some-team>; rel="next"`,
+						`<https://example.com/api/v1/audit-events?limit=2&team=some-team&until=4>; rel="previous"`,
+					}))
+				})
+			})
+
+			Context("when getting the events fails", func() {
+				BeforeEach(func() {
+					dbAuditEventRepository.AuditEventsReturns(nil, db.Pagination{}, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when authenticated as a team owner", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.TeamRolesReturns(map[string][]string{
+					"some-team":  {"owner"},
+					"other-team": {"member"},
+				})
+			})
+
+			Context("when filtering by the team", func() {
+				BeforeEach(func() {
+					query.Set("team", "some-team")
+				})
+
+				It("returns 200 with the team's events", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					filter, _ := dbAuditEventRepository.AuditEventsArgsForCall(0)
+					Expect(filter.TeamName).To(Equal("some-team"))
+				})
+			})
+
+			Context("when filtering by a team they are not an owner of", func() {
+				BeforeEach(func() {
+					query.Set("team", "other-team")
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbAuditEventRepository.AuditEventsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when not filtering by team", func() {
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbAuditEventRepository.AuditEventsCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,132 @@
+package auditserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ListAuditEvents lists the audit events matching the request's filters.
+// Admins can see every event; team owners can only see their team's events,
+// and so must filter by team.
+func (s *Server) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-audit-events")
+
+	filter := db.AuditEventFilter{
+		TeamName:     r.FormValue("team"),
+		UserName:     r.FormValue("user"),
+		Action:       r.FormValue("action"),
+		PipelineName: r.FormValue("pipeline"),
+		JobName:      r.FormValue("job"),
+		ResourceName: r.FormValue("resource"),
+	}
+
+	var err error
+	if r.FormValue("build") != "" {
+		filter.BuildID, err = strconv.Atoi(r.FormValue("build"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid build id '%s'", r.FormValue("build"))
+			return
+		}
+	}
+
+	for param, t := range map[string]*time.Time{
+		atc.PaginationQueryFrom: &filter.From,
+		atc.PaginationQueryTo:   &filter.To,
+	} {
+		if r.FormValue(param) == "" {
+			continue
+		}
+
+		unix, err := strconv.ParseInt(r.FormValue(param), 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid '%s' timestamp '%s'", param, r.FormValue(param))
+			return
+		}
+
+		*t = time.Unix(unix, 0)
+	}
+
+	acc := accessor.GetAccessor(r)
+	if !acc.IsAdmin() && !isOwner(acc, filter.TeamName) {
+		logger.Info("not-admin-or-team-owner", lager.Data{"team": filter.TeamName})
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	until, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryUntil))
+	since, _ := strconv.Atoi(r.FormValue(atc.PaginationQuerySince))
+
+	limit, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+	if limit == 0 {
+		limit = atc.PaginationAPIDefaultLimit
+	}
+
+	events, pagination, err := s.auditEventRepository.AuditEvents(filter, db.Page{
+		Until: until,
+		Since: since,
+		Limit: limit,
+	})
+	if err != nil {
+		logger.Error("failed-to-get-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if pagination.Next != nil {
+		s.addLink(w, r.URL.Query(), atc.PaginationQuerySince, pagination.Next.Since, pagination.Next.Limit, atc.LinkRelNext)
+	}
+
+	if pagination.Previous != nil {
+		s.addLink(w, r.URL.Query(), atc.PaginationQueryUntil, pagination.Previous.Until, pagination.Previous.Limit, atc.LinkRelPrevious)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(events)
+	if err != nil {
+		logger.Error("failed-to-encode-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// addLink adds a link to another page of events, keeping the request's
+// filters.
+func (s *Server) addLink(w http.ResponseWriter, query url.Values, boundary string, id int, limit int, rel string) {
+	query.Del(atc.PaginationQuerySince)
+	query.Del(atc.PaginationQueryUntil)
+	query.Set(boundary, strconv.Itoa(id))
+	query.Set(atc.PaginationQueryLimit, strconv.Itoa(limit))
+
+	w.Header().Add("Link", fmt.Sprintf(
+		`<%s/api/v1/audit-events?%s>; rel="%s"`,
+		s.externalURL,
+		query.Encode(),
+		rel,
+	))
+}
+
+func isOwner(acc accessor.Access, teamName string) bool {
+	if teamName == "" {
+		return false
+	}
+
+	for _, role := range acc.TeamRoles()[teamName] {
+		if role == atc.OwnerRole {
+			return true
+		}
+	}
+
+	return false
+}
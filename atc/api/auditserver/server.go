@@ -0,0 +1,25 @@
+package auditserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger lager.Logger
+
+	externalURL          string
+	auditEventRepository db.AuditEventRepository
+}
+
+func NewServer(
+	logger lager.Logger,
+	externalURL string,
+	auditEventRepository db.AuditEventRepository,
+) *Server {
+	return &Server{
+		logger:               logger,
+		externalURL:          externalURL,
+		auditEventRepository: auditEventRepository,
+	}
+}
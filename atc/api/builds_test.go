@@ -1089,6 +1089,8 @@ var _ = Describe("Builds API", func() {
 					PausedPipeline:   db.BuildPreparationStatusNotBlocking,
 					PausedJob:        db.BuildPreparationStatusNotBlocking,
 					MaxRunningBuilds: db.BuildPreparationStatusBlocking,
+					TeamQuota:        db.BuildPreparationStatusNotBlocking,
+					ClusterQuota:     db.BuildPreparationStatusBlocking,
 					Inputs: map[string]db.BuildPreparationStatus{
 						"foo": db.BuildPreparationStatusNotBlocking,
 						"bar": db.BuildPreparationStatusBlocking,
@@ -1097,6 +1099,8 @@ var _ = Describe("Builds API", func() {
 					MissingInputReasons: db.MissingInputReasons{"some-input": "some-reason"},
 				}
 				dbBuildFactory.BuildReturns(build, true, nil)
+				build.IDReturns(42)
+				dbBuildQueue.PositionReturns(3, true, nil)
 				build.JobNameReturns("job1")
 				build.TeamNameReturns("some-team")
 				build.PreparationReturns(buildPrep, true, nil)
@@ -1206,6 +1210,11 @@ var _ = Describe("Builds API", func() {
 					Expect(build.PreparationCallCount()).To(Equal(1))
 				})
 
+				It("looks up the build's position in the queue", func() {
+					Expect(dbBuildQueue.PositionCallCount()).To(Equal(1))
+					Expect(dbBuildQueue.PositionArgsForCall(0)).To(Equal(42))
+				})
+
 				It("returns OK", func() {
 					Expect(response.StatusCode).To(Equal(http.StatusOK))
 				})
@@ -1226,6 +1235,8 @@ var _ = Describe("Builds API", func() {
 					"paused_pipeline": "not_blocking",
 					"paused_job": "not_blocking",
 					"max_running_builds": "blocking",
+					"team_quota": "not_blocking",
+					"cluster_quota": "blocking",
 					"inputs": {
 						"foo": "not_blocking",
 						"bar": "blocking"
@@ -1233,10 +1244,36 @@ var _ = Describe("Builds API", func() {
 					"inputs_satisfied": "blocking",
 					"missing_input_reasons": {
 						"some-input": "some-reason"
-					}
+					},
+					"queue_position": 3
 				}`))
 				})
 
+				Context("when the build is not queued", func() {
+					BeforeEach(func() {
+						dbBuildQueue.PositionReturns(0, false, nil)
+					})
+
+					It("leaves out the queue position", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						var prep map[string]interface{}
+						Expect(json.Unmarshal(body, &prep)).To(Succeed())
+						Expect(prep).ToNot(HaveKey("queue_position"))
+					})
+				})
+
+				Context("when looking up the queue position fails", func() {
+					BeforeEach(func() {
+						dbBuildQueue.PositionReturns(0, false, errors.New("ho ho ho merry festivus"))
+					})
+
+					It("returns 500 Internal Server Error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
 				Context("when the build preparation is not found", func() {
 					BeforeEach(func() {
 						dbBuildFactory.BuildReturns(build, true, nil)
@@ -1282,6 +1319,130 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/:build_id/notifications", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = http.Get(server.URL + "/api/v1/builds/42/notifications")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildReturns(build, true, nil)
+				build.IDReturns(42)
+				build.JobNameReturns("job1")
+				build.TeamNameReturns("some-team")
+				build.PipelineReturns(fakePipeline, true, nil)
+			})
+
+			Context("when not authenticated and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(false)
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("when authenticated", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when the notifications are found", func() {
+					BeforeEach(func() {
+						delivered := new(dbfakes.FakeBuildNotification)
+						delivered.IDReturns(1)
+						delivered.BuildIDReturns(42)
+						delivered.NameReturns("slack")
+						delivered.PreviousStatusReturns(db.BuildStatusSucceeded)
+						delivered.StatusReturns("delivered")
+						delivered.AttemptsReturns(1)
+						delivered.ResponseCodeReturns(200)
+						delivered.CreatedAtReturns(time.Unix(100, 0))
+						delivered.DeliveredAtReturns(time.Unix(101, 0))
+
+						failed := new(dbfakes.FakeBuildNotification)
+						failed.IDReturns(2)
+						failed.BuildIDReturns(42)
+						failed.NameReturns("pager")
+						failed.StatusReturns("failed")
+						failed.AttemptsReturns(5)
+						failed.ResponseCodeReturns(500)
+						failed.LastErrorReturns("unexpected response 500: oh no")
+						failed.CreatedAtReturns(time.Unix(100, 0))
+
+						dbNotificationFactory.BuildNotificationsReturns([]db.BuildNotification{delivered, failed}, nil)
+					})
+
+					It("looks up the build's notifications", func() {
+						Expect(dbNotificationFactory.BuildNotificationsCallCount()).To(Equal(1))
+						Expect(dbNotificationFactory.BuildNotificationsArgsForCall(0)).To(Equal(42))
+					})
+
+					It("returns 200 with the delivery log", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(response).Should(IncludeHeaderEntries(map[string]string{
+							"Content-Type": "application/json",
+						}))
+
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`[
+							{
+								"id": 1,
+								"build_id": 42,
+								"name": "slack",
+								"previous_status": "succeeded",
+								"status": "delivered",
+								"attempts": 1,
+								"response_code": 200,
+								"created_at": 100,
+								"delivered_at": 101
+							},
+							{
+								"id": 2,
+								"build_id": 42,
+								"name": "pager",
+								"status": "failed",
+								"attempts": 5,
+								"response_code": 500,
+								"last_error": "unexpected response 500: oh no",
+								"created_at": 100
+							}
+						]`))
+					})
+				})
+
+				Context("when looking up the notifications fails", func() {
+					BeforeEach(func() {
+						dbNotificationFactory.BuildNotificationsReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server Error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+
+		Context("when build is not found", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/plan", func() {
 		var plan *json.RawMessage
 
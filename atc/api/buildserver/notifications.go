@@ -0,0 +1,39 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ListBuildNotifications returns the delivery log of the build's
+// notifications.
+func (s *Server) ListBuildNotifications(build db.Build) http.Handler {
+	logger := s.logger.Session("list-build-notifications")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		notifications, err := s.notificationFactory.BuildNotifications(build.ID())
+		if err != nil {
+			logger.Error("failed-to-get-notifications", err, lager.Data{"build": build.ID()})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		presented := make([]atc.BuildNotification, 0, len(notifications))
+		for _, notification := range notifications {
+			presented = append(presented, present.BuildNotification(notification))
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-notifications", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -25,6 +25,13 @@ func (s *Server) GetBuildPreparation(build db.Build) http.Handler {
 			return
 		}
 
+		prep.QueuePosition, _, err = s.buildQueue.Position(build.ID())
+		if err != nil {
+			logger.Error("failed-to-get-queue-position", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		err = json.NewEncoder(w).Encode(present.BuildPreparation(prep))
 		if err != nil {
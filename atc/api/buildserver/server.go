@@ -17,6 +17,8 @@ type Server struct {
 
 	teamFactory         db.TeamFactory
 	buildFactory        db.BuildFactory
+	buildQueue          db.BuildQueue
+	notificationFactory db.BuildNotificationFactory
 	eventHandlerFactory EventHandlerFactory
 	rejector            auth.Rejector
 }
@@ -26,6 +28,8 @@ func NewServer(
 	externalURL string,
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
+	buildQueue db.BuildQueue,
+	notificationFactory db.BuildNotificationFactory,
 	eventHandlerFactory EventHandlerFactory,
 ) *Server {
 	return &Server{
@@ -35,6 +39,8 @@ func NewServer(
 
 		teamFactory:         teamFactory,
 		buildFactory:        buildFactory,
+		buildQueue:          buildQueue,
+		notificationFactory: notificationFactory,
 		eventHandlerFactory: eventHandlerFactory,
 
 		rejector: auth.UnauthorizedRejector{},
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/creds/noop"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -385,13 +386,24 @@ var _ = Describe("Config API", func() {
 						It("saves it initially paused", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							name, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
+							Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
 						})
 
+						Context("when the user is known", func() {
+							BeforeEach(func() {
+								fakeAccess.ClaimsReturns(accessor.Claims{UserName: "some-user"})
+							})
+
+							It("records who saved it", func() {
+								_, _, _, _, savedBy := dbTeam.SavePipelineArgsForCall(0)
+								Expect(savedBy).To(Equal(db.ConfigAuthor{UserName: "some-user"}))
+							})
+						})
+
 						Context("and saving it fails", func() {
 							BeforeEach(func() {
 								dbTeam.SavePipelineReturns(nil, false, errors.New("oh no!"))
@@ -406,6 +418,40 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("when a passed constraint names a job which does not exist", func() {
+							BeforeEach(func() {
+								dbTeam.SavePipelineReturns(nil, false, db.PassedJobNotFoundError{Job: "other-pipeline/some-job"})
+							})
+
+							It("returns 400 with the error", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+									"errors": [
+										"passed constraint references a job which does not exist in the team ('other-pipeline/some-job')"
+									]
+								}`))
+							})
+						})
+
+						Context("when a removed job is used in a passed constraint of another pipeline", func() {
+							BeforeEach(func() {
+								dbTeam.SavePipelineReturns(nil, false, db.PassedJobInUseError{
+									Job:           "some-job",
+									Pipeline:      "other-pipeline",
+									DownstreamJob: "other-job",
+								})
+							})
+
+							It("returns 400 with the error", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+									"errors": [
+										"job 'some-job' is used in a passed constraint of 'other-pipeline/other-job'"
+									]
+								}`))
+							})
+						})
+
 						Context("when it's the first time the pipeline has been created", func() {
 							BeforeEach(func() {
 								returnedPipeline := new(dbfakes.FakePipeline)
@@ -483,8 +529,8 @@ var _ = Describe("Config API", func() {
 						It("saves it initially paused", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-							Expect(name).To(Equal("a-pipeline"))
+							name, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
+							Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
@@ -530,8 +576,8 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-								Expect(name).To(Equal("a-pipeline"))
+								name, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
+								Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
 										{
@@ -800,8 +846,8 @@ jobs:
 									It("passes validation and saves it un-interpolated", func() {
 										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-										name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-										Expect(name).To(Equal("a-pipeline"))
+										name, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
+										Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 										Expect(id).To(Equal(db.ConfigVersion(42)))
 										Expect(initiallyPaused).To(BeTrue())
@@ -966,8 +1012,8 @@ jobs:
 					It("saves it", func() {
 						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-						name, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
-						Expect(name).To(Equal("a-pipeline"))
+						name, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
+						Expect(name).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
 								{
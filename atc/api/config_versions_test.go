@@ -0,0 +1,266 @@
+package api_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/testhelpers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Config Versions API", func() {
+	var (
+		requestGenerator *rata.RequestGenerator
+		fakeTeam         *dbfakes.FakeTeam
+		fakePipeline     *dbfakes.FakePipeline
+
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		requestGenerator = rata.NewRequestGenerator(server.URL, atc.Routes)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.NameReturns("a-team")
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.NameReturns("some-pipeline")
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+		fakeTeam.PipelineReturns(fakePipeline, true, nil)
+
+		fakePipeline.ConfigHistoryReturns([]db.PipelineConfigVersion{
+			{
+				Version: 3,
+				SavedBy: db.ConfigAuthor{BuildID: 42},
+				SavedAt: time.Unix(300, 0),
+			},
+			{
+				Version: 2,
+				SavedBy: db.ConfigAuthor{UserName: "some-user"},
+				SavedAt: time.Unix(200, 0),
+			},
+			{
+				Version: 1,
+				SavedAt: time.Unix(100, 0),
+			},
+		}, nil)
+
+		fakePipeline.ConfigAtVersionStub = func(version db.ConfigVersion) (db.PipelineConfigVersion, bool, error) {
+			if version < 1 || version > 3 {
+				return db.PipelineConfigVersion{}, false, nil
+			}
+
+			return db.PipelineConfigVersion{
+				Version: version,
+				Config: atc.Config{
+					Groups: atc.GroupConfigs{{Name: fmt.Sprintf("group-%d", version)}},
+				},
+				SavedAt: time.Unix(int64(version)*100, 0),
+			}, true, nil
+		}
+	})
+
+	request := func(name string, params rata.Params, query string) {
+		params["team_name"] = "a-team"
+		params["pipeline_name"] = "some-pipeline"
+
+		req, err := requestGenerator.CreateRequest(name, params, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		req.URL.RawQuery = query
+
+		response, err = client.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", func() {
+		JustBeforeEach(func() {
+			request(atc.ListConfigVersions, rata.Params{}, "")
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns the versions", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response).Should(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`[
+					{"version": 3, "saved_at": 300, "saved_by_build": 42},
+					{"version": 2, "saved_at": 200, "saved_by": "some-user"},
+					{"version": 1, "saved_at": 100}
+				]`))
+			})
+
+			Context("when getting the history fails", func() {
+				BeforeEach(func() {
+					fakePipeline.ConfigHistoryReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version", func() {
+		var configVersion string
+
+		BeforeEach(func() {
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAuthorizedReturns(true)
+
+			configVersion = "2"
+		})
+
+		JustBeforeEach(func() {
+			request(atc.GetConfigVersion, rata.Params{"config_version": configVersion}, "")
+		})
+
+		It("returns the version with its config", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(fakePipeline.ConfigAtVersionArgsForCall(0)).To(Equal(db.ConfigVersion(2)))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(`{
+				"version": 2,
+				"saved_at": 200,
+				"config": {"groups": [{"name": "group-2"}]}
+			}`))
+		})
+
+		Context("when the version is not found", func() {
+			BeforeEach(func() {
+				configVersion = "4"
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the version is malformed", func() {
+			BeforeEach(func() {
+				configVersion = "nope"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/diff", func() {
+		var (
+			query string
+			diff  atc.PipelineConfigDiff
+		)
+
+		BeforeEach(func() {
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAuthorizedReturns(true)
+
+			query = ""
+			diff = atc.PipelineConfigDiff{}
+		})
+
+		JustBeforeEach(func() {
+			request(atc.DiffConfigVersions, rata.Params{}, query)
+
+			if response.StatusCode == http.StatusOK {
+				Expect(json.NewDecoder(response.Body).Decode(&diff)).To(Succeed())
+			}
+		})
+
+		It("compares the latest version with the one before it", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(diff.To.Version).To(Equal(3))
+			Expect(diff.To.Config.Groups[0].Name).To(Equal("group-3"))
+			Expect(diff.From.Version).To(Equal(2))
+			Expect(diff.From.Config.Groups[0].Name).To(Equal("group-2"))
+		})
+
+		Context("when given a version to compare", func() {
+			BeforeEach(func() {
+				query = "to=2"
+			})
+
+			It("compares it with the one before it", func() {
+				Expect(diff.To.Version).To(Equal(2))
+				Expect(diff.From.Version).To(Equal(1))
+			})
+		})
+
+		Context("when given both versions", func() {
+			BeforeEach(func() {
+				query = "from=1&to=3"
+			})
+
+			It("compares them", func() {
+				Expect(diff.To.Version).To(Equal(3))
+				Expect(diff.From.Version).To(Equal(1))
+			})
+		})
+
+		Context("when comparing the first version", func() {
+			BeforeEach(func() {
+				query = "to=1"
+			})
+
+			It("compares it with an empty config", func() {
+				Expect(diff.To.Version).To(Equal(1))
+				Expect(diff.From.Version).To(BeZero())
+				Expect(diff.From.Config).To(Equal(&atc.Config{}))
+			})
+		})
+
+		Context("when the version is not in the history", func() {
+			BeforeEach(func() {
+				query = "from=7"
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the pipeline has no history", func() {
+			BeforeEach(func() {
+				fakePipeline.ConfigHistoryReturns([]db.PipelineConfigVersion{}, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+})
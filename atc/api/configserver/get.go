@@ -29,7 +29,16 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	pipeline, found, err := team.Pipeline(pipelineName)
+	instanceVars, err := atc.InstanceVarsFromQueryParams(r.URL.Query())
+	if err != nil {
+		logger.Error("malformed-instance-vars", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	pipelineRef := atc.PipelineRef{Name: pipelineName, InstanceVars: instanceVars}
+
+	pipeline, found, err := team.Pipeline(pipelineRef)
 	if err != nil {
 		logger.Error("failed-to-find-pipeline", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -37,13 +46,13 @@ func (s *Server) GetConfig(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if !found {
-		logger.Debug("pipeline-not-found", lager.Data{"pipeline": pipelineName})
+		logger.Debug("pipeline-not-found", lager.Data{"pipeline": pipelineRef.String()})
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
 
 	if pipeline.Archived() {
-		logger.Debug("pipeline-is-archived", lager.Data{"pipeline": pipelineName})
+		logger.Debug("pipeline-is-archived", lager.Data{"pipeline": pipelineRef.String()})
 		w.WriteHeader(http.StatusNotFound)
 		return
 	}
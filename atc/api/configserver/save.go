@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/configvalidate"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -71,6 +72,13 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 	pipelineName := rata.Param(r, "pipeline_name")
 	teamName := rata.Param(r, "team_name")
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+	if err != nil {
+		session.Error("malformed-instance-vars", err)
+		s.handleBadRequest(w, fmt.Sprintf("instance vars are malformed: %s", err))
+		return
+	}
+
 	if checkCredentials {
 		variables := creds.NewVariables(s.secretManager, teamName, pipelineName, false)
 
@@ -96,8 +104,19 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, created, err := team.SavePipeline(pipelineName, config, version, true)
+	pipelineRef := atc.PipelineRef{Name: pipelineName, InstanceVars: instanceVars}
+
+	savedBy := db.ConfigAuthor{UserName: accessor.GetAccessor(r).Claims().UserName}
+
+	_, created, err := team.SavePipeline(pipelineRef, config, version, true, savedBy)
 	if err != nil {
+		switch err.(type) {
+		case db.PassedJobNotFoundError, db.PassedJobInUseError:
+			session.Info("ignoring-invalid-passed-constraint", lager.Data{"error": err.Error()})
+			s.handleBadRequest(w, err.Error())
+			return
+		}
+
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		fmt.Fprintf(w, "failed to save config: %s", err)
@@ -361,7 +361,7 @@ var _ = Describe("Containers API", func() {
 					Expect(err).NotTo(HaveOccurred())
 
 					_, pipelineName, resourceName, secretManager, varSourcePool := dbTeam.FindCheckContainersArgsForCall(0)
-					Expect(pipelineName).To(Equal("some-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(resourceName).To(Equal("some-resource"))
 					Expect(secretManager).To(Equal(fakeSecretManager))
 					Expect(varSourcePool).To(Equal(fakeVarSourcePool))
@@ -1233,4 +1233,137 @@ var _ = Describe("Containers API", func() {
 			})
 		})
 	})
+
+	Describe("PUT /api/v1/builds/:build_id/containers/retain", func() {
+		var (
+			duration string
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			duration = "1h"
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/containers/retain?duration="+duration, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				build.IDReturns(128)
+				build.TeamNameReturns("some-team")
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			It("keeps the containers of the build on failure", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(build.KeepContainersOnFailureCallCount()).To(Equal(1))
+				Expect(build.KeepContainersOnFailureArgsForCall(0)).To(Equal(time.Hour))
+			})
+
+			It("returns the build", func() {
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{
+					"id": 128,
+					"name": "",
+					"team_name": "some-team",
+					"status": "",
+					"api_url": "/api/v1/builds/128"
+				}`))
+			})
+
+			Context("when the duration is invalid", func() {
+				BeforeEach(func() {
+					duration = "nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(build.KeepContainersOnFailureCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when retaining the containers fails", func() {
+				BeforeEach(func() {
+					build.KeepContainersOnFailureReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/builds/:build_id/containers/release", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/containers/release", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+
+				build.TeamNameReturns("some-team")
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			It("releases the containers of the build", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(build.ReleaseContainersCallCount()).To(Equal(1))
+			})
+
+			Context("when releasing the containers fails", func() {
+				BeforeEach(func() {
+					build.ReleaseContainersReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+
+				build.TeamNameReturns("some-team")
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(build.ReleaseContainersCallCount()).To(BeZero())
+			})
+		})
+	})
 })
@@ -0,0 +1,63 @@
+package containerserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// RetainBuildContainers keeps the containers of the build around for
+// intercepting if it fails, or from now on if it has failed already.
+func (s *Server) RetainBuildContainers(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("retain-build-containers", lager.Data{
+			"build": build.ID(),
+		})
+
+		retention, err := time.ParseDuration(r.URL.Query().Get("duration"))
+		if err != nil || retention <= 0 {
+			logger.Info("invalid-duration", lager.Data{"duration": r.URL.Query().Get("duration")})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = build.KeepContainersOnFailure(retention)
+		if err != nil {
+			logger.Error("failed-to-retain-containers", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(present.Build(build))
+		if err != nil {
+			logger.Error("failed-to-encode-build", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+// ReleaseBuildContainers lets the containers of the build be collected as
+// usual.
+func (s *Server) ReleaseBuildContainers(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("release-build-containers", lager.Data{
+			"build": build.ID(),
+		})
+
+		err := build.ReleaseContainers()
+		if err != nil {
+			logger.Error("failed-to-release-containers", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
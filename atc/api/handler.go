@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/artifactserver"
+	"github.com/concourse/concourse/atc/api/auditserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/checkserver"
@@ -19,6 +20,7 @@ import (
 	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
+	"github.com/concourse/concourse/atc/api/quotaserver"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
@@ -55,6 +57,7 @@ func NewHandler(
 	dbCheckFactory db.CheckFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbBuildNotificationFactory db.BuildNotificationFactory,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
 
@@ -73,6 +76,9 @@ func NewHandler(
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
 	dbWall db.Wall,
+	dbAuditEventRepository db.AuditEventRepository,
+	dbQuotaRepository db.QuotaRepository,
+	dbBuildQueue db.BuildQueue,
 	clock clock.Clock,
 
 	enableArchivePipeline bool,
@@ -87,7 +93,7 @@ func NewHandler(
 	buildHandlerFactory := buildserver.NewScopedHandlerFactory(logger)
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
-	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory)
+	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, dbBuildQueue, dbBuildNotificationFactory, eventHandlerFactory)
 	checkServer := checkserver.NewServer(logger, dbCheckFactory)
 	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory)
 	resourceServer := resourceserver.NewServer(logger, secretManager, varSourcePool, dbCheckFactory, dbResourceFactory, dbResourceConfigFactory)
@@ -106,6 +112,8 @@ func NewHandler(
 	artifactServer := artifactserver.NewServer(logger, workerClient)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	auditServer := auditserver.NewServer(logger, externalURL, dbAuditEventRepository)
+	quotaServer := quotaserver.NewServer(logger, dbQuotaRepository)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -113,15 +121,16 @@ func NewHandler(
 
 		atc.GetCC: http.HandlerFunc(ccServer.GetCC),
 
-		atc.ListBuilds:          http.HandlerFunc(buildServer.ListBuilds),
-		atc.CreateBuild:         teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
-		atc.GetBuild:            buildHandlerFactory.HandlerFor(buildServer.GetBuild),
-		atc.BuildResources:      buildHandlerFactory.HandlerFor(buildServer.BuildResources),
-		atc.AbortBuild:          buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
-		atc.GetBuildPlan:        buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
-		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
-		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
-		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
+		atc.ListBuilds:             http.HandlerFunc(buildServer.ListBuilds),
+		atc.CreateBuild:            teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
+		atc.GetBuild:               buildHandlerFactory.HandlerFor(buildServer.GetBuild),
+		atc.BuildResources:         buildHandlerFactory.HandlerFor(buildServer.BuildResources),
+		atc.AbortBuild:             buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
+		atc.GetBuildPlan:           buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
+		atc.GetBuildPreparation:    buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
+		atc.ListBuildNotifications: buildHandlerFactory.HandlerFor(buildServer.ListBuildNotifications),
+		atc.BuildEvents:            buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
+		atc.ListBuildArtifacts:     buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
 
 		atc.GetCheck: http.HandlerFunc(checkServer.GetCheck),
 
@@ -143,10 +152,14 @@ func NewHandler(
 		},
 
 		atc.ClearTaskCache: pipelineHandlerFactory.HandlerFor(jobServer.ClearTaskCache),
+		atc.GetJobTaskPlan: pipelineHandlerFactory.HandlerFor(jobServer.GetJobTaskPlan),
 
 		atc.ListAllPipelines:    http.HandlerFunc(pipelineServer.ListAllPipelines),
 		atc.ListPipelines:       http.HandlerFunc(pipelineServer.ListPipelines),
 		atc.GetPipeline:         pipelineHandlerFactory.HandlerFor(pipelineServer.GetPipeline),
+		atc.ListConfigVersions:  pipelineHandlerFactory.HandlerFor(pipelineServer.ListConfigVersions),
+		atc.GetConfigVersion:    pipelineHandlerFactory.HandlerFor(pipelineServer.GetConfigVersion),
+		atc.DiffConfigVersions:  pipelineHandlerFactory.HandlerFor(pipelineServer.DiffConfigVersions),
 		atc.DeletePipeline:      pipelineHandlerFactory.HandlerFor(pipelineServer.DeletePipeline),
 		atc.OrderPipelines:      http.HandlerFunc(pipelineServer.OrderPipelines),
 		atc.PausePipeline:       pipelineHandlerFactory.HandlerFor(pipelineServer.PausePipeline),
@@ -183,10 +196,15 @@ func NewHandler(
 		atc.RegisterWorker:  http.HandlerFunc(workerServer.RegisterWorker),
 		atc.LandWorker:      http.HandlerFunc(workerServer.LandWorker),
 		atc.RetireWorker:    http.HandlerFunc(workerServer.RetireWorker),
+		atc.CordonWorker:    http.HandlerFunc(workerServer.CordonWorker),
+		atc.UncordonWorker:  http.HandlerFunc(workerServer.UncordonWorker),
 		atc.PruneWorker:     http.HandlerFunc(workerServer.PruneWorker),
 		atc.HeartbeatWorker: http.HandlerFunc(workerServer.HeartbeatWorker),
 		atc.DeleteWorker:    http.HandlerFunc(workerServer.DeleteWorker),
 
+		atc.CordonTaggedWorkers:   http.HandlerFunc(workerServer.CordonTaggedWorkers),
+		atc.UncordonTaggedWorkers: http.HandlerFunc(workerServer.UncordonTaggedWorkers),
+
 		atc.SetLogLevel: http.HandlerFunc(logLevelServer.SetMinLevel),
 		atc.GetLogLevel: http.HandlerFunc(logLevelServer.GetMinLevel),
 
@@ -202,6 +220,8 @@ func NewHandler(
 		atc.HijackContainer:          teamHandlerFactory.HandlerFor(containerServer.HijackContainer),
 		atc.ListDestroyingContainers: http.HandlerFunc(containerServer.ListDestroyingContainers),
 		atc.ReportWorkerContainers:   http.HandlerFunc(containerServer.ReportWorkerContainers),
+		atc.RetainBuildContainers:    buildHandlerFactory.HandlerFor(containerServer.RetainBuildContainers),
+		atc.ReleaseBuildContainers:   buildHandlerFactory.HandlerFor(containerServer.ReleaseBuildContainers),
 
 		atc.ListVolumes:           teamHandlerFactory.HandlerFor(volumesServer.ListVolumes),
 		atc.ListDestroyingVolumes: http.HandlerFunc(volumesServer.ListDestroyingVolumes),
@@ -214,12 +234,23 @@ func NewHandler(
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
 
+		atc.ListAPITokens:  http.HandlerFunc(teamServer.ListAPITokens),
+		atc.CreateAPIToken: http.HandlerFunc(teamServer.CreateAPIToken),
+		atc.RevokeAPIToken: http.HandlerFunc(teamServer.RevokeAPIToken),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
 
 		atc.GetWall:   http.HandlerFunc(wallServer.GetWall),
 		atc.SetWall:   http.HandlerFunc(wallServer.SetWall),
 		atc.ClearWall: http.HandlerFunc(wallServer.ClearWall),
+
+		atc.ListAuditEvents: http.HandlerFunc(auditServer.ListAuditEvents),
+
+		atc.GetClusterQuota: http.HandlerFunc(quotaServer.GetClusterQuota),
+		atc.SetClusterQuota: http.HandlerFunc(quotaServer.SetClusterQuota),
+		atc.GetTeamQuota:    teamHandlerFactory.HandlerFor(quotaServer.GetTeamQuota),
+		atc.SetTeamQuota:    teamHandlerFactory.HandlerFor(quotaServer.SetTeamQuota),
 	}
 
 	return rata.NewRouter(atc.Routes, wrapper.Wrap(handlers))
@@ -504,6 +504,26 @@ var _ = Describe("Jobs API", func() {
 								})
 							})
 
+							Context("when the job has a schedule", func() {
+								BeforeEach(func() {
+									fakeJob.ScheduleReturns(&atc.ScheduleConfig{
+										Cron:     "0 2 * * *",
+										Location: "America/Toronto",
+									})
+								})
+
+								It("returns the schedule", func() {
+									var job map[string]interface{}
+									err := json.NewDecoder(response.Body).Decode(&job)
+									Expect(err).NotTo(HaveOccurred())
+
+									Expect(job["schedule"]).To(Equal(map[string]interface{}{
+										"cron":     "0 2 * * *",
+										"location": "America/Toronto",
+									}))
+								})
+							})
+
 							Context("when getting the job's builds fails", func() {
 								BeforeEach(func() {
 									fakeJob.FinishedAndNextBuildReturns(nil, nil, errors.New("oh no!"))
@@ -2313,6 +2333,116 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/tasks/:step_name/plan", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/tasks/unit/plan")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the job is found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(fakeJob, true, nil)
+					fakeJob.ConfigReturns(atc.JobConfig{
+						Name: "some-job",
+						Plan: atc.PlanSequence{
+							{Get: "some-input"},
+							{
+								Do: &atc.PlanSequence{
+									{
+										Task:         "unit",
+										File:         "some-input/ci/unit.yml",
+										Vars:         atc.Params{"repository": "((repository))"},
+										Params:       atc.Params{"FOO": "((foo))"},
+										InputMapping: map[string]string{"source": "some-input"},
+										Tags:         atc.Tags{"some-tag"},
+										Privileged:   true,
+									},
+								},
+							},
+						},
+					}, nil)
+				})
+
+				It("looks up the job", func() {
+					Expect(fakePipeline.JobArgsForCall(0)).To(Equal("some-job"))
+				})
+
+				It("returns the step's plan with its vars left to be resolved by the build", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					var plan atc.TaskPlan
+					err := json.NewDecoder(response.Body).Decode(&plan)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(plan).To(Equal(atc.TaskPlan{
+						Name:         "unit",
+						Privileged:   true,
+						Tags:         atc.Tags{"some-tag"},
+						ConfigPath:   "some-input/ci/unit.yml",
+						Vars:         atc.Params{"repository": "((repository))"},
+						Params:       atc.Params{"FOO": "((foo))"},
+						InputMapping: map[string]string{"source": "some-input"},
+
+						VersionedResourceTypes: versionedResourceTypes,
+					}))
+				})
+
+				Context("when the step is not a task step of the job", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{
+							Name: "some-job",
+							Plan: atc.PlanSequence{{Get: "unit"}},
+						}, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when getting the resource types fails", func() {
+					BeforeEach(func() {
+						fakePipeline.ResourceTypesReturns(nil, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
 	Describe("DELETE /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/tasks/:step_name/cache", func() {
 		var (
 			request  *http.Request
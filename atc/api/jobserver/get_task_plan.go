@@ -0,0 +1,55 @@
+package jobserver
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// GetJobTaskPlan returns the plan of one of the job's task steps, as the job's
+// builds run it, so that it can be run in a one-off build of the pipeline.
+// The step's vars are not resolved here; the build resolves them when it runs,
+// using the pipeline's var sources like the job's own builds do.
+func (s *Server) GetJobTaskPlan(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("get-job-task-plan")
+		jobName := r.FormValue(":job_name")
+		stepName := r.FormValue(":step_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		jobConfig, err := job.Config()
+		if err != nil {
+			logger.Error("failed-to-get-job-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		step, found := jobConfig.TaskStep(stepName)
+		if !found {
+			logger.Debug("task-step-not-found", lager.Data{"job": jobName, "step": stepName})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		resourceTypes, err := pipeline.ResourceTypes()
+		if err != nil {
+			logger.Error("failed-to-get-resource-types", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		s.writeJSONResponse(w, step.TaskPlan(resourceTypes.Deserialize()))
+	})
+}
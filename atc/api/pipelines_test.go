@@ -316,6 +316,39 @@ var _ = Describe("Pipelines API", func() {
 				))
 			})
 
+			Context("when the team has instanced pipelines", func() {
+				BeforeEach(func() {
+					instancedPipeline := new(dbfakes.FakePipeline)
+					instancedPipeline.IDReturns(4)
+					instancedPipeline.TeamNameReturns("main")
+					instancedPipeline.NameReturns("private-pipeline")
+					instancedPipeline.InstanceVarsReturns(atc.InstanceVars{"branch": "feature"})
+
+					fakeTeam.PipelinesReturns([]db.Pipeline{
+						privatePipeline,
+						publicPipeline,
+						instancedPipeline,
+					}, nil)
+				})
+
+				It("groups the instances of a pipeline together", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					var pipelines []atc.Pipeline
+					err = json.Unmarshal(body, &pipelines)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(pipelines).To(HaveLen(3))
+					Expect(pipelines[0].Ref()).To(Equal(atc.PipelineRef{Name: "private-pipeline"}))
+					Expect(pipelines[1].Ref()).To(Equal(atc.PipelineRef{
+						Name:         "private-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "feature"},
+					}))
+					Expect(pipelines[2].Ref()).To(Equal(atc.PipelineRef{Name: "public-pipeline"}))
+				})
+			})
+
 			Context("when the call to get active pipelines fails", func() {
 				BeforeEach(func() {
 					fakeTeam.PipelinesReturns(nil, errors.New("disaster"))
@@ -821,7 +854,7 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline-name"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline-name"}))
 				})
 
 				It("deletes the named pipeline from the database", func() {
@@ -839,6 +872,22 @@ var _ = Describe("Pipelines API", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 					})
 				})
+
+				Context("when a job of the pipeline is used in a passed constraint of another pipeline", func() {
+					BeforeEach(func() {
+						fakeTeam.PipelineReturns(dbPipeline, true, nil)
+						dbPipeline.DestroyReturns(db.PassedJobInUseError{
+							Job:           "some-job",
+							Pipeline:      "other-pipeline",
+							DownstreamJob: "other-job",
+						})
+					})
+
+					It("returns 409 Conflict with the error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+						Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("job 'some-job' is used in a passed constraint of 'other-pipeline/other-job'"))
+					})
+				})
 			})
 
 			Context("when requester does not belong to the team", func() {
@@ -894,7 +943,7 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when pausing the pipeline succeeds", func() {
@@ -1020,7 +1069,7 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipelineDB", func() {
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when unpausing the pipeline succeeds", func() {
@@ -1104,7 +1153,7 @@ var _ = Describe("Pipelines API", func() {
 				It("injects the proper pipelineDB", func() {
 					Expect(fakeTeam.PipelineCallCount()).To(Equal(1))
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when exposing the pipeline succeeds", func() {
@@ -1182,7 +1231,7 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipeline", func() {
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				Context("when hiding the pipeline succeeds", func() {
@@ -1576,7 +1625,7 @@ var _ = Describe("Pipelines API", func() {
 
 				It("injects the proper pipeline", func() {
 					pipelineName := fakeTeam.PipelineArgsForCall(0)
-					Expect(pipelineName).To(Equal("a-pipeline"))
+					Expect(pipelineName).To(Equal(atc.PipelineRef{Name: "a-pipeline"}))
 				})
 
 				It("returns 204", func() {
@@ -0,0 +1,187 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListConfigVersions(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-config-versions")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		history, err := pipeline.ConfigHistory()
+		if err != nil {
+			logger.Error("failed-to-get-config-history", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		versions := make([]atc.PipelineConfigVersion, len(history))
+		for i, version := range history {
+			versions[i] = present.PipelineConfigVersion(version)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(versions)
+		if err != nil {
+			logger.Error("failed-to-encode-config-versions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) GetConfigVersion(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-config-version")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		configVersion, err := strconv.Atoi(r.FormValue(":config_version"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		version, found, err := pipeline.ConfigAtVersion(db.ConfigVersion(configVersion))
+		if err != nil {
+			logger.Error("failed-to-get-config-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Debug("config-version-not-found", lager.Data{"version": configVersion})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presentConfigVersion(version))
+		if err != nil {
+			logger.Error("failed-to-encode-config-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+// DiffConfigVersions returns two versions of the pipeline's config to compare.
+// The "to" version defaults to the latest one, and the "from" version to the
+// one saved before it. The first version is compared with an empty config.
+func (s *Server) DiffConfigVersions(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("diff-config-versions")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		history, err := pipeline.ConfigHistory()
+		if err != nil {
+			logger.Error("failed-to-get-config-history", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if len(history) == 0 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		toIndex := 0
+		if to := r.FormValue(atc.DiffConfigVersionsTo); to != "" {
+			toIndex, err = historyIndex(history, to)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			if toIndex < 0 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		}
+
+		fromIndex := toIndex + 1
+		if from := r.FormValue(atc.DiffConfigVersionsFrom); from != "" {
+			fromIndex, err = historyIndex(history, from)
+			if err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+
+			if fromIndex < 0 {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		}
+
+		var diff atc.PipelineConfigDiff
+
+		diff.To, err = s.configVersion(pipeline, history[toIndex].Version)
+		if err != nil {
+			logger.Error("failed-to-get-config-version", err, lager.Data{"version": history[toIndex].Version})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if fromIndex < len(history) {
+			diff.From, err = s.configVersion(pipeline, history[fromIndex].Version)
+			if err != nil {
+				logger.Error("failed-to-get-config-version", err, lager.Data{"version": history[fromIndex].Version})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		} else {
+			diff.From = atc.PipelineConfigVersion{Config: &atc.Config{}}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(diff)
+		if err != nil {
+			logger.Error("failed-to-encode-config-diff", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) configVersion(pipeline db.Pipeline, configVersion db.ConfigVersion) (atc.PipelineConfigVersion, error) {
+	version, found, err := pipeline.ConfigAtVersion(configVersion)
+	if err != nil {
+		return atc.PipelineConfigVersion{}, err
+	}
+
+	if !found {
+		// the history was just listed, and versions are only removed along with
+		// the pipeline
+		return atc.PipelineConfigVersion{}, fmt.Errorf("config version %d disappeared", configVersion)
+	}
+
+	return presentConfigVersion(version), nil
+}
+
+func presentConfigVersion(version db.PipelineConfigVersion) atc.PipelineConfigVersion {
+	presented := present.PipelineConfigVersion(version)
+	presented.Config = &version.Config
+	return presented
+}
+
+// historyIndex finds the position of the version in the history, or -1 if
+// the pipeline was never saved with it.
+func historyIndex(history []db.PipelineConfigVersion, version string) (int, error) {
+	v, err := strconv.Atoi(version)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, h := range history {
+		if h.Version == db.ConfigVersion(v) {
+			return i, nil
+		}
+	}
+
+	return -1, nil
+}
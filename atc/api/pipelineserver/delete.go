@@ -17,6 +17,12 @@ func (s *Server) DeletePipeline(pipelineDB db.Pipeline) http.Handler {
 
 		err := pipelineDB.Destroy()
 		if err != nil {
+			if _, ok := err.(db.PassedJobInUseError); ok {
+				logger.Info("pipeline-in-use", lager.Data{"error": err.Error()})
+				http.Error(w, err.Error(), http.StatusConflict)
+				return
+			}
+
 			logger.Error("failed", err)
 
 			w.WriteHeader(http.StatusInternalServerError)
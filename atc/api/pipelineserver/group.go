@@ -0,0 +1,32 @@
+package pipelineserver
+
+import "github.com/concourse/concourse/atc/db"
+
+type pipelineGroupKey struct {
+	teamName string
+	name     string
+}
+
+// groupInstances reorders the pipelines so that all instances of a pipeline
+// are listed together, at the position of the first instance. The relative
+// order of everything else is preserved.
+func groupInstances(pipelines []db.Pipeline) []db.Pipeline {
+	var keys []pipelineGroupKey
+	groups := map[pipelineGroupKey][]db.Pipeline{}
+
+	for _, pipeline := range pipelines {
+		key := pipelineGroupKey{pipeline.TeamName(), pipeline.Name()}
+		if _, found := groups[key]; !found {
+			keys = append(keys, key)
+		}
+
+		groups[key] = append(groups[key], pipeline)
+	}
+
+	grouped := make([]db.Pipeline, 0, len(pipelines))
+	for _, key := range keys {
+		grouped = append(grouped, groups[key]...)
+	}
+
+	return grouped
+}
@@ -31,7 +31,7 @@ func (s *Server) ListAllPipelines(w http.ResponseWriter, r *http.Request) {
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	err = json.NewEncoder(w).Encode(present.Pipelines(pipelines))
+	err = json.NewEncoder(w).Encode(present.Pipelines(groupInstances(pipelines)))
 	if err != nil {
 		logger.Error("failed-to-encode-pipelines", err)
 		w.WriteHeader(http.StatusInternalServerError)
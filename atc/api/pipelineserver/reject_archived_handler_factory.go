@@ -3,6 +3,7 @@ package pipelineserver
 import (
 	"net/http"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -32,6 +33,12 @@ func (ra RejectArchivedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 	teamName := r.FormValue(":team_name")
 	pipelineName := r.FormValue(":pipeline_name")
 
+	instanceVars, err := atc.InstanceVarsFromQueryParams(r.URL.Query())
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	team, found, err := ra.teamFactory.FindTeam(teamName)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -43,7 +50,7 @@ func (ra RejectArchivedHandler) ServeHTTP(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	pipeline, found, err := team.Pipeline(pipelineName)
+	pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName, InstanceVars: instanceVars})
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		return
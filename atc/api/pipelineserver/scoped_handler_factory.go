@@ -3,6 +3,7 @@ package pipelineserver
 import (
 	"net/http"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -24,6 +25,12 @@ func (pdbh *ScopedHandlerFactory) HandlerFor(pipelineScopedHandler func(db.Pipel
 		teamName := r.FormValue(":team_name")
 		pipelineName := r.FormValue(":pipeline_name")
 
+		instanceVars, err := atc.InstanceVarsFromQueryParams(r.URL.Query())
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
 		pipeline, ok := r.Context().Value(auth.PipelineContextKey).(db.Pipeline)
 		if !ok {
 			dbTeam, found, err := pdbh.teamDBFactory.FindTeam(teamName)
@@ -37,7 +44,7 @@ func (pdbh *ScopedHandlerFactory) HandlerFor(pipelineScopedHandler func(db.Pipel
 				return
 			}
 
-			pipeline, found, err = dbTeam.Pipeline(pipelineName)
+			pipeline, found, err = dbTeam.Pipeline(atc.PipelineRef{Name: pipelineName, InstanceVars: instanceVars})
 			if err != nil {
 				w.WriteHeader(http.StatusInternalServerError)
 				return
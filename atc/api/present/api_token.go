@@ -0,0 +1,27 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func APIToken(token db.APIToken) atc.APIToken {
+	presented := atc.APIToken{
+		Name:           token.Name(),
+		TeamName:       token.TeamName(),
+		Role:           token.Role(),
+		ServiceAccount: token.ServiceAccount(),
+		CreatedAt:      token.CreatedAt().Unix(),
+		ExpiresAt:      token.ExpiresAt().Unix(),
+	}
+
+	if token.UserName() != "" {
+		presented.User = token.UserConnector() + ":" + token.UserName()
+	}
+
+	if !token.LastUsedAt().IsZero() {
+		presented.LastUsedAt = token.LastUsedAt().Unix()
+	}
+
+	return presented
+}
@@ -2,6 +2,7 @@ package present
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -19,13 +20,14 @@ func Build(build db.Build) atc.Build {
 	}
 
 	atcBuild := atc.Build{
-		ID:           build.ID(),
-		Name:         build.Name(),
-		JobName:      build.JobName(),
-		PipelineName: build.PipelineName(),
-		TeamName:     build.TeamName(),
-		Status:       string(build.Status()),
-		APIURL:       apiURL,
+		ID:                   build.ID(),
+		Name:                 build.Name(),
+		JobName:              build.JobName(),
+		PipelineName:         build.PipelineName(),
+		PipelineInstanceVars: build.PipelineInstanceVars(),
+		TeamName:             build.TeamName(),
+		Status:               string(build.Status()),
+		APIURL:               apiURL,
 	}
 
 	if build.RerunOf() != 0 {
@@ -48,5 +50,11 @@ func Build(build db.Build) atc.Build {
 		atcBuild.ReapTime = build.ReapTime().Unix()
 	}
 
+	// containers are only retained until then, after which they are collected
+	// as usual
+	if retainedUntil := build.ContainersRetainedUntil(); retainedUntil.After(time.Now()) {
+		atcBuild.ContainersRetainedUntil = retainedUntil.Unix()
+	}
+
 	return atcBuild
 }
@@ -0,0 +1,26 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func BuildNotification(notification db.BuildNotification) atc.BuildNotification {
+	presented := atc.BuildNotification{
+		ID:             notification.ID(),
+		BuildID:        notification.BuildID(),
+		Name:           notification.Name(),
+		PreviousStatus: string(notification.PreviousStatus()),
+		Status:         notification.Status(),
+		Attempts:       notification.Attempts(),
+		ResponseCode:   notification.ResponseCode(),
+		LastError:      notification.LastError(),
+		CreatedAt:      notification.CreatedAt().Unix(),
+	}
+
+	if !notification.DeliveredAt().IsZero() {
+		presented.DeliveredAt = notification.DeliveredAt().Unix()
+	}
+
+	return presented
+}
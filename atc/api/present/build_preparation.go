@@ -17,8 +17,11 @@ func BuildPreparation(preparation db.BuildPreparation) atc.BuildPreparation {
 		PausedPipeline:      atc.BuildPreparationStatus(preparation.PausedPipeline),
 		PausedJob:           atc.BuildPreparationStatus(preparation.PausedJob),
 		MaxRunningBuilds:    atc.BuildPreparationStatus(preparation.MaxRunningBuilds),
+		TeamQuota:           atc.BuildPreparationStatus(preparation.TeamQuota),
+		ClusterQuota:        atc.BuildPreparationStatus(preparation.ClusterQuota),
 		Inputs:              inputs,
 		InputsSatisfied:     atc.BuildPreparationStatus(preparation.InputsSatisfied),
 		MissingInputReasons: atc.MissingInputReasons(preparation.MissingInputReasons),
+		QueuePosition:       preparation.QueuePosition,
 	}
 }
@@ -46,6 +46,7 @@ func DashboardJob(
 		TeamName:     teamName,
 		Paused:       job.Paused,
 		HasNewInputs: job.HasNewInputs,
+		Schedule:     job.Schedule,
 
 		Inputs:  sanitizedInputs,
 		Outputs: job.Outputs,
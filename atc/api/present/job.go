@@ -54,6 +54,7 @@ func Job(
 
 		Name:                 job.Name(),
 		PipelineName:         job.PipelineName(),
+		PipelineInstanceVars: job.PipelineInstanceVars(),
 		TeamName:             teamName,
 		DisableManualTrigger: job.DisableManualTrigger(),
 		Paused:               job.Paused(),
@@ -62,6 +63,7 @@ func Job(
 		NextBuild:            presentedNextBuild,
 		TransitionBuild:      presentedTransitionBuild,
 		HasNewInputs:         job.HasNewInputs(),
+		Schedule:             job.Schedule(),
 
 		Inputs:  sanitizedInputs,
 		Outputs: sanitizedOutputs,
@@ -7,13 +7,23 @@ import (
 
 func Pipeline(savedPipeline db.Pipeline) atc.Pipeline {
 	return atc.Pipeline{
-		ID:          savedPipeline.ID(),
-		Name:        savedPipeline.Name(),
-		TeamName:    savedPipeline.TeamName(),
-		Paused:      savedPipeline.Paused(),
-		Public:      savedPipeline.Public(),
-		Archived:    savedPipeline.Archived(),
-		Groups:      savedPipeline.Groups(),
-		LastUpdated: savedPipeline.LastUpdated().Unix(),
+		ID:           savedPipeline.ID(),
+		Name:         savedPipeline.Name(),
+		InstanceVars: savedPipeline.InstanceVars(),
+		TeamName:     savedPipeline.TeamName(),
+		Paused:       savedPipeline.Paused(),
+		Public:       savedPipeline.Public(),
+		Archived:     savedPipeline.Archived(),
+		Groups:       savedPipeline.Groups(),
+		LastUpdated:  savedPipeline.LastUpdated().Unix(),
+	}
+}
+
+func PipelineConfigVersion(version db.PipelineConfigVersion) atc.PipelineConfigVersion {
+	return atc.PipelineConfigVersion{
+		Version:      int(version.Version),
+		SavedAt:      version.SavedAt.Unix(),
+		SavedBy:      version.SavedBy.UserName,
+		SavedByBuild: version.SavedBy.BuildID,
 	}
 }
@@ -7,8 +7,9 @@ import (
 
 func Team(team db.Team) atc.Team {
 	return atc.Team{
-		ID:   team.ID(),
-		Name: team.Name(),
-		Auth: team.Auth(),
+		ID:    team.ID(),
+		Name:  team.Name(),
+		Auth:  team.Auth(),
+		Roles: team.Roles(),
 	}
 }
@@ -22,6 +22,10 @@ func Worker(workerInfo db.Worker) atc.Worker {
 	if err != nil {
 		activeTasks = 0
 	}
+	allocatedCPU, allocatedMemory, err := workerInfo.AllocatedResources()
+	if err != nil {
+		allocatedCPU, allocatedMemory = 0, 0
+	}
 
 	atcWorker := atc.Worker{
 		GardenAddr:       gardenAddr,
@@ -32,19 +36,36 @@ func Worker(workerInfo db.Worker) atc.Worker {
 		ActiveContainers: workerInfo.ActiveContainers(),
 		ActiveVolumes:    workerInfo.ActiveVolumes(),
 		ActiveTasks:      activeTasks,
-		ResourceTypes:    workerInfo.ResourceTypes(),
-		Platform:         workerInfo.Platform(),
-		Tags:             workerInfo.Tags(),
-		Name:             workerInfo.Name(),
-		Team:             workerInfo.TeamName(),
-		State:            string(workerInfo.State()),
-		Version:          version,
-		Ephemeral:        workerInfo.Ephemeral(),
+
+		AllocatableCPU:    workerInfo.AllocatableCPU(),
+		AllocatableMemory: workerInfo.AllocatableMemory(),
+		AllocatedCPU:      allocatedCPU,
+		AllocatedMemory:   allocatedMemory,
+		Usage:             workerInfo.Usage(),
+
+		ResourceTypes: workerInfo.ResourceTypes(),
+		Platform:      workerInfo.Platform(),
+		Tags:          workerInfo.Tags(),
+		Name:          workerInfo.Name(),
+		Team:          workerInfo.TeamName(),
+		State:         string(workerInfo.State()),
+		Version:       version,
+		Ephemeral:     workerInfo.Ephemeral(),
 	}
 
 	if !workerInfo.StartTime().IsZero() {
 		atcWorker.StartTime = workerInfo.StartTime().Unix()
 	}
 
+	if cordon, cordoned := workerInfo.CordonWindow(); cordoned {
+		atcWorker.Cordon = &atc.WorkerCordon{
+			StartTime: cordon.StartTime.Unix(),
+		}
+
+		if !cordon.EndTime.IsZero() {
+			atcWorker.Cordon.EndTime = cordon.EndTime.Unix()
+		}
+	}
+
 	return atcWorker
 }
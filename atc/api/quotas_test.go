@@ -0,0 +1,232 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/rata"
+)
+
+var _ = Describe("Quotas API", func() {
+	var (
+		requestGenerator *rata.RequestGenerator
+		fakeTeam         *dbfakes.FakeTeam
+
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		requestGenerator = rata.NewRequestGenerator(server.URL, atc.Routes)
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.IDReturns(7)
+		fakeTeam.NameReturns("a-team")
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+
+		fakeAccess.IsAuthenticatedReturns(true)
+	})
+
+	request := func(name string, params rata.Params, body interface{}) {
+		payload, err := json.Marshal(body)
+		Expect(err).NotTo(HaveOccurred())
+
+		req, err := requestGenerator.CreateRequest(name, params, bytes.NewBuffer(payload))
+		Expect(err).NotTo(HaveOccurred())
+
+		response, err = client.Do(req)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Describe("GET /api/v1/quota", func() {
+		BeforeEach(func() {
+			dbQuotaRepository.ClusterQuotaReturns(atc.QuotaUsage{
+				Quota:   atc.Quota{Builds: 10, TaskContainers: 20},
+				Running: atc.Quota{Builds: 3, TaskContainers: 5},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request(atc.GetClusterQuota, rata.Params{}, nil)
+		})
+
+		It("returns the quota and its usage", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(`{
+				"quota": {"builds": 10, "task_containers": 20},
+				"running": {"builds": 3, "task_containers": 5}
+			}`))
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when getting the quota fails", func() {
+			BeforeEach(func() {
+				dbQuotaRepository.ClusterQuotaReturns(atc.QuotaUsage{}, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/quota", func() {
+		var quota interface{}
+
+		BeforeEach(func() {
+			quota = atc.Quota{Builds: 10}
+		})
+
+		JustBeforeEach(func() {
+			request(atc.SetClusterQuota, rata.Params{}, quota)
+		})
+
+		Context("when not an admin", func() {
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbQuotaRepository.SetClusterQuotaCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("sets the quota", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+				Expect(dbQuotaRepository.SetClusterQuotaCallCount()).To(Equal(1))
+				Expect(dbQuotaRepository.SetClusterQuotaArgsForCall(0)).To(Equal(atc.Quota{Builds: 10}))
+			})
+
+			Context("when a limit is negative", func() {
+				BeforeEach(func() {
+					quota = atc.Quota{TaskContainers: -1}
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("quota limits must not be negative")))
+					Expect(dbQuotaRepository.SetClusterQuotaCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the body is not a quota", func() {
+				BeforeEach(func() {
+					quota = "lots"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/quota", func() {
+		BeforeEach(func() {
+			dbQuotaRepository.TeamQuotaReturns(atc.QuotaUsage{
+				Quota:   atc.Quota{Builds: 2},
+				Running: atc.Quota{Builds: 1},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			request(atc.GetTeamQuota, rata.Params{"team_name": "a-team"}, nil)
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns the team's quota and its usage", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbQuotaRepository.TeamQuotaArgsForCall(0)).To(Equal(7))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{
+					"quota": {"builds": 2},
+					"running": {"builds": 1}
+				}`))
+			})
+		})
+
+		Context("when not authorized", func() {
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/quota", func() {
+		JustBeforeEach(func() {
+			request(atc.SetTeamQuota, rata.Params{"team_name": "a-team"}, atc.Quota{Builds: 2, TaskContainers: 4})
+		})
+
+		Context("when an owner of the team but not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbQuotaRepository.SetTeamQuotaCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("sets the team's quota", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				teamID, quota := dbQuotaRepository.SetTeamQuotaArgsForCall(0)
+				Expect(teamID).To(Equal(7))
+				Expect(quota).To(Equal(atc.Quota{Builds: 2, TaskContainers: 4}))
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when setting the quota fails", func() {
+				BeforeEach(func() {
+					dbQuotaRepository.SetTeamQuotaReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
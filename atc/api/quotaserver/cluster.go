@@ -0,0 +1,63 @@
+package quotaserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+)
+
+func (s *Server) GetClusterQuota(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("get-cluster-quota")
+
+	usage, err := s.quotas.ClusterQuota()
+	if err != nil {
+		logger.Error("failed-to-get-quota", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(usage)
+	if err != nil {
+		logger.Error("failed-to-encode-quota", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) SetClusterQuota(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("set-cluster-quota")
+
+	quota, ok := decodeQuota(w, r)
+	if !ok {
+		return
+	}
+
+	err := s.quotas.SetClusterQuota(quota)
+	if err != nil {
+		logger.Error("failed-to-set-quota", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func decodeQuota(w http.ResponseWriter, r *http.Request) (atc.Quota, bool) {
+	var quota atc.Quota
+	err := json.NewDecoder(r.Body).Decode(&quota)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return atc.Quota{}, false
+	}
+
+	err = quota.Validate()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return atc.Quota{}, false
+	}
+
+	return quota, true
+}
@@ -0,0 +1,18 @@
+package quotaserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger lager.Logger
+	quotas db.QuotaRepository
+}
+
+func NewServer(logger lager.Logger, quotas db.QuotaRepository) *Server {
+	return &Server{
+		logger: logger,
+		quotas: quotas,
+	}
+}
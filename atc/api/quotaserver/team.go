@@ -0,0 +1,50 @@
+package quotaserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetTeamQuota(team db.Team) http.Handler {
+	logger := s.logger.Session("get-team-quota", lager.Data{"team": team.Name()})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		usage, err := s.quotas.TeamQuota(team.ID())
+		if err != nil {
+			logger.Error("failed-to-get-quota", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(usage)
+		if err != nil {
+			logger.Error("failed-to-encode-quota", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) SetTeamQuota(team db.Team) http.Handler {
+	logger := s.logger.Session("set-team-quota", lager.Data{"team": team.Name()})
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		quota, ok := decodeQuota(w, r)
+		if !ok {
+			return
+		}
+
+		err := s.quotas.SetTeamQuota(team.ID(), quota)
+		if err != nil {
+			logger.Error("failed-to-set-quota", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -332,8 +332,61 @@ var _ = Describe("Teams API", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusOK))
 						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
 
-						updatedProviderAuth := fakeTeam.UpdateProviderAuthArgsForCall(0)
+						updatedProviderAuth, updatedRoles := fakeTeam.UpdateProviderAuthArgsForCall(0)
 						Expect(updatedProviderAuth).To(Equal(atcTeam.Auth))
+						Expect(updatedRoles).To(BeEmpty())
+					})
+
+					Context("when the team defines custom roles", func() {
+						BeforeEach(func() {
+							atcTeam.Auth["releaser"] = map[string][]string{
+								"users": []string{"local:releaser"},
+							}
+							atcTeam.Roles = atc.TeamRoles{
+								{
+									Name:      "releaser",
+									Actions:   []string{atc.CreateJobBuild, atc.PinResourceVersion},
+									Pipelines: []string{"release-*"},
+								},
+							}
+						})
+
+						It("updates the roles along with the provider auth", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+
+							_, updatedRoles := fakeTeam.UpdateProviderAuthArgsForCall(0)
+							Expect(updatedRoles).To(Equal(atcTeam.Roles))
+						})
+					})
+
+					Context("when a custom role has an unknown action", func() {
+						BeforeEach(func() {
+							atcTeam.Auth["releaser"] = map[string][]string{
+								"users": []string{"local:releaser"},
+							}
+							atcTeam.Roles = atc.TeamRoles{
+								{Name: "releaser", Actions: []string{"ReleaseTheKraken"}},
+							}
+						})
+
+						It("does not update provider auth", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when auth refers to an undefined role", func() {
+						BeforeEach(func() {
+							atcTeam.Auth["releaser"] = map[string][]string{
+								"users": []string{"local:releaser"},
+							}
+						})
+
+						It("does not update provider auth", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+						})
 					})
 
 					Context("when updating provider auth fails", func() {
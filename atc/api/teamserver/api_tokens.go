@@ -0,0 +1,252 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// ListAPITokens lists the tokens of the team to its owners. Anyone else only
+// sees their own tokens.
+func (s *Server) ListAPITokens(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("list-api-tokens")
+
+	acc := accessor.GetAccessor(r)
+
+	team, found, err := s.teamFactory.FindTeam(r.FormValue(":team_name"))
+	if err != nil {
+		hLog.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	tokens, err := team.APITokens()
+	if err != nil {
+		hLog.Error("failed-to-get-api-tokens", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	ownsTeam := acc.IsAdmin() || hasRole(acc.TeamRoles()[team.Name()], atc.OwnerRole)
+
+	presentedTokens := []atc.APIToken{}
+	for _, token := range tokens {
+		if !ownsTeam && !ownsToken(acc, token) {
+			continue
+		}
+
+		presentedTokens = append(presentedTokens, present.APIToken(token))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(presentedTokens)
+	if err != nil {
+		hLog.Error("failed-to-encode-api-tokens", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// CreateAPIToken creates an API token for the requesting user, or for one of
+// the team's service accounts if the user owns the team. The token's role may
+// be any role the user has on the team, or a built-in role below one of them.
+//
+// Tokens can't be created with another token, as the new token would outlive
+// the expiry and revocation of the one it was created with.
+func (s *Server) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("create-api-token")
+
+	acc := accessor.GetAccessor(r)
+
+	if acc.IsAPIToken() {
+		hLog.Info("requested-with-api-token")
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, "api tokens can only be created by logging in")
+		return
+	}
+
+	var request atc.APIToken
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		hLog.Error("malformed-request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(r.FormValue(":team_name"))
+	if err != nil {
+		hLog.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	expiresAt := time.Unix(request.ExpiresAt, 0)
+
+	switch {
+	case request.Name == "":
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, "token must have a name")
+		return
+	case !expiresAt.After(time.Now()):
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, "token must expire in the future")
+		return
+	case !isTeamRole(team, request.Role):
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "team '%s' has no role '%s'", team.Name(), request.Role)
+		return
+	}
+
+	spec := db.APITokenSpec{
+		Name:      request.Name,
+		Role:      request.Role,
+		ExpiresAt: expiresAt,
+	}
+
+	heldRoles := acc.TeamRoles()[team.Name()]
+
+	if request.ServiceAccount != "" {
+		if !acc.IsAdmin() && !hasRole(heldRoles, atc.OwnerRole) {
+			hLog.Info("not-team-owner")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		spec.ServiceAccount = request.ServiceAccount
+	} else {
+		claims := acc.Claims()
+		if claims.UserName == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "token must belong to a user or a service account")
+			return
+		}
+
+		if !acc.IsAdmin() && !atc.RolesCover(heldRoles, request.Role) {
+			hLog.Info("role-not-held", lager.Data{"role": request.Role})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		spec.UserName = claims.UserName
+		spec.UserConnector = claims.Connector
+		spec.UserSub = claims.Sub
+		spec.UserID = claims.UserID
+		spec.UserGroups = claims.Groups
+	}
+
+	token, secret, err := team.CreateAPIToken(spec)
+	if err != nil {
+		if err == db.ErrAPITokenAlreadyExists {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "token '%s' already exists", request.Name)
+			return
+		}
+
+		hLog.Error("failed-to-create-api-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presentedToken := present.APIToken(token)
+	presentedToken.Token = secret
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(presentedToken)
+	if err != nil {
+		hLog.Error("failed-to-encode-api-token", err)
+	}
+}
+
+// RevokeAPIToken revokes a token of the team. Users can revoke their own
+// tokens; the team's owners can revoke any of them.
+func (s *Server) RevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("revoke-api-token")
+
+	acc := accessor.GetAccessor(r)
+
+	team, found, err := s.teamFactory.FindTeam(r.FormValue(":team_name"))
+	if err != nil {
+		hLog.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	token, found, err := team.APIToken(r.FormValue(":token_name"))
+	if err != nil {
+		hLog.Error("failed-to-get-api-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	if !ownsToken(acc, token) && !acc.IsAdmin() && !hasRole(acc.TeamRoles()[team.Name()], atc.OwnerRole) {
+		hLog.Info("not-allowed")
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	err = token.Revoke()
+	if err != nil {
+		hLog.Error("failed-to-revoke-api-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func isTeamRole(team db.Team, role string) bool {
+	if atc.IsBuiltinRole(role) {
+		return true
+	}
+
+	_, found := team.Roles().Lookup(role)
+	return found
+}
+
+func ownsToken(acc accessor.Access, token db.APIToken) bool {
+	claims := acc.Claims()
+
+	return token.UserName() != "" &&
+		token.UserName() == claims.UserName &&
+		token.UserConnector() == claims.Connector
+}
+
+func hasRole(roles []string, role string) bool {
+	for _, candidate := range roles {
+		if candidate == role {
+			return true
+		}
+	}
+
+	return false
+}
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -134,6 +136,27 @@ var _ = Describe("Workers API", func() {
 					}))
 
 				})
+
+				Context("when a worker is cordoned", func() {
+					BeforeEach(func() {
+						teamWorker2.CordonWindowReturns(db.WorkerCordon{
+							StartTime: time.Unix(1595923200, 0),
+							EndTime:   time.Unix(1595926800, 0),
+						}, true)
+					})
+
+					It("returns the cordon's window", func() {
+						var returnedWorkers []atc.Worker
+						err := json.NewDecoder(response.Body).Decode(&returnedWorkers)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(returnedWorkers[0].Cordon).To(BeNil())
+						Expect(returnedWorkers[1].Cordon).To(Equal(&atc.WorkerCordon{
+							StartTime: 1595923200,
+							EndTime:   1595926800,
+						}))
+					})
+				})
 			})
 
 			Context("when getting the workers fails", func() {
@@ -585,6 +608,409 @@ var _ = Describe("Workers API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/workers/:worker_name/cordon", func() {
+		var (
+			response   *http.Response
+			workerName string
+			body       string
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/workers/"+workerName+"/cordon", strings.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			workerName = "some-worker"
+			body = ""
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+			fakeWorker.CordonReturns(nil)
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+		})
+
+		Context("when the request is authenticated as system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsSystemReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("cordons the worker right away and indefinitely", func() {
+				Expect(dbWorkerFactory.GetWorkerCallCount()).To(Equal(1))
+				Expect(dbWorkerFactory.GetWorkerArgsForCall(0)).To(Equal(workerName))
+				Expect(fakeWorker.CordonCallCount()).To(Equal(1))
+				Expect(fakeWorker.CordonArgsForCall(0)).To(Equal(db.WorkerCordon{}))
+			})
+
+			Context("when the cordon has a window", func() {
+				var start, end time.Time
+
+				BeforeEach(func() {
+					start = time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+					end = start.Add(time.Hour)
+					body = fmt.Sprintf(`{"start_time":%d,"end_time":%d}`, start.Unix(), end.Unix())
+				})
+
+				It("cordons the worker during the window", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeWorker.CordonArgsForCall(0)).To(Equal(db.WorkerCordon{
+						StartTime: start,
+						EndTime:   end,
+					}))
+				})
+			})
+
+			Context("when the cordon ends before it starts", func() {
+				BeforeEach(func() {
+					body = `{"start_time":1595926800,"end_time":1595923200}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("cordon must end after it starts")))
+				})
+
+				It("does not cordon the worker", func() {
+					Expect(fakeWorker.CordonCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the cordon has already ended", func() {
+				BeforeEach(func() {
+					body = `{"end_time":1595923200}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("cordon must end in the future")))
+				})
+			})
+
+			Context("when the body is malformed", func() {
+				BeforeEach(func() {
+					body = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when cordoning the worker fails", func() {
+				BeforeEach(func() {
+					fakeWorker.CordonReturns(errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when the request is authorized as the worker's owner", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+		})
+
+		Context("when the request is authorized as the wrong team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/workers/:worker_name/cordon", func() {
+		var (
+			response   *http.Response
+			workerName string
+			fakeWorker *dbfakes.FakeWorker
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/workers/"+workerName+"/cordon", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			fakeWorker = new(dbfakes.FakeWorker)
+			workerName = "some-worker"
+			fakeWorker.NameReturns(workerName)
+			fakeWorker.TeamNameReturns("some-team")
+			fakeWorker.UncordonReturns(nil)
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			dbWorkerFactory.GetWorkerReturns(fakeWorker, true, nil)
+		})
+
+		Context("when the request is authenticated as system", func() {
+			BeforeEach(func() {
+				fakeAccess.IsSystemReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("uncordons the worker", func() {
+				Expect(fakeWorker.UncordonCallCount()).To(Equal(1))
+			})
+
+			Context("when uncordoning the worker fails", func() {
+				BeforeEach(func() {
+					fakeWorker.UncordonReturns(errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the worker does not exist", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.GetWorkerReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when the request is authorized as the wrong team", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/worker-cordons", func() {
+		var (
+			response *http.Response
+			body     string
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/worker-cordons", strings.NewReader(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			body = `{"tags":["some-tag","other-tag"]}`
+
+			fakeAccess.IsAuthenticatedReturns(true)
+		})
+
+		Context("when the request is authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("cordons the workers with the tags right away and indefinitely", func() {
+				Expect(dbWorkerFactory.CordonTaggedWorkersCallCount()).To(Equal(1))
+				tags, cordon := dbWorkerFactory.CordonTaggedWorkersArgsForCall(0)
+				Expect(tags).To(Equal([]string{"some-tag", "other-tag"}))
+				Expect(cordon).To(Equal(db.WorkerCordon{}))
+			})
+
+			Context("when the cordon has a window", func() {
+				var start, end time.Time
+
+				BeforeEach(func() {
+					start = time.Unix(time.Now().Add(time.Hour).Unix(), 0)
+					end = start.Add(time.Hour)
+					body = fmt.Sprintf(`{"tags":["some-tag"],"start_time":%d,"end_time":%d}`, start.Unix(), end.Unix())
+				})
+
+				It("cordons the workers during the window", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					_, cordon := dbWorkerFactory.CordonTaggedWorkersArgsForCall(0)
+					Expect(cordon).To(Equal(db.WorkerCordon{
+						StartTime: start,
+						EndTime:   end,
+					}))
+				})
+			})
+
+			Context("when no tags are given", func() {
+				BeforeEach(func() {
+					body = `{"tags":[]}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("at least one tag is required")))
+					Expect(dbWorkerFactory.CordonTaggedWorkersCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the cordon has already ended", func() {
+				BeforeEach(func() {
+					body = `{"tags":["some-tag"],"end_time":1595923200}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("cordon must end in the future")))
+				})
+			})
+
+			Context("when the body is malformed", func() {
+				BeforeEach(func() {
+					body = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when cordoning the workers fails", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.CordonTaggedWorkersReturns(errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when the request is not authenticated as an admin", func() {
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbWorkerFactory.CordonTaggedWorkersCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/worker-cordons", func() {
+		var (
+			response *http.Response
+			query    string
+		)
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/worker-cordons?"+query, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			query = "tag=some-tag&tag=other-tag"
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			dbWorkerFactory.UncordonTaggedWorkersReturns(true, nil)
+		})
+
+		Context("when the request is authenticated as an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			It("returns 200", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+			})
+
+			It("uncordons the workers with the tags", func() {
+				Expect(dbWorkerFactory.UncordonTaggedWorkersCallCount()).To(Equal(1))
+				Expect(dbWorkerFactory.UncordonTaggedWorkersArgsForCall(0)).To(Equal([]string{"some-tag", "other-tag"}))
+			})
+
+			Context("when no tags are given", func() {
+				BeforeEach(func() {
+					query = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbWorkerFactory.UncordonTaggedWorkersCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the tags are not cordoned", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.UncordonTaggedWorkersReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when uncordoning the workers fails", func() {
+				BeforeEach(func() {
+					dbWorkerFactory.UncordonTaggedWorkersReturns(false, errors.New("some-error"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when the request is not authenticated as an admin", func() {
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbWorkerFactory.UncordonTaggedWorkersCallCount()).To(BeZero())
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/workers/:worker_name/retire", func() {
 		var (
 			response   *http.Response
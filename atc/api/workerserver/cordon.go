@@ -0,0 +1,158 @@
+package workerserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) CordonWorker(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("cordoning-worker")
+	workerName := r.FormValue(":worker_name")
+
+	var cordon atc.WorkerCordon
+	err := json.NewDecoder(r.Body).Decode(&cordon)
+	if err != nil && err != io.EOF {
+		logger.Error("failed-to-decode-cordon", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = cordon.Validate()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if cordon.EndTime != 0 && cordon.EndTime <= time.Now().Unix() {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("cordon must end in the future"))
+		return
+	}
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-finding-worker-to-cordon", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.Cordon(cordonWindow(cordon))
+	if err != nil {
+		logger.Error("failed-to-cordon-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) UncordonWorker(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("uncordoning-worker")
+	workerName := r.FormValue(":worker_name")
+
+	worker, found, err := s.dbWorkerFactory.GetWorker(workerName)
+	if err != nil {
+		logger.Error("failed-finding-worker-to-uncordon", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Error("failed-to-find-worker", err)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	err = worker.Uncordon()
+	if err != nil {
+		logger.Error("failed-to-uncordon-worker", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) CordonTaggedWorkers(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("cordoning-tagged-workers")
+
+	var cordon atc.WorkerTagCordon
+	err := json.NewDecoder(r.Body).Decode(&cordon)
+	if err != nil {
+		logger.Error("failed-to-decode-cordon", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	err = cordon.Validate()
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(err.Error()))
+		return
+	}
+
+	if cordon.EndTime != 0 && cordon.EndTime <= time.Now().Unix() {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("cordon must end in the future"))
+		return
+	}
+
+	err = s.dbWorkerFactory.CordonTaggedWorkers(cordon.Tags, cordonWindow(cordon.WorkerCordon))
+	if err != nil {
+		logger.Error("failed-to-cordon-tagged-workers", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) UncordonTaggedWorkers(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("uncordoning-tagged-workers")
+
+	tags := r.URL.Query()["tag"]
+	if len(tags) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("at least one tag is required"))
+		return
+	}
+
+	found, err := s.dbWorkerFactory.UncordonTaggedWorkers(tags)
+	if err != nil {
+		logger.Error("failed-to-uncordon-tagged-workers", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func cordonWindow(cordon atc.WorkerCordon) db.WorkerCordon {
+	var window db.WorkerCordon
+	if cordon.StartTime != 0 {
+		window.StartTime = time.Unix(cordon.StartTime, 0)
+	}
+
+	if cordon.EndTime != 0 {
+		window.EndTime = time.Unix(cordon.EndTime, 0)
+	}
+
+	return window
+}
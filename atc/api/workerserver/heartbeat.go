@@ -82,6 +82,14 @@ func (s *Server) HeartbeatWorker(w http.ResponseWriter, r *http.Request) {
 		Platform:   registration.Platform,
 	}.Emit(s.logger)
 
+	if registration.Usage != nil {
+		metric.WorkerUsage{
+			WorkerName: registration.Name,
+			Platform:   registration.Platform,
+			Usage:      *registration.Usage,
+		}.Emit(s.logger)
+	}
+
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	err = json.NewEncoder(w).Encode(present.Worker(savedWorker))
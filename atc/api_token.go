@@ -0,0 +1,28 @@
+package atc
+
+import "strings"
+
+// APITokenPrefix starts every API token, which sets them apart from the
+// tokens issued on login.
+const APITokenPrefix = "concourse_"
+
+func IsAPIToken(token string) bool {
+	return strings.HasPrefix(token, APITokenPrefix)
+}
+
+// APIToken is a long-lived token for automation, belonging to either a user
+// or one of the team's service accounts. It only has the role it was created
+// with, and only on its team.
+type APIToken struct {
+	Name           string `json:"name"`
+	TeamName       string `json:"team_name,omitempty"`
+	Role           string `json:"role"`
+	User           string `json:"user,omitempty"`
+	ServiceAccount string `json:"service_account,omitempty"`
+	CreatedAt      int64  `json:"created_at,omitempty"`
+	ExpiresAt      int64  `json:"expires_at"`
+	LastUsedAt     int64  `json:"last_used_at,omitempty"`
+
+	// Token is only ever returned when the token is created.
+	Token string `json:"token,omitempty"`
+}
@@ -33,6 +33,7 @@ import (
 	"github.com/concourse/concourse/atc/compression"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/noop"
+	"github.com/concourse/concourse/atc/crontrigger"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
@@ -41,7 +42,10 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/lidar"
+	"github.com/concourse/concourse/atc/logsink"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/notify"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
@@ -81,6 +85,7 @@ import (
 	_ "github.com/concourse/concourse/atc/creds/credhub"
 	_ "github.com/concourse/concourse/atc/creds/dummy"
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
+	_ "github.com/concourse/concourse/atc/creds/plugin"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
 	_ "github.com/concourse/concourse/atc/creds/vault"
@@ -152,10 +157,12 @@ type RunCommand struct {
 	ResourceCheckingInterval            time.Duration `long:"resource-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources."`
 	ResourceWithWebhookCheckingInterval time.Duration `long:"resource-with-webhook-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources that has webhook defined."`
 
-	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" description:"Method by which a worker is selected during container placement."`
+	ContainerPlacementStrategy        []string      `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-resources" description:"Method by which a worker is selected during container placement. If multiple methods are specified, they are applied in order, each narrowing down the workers left over by the previous one."`
 	MaxActiveTasksPerWorker           int           `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
+	EnableBuildQueue                  bool          `long:"enable-build-queue" description:"Start pending builds by job priority and team fair share once the workers their tasks could run on have no active task slots left. Requires max-active-tasks-per-worker."`
 	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
 	StreamingArtifactsCompression     string        `long:"streaming-artifacts-compression" default:"gzip" choice:"gzip" choice:"zstd" description:"Compression algorithm for internal streaming."`
+	ResourceCachePrefetchLimit        int           `long:"resource-cache-prefetch-limit" default:"4" description:"Maximum number of resource caches streamed to other workers at once for resources configured to be prefetched. 0 disables prefetching."`
 
 	GardenRequestTimeout time.Duration `long:"garden-request-timeout" default:"5m" description:"How long to wait for requests to Garden to complete. 0 means no timeout."`
 
@@ -170,6 +177,8 @@ type RunCommand struct {
 
 	Tracing tracing.Config `group:"Tracing" namespace:"tracing"`
 
+	PolicyChecker policy.Config `group:"Policy Checking" namespace:"policy-check"`
+
 	Server struct {
 		XFrameOptions string `long:"x-frame-options" default:"deny" description:"The value to set for X-Frame-Options."`
 		ClusterName   string `long:"cluster-name" description:"A name for this Concourse cluster, to be displayed on the dashboard page."`
@@ -215,6 +224,9 @@ type RunCommand struct {
 		EnableTeamAuditLog      bool `long:"enable-team-auditing" description:"Enable auditing for all api requests connected to teams."`
 		EnableWorkerAuditLog    bool `long:"enable-worker-auditing" description:"Enable auditing for all api requests connected to workers."`
 		EnableVolumeAuditLog    bool `long:"enable-volume-auditing" description:"Enable auditing for all api requests connected to volumes."`
+
+		Destinations []string      `long:"audit-destination" default:"log" choice:"log" choice:"database" description:"Where to send audited api requests. Can be specified multiple times."`
+		Retention    time.Duration `long:"audit-retention" default:"2160h" description:"How long to keep audit events recorded in the database. 0 means they are kept forever."`
 	}
 
 	Syslog struct {
@@ -225,6 +237,10 @@ type RunCommand struct {
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
 	} ` group:"Syslog Drainer Configuration"`
 
+	BuildLogExport logsink.Config `group:"Build Log Export" namespace:"build-log-export"`
+
+	BuildNotifications notify.Config `group:"Build Notifications" namespace:"build-notification"`
+
 	Auth struct {
 		AuthFlags     skycmd.AuthFlags
 		MainTeamFlags skycmd.AuthTeamFlags `group:"Authentication (Main Team)" namespace:"main-team"`
@@ -423,6 +439,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	})
 
 	atc.EnableGlobalResources = cmd.EnableGlobalResources
+	atc.EnableBuildLogExport = cmd.BuildLogExport.IsConfigured()
 
 	//FIXME: These only need to run once for the entire binary. At the moment,
 	//they rely on state of the command.
@@ -549,12 +566,17 @@ func (cmd *RunCommand) constructMembers(
 		}()
 	}
 
-	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, secretManager)
+	policyChecker, err := policy.Initialize(logger, cmd.Server.ClusterName, concourse.Version, cmd.PolicyChecker)
 	if err != nil {
 		return nil, err
 	}
 
-	backendComponents, err := cmd.backendComponents(logger, backendConn, lockFactory, secretManager)
+	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, secretManager, policyChecker)
+	if err != nil {
+		return nil, err
+	}
+
+	backendComponents, err := cmd.backendComponents(logger, backendConn, lockFactory, secretManager, policyChecker)
 	if err != nil {
 		return nil, err
 	}
@@ -615,6 +637,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
+	policyChecker policy.Checker,
 ) ([]grouper.Member, error) {
 
 	httpClient, err := cmd.skyHttpClient()
@@ -678,7 +701,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	)
 
 	pool := worker.NewPool(workerProvider)
-	workerClient := worker.NewClient(pool, workerProvider, compressionLib, workerAvailabilityPollingInterval, workerStatusPublishInterval)
+	workerClient := worker.NewClient(pool, workerProvider, compressionLib, workerAvailabilityPollingInterval, workerStatusPublishInterval, cmd.ResourceCachePrefetchLimit)
 
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
@@ -688,10 +711,18 @@ func (cmd *RunCommand) constructAPIMembers(
 	gcContainerDestroyer := gc.NewDestroyer(logger, dbContainerRepository, dbVolumeRepository)
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
 	dbCheckFactory := db.NewCheckFactory(dbConn, lockFactory, secretManager, cmd.varSourcePool, cmd.GlobalResourceCheckTimeout)
+	dbBuildNotificationFactory := db.NewBuildNotificationFactory(dbConn)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
-
-	tokenVerifier := cmd.constructTokenVerifier(httpClient)
+	dbAuditEventRepository := db.NewAuditEventRepository(dbConn)
+	dbQuotaRepository := db.NewQuotaRepository(dbConn)
+	dbBuildQueue := cmd.buildQueue(dbConn)
+
+	tokenVerifier := accessor.NewAPITokenVerifier(
+		logger.Session("api-token-verifier"),
+		db.NewAPITokenFactory(dbConn),
+		cmd.constructTokenVerifier(httpClient),
+	)
 
 	accessFactory := accessor.NewAccessFactory(
 		cmd.SystemClaimKey,
@@ -715,13 +746,18 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		userFactory,
+		dbBuildNotificationFactory,
 		workerClient,
 		secretManager,
 		credsManagers,
 		accessFactory,
 		dbWall,
+		dbAuditEventRepository,
+		dbQuotaRepository,
+		dbBuildQueue,
 		tokenVerifier,
 		dbConn.Bus(),
+		policyChecker,
 	)
 	if err != nil {
 		return nil, err
@@ -846,6 +882,7 @@ func (cmd *RunCommand) backendComponents(
 	dbConn db.Conn,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
+	policyChecker policy.Checker,
 ) ([]RunnableComponent, error) {
 	if cmd.Syslog.Address != "" && cmd.Syslog.Transport == "" {
 		return nil, fmt.Errorf("syslog Drainer is misconfigured, cannot configure a drainer without a transport")
@@ -856,6 +893,11 @@ func (cmd *RunCommand) backendComponents(
 		syslogDrainConfigured = false
 	}
 
+	buildLogSink, err := cmd.BuildLogExport.NewSink(logger)
+	if err != nil {
+		return nil, err
+	}
+
 	teamFactory := db.NewTeamFactory(dbConn, lockFactory)
 
 	resourceFactory := resource.NewResourceFactory()
@@ -909,7 +951,8 @@ func (cmd *RunCommand) backendComponents(
 		workerProvider,
 		compressionLib,
 		workerAvailabilityPollingInterval,
-		workerStatusPublishInterval)
+		workerStatusPublishInterval,
+		cmd.ResourceCachePrefetchLimit)
 
 	defaultLimits, err := cmd.parseDefaultLimits()
 	if err != nil {
@@ -932,6 +975,7 @@ func (cmd *RunCommand) backendComponents(
 		defaultLimits,
 		buildContainerStrategy,
 		lockFactory,
+		policyChecker,
 	)
 
 	dbBuildFactory := db.NewBuildFactory(dbConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod)
@@ -995,7 +1039,10 @@ func (cmd *RunCommand) backendComponents(
 						factory.NewBuildFactory(
 							atc.NewPlanFactory(time.Now().Unix()),
 						),
-						alg),
+						alg,
+						cmd.buildQueue(dbConn),
+						db.NewQuotaRepository(dbConn),
+					),
 				},
 				cmd.JobSchedulingMaxInFlight,
 			),
@@ -1022,6 +1069,7 @@ func (cmd *RunCommand) backendComponents(
 					cmd.MaxDaysToRetainBuildLogs,
 				),
 				syslogDrainConfigured,
+				buildLogSink != nil,
 			),
 		},
 	}
@@ -1042,6 +1090,47 @@ func (cmd *RunCommand) backendComponents(
 		})
 	}
 
+	components = append(components, RunnableComponent{
+		Component: atc.Component{
+			Name:     atc.ComponentBuildNotifier,
+			Interval: cmd.BuildNotifications.Interval,
+		},
+		Runnable: notify.NewNotifier(
+			db.NewBuildNotificationFactory(dbConn),
+			dbBuildFactory,
+			secretManager,
+			cmd.varSourcePool,
+			&http.Client{},
+			clock.NewClock(),
+			cmd.ExternalURL.String(),
+			cmd.BuildNotifications,
+		),
+	})
+
+	components = append(components, RunnableComponent{
+		Component: atc.Component{
+			Name:     atc.ComponentJobScheduleTrigger,
+			Interval: 10 * time.Second,
+		},
+		Runnable: crontrigger.NewTrigger(dbJobFactory, clock.NewClock()),
+	})
+
+	if buildLogSink != nil {
+		components = append(components, RunnableComponent{
+			Component: atc.Component{
+				Name:     atc.ComponentBuildLogExporter,
+				Interval: cmd.BuildLogExport.Interval,
+			},
+			Runnable: logsink.NewExporter(
+				buildLogSink,
+				dbBuildFactory,
+				cmd.BuildLogExport.BatchSize,
+				cmd.BuildLogExport.Retries,
+				cmd.BuildLogExport.RetryInterval,
+			),
+		})
+	}
+
 	return components, err
 }
 
@@ -1072,6 +1161,7 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorVolumes:           gc.NewVolumeCollector(dbVolumeRepository, cmd.GC.MissingGracePeriod),
 		atc.ComponentCollectorContainers:        gc.NewContainerCollector(dbContainerRepository, cmd.GC.MissingGracePeriod, cmd.GC.HijackGracePeriod),
 		atc.ComponentCollectorCheckSessions:     gc.NewResourceConfigCheckSessionCollector(resourceConfigCheckSessionLifecycle),
+		atc.ComponentCollectorAuditEvents:       gc.NewAuditEventCollector(db.NewAuditEventRepository(gcConn), cmd.Auditor.Retention),
 	}
 
 	var components []RunnableComponent
@@ -1389,6 +1479,13 @@ func (cmd *RunCommand) validate() error {
 		)
 	}
 
+	if cmd.EnableBuildQueue && cmd.MaxActiveTasksPerWorker == 0 {
+		errs = multierror.Append(
+			errs,
+			errors.New("must specify --max-active-tasks-per-worker to use --enable-build-queue"),
+		)
+	}
+
 	if err := cmd.validateCustomRoles(); err != nil {
 		errs = multierror.Append(errs, err)
 	}
@@ -1462,26 +1559,22 @@ func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
 	return dbConn, nil
 }
 
-func (cmd *RunCommand) chooseBuildContainerStrategy() (worker.ContainerPlacementStrategy, error) {
-	var strategy worker.ContainerPlacementStrategy
-	if cmd.ContainerPlacementStrategy != "limit-active-tasks" && cmd.MaxActiveTasksPerWorker != 0 {
-		return nil, errors.New("max-active-tasks-per-worker has only effect with limit-active-tasks strategy")
-	}
-	if cmd.MaxActiveTasksPerWorker < 0 {
-		return nil, errors.New("max-active-tasks-per-worker must be greater or equal than 0")
-	}
-	switch cmd.ContainerPlacementStrategy {
-	case "random":
-		strategy = worker.NewRandomPlacementStrategy()
-	case "fewest-build-containers":
-		strategy = worker.NewFewestBuildContainersPlacementStrategy()
-	case "limit-active-tasks":
-		strategy = worker.NewLimitActiveTasksPlacementStrategy(cmd.MaxActiveTasksPerWorker)
-	default:
-		strategy = worker.NewVolumeLocalityPlacementStrategy()
+// buildQueue returns the queue of pending builds, which is disabled unless
+// opted into. It knows how busy the workers are from their active tasks, so it
+// relies on the limit-active-tasks placement strategy.
+func (cmd *RunCommand) buildQueue(dbConn db.Conn) db.BuildQueue {
+	if !cmd.EnableBuildQueue {
+		return db.NewBuildQueue(dbConn, 0)
 	}
 
-	return strategy, nil
+	return db.NewBuildQueue(dbConn, cmd.MaxActiveTasksPerWorker)
+}
+
+func (cmd *RunCommand) chooseBuildContainerStrategy() (worker.ContainerPlacementStrategy, error) {
+	return worker.NewContainerPlacementStrategy(worker.ContainerPlacementStrategyOptions{
+		ContainerPlacementStrategy: cmd.ContainerPlacementStrategy,
+		MaxActiveTasksPerWorker:    cmd.MaxActiveTasksPerWorker,
+	})
 }
 
 func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) error {
@@ -1499,7 +1592,12 @@ func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) e
 		return fmt.Errorf("default team auth not configured: %v", err)
 	}
 
-	err = team.UpdateProviderAuth(auth)
+	roles, err := cmd.Auth.MainTeamFlags.Roles()
+	if err != nil {
+		return fmt.Errorf("default team roles misconfigured: %v", err)
+	}
+
+	err = team.UpdateProviderAuth(auth, roles)
 	if err != nil {
 		return err
 	}
@@ -1518,6 +1616,7 @@ func (cmd *RunCommand) constructEngine(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
+	policyChecker policy.Checker,
 ) engine.Engine {
 
 	stepFactory := builder.NewStepFactory(
@@ -1531,6 +1630,7 @@ func (cmd *RunCommand) constructEngine(
 		strategy,
 		lockFactory,
 		cmd.EnableBuildRerunWhenWorkerDisappears,
+		policyChecker,
 	)
 
 	stepBuilder := builder.NewStepBuilder(
@@ -1696,13 +1796,18 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbCheckFactory db.CheckFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbBuildNotificationFactory db.BuildNotificationFactory,
 	workerClient worker.Client,
 	secretManager creds.Secrets,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
 	dbWall db.Wall,
+	dbAuditEventRepository db.AuditEventRepository,
+	dbQuotaRepository db.QuotaRepository,
+	dbBuildQueue db.BuildQueue,
 	tokenVerifier accessor.TokenVerifier,
 	notifications db.NotificationsBus,
+	policyChecker policy.Checker,
 ) (http.Handler, error) {
 
 	checkPipelineAccessHandlerFactory := auth.NewCheckPipelineAccessHandlerFactory(teamFactory)
@@ -1722,6 +1827,8 @@ func (cmd *RunCommand) constructAPIHandler(
 		cmd.Auditor.EnableTeamAuditLog,
 		cmd.Auditor.EnableWorkerAuditLog,
 		cmd.Auditor.EnableVolumeAuditLog,
+		cmd.Auditor.Destinations,
+		dbAuditEventRepository,
 		logger,
 	)
 
@@ -1751,6 +1858,7 @@ func (cmd *RunCommand) constructAPIHandler(
 			wrappa.NewConcurrentRequestPolicy(cmd.ConcurrentRequestLimits),
 		),
 		wrappa.NewAPIMetricsWrappa(logger),
+		wrappa.NewPolicyCheckWrappa(logger, policyChecker),
 		wrappa.NewAPIAuthWrappa(
 			checkPipelineAccessHandlerFactory,
 			checkBuildReadAccessHandlerFactory,
@@ -1789,6 +1897,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbCheckFactory,
 		resourceConfigFactory,
 		dbUserFactory,
+		dbBuildNotificationFactory,
 
 		buildserver.NewEventHandler,
 
@@ -1807,6 +1916,9 @@ func (cmd *RunCommand) constructAPIHandler(
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		time.Minute,
 		dbWall,
+		dbAuditEventRepository,
+		dbQuotaRepository,
+		dbBuildQueue,
 		clock.NewClock(),
 
 		cmd.EnableArchivePipeline,
@@ -0,0 +1,23 @@
+package atc
+
+// AuditEvent records an API request made to the ATC: who made it, what it
+// did, and what it did it to.
+type AuditEvent struct {
+	ID     int    `json:"id"`
+	Time   int64  `json:"time"`
+	Action string `json:"action"`
+
+	UserName string `json:"user_name,omitempty"`
+
+	TeamName     string `json:"team_name,omitempty"`
+	PipelineName string `json:"pipeline_name,omitempty"`
+	JobName      string `json:"job_name,omitempty"`
+	ResourceName string `json:"resource_name,omitempty"`
+	BuildID      int    `json:"build_id,omitempty"`
+
+	// SourceIP is the address the request was received from. ForwardedFor is
+	// the request's X-Forwarded-For header, if any, as given by the client or
+	// any proxies in between.
+	SourceIP     string `json:"source_ip,omitempty"`
+	ForwardedFor string `json:"forwarded_for,omitempty"`
+}
@@ -2,10 +2,20 @@ package auditor
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"strconv"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// Audited actions can be written to the log, recorded in the database, or
+// both.
+const (
+	DestinationLog      = "log"
+	DestinationDatabase = "database"
 )
 
 //go:generate counterfeiter . Auditor
@@ -20,9 +30,11 @@ func NewAuditor(
 	EnableTeamAuditLog bool,
 	EnableWorkerAuditLog bool,
 	EnableVolumeAuditLog bool,
+	destinations []string,
+	auditEventRepository db.AuditEventRepository,
 	logger lager.Logger,
 ) *auditor {
-	return &auditor{
+	a := &auditor{
 		EnableBuildAuditLog:     EnableBuildAuditLog,
 		EnableContainerAuditLog: EnableContainerAuditLog,
 		EnableJobAuditLog:       EnableJobAuditLog,
@@ -32,8 +44,20 @@ func NewAuditor(
 		EnableTeamAuditLog:      EnableTeamAuditLog,
 		EnableWorkerAuditLog:    EnableWorkerAuditLog,
 		EnableVolumeAuditLog:    EnableVolumeAuditLog,
+		auditEventRepository:    auditEventRepository,
 		logger:                  logger,
 	}
+
+	for _, destination := range destinations {
+		switch destination {
+		case DestinationLog:
+			a.toLog = true
+		case DestinationDatabase:
+			a.toDatabase = true
+		}
+	}
+
+	return a
 }
 
 type Auditor interface {
@@ -50,7 +74,12 @@ type auditor struct {
 	EnableTeamAuditLog      bool
 	EnableWorkerAuditLog    bool
 	EnableVolumeAuditLog    bool
-	logger                  lager.Logger
+
+	toLog                bool
+	toDatabase           bool
+	auditEventRepository db.AuditEventRepository
+
+	logger lager.Logger
 }
 
 func (a *auditor) ValidateAction(action string) bool {
@@ -64,6 +93,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.BuildResources,
 		atc.AbortBuild,
 		atc.GetBuildPreparation,
+		atc.ListBuildNotifications,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
@@ -73,6 +103,8 @@ func (a *auditor) ValidateAction(action string) bool {
 	case atc.ListContainers,
 		atc.GetContainer,
 		atc.HijackContainer,
+		atc.RetainBuildContainers,
+		atc.ReleaseBuildContainers,
 		atc.ListDestroyingContainers,
 		atc.ReportWorkerContainers:
 		return a.EnableContainerAuditLog
@@ -82,6 +114,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListJobs,
 		atc.ListJobBuilds,
 		atc.ListJobInputs,
+		atc.GetJobTaskPlan,
 		atc.GetJobBuild,
 		atc.PauseJob,
 		atc.UnpauseJob,
@@ -124,6 +157,9 @@ func (a *auditor) ValidateAction(action string) bool {
 	case
 		atc.SaveConfig,
 		atc.GetConfig,
+		atc.ListConfigVersions,
+		atc.GetConfigVersion,
+		atc.DiffConfigVersions,
 		atc.GetCC,
 		atc.GetVersionsDB,
 		atc.ClearTaskCache,
@@ -136,18 +172,30 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetUser,
 		atc.GetWall,
 		atc.SetWall,
-		atc.ClearWall:
+		atc.ClearWall,
+		atc.ListAuditEvents,
+		atc.GetClusterQuota,
+		atc.SetClusterQuota:
 		return a.EnableSystemAuditLog
 	case atc.ListTeams,
 		atc.SetTeam,
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
-		atc.GetTeam:
+		atc.GetTeam,
+		atc.ListAPITokens,
+		atc.CreateAPIToken,
+		atc.RevokeAPIToken,
+		atc.GetTeamQuota,
+		atc.SetTeamQuota:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
 		atc.LandWorker,
 		atc.RetireWorker,
+		atc.CordonWorker,
+		atc.UncordonWorker,
+		atc.CordonTaggedWorkers,
+		atc.UncordonTaggedWorkers,
 		atc.PruneWorker,
 		atc.HeartbeatWorker,
 		atc.ListWorkers,
@@ -164,7 +212,41 @@ func (a *auditor) ValidateAction(action string) bool {
 
 func (a *auditor) Audit(action string, userName string, r *http.Request) {
 	err := r.ParseForm()
-	if err == nil && a.ValidateAction(action) {
+	if err != nil || !a.ValidateAction(action) {
+		return
+	}
+
+	if a.toLog {
 		a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
 	}
+
+	if a.toDatabase {
+		err = a.auditEventRepository.Record(auditEvent(action, userName, r))
+		if err != nil {
+			a.logger.Error("failed-to-record-audit-event", err, lager.Data{"action": action, "user": userName})
+		}
+	}
+}
+
+// auditEvent describes the request, taking what it was done to from the
+// route's parameters.
+func auditEvent(action string, userName string, r *http.Request) atc.AuditEvent {
+	buildID, _ := strconv.Atoi(r.FormValue(":build_id"))
+
+	sourceIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		sourceIP = r.RemoteAddr
+	}
+
+	return atc.AuditEvent{
+		Action:       action,
+		UserName:     userName,
+		TeamName:     r.FormValue(":team_name"),
+		PipelineName: r.FormValue(":pipeline_name"),
+		JobName:      r.FormValue(":job_name"),
+		ResourceName: r.FormValue(":resource_name"),
+		BuildID:      buildID,
+		SourceIP:     sourceIP,
+		ForwardedFor: r.Header.Get("X-Forwarded-For"),
+	}
 }
@@ -1,12 +1,15 @@
 package auditor_test
 
 import (
+	"errors"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/lager/lagertest"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -28,10 +31,14 @@ var _ = Describe("Audit", func() {
 		EnableTeamAuditLog      bool
 		EnableWorkerAuditLog    bool
 		EnableVolumeAuditLog    bool
+		destinations            []string
+		fakeAuditEventRepo      *dbfakes.FakeAuditEventRepository
 	)
 
 	BeforeEach(func() {
 		userName = "test"
+		destinations = []string{auditor.DestinationLog}
+		fakeAuditEventRepo = new(dbfakes.FakeAuditEventRepository)
 
 		var err error
 		req, err = http.NewRequest("GET", "localhost:8080", nil)
@@ -51,6 +58,8 @@ var _ = Describe("Audit", func() {
 			EnableTeamAuditLog,
 			EnableWorkerAuditLog,
 			EnableVolumeAuditLog,
+			destinations,
+			fakeAuditEventRepo,
 			logger,
 		)
 	})
@@ -87,6 +96,76 @@ var _ = Describe("Audit", func() {
 		})
 	})
 
+	Describe("destinations", func() {
+		BeforeEach(func() {
+			EnablePipelineAuditLog = true
+
+			var err error
+			req, err = http.NewRequest("PUT", "/api/v1/teams/some-team/pipelines/some-pipeline/pause?:team_name=some-team&:pipeline_name=some-pipeline", strings.NewReader(""))
+			Expect(err).NotTo(HaveOccurred())
+
+			req.RemoteAddr = "10.0.0.1:12345"
+			req.Header.Set("X-Forwarded-For", "1.2.3.4")
+		})
+
+		Context("when auditing to the log", func() {
+			It("logs the action without recording it", func() {
+				aud.Audit(atc.PausePipeline, userName, req)
+				Expect(logger.Logs()).To(HaveLen(1))
+				Expect(fakeAuditEventRepo.RecordCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when auditing to the database", func() {
+			BeforeEach(func() {
+				destinations = []string{auditor.DestinationDatabase}
+			})
+
+			It("records the action and what it was done to", func() {
+				aud.Audit(atc.PausePipeline, userName, req)
+				Expect(logger.Logs()).To(BeEmpty())
+
+				Expect(fakeAuditEventRepo.RecordCallCount()).To(Equal(1))
+				Expect(fakeAuditEventRepo.RecordArgsForCall(0)).To(Equal(atc.AuditEvent{
+					Action:       atc.PausePipeline,
+					UserName:     "test",
+					TeamName:     "some-team",
+					PipelineName: "some-pipeline",
+					SourceIP:     "10.0.0.1",
+					ForwardedFor: "1.2.3.4",
+				}))
+			})
+
+			It("does not record actions whose auditing is disabled", func() {
+				aud.Audit(atc.SetLogLevel, userName, req)
+				Expect(fakeAuditEventRepo.RecordCallCount()).To(Equal(0))
+			})
+
+			Context("when recording fails", func() {
+				BeforeEach(func() {
+					fakeAuditEventRepo.RecordReturns(errors.New("disaster"))
+				})
+
+				It("logs the error", func() {
+					aud.Audit(atc.PausePipeline, userName, req)
+					Expect(logger.LogMessages()).To(ConsistOf("access_handler.failed-to-record-audit-event"))
+				})
+			})
+		})
+
+		Context("when auditing to both", func() {
+			BeforeEach(func() {
+				destinations = []string{auditor.DestinationLog, auditor.DestinationDatabase}
+			})
+
+			It("logs and records the action", func() {
+				aud.Audit(atc.PausePipeline, userName, req)
+				Expect(logger.Logs()).To(HaveLen(1))
+				Expect(fakeAuditEventRepo.RecordCallCount()).To(Equal(1))
+			})
+		})
+	})
+
 	Describe("EnableBuildAuditLog", func() {
 
 		Context("When EnableBuildAudit is false with a Build action", func() {
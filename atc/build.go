@@ -11,19 +11,26 @@ const (
 	StatusAborted   BuildStatus = "aborted"
 )
 
+// EnableBuildLogExport is set when a build log sink is configured. Only the
+// builds that finish while it is set are left to be exported.
+var EnableBuildLogExport bool
+
 type Build struct {
-	ID           int           `json:"id"`
-	TeamName     string        `json:"team_name"`
-	Name         string        `json:"name"`
-	Status       string        `json:"status"`
-	JobName      string        `json:"job_name,omitempty"`
-	APIURL       string        `json:"api_url"`
-	PipelineName string        `json:"pipeline_name,omitempty"`
-	StartTime    int64         `json:"start_time,omitempty"`
-	EndTime      int64         `json:"end_time,omitempty"`
-	ReapTime     int64         `json:"reap_time,omitempty"`
-	RerunNumber  int           `json:"rerun_number,omitempty"`
-	RerunOf      *RerunOfBuild `json:"rerun_of,omitempty"`
+	ID                   int           `json:"id"`
+	TeamName             string        `json:"team_name"`
+	Name                 string        `json:"name"`
+	Status               string        `json:"status"`
+	JobName              string        `json:"job_name,omitempty"`
+	APIURL               string        `json:"api_url"`
+	PipelineName         string        `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars  `json:"pipeline_instance_vars,omitempty"`
+	StartTime            int64         `json:"start_time,omitempty"`
+	EndTime              int64         `json:"end_time,omitempty"`
+	ReapTime             int64         `json:"reap_time,omitempty"`
+	RerunNumber          int           `json:"rerun_number,omitempty"`
+	RerunOf              *RerunOfBuild `json:"rerun_of,omitempty"`
+
+	ContainersRetainedUntil int64 `json:"containers_retained_until,omitempty"`
 }
 
 type RerunOfBuild struct {
@@ -63,7 +70,10 @@ type BuildPreparation struct {
 	PausedPipeline      BuildPreparationStatus            `json:"paused_pipeline"`
 	PausedJob           BuildPreparationStatus            `json:"paused_job"`
 	MaxRunningBuilds    BuildPreparationStatus            `json:"max_running_builds"`
+	TeamQuota           BuildPreparationStatus            `json:"team_quota"`
+	ClusterQuota        BuildPreparationStatus            `json:"cluster_quota"`
 	Inputs              map[string]BuildPreparationStatus `json:"inputs"`
 	InputsSatisfied     BuildPreparationStatus            `json:"inputs_satisfied"`
 	MissingInputReasons MissingInputReasons               `json:"missing_input_reasons"`
+	QueuePosition       int                               `json:"queue_position,omitempty"`
 }
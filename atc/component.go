@@ -9,7 +9,11 @@ const (
 	ComponentLidarChecker               = "checker"
 	ComponentBuildReaper                = "reaper"
 	ComponentSyslogDrainer              = "drainer"
+	ComponentBuildLogExporter           = "build_log_exporter"
+	ComponentBuildNotifier              = "build_notifier"
+	ComponentJobScheduleTrigger         = "job_schedule_trigger"
 	ComponentCollectorArtifacts         = "collector_artifacts"
+	ComponentCollectorAuditEvents       = "collector_audit_events"
 	ComponentCollectorBuilds            = "collector_builds"
 	ComponentCollectorCheckSessions     = "collector_check_sessions"
 	ComponentCollectorChecks            = "collector_checks"
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"golang.org/x/crypto/ssh"
@@ -19,11 +20,12 @@ const DefaultTeamName = "main"
 type Tags []string
 
 type Config struct {
-	Groups        GroupConfigs     `json:"groups,omitempty"`
-	VarSources    VarSourceConfigs `json:"var_sources,omitempty"`
-	Resources     ResourceConfigs  `json:"resources,omitempty"`
-	ResourceTypes ResourceTypes    `json:"resource_types,omitempty"`
-	Jobs          JobConfigs       `json:"jobs,omitempty"`
+	Groups        GroupConfigs        `json:"groups,omitempty"`
+	VarSources    VarSourceConfigs    `json:"var_sources,omitempty"`
+	Resources     ResourceConfigs     `json:"resources,omitempty"`
+	ResourceTypes ResourceTypes       `json:"resource_types,omitempty"`
+	Jobs          JobConfigs          `json:"jobs,omitempty"`
+	Notifications NotificationConfigs `json:"notifications,omitempty"`
 }
 
 func UnmarshalConfig(payload []byte, config interface{}) error {
@@ -34,6 +36,7 @@ func UnmarshalConfig(payload []byte, config interface{}) error {
 		Resources     interface{} `json:"resources,omitempty"`
 		ResourceTypes interface{} `json:"resource_types,omitempty"`
 		Jobs          interface{} `json:"jobs,omitempty"`
+		Notifications interface{} `json:"notifications,omitempty"`
 	}
 
 	var stripped skeletonConfig
@@ -183,6 +186,7 @@ type ResourceConfig struct {
 	Tags         Tags    `json:"tags,omitempty"`
 	Version      Version `json:"version,omitempty"`
 	Icon         string  `json:"icon,omitempty"`
+	Prefetch     bool    `json:"prefetch,omitempty"`
 }
 
 type ResourceType struct {
@@ -386,6 +390,116 @@ func (c *InParallelConfig) UnmarshalJSON(payload []byte) error {
 	return nil
 }
 
+// An AcrossVarConfig configures a var to be set to each of the given values
+// when running the step it modifies.
+type AcrossVarConfig struct {
+	Var         string             `json:"var"`
+	Values      AcrossValuesConfig `json:"values"`
+	MaxInFlight *MaxInFlightConfig `json:"max_in_flight,omitempty"`
+}
+
+// An AcrossValuesConfig represents either a static list of values for an
+// across var, or a reference to a local var set by a load_var step, e.g.
+// ((.:versions)).
+type AcrossValuesConfig struct {
+	Static  []interface{}
+	LoadVar string
+}
+
+var localVarRefRegexp = regexp.MustCompile(`^\(\(\.:([-\w\p{L}]+)\)\)$`)
+
+func (c *AcrossValuesConfig) UnmarshalJSON(values []byte) error {
+	var data interface{}
+
+	err := json.Unmarshal(values, &data)
+	if err != nil {
+		return err
+	}
+
+	switch actual := data.(type) {
+	case []interface{}:
+		c.Static = actual
+	case string:
+		match := localVarRefRegexp.FindStringSubmatch(strings.TrimSpace(actual))
+		if match == nil {
+			return fmt.Errorf("across values must be a list or a local var reference (e.g. ((.:my-var))), got '%s'", actual)
+		}
+
+		c.LoadVar = match[1]
+	default:
+		return errors.New("unknown type for across values")
+	}
+
+	return nil
+}
+
+func (c AcrossValuesConfig) MarshalJSON() ([]byte, error) {
+	if c.LoadVar != "" {
+		return json.Marshal(fmt.Sprintf("((.:%s))", c.LoadVar))
+	}
+
+	if c.Static != nil {
+		return json.Marshal(c.Static)
+	}
+
+	return json.Marshal([]interface{}{})
+}
+
+// A MaxInFlightConfig represents the maximum number of sub-steps to run at
+// once, either as a fixed limit or "all".
+type MaxInFlightConfig struct {
+	All   bool
+	Limit int
+}
+
+const MaxInFlightAll = "all"
+
+func (c *MaxInFlightConfig) UnmarshalJSON(limit []byte) error {
+	var data interface{}
+
+	err := json.Unmarshal(limit, &data)
+	if err != nil {
+		return err
+	}
+
+	switch actual := data.(type) {
+	case string:
+		if actual != MaxInFlightAll {
+			return fmt.Errorf("invalid max_in_flight '%s', must be a number or '%s'", actual, MaxInFlightAll)
+		}
+
+		c.All = true
+	case float64:
+		c.Limit = int(actual)
+	default:
+		return errors.New("unknown type for max_in_flight")
+	}
+
+	return nil
+}
+
+func (c MaxInFlightConfig) MarshalJSON() ([]byte, error) {
+	if c.All {
+		return json.Marshal(MaxInFlightAll)
+	}
+
+	return json.Marshal(c.Limit)
+}
+
+// EffectiveLimit returns the limit to apply, where 0 means unlimited. If no
+// max_in_flight was configured, sub-steps run one at a time.
+func (c *MaxInFlightConfig) EffectiveLimit() int {
+	if c == nil {
+		return 1
+	}
+
+	if c.All {
+		return 0
+	}
+
+	return c.Limit
+}
+
 // A PlanConfig is a flattened set of configuration corresponding to
 // a particular Plan, where Source and Version are populated lazily.
 type PlanConfig struct {
@@ -408,7 +522,8 @@ type PlanConfig struct {
 	// corresponds to Get and Put resource plans, respectively
 	// name of 'input', e.g. bosh-stemcell
 	Get string `json:"get,omitempty"`
-	// jobs that this resource must have made it through
+	// jobs that this resource must have made it through, either in this
+	// pipeline or, as pipeline/job, in another pipeline of the team
 	Passed []string `json:"passed,omitempty"`
 	// whether to trigger based on this resource changing
 	Trigger bool `json:"trigger,omitempty"`
@@ -433,6 +548,8 @@ type PlanConfig struct {
 	// name of 'set_pipeline'
 	SetPipeline string   `json:"set_pipeline,omitempty"`
 	VarFiles    []string `json:"var_files,omitempty"`
+	// instance vars identifying the instance of the pipeline to set
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
 
 	// config path, e.g. foo/build.yml. Multiple steps might have this field, e.g. Task step and SetPipeline step.
 	File string `json:"file,omitempty"`
@@ -483,6 +600,12 @@ type PlanConfig struct {
 	// repeat the step up to N times, until it works
 	Attempts int `json:"attempts,omitempty"`
 
+	// run the step once for each combination of the given vars' values
+	Across []AcrossVarConfig `json:"across,omitempty"`
+
+	// used with across to stop running sub-steps once one of them fails
+	FailFast bool `json:"fail_fast,omitempty"`
+
 	Version *VersionConfig `json:"version,omitempty"`
 
 	// name of 'load_var' step
@@ -534,10 +657,45 @@ func (config PlanConfig) ResourceName() string {
 	panic("no resource name!")
 }
 
+// SplitPassedJob splits a passed constraint of the form pipeline/job, which
+// refers to a job in another pipeline of the team. The bool is false when the
+// constraint is not of that form.
+//
+// Jobs in the same pipeline take precedence, so callers should only split a
+// constraint after failing to find a job with that exact name.
+func SplitPassedJob(passed string) (string, string, bool) {
+	segments := strings.SplitN(passed, "/", 2)
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", false
+	}
+
+	return segments[0], segments[1], true
+}
+
 func (config PlanConfig) Hooks() Hooks {
 	return Hooks{Abort: config.Abort, Error: config.Error, Failure: config.Failure, Ensure: config.Ensure, Success: config.Success}
 }
 
+// TaskPlan is the plan of a task step, as the job's builds run it. The config
+// file, vars and params are left for the task step to load and interpolate
+// when the build runs.
+func (config PlanConfig) TaskPlan(resourceTypes VersionedResourceTypes) TaskPlan {
+	return TaskPlan{
+		Name:              config.Task,
+		Privileged:        config.Privileged,
+		Config:            config.TaskConfig,
+		ConfigPath:        config.File,
+		Vars:              config.Vars,
+		Tags:              config.Tags,
+		Params:            config.Params,
+		InputMapping:      config.InputMapping,
+		OutputMapping:     config.OutputMapping,
+		ImageArtifactName: config.ImageArtifactName,
+
+		VersionedResourceTypes: resourceTypes,
+	}
+}
+
 type ResourceConfigs []ResourceConfig
 
 func (resources ResourceConfigs) Lookup(name string) (ResourceConfig, bool) {
@@ -41,6 +41,64 @@ var _ = Describe("Config", func() {
 		})
 	})
 
+	Describe("AcrossVarConfig", func() {
+		Context("when unmarshaling static values", func() {
+			It("produces the values and max in flight", func() {
+				var acrossVar AcrossVarConfig
+				bs := []byte(`{ "var": "v", "values": ["a", 1, {"k": "v"}], "max_in_flight": 2 }`)
+				err := json.Unmarshal(bs, &acrossVar)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(acrossVar).To(Equal(AcrossVarConfig{
+					Var: "v",
+					Values: AcrossValuesConfig{
+						Static: []interface{}{"a", float64(1), map[string]interface{}{"k": "v"}},
+					},
+					MaxInFlight: &MaxInFlightConfig{Limit: 2},
+				}))
+			})
+		})
+
+		Context("when unmarshaling values from a local var", func() {
+			It("produces the name of the local var", func() {
+				var acrossVar AcrossVarConfig
+				bs := []byte(`{ "var": "v", "values": "((.:loaded-var))", "max_in_flight": "all" }`)
+				err := json.Unmarshal(bs, &acrossVar)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(acrossVar).To(Equal(AcrossVarConfig{
+					Var:         "v",
+					Values:      AcrossValuesConfig{LoadVar: "loaded-var"},
+					MaxInFlight: &MaxInFlightConfig{All: true},
+				}))
+			})
+
+			It("marshals back to the local var reference", func() {
+				bs, err := json.Marshal(AcrossValuesConfig{LoadVar: "loaded-var"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(bs).To(MatchJSON(`"((.:loaded-var))"`))
+			})
+		})
+
+		Context("when the values are some other string", func() {
+			It("produces an error", func() {
+				var acrossVar AcrossVarConfig
+				bs := []byte(`{ "var": "v", "values": "((some-cred))" }`)
+				err := json.Unmarshal(bs, &acrossVar)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when max in flight is some other string", func() {
+			It("produces an error", func() {
+				var acrossVar AcrossVarConfig
+				bs := []byte(`{ "var": "v", "values": [], "max_in_flight": "lots" }`)
+				err := json.Unmarshal(bs, &acrossVar)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("VarSourceConfigs.OrderByDependency", func() {
 		var (
 			varSources VarSourceConfigs
@@ -143,4 +201,33 @@ var _ = Describe("Config", func() {
 			})
 		})
 	})
+
+	Describe("SplitPassedJob", func() {
+		It("splits a job in another pipeline", func() {
+			pipeline, job, ok := SplitPassedJob("other-pipeline/some-job")
+			Expect(ok).To(BeTrue())
+			Expect(pipeline).To(Equal("other-pipeline"))
+			Expect(job).To(Equal("some-job"))
+		})
+
+		It("keeps any further slashes in the job name", func() {
+			pipeline, job, ok := SplitPassedJob("other-pipeline/some/job")
+			Expect(ok).To(BeTrue())
+			Expect(pipeline).To(Equal("other-pipeline"))
+			Expect(job).To(Equal("some/job"))
+		})
+
+		It("does not split a job in the same pipeline", func() {
+			_, _, ok := SplitPassedJob("some-job")
+			Expect(ok).To(BeFalse())
+		})
+
+		It("does not split a constraint with an empty pipeline or job", func() {
+			_, _, ok := SplitPassedJob("/some-job")
+			Expect(ok).To(BeFalse())
+
+			_, _, ok = SplitPassedJob("other-pipeline/")
+			Expect(ok).To(BeFalse())
+		})
+	})
 })
@@ -52,6 +52,11 @@ func Validate(c Config) ([]ConfigWarning, []string) {
 	}
 	warnings = append(warnings, jobWarnings...)
 
+	notificationsErr := validateNotifications(c)
+	if notificationsErr != nil {
+		errorMessages = append(errorMessages, formatErr("notifications", notificationsErr))
+	}
+
 	return warnings, errorMessages
 }
 
@@ -276,6 +281,30 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			}
 		}
 
+		if job.KeepContainersOnFailure != "" {
+			retention, err := time.ParseDuration(job.KeepContainersOnFailure)
+			if err != nil {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has keep_containers_on_failure that could not be parsed ('%s')", job.KeepContainersOnFailure),
+				)
+			} else if retention < 0 {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has negative keep_containers_on_failure: %s", job.KeepContainersOnFailure),
+				)
+			}
+		}
+
+		if job.Schedule != nil {
+			if err := job.Schedule.Validate(); err != nil {
+				errorMessages = append(
+					errorMessages,
+					identifier+fmt.Sprintf(" has an invalid schedule: %s", err),
+				)
+			}
+		}
+
 		planWarnings, planErrMessages := validatePlan(c, identifier+".plan", PlanConfig{Do: &job.Plan})
 		warnings = append(warnings, planWarnings...)
 		errorMessages = append(errorMessages, planErrMessages...)
@@ -488,6 +517,12 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		for _, job := range plan.Passed {
 			jobConfig, found := c.Jobs.Lookup(job)
 			if !found {
+				if _, _, ok := SplitPassedJob(job); ok {
+					// jobs in other pipelines are looked up when the pipeline is
+					// saved
+					continue
+				}
+
 				errorMessages = append(
 					errorMessages,
 					fmt.Sprintf(
@@ -661,9 +696,46 @@ func validatePlan(c Config, identifier string, plan PlanConfig) ([]ConfigWarning
 		errorMessages = append(errorMessages, subIdentifier+fmt.Sprintf(" has an invalid number of attempts (%d)", plan.Attempts))
 	}
 
+	if len(plan.Across) > 0 {
+		warnings = append(warnings, ConfigWarning{
+			Type:    "pipeline",
+			Message: identifier + " : the across step modifier is experimental and subject to change",
+		})
+
+		errorMessages = append(errorMessages, validateAcross(identifier, plan)...)
+	} else if plan.FailFast {
+		errorMessages = append(errorMessages, identifier+".fail_fast can only be used with across")
+	}
+
 	return warnings, errorMessages
 }
 
+func validateAcross(identifier string, plan PlanConfig) []string {
+	var errorMessages []string
+
+	seen := map[string]bool{}
+	for i, acrossVar := range plan.Across {
+		subIdentifier := fmt.Sprintf("%s.across[%d]", identifier, i)
+
+		if acrossVar.Var == "" {
+			errorMessages = append(errorMessages, subIdentifier+" has no var name")
+		} else if seen[acrossVar.Var] {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s repeats var '%s'", subIdentifier, acrossVar.Var))
+		}
+		seen[acrossVar.Var] = true
+
+		if acrossVar.Values.LoadVar == "" && len(acrossVar.Values.Static) == 0 {
+			errorMessages = append(errorMessages, subIdentifier+" has no values")
+		}
+
+		if acrossVar.MaxInFlight != nil && !acrossVar.MaxInFlight.All && acrossVar.MaxInFlight.Limit < 1 {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s.max_in_flight must be greater than 0 (%d)", subIdentifier, acrossVar.MaxInFlight.Limit))
+		}
+	}
+
+	return errorMessages
+}
+
 func validateInapplicableFields(inapplicableFields []string, plan PlanConfig, identifier string) []string {
 	var errorMessages []string
 	var foundInapplicableFields []string
@@ -731,7 +803,7 @@ func validateVarSources(c Config) error {
 		// TODO: this check should eventually be removed once all credential managers
 		// are supported in pipeline. - @evanchaoli
 		switch cm.Type {
-		case "vault", "dummy", "ssm":
+		case "vault", "dummy", "ssm", "plugin":
 		default:
 			return fmt.Errorf("credential manager type %s is not supported in pipeline yet", cm.Type)
 		}
@@ -757,3 +829,60 @@ func validateVarSources(c Config) error {
 
 	return nil
 }
+
+func validateNotifications(c Config) error {
+	errorMessages := []string{}
+
+	names := map[string]int{}
+
+	for i, notification := range c.Notifications {
+		var identifier string
+		if notification.Name == "" {
+			identifier = fmt.Sprintf("notifications[%d]", i)
+		} else {
+			identifier = fmt.Sprintf("notifications.%s", notification.Name)
+		}
+
+		if notification.Name == "" {
+			errorMessages = append(errorMessages, identifier+" has no name")
+		} else if existing, found := names[notification.Name]; found {
+			errorMessages = append(errorMessages, fmt.Sprintf(
+				"notifications[%d] and notifications[%d] have the same name ('%s')",
+				existing, i, notification.Name))
+		} else {
+			names[notification.Name] = i
+		}
+
+		for _, job := range notification.Jobs {
+			if _, found := c.Jobs.Lookup(job); !found {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s has unknown job '%s'", identifier, job))
+			}
+		}
+
+		for _, statuses := range [][]BuildStatus{notification.Statuses, notification.PreviousStatuses} {
+			for _, status := range statuses {
+				switch status {
+				case StatusSucceeded, StatusFailed, StatusErrored, StatusAborted:
+				default:
+					errorMessages = append(errorMessages, fmt.Sprintf("%s has invalid status '%s'", identifier, status))
+				}
+			}
+		}
+
+		if notification.Webhook.URL == "" {
+			errorMessages = append(errorMessages, identifier+" has no webhook url")
+		}
+
+		switch notification.Webhook.Method {
+		case "", "POST", "PUT", "PATCH":
+		default:
+			errorMessages = append(errorMessages, fmt.Sprintf("%s has invalid webhook method '%s'", identifier, notification.Webhook.Method))
+		}
+
+		if _, err := notification.Webhook.BodyTemplate(); err != nil {
+			errorMessages = append(errorMessages, fmt.Sprintf("%s has invalid webhook body: %s", identifier, err))
+		}
+	}
+
+	return compositeErr(errorMessages)
+}
@@ -8,6 +8,7 @@ import (
 
 	// load dummy credential manager
 	_ "github.com/concourse/concourse/atc/creds/dummy"
+	_ "github.com/concourse/concourse/atc/creds/plugin"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -17,6 +18,7 @@ var _ = Describe("ValidateConfig", func() {
 	var (
 		config Config
 
+		warnings      []ConfigWarning
 		errorMessages []string
 	)
 
@@ -98,7 +100,7 @@ var _ = Describe("ValidateConfig", func() {
 	})
 
 	JustBeforeEach(func() {
-		_, errorMessages = configvalidate.Validate(config)
+		warnings, errorMessages = configvalidate.Validate(config)
 	})
 
 	Context("when the config is valid", func() {
@@ -224,6 +226,40 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a plugin var source refers to a path", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, VarSourceConfig{
+					Name: "some",
+					Type: "plugin",
+					Config: map[string]interface{}{
+						"plugin": "../../bin/sh",
+					},
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("credential manager some is invalid: plugin must be the name of an executable in the plugin directory, not a path"))
+			})
+		})
+
+		Context("when a plugin var source is valid", func() {
+			BeforeEach(func() {
+				config.VarSources = append(config.VarSources, VarSourceConfig{
+					Name: "some",
+					Type: "plugin",
+					Config: map[string]interface{}{
+						"plugin": "in-house-secrets",
+						"source": map[string]interface{}{"team": "some-team"},
+					},
+				})
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
 		Context("when duplicate var source names", func() {
 			BeforeEach(func() {
 				config.VarSources = append(config.VarSources,
@@ -1391,6 +1427,37 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a job's input's passed constraints reference a job in another pipeline", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:    "some-resource",
+						Passed: []string{"other-pipeline/some-job"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a job's input's passed constraints reference a job in another pipeline without naming the job", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Get:    "some-resource",
+						Passed: []string{"other-pipeline/"},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].get.some-resource.passed references an unknown job ('other-pipeline/')"))
+				})
+			})
+
 			Context("when a job's input's passed constraints references a valid job that has the resource as an output", func() {
 				BeforeEach(func() {
 					config.Jobs[0].Plan = append(config.Jobs[0].Plan, PlanConfig{
@@ -1491,6 +1558,81 @@ var _ = Describe("ValidateConfig", func() {
 					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job has load_var steps with the same name: a-var"))
 				})
 			})
+
+			Context("when a step has a valid across", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Task:       "some-task",
+						TaskConfig: &TaskConfig{Platform: "linux", Run: TaskRunConfig{Path: "ls"}, RootfsURI: "some-image"},
+						Across: []AcrossVarConfig{
+							{
+								Var:         "v1",
+								Values:      AcrossValuesConfig{Static: []interface{}{"a", "b"}},
+								MaxInFlight: &MaxInFlightConfig{All: true},
+							},
+							{
+								Var:    "v2",
+								Values: AcrossValuesConfig{LoadVar: "loaded"},
+							},
+						},
+						FailFast: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("warns that across is experimental", func() {
+					Expect(errorMessages).To(BeEmpty())
+					Expect(warnings).To(ContainElement(ConfigWarning{
+						Type:    "pipeline",
+						Message: "jobs.some-other-job.plan[0].task.some-task : the across step modifier is experimental and subject to change",
+					}))
+				})
+			})
+
+			Context("when an across var is invalid", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put: "some-resource",
+						Across: []AcrossVarConfig{
+							{
+								Var:         "v",
+								Values:      AcrossValuesConfig{},
+								MaxInFlight: &MaxInFlightConfig{Limit: 0},
+							},
+							{
+								Var:    "v",
+								Values: AcrossValuesConfig{Static: []interface{}{"a"}},
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error for each problem", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[0] has no values"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[0].max_in_flight must be greater than 0 (0)"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.across[1] repeats var 'v'"))
+				})
+			})
+
+			Context("when fail_fast is used without across", func() {
+				BeforeEach(func() {
+					job.Plan = append(job.Plan, PlanConfig{
+						Put:      "some-resource",
+						FailFast: true,
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan[0].put.some-resource.fail_fast can only be used with across"))
+				})
+			})
 		})
 
 		Context("when two jobs have the same name", func() {
@@ -1544,5 +1686,161 @@ var _ = Describe("ValidateConfig", func() {
 				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has negative build_log_retention.days: -1"))
 			})
 		})
+
+		Context("when a job has a keep_containers_on_failure that can't be parsed", func() {
+			BeforeEach(func() {
+				config.Jobs[0].KeepContainersOnFailure = "nope"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has keep_containers_on_failure that could not be parsed ('nope')"))
+			})
+		})
+
+		Context("when a job has a negative keep_containers_on_failure", func() {
+			BeforeEach(func() {
+				config.Jobs[0].KeepContainersOnFailure = "-1h"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has negative keep_containers_on_failure: -1h"))
+			})
+		})
+
+		Context("when a job has a valid schedule", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &ScheduleConfig{
+					Cron:     "0 2 * * 1-5",
+					Location: "America/Toronto",
+					Jitter:   "10m",
+				}
+			})
+
+			It("is valid", func() {
+				Expect(errorMessages).To(BeEmpty())
+			})
+		})
+
+		Context("when a job has a schedule with an invalid cron", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &ScheduleConfig{Cron: "every night"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has an invalid schedule: invalid cron 'every night'"))
+			})
+		})
+
+		Context("when a job has a schedule with an unknown location", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &ScheduleConfig{Cron: "@daily", Location: "Mars/Olympus_Mons"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has an invalid schedule: invalid location 'Mars/Olympus_Mons'"))
+			})
+		})
+	})
+
+	Describe("invalid notifications", func() {
+		BeforeEach(func() {
+			config.Notifications = NotificationConfigs{
+				{
+					Name:             "some-notification",
+					Jobs:             []string{"some-job"},
+					Statuses:         []BuildStatus{StatusFailed},
+					PreviousStatuses: []BuildStatus{StatusSucceeded},
+					Webhook: NotificationWebhookConfig{
+						URL:  "https://example.com/hook",
+						Body: `{"text": {{json .Job}}}`,
+					},
+				},
+			}
+		})
+
+		It("is valid", func() {
+			Expect(errorMessages).To(BeEmpty())
+		})
+
+		Context("when a notification has no name", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Name = ""
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications[0] has no name"))
+			})
+		})
+
+		Context("when two notifications have the same name", func() {
+			BeforeEach(func() {
+				config.Notifications = append(config.Notifications, config.Notifications[0])
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications[0] and notifications[1] have the same name ('some-notification')"))
+			})
+		})
+
+		Context("when a notification has an unknown job", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Jobs = []string{"bogus-job"}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-notification has unknown job 'bogus-job'"))
+			})
+		})
+
+		Context("when a notification has an invalid status", func() {
+			BeforeEach(func() {
+				config.Notifications[0].PreviousStatuses = []BuildStatus{StatusStarted}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-notification has invalid status 'started'"))
+			})
+		})
+
+		Context("when a notification has no webhook url", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Webhook.URL = ""
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-notification has no webhook url"))
+			})
+		})
+
+		Context("when a notification has an invalid webhook method", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Webhook.Method = "GET"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-notification has invalid webhook method 'GET'"))
+			})
+		})
+
+		Context("when a notification has a webhook body that can't be parsed", func() {
+			BeforeEach(func() {
+				config.Notifications[0].Webhook.Body = "{{.Job"
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("notifications.some-notification has invalid webhook body"))
+			})
+		})
 	})
 })
@@ -47,7 +47,7 @@ func ParseContainerLimits(data interface{}) (ContainerLimits, error) {
 		if key == "memory" {
 			switch val.(type) {
 			case string:
-				memoryBytes, err = parseMemoryLimit(val.(string))
+				memoryBytes, err = ParseMemoryLimit(val.(string))
 				if err != nil {
 					return ContainerLimits{}, err
 				}
@@ -56,7 +56,7 @@ func ParseContainerLimits(data interface{}) (ContainerLimits, error) {
 					c.Memory = nil
 					continue
 				}
-				memoryBytes, err = parseMemoryLimit(*val.(*string))
+				memoryBytes, err = ParseMemoryLimit(*val.(*string))
 				if err != nil {
 					return ContainerLimits{}, err
 				}
@@ -91,7 +91,9 @@ func ParseContainerLimits(data interface{}) (ContainerLimits, error) {
 	return c, nil
 }
 
-func parseMemoryLimit(limit string) (uint64, error) {
+// ParseMemoryLimit parses a number of bytes, optionally with a KB, MB or GB
+// unit, e.g. 512MB.
+func ParseMemoryLimit(limit string) (uint64, error) {
 	limit = strings.ToUpper(limit)
 	var sizeRegex *regexp.Regexp = regexp.MustCompile(MemoryRegex)
 	matches := sizeRegex.FindStringSubmatch(limit)
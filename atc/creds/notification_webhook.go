@@ -0,0 +1,28 @@
+package creds
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+)
+
+type NotificationWebhook struct {
+	variablesResolver vars.Variables
+	rawWebhook        atc.NotificationWebhookConfig
+}
+
+func NewNotificationWebhook(variables vars.Variables, webhook atc.NotificationWebhookConfig) NotificationWebhook {
+	return NotificationWebhook{
+		variablesResolver: variables,
+		rawWebhook:        webhook,
+	}
+}
+
+func (w NotificationWebhook) Evaluate() (atc.NotificationWebhookConfig, error) {
+	var webhook atc.NotificationWebhookConfig
+	err := evaluate(w.variablesResolver, w.rawWebhook, &webhook)
+	if err != nil {
+		return atc.NotificationWebhookConfig{}, err
+	}
+
+	return webhook, nil
+}
@@ -0,0 +1,113 @@
+package plugin
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type Manager struct {
+	Dir     string        `long:"dir" description:"Directory containing the executables that var sources of type 'plugin' may run. Var source plugins are disabled unless this is set."`
+	Timeout time.Duration `long:"timeout" default:"10s" description:"Time limit on each run of a var source plugin."`
+
+	RetryConfig creds.SecretRetryConfig
+	CacheConfig creds.SecretCacheConfig
+
+	Plugin string
+	Source map[string]interface{}
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"plugin": manager.Plugin,
+		"health": health,
+	})
+}
+
+// IsConfigured is always false, as plugins can only be used by a pipeline's
+// var_sources rather than as the cluster-wide credential manager.
+func (manager *Manager) IsConfigured() bool {
+	return false
+}
+
+func (manager *Manager) Validate() error {
+	if manager.Plugin == "" {
+		return errors.New("plugin is required")
+	}
+
+	if strings.ContainsAny(manager.Plugin, `/\`) || manager.Plugin == "." || manager.Plugin == ".." {
+		return fmt.Errorf("plugin must be the name of an executable in the plugin directory, not a path: %s", manager.Plugin)
+	}
+
+	return nil
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	if manager.Dir == "" {
+		return errors.New("var source plugins are not enabled on this cluster")
+	}
+
+	info, err := os.Stat(manager.path())
+	if err != nil {
+		log.Error("failed-to-find-plugin", err, lager.Data{"plugin": manager.Plugin})
+		return fmt.Errorf("plugin not found: %s", manager.Plugin)
+	}
+
+	if info.IsDir() || info.Mode()&0111 == 0 {
+		return fmt.Errorf("plugin is not executable: %s", manager.Plugin)
+	}
+
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "exec",
+	}
+
+	err := manager.Init(lager.NewLogger("plugin-health"))
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = map[string]string{
+		"status": "UP",
+	}
+
+	return health, nil
+}
+
+func (manager *Manager) Close(logger lager.Logger) {
+}
+
+// NewSecretsFactory wraps the plugin with the retry and cache behaviour of the
+// other credential managers. The same secrets are handed out every time, so
+// that the cache is shared by every pipeline using the same var source.
+func (manager *Manager) NewSecretsFactory(logger lager.Logger) (creds.SecretsFactory, error) {
+	var secrets creds.Secrets = NewSecrets(logger, manager.path(), manager.Source, manager.Timeout)
+
+	secrets = creds.NewRetryableSecrets(secrets, manager.RetryConfig)
+	if manager.CacheConfig.Enabled {
+		secrets = creds.NewCachedSecrets(secrets, manager.CacheConfig)
+	}
+
+	return NewSecretsFactory(secrets), nil
+}
+
+func (manager *Manager) path() string {
+	return filepath.Join(manager.Dir, manager.Plugin)
+}
@@ -0,0 +1,87 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+	"github.com/mitchellh/mapstructure"
+)
+
+type managerFactory struct {
+	global *Manager
+}
+
+type pluginConfig struct {
+	Plugin string                 `mapstructure:"plugin"`
+	Source map[string]interface{} `mapstructure:"source"`
+
+	// Image is only decoded to reject it with a helpful error; plugins can
+	// not be run in a container image, as the web node has no container
+	// runtime to run them with.
+	Image interface{} `mapstructure:"image"`
+}
+
+var ErrImagePluginsNotSupported = errors.New("var source plugins can not be run from an image; install the plugin as an executable in the plugin directory instead")
+
+func init() {
+	creds.Register("plugin", NewManagerFactory())
+}
+
+func NewManagerFactory() creds.ManagerFactory {
+	return &managerFactory{}
+}
+
+func (factory *managerFactory) AddConfig(group *flags.Group) creds.Manager {
+	manager := &Manager{}
+
+	subGroup, err := group.AddGroup("Var Source Plugins", "", manager)
+	if err != nil {
+		panic(err)
+	}
+
+	subGroup.Namespace = "var-source-plugin"
+
+	factory.global = manager
+
+	return manager
+}
+
+// NewInstance configures a plugin from a pipeline's var source. The plugin
+// directory, timeout, retries and caching are always taken from the ATC's
+// flags, so a pipeline can only run the plugins the operator has installed.
+func (factory *managerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	var parsed pluginConfig
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused: true,
+		Result:      &parsed,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = decoder.Decode(config)
+	if err != nil {
+		return nil, fmt.Errorf("invalid plugin var source config: %w", err)
+	}
+
+	if parsed.Image != nil {
+		return nil, ErrImagePluginsNotSupported
+	}
+
+	manager := &Manager{
+		Plugin: parsed.Plugin,
+		Source: parsed.Source,
+	}
+
+	if factory.global != nil {
+		manager.Dir = factory.global.Dir
+		manager.Timeout = factory.global.Timeout
+		manager.RetryConfig = factory.global.RetryConfig
+		manager.CacheConfig = factory.global.CacheConfig
+	}
+
+	return manager, nil
+}
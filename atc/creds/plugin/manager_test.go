@@ -0,0 +1,166 @@
+package plugin_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/plugin"
+	flags "github.com/jessevdk/go-flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	var factory creds.ManagerFactory
+	var global *plugin.Manager
+
+	BeforeEach(func() {
+		factory = plugin.NewManagerFactory()
+
+		parser := flags.NewParser(nil, flags.Default)
+		parser.NamespaceDelimiter = "-"
+		group, err := parser.AddGroup("Credential Management", "", &struct{}{})
+		Expect(err).NotTo(HaveOccurred())
+
+		global = factory.AddConfig(group).(*plugin.Manager)
+
+		_, err = parser.ParseArgs([]string{
+			"--var-source-plugin-dir", "/some/plugins",
+			"--var-source-plugin-timeout", "5s",
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("is never the cluster-wide credential manager", func() {
+		Expect(global.IsConfigured()).To(BeFalse())
+	})
+
+	Describe("NewInstance", func() {
+		var config interface{}
+		var manager creds.Manager
+		var err error
+
+		BeforeEach(func() {
+			config = map[string]interface{}{
+				"plugin": "some-plugin",
+				"source": map[string]interface{}{"some": "source"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			manager, err = factory.NewInstance(config)
+		})
+
+		It("configures the plugin from the var source and the rest from the flags", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(manager).To(Equal(&plugin.Manager{
+				Dir:     "/some/plugins",
+				Timeout: 5 * time.Second,
+				RetryConfig: creds.SecretRetryConfig{
+					Attempts: 5,
+					Interval: time.Second,
+				},
+				CacheConfig: creds.SecretCacheConfig{
+					Duration:         time.Minute,
+					DurationNotFound: 10 * time.Second,
+					PurgeInterval:    10 * time.Minute,
+				},
+				Plugin: "some-plugin",
+				Source: map[string]interface{}{"some": "source"},
+			}))
+		})
+
+		Context("when the var source tries to configure the plugin directory", func() {
+			BeforeEach(func() {
+				config = map[string]interface{}{
+					"plugin": "some-plugin",
+					"dir":    "/bin",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when the var source configures an image to run the plugin in", func() {
+			BeforeEach(func() {
+				config = map[string]interface{}{
+					"plugin": "some-plugin",
+					"image": map[string]interface{}{
+						"type":   "registry-image",
+						"source": map[string]interface{}{"repository": "some-plugin"},
+					},
+				}
+			})
+
+			It("rejects it", func() {
+				Expect(err).To(Equal(plugin.ErrImagePluginsNotSupported))
+			})
+		})
+	})
+
+	Describe("Validate", func() {
+		It("requires a plugin", func() {
+			manager := &plugin.Manager{}
+			Expect(manager.Validate()).To(MatchError("plugin is required"))
+		})
+
+		It("does not allow paths", func() {
+			for _, name := range []string{"../sh", "/bin/sh", "a/b", "..", "."} {
+				manager := &plugin.Manager{Plugin: name}
+				Expect(manager.Validate()).To(HaveOccurred(), name)
+			}
+		})
+
+		It("allows executable names", func() {
+			manager := &plugin.Manager{Plugin: "some-plugin"}
+			Expect(manager.Validate()).To(Succeed())
+		})
+	})
+
+	Describe("Init", func() {
+		var dir string
+
+		BeforeEach(func() {
+			var err error
+			dir, err = ioutil.TempDir("", "plugins")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(dir, "executable"), []byte("#!/bin/sh\n"), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(dir, "not-executable"), []byte("#!/bin/sh\n"), 0644)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(dir)
+		})
+
+		It("succeeds when the plugin is executable", func() {
+			manager := &plugin.Manager{Dir: dir, Plugin: "executable"}
+			Expect(manager.Init(lagertest.NewTestLogger("test"))).To(Succeed())
+		})
+
+		It("fails when the plugin is not executable", func() {
+			manager := &plugin.Manager{Dir: dir, Plugin: "not-executable"}
+			Expect(manager.Init(lagertest.NewTestLogger("test"))).To(MatchError("plugin is not executable: not-executable"))
+		})
+
+		It("fails when the plugin does not exist", func() {
+			manager := &plugin.Manager{Dir: dir, Plugin: "missing"}
+			Expect(manager.Init(lagertest.NewTestLogger("test"))).To(MatchError("plugin not found: missing"))
+		})
+
+		It("fails when plugins are not enabled", func() {
+			manager := &plugin.Manager{Plugin: "executable"}
+			Expect(manager.Init(lagertest.NewTestLogger("test"))).To(MatchError("var source plugins are not enabled on this cluster"))
+		})
+	})
+})
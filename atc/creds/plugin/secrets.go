@@ -0,0 +1,114 @@
+package plugin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+// GetRequest is written to the plugin's stdin when it is run with the "get"
+// argument.
+type GetRequest struct {
+	Source map[string]interface{} `json:"source"`
+	Path   string                 `json:"path"`
+}
+
+// GetResponse is read from the plugin's stdout once it exits successfully.
+type GetResponse struct {
+	Found     bool        `json:"found"`
+	Value     interface{} `json:"value,omitempty"`
+	ExpiresAt *time.Time  `json:"expires_at,omitempty"`
+}
+
+type Secrets struct {
+	logger  lager.Logger
+	path    string
+	source  map[string]interface{}
+	timeout time.Duration
+}
+
+func NewSecrets(logger lager.Logger, path string, source map[string]interface{}, timeout time.Duration) *Secrets {
+	return &Secrets{
+		logger:  logger,
+		path:    path,
+		source:  source,
+		timeout: timeout,
+	}
+}
+
+// NewSecretLookupPaths returns no lookup paths, so that each var is passed to
+// the plugin by its name as it appears in the pipeline.
+func (secrets *Secrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+	return nil
+}
+
+// Get runs the plugin to retrieve the value and expiration of an individual
+// secret.
+func (secrets *Secrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	request, err := json.Marshal(GetRequest{
+		Source: secrets.source,
+		Path:   secretPath,
+	})
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	ctx := context.Background()
+	if secrets.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, secrets.timeout)
+		defer cancel()
+	}
+
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+
+	cmd := exec.CommandContext(ctx, secrets.path, "get")
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	err = cmd.Run()
+	if ctx.Err() == context.DeadlineExceeded {
+		secrets.logger.Error("plugin-timed-out", ctx.Err(), lager.Data{"secretPath": secretPath})
+		return nil, nil, false, timeoutError{timeout: secrets.timeout}
+	}
+
+	if err != nil {
+		secrets.logger.Error("plugin-failed", err, lager.Data{"secretPath": secretPath})
+		return nil, nil, false, fmt.Errorf("plugin failed: %s: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	var response GetResponse
+	err = json.Unmarshal(stdout.Bytes(), &response)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("malformed plugin response: %s", err)
+	}
+
+	if !response.Found {
+		return nil, nil, false, nil
+	}
+
+	return response.Value, response.ExpiresAt, true, nil
+}
+
+// timeoutError is a temporary error, so that plugins that time out are
+// retried by creds.RetryableSecrets.
+type timeoutError struct {
+	timeout time.Duration
+}
+
+func (err timeoutError) Error() string {
+	return fmt.Sprintf("plugin timed out after %s", err.timeout)
+}
+
+func (err timeoutError) Timeout() bool   { return true }
+func (err timeoutError) Temporary() bool { return true }
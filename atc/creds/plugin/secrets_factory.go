@@ -0,0 +1,19 @@
+package plugin
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type SecretsFactory struct {
+	secrets creds.Secrets
+}
+
+func NewSecretsFactory(secrets creds.Secrets) *SecretsFactory {
+	return &SecretsFactory{
+		secrets: secrets,
+	}
+}
+
+func (factory *SecretsFactory) NewSecrets() creds.Secrets {
+	return factory.secrets
+}
@@ -0,0 +1,119 @@
+package plugin_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds/plugin"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secrets", func() {
+	var dir string
+	var script string
+	var timeout time.Duration
+
+	var value interface{}
+	var expiration *time.Time
+	var found bool
+	var err error
+
+	BeforeEach(func() {
+		dir, err = ioutil.TempDir("", "plugins")
+		Expect(err).NotTo(HaveOccurred())
+
+		timeout = time.Minute
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	JustBeforeEach(func() {
+		path := filepath.Join(dir, "plugin")
+
+		writeErr := ioutil.WriteFile(path, []byte("#!/bin/sh\n"+script), 0755)
+		Expect(writeErr).NotTo(HaveOccurred())
+
+		secrets := plugin.NewSecrets(
+			lagertest.NewTestLogger("test"),
+			path,
+			map[string]interface{}{"some": "source"},
+			timeout,
+		)
+
+		value, expiration, found, err = secrets.Get("some-secret")
+	})
+
+	Context("when the plugin finds the secret", func() {
+		BeforeEach(func() {
+			// echo the request back, so that it can be checked
+			script = `
+test "$1" = get || exit 1
+request=$(cat)
+echo "{\"found\":true,\"value\":$request,\"expires_at\":\"2020-01-02T03:04:05Z\"}"
+`
+		})
+
+		It("returns its value and expiration", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(map[string]interface{}{
+				"source": map[string]interface{}{"some": "source"},
+				"path":   "some-secret",
+			}))
+			Expect(expiration).NotTo(BeNil())
+			Expect(*expiration).To(BeTemporally("==", time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)))
+		})
+	})
+
+	Context("when the plugin does not find the secret", func() {
+		BeforeEach(func() {
+			script = `echo '{"found":false}'`
+		})
+
+		It("returns not found", func() {
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+			Expect(value).To(BeNil())
+		})
+	})
+
+	Context("when the plugin fails", func() {
+		BeforeEach(func() {
+			script = `echo "access denied" >&2; exit 1`
+		})
+
+		It("returns an error including its stderr", func() {
+			Expect(err).To(MatchError(ContainSubstring("access denied")))
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when the plugin responds with garbage", func() {
+		BeforeEach(func() {
+			script = `echo nope`
+		})
+
+		It("returns an error", func() {
+			Expect(err).To(MatchError(ContainSubstring("malformed plugin response")))
+		})
+	})
+
+	Context("when the plugin times out", func() {
+		BeforeEach(func() {
+			timeout = 100 * time.Millisecond
+			script = `exec sleep 10`
+		})
+
+		It("returns a temporary error", func() {
+			Expect(err).To(MatchError("plugin timed out after 100ms"))
+			Expect(err.(interface{ Temporary() bool }).Temporary()).To(BeTrue())
+		})
+	})
+})
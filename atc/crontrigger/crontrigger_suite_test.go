@@ -0,0 +1,13 @@
+package crontrigger_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestCronTrigger(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Cron Trigger Suite")
+}
@@ -0,0 +1,106 @@
+package crontrigger
+
+import (
+	"context"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+//go:generate counterfeiter . Trigger
+
+type Trigger interface {
+	Run(context.Context) error
+}
+
+type trigger struct {
+	jobFactory db.JobFactory
+	clock      clock.Clock
+}
+
+// NewTrigger returns a component which creates a build of each job whose
+// schedule has fired since it was last triggered. A schedule which fired more
+// than once while the job was paused or no ATC was running only creates one
+// build.
+func NewTrigger(jobFactory db.JobFactory, clock clock.Clock) Trigger {
+	return &trigger{
+		jobFactory: jobFactory,
+		clock:      clock,
+	}
+}
+
+func (t *trigger) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("job-schedule-trigger")
+
+	jobs, err := t.jobFactory.ScheduledJobs()
+	if err != nil {
+		logger.Error("failed-to-get-scheduled-jobs", err)
+		return err
+	}
+
+	for _, job := range jobs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		t.trigger(logger, job)
+	}
+
+	return nil
+}
+
+func (t *trigger) trigger(logger lager.Logger, job db.Job) {
+	logger = logger.Session("trigger", lager.Data{
+		"team":     job.TeamName(),
+		"pipeline": job.PipelineName(),
+		"job":      job.Name(),
+	})
+
+	schedule := job.Schedule()
+
+	next, err := schedule.Next(job.ScheduleTriggeredAt())
+	if err != nil {
+		logger.Error("failed-to-evaluate-schedule", err)
+		return
+	}
+
+	now := t.clock.Now()
+	if now.Before(next.Add(jitter(job.ID(), next, schedule.MaxJitter()))) {
+		return
+	}
+
+	build, created, err := job.TriggerScheduledBuild(now)
+	if err != nil {
+		logger.Error("failed-to-trigger-build", err)
+		return
+	}
+
+	if !created {
+		logger.Debug("already-triggered")
+		return
+	}
+
+	logger.Info("triggered-build", lager.Data{
+		"build":    build.Name(),
+		"fired-at": next,
+	})
+}
+
+// jitter returns how long to delay the build of a job for the schedule firing
+// at the given time. It is derived from the job and the firing so that every
+// ATC agrees on it, while builds of jobs sharing a schedule are spread out.
+func jitter(jobID int, firesAt time.Time, max time.Duration) time.Duration {
+	if max <= 0 {
+		return 0
+	}
+
+	hash := fnv.New64a()
+	hash.Write([]byte(strconv.Itoa(jobID) + "/" + strconv.FormatInt(firesAt.Unix(), 10)))
+
+	return time.Duration(hash.Sum64() % uint64(max))
+}
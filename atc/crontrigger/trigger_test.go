@@ -0,0 +1,145 @@
+package crontrigger_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/crontrigger"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Trigger", func() {
+	var (
+		fakeJobFactory *dbfakes.FakeJobFactory
+		fakeClock      *fakeclock.FakeClock
+		fakeJob        *dbfakes.FakeJob
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeJobFactory = new(dbfakes.FakeJobFactory)
+		fakeClock = fakeclock.NewFakeClock(time.Date(2020, 7, 24, 2, 0, 30, 0, time.UTC))
+
+		fakeJob = new(dbfakes.FakeJob)
+		fakeJob.IDReturns(42)
+		fakeJob.NameReturns("nightly")
+		fakeJob.ScheduleReturns(&atc.ScheduleConfig{Cron: "0 2 * * *"})
+		fakeJob.ScheduleTriggeredAtReturns(time.Date(2020, 7, 23, 2, 0, 0, 0, time.UTC))
+		fakeJob.TriggerScheduledBuildReturns(new(dbfakes.FakeBuild), true, nil)
+
+		fakeJobFactory.ScheduledJobsReturns(db.Jobs{fakeJob}, nil)
+	})
+
+	JustBeforeEach(func() {
+		runErr = crontrigger.NewTrigger(fakeJobFactory, fakeClock).Run(context.TODO())
+	})
+
+	It("triggers a build of a job whose schedule has fired", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakeJob.TriggerScheduledBuildCallCount()).To(Equal(1))
+		Expect(fakeJob.TriggerScheduledBuildArgsForCall(0)).To(Equal(fakeClock.Now()))
+	})
+
+	Context("when the schedule has not fired since it was last triggered", func() {
+		BeforeEach(func() {
+			fakeJob.ScheduleTriggeredAtReturns(time.Date(2020, 7, 24, 2, 0, 0, 0, time.UTC))
+		})
+
+		It("does not trigger a build", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeJob.TriggerScheduledBuildCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the schedule fired many times since it was last triggered", func() {
+		BeforeEach(func() {
+			fakeJob.ScheduleTriggeredAtReturns(time.Date(2020, 7, 1, 2, 0, 0, 0, time.UTC))
+		})
+
+		It("triggers a single build", func() {
+			Expect(fakeJob.TriggerScheduledBuildCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the schedule is in another location", func() {
+		BeforeEach(func() {
+			fakeJob.ScheduleReturns(&atc.ScheduleConfig{Cron: "0 2 * * *", Location: "America/Toronto"})
+			fakeJob.ScheduleTriggeredAtReturns(time.Date(2020, 7, 23, 7, 0, 0, 0, time.UTC))
+		})
+
+		It("does not trigger a build until it fires there", func() {
+			Expect(fakeJob.TriggerScheduledBuildCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the schedule has a jitter", func() {
+		BeforeEach(func() {
+			fakeJob.ScheduleReturns(&atc.ScheduleConfig{Cron: "0 2 * * *", Jitter: "1h"})
+		})
+
+		It("triggers a build within the jitter of the schedule firing", func() {
+			var triggeredAt time.Time
+			for i := 0; i < 60; i++ {
+				err := crontrigger.NewTrigger(fakeJobFactory, fakeClock).Run(context.TODO())
+				Expect(err).ToNot(HaveOccurred())
+
+				if fakeJob.TriggerScheduledBuildCallCount() > 0 {
+					triggeredAt = fakeJob.TriggerScheduledBuildArgsForCall(0)
+					break
+				}
+
+				fakeClock.Increment(time.Minute)
+			}
+
+			Expect(triggeredAt).To(BeTemporally(">=", time.Date(2020, 7, 24, 2, 0, 0, 0, time.UTC)))
+			Expect(triggeredAt).To(BeTemporally("<", time.Date(2020, 7, 24, 3, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Context("when the build was already triggered by another ATC", func() {
+		BeforeEach(func() {
+			fakeJob.TriggerScheduledBuildReturns(nil, false, nil)
+		})
+
+		It("does not fail", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+		})
+	})
+
+	Context("when triggering the build fails", func() {
+		var otherJob *dbfakes.FakeJob
+
+		BeforeEach(func() {
+			fakeJob.TriggerScheduledBuildReturns(nil, false, errors.New("nope"))
+
+			otherJob = new(dbfakes.FakeJob)
+			otherJob.ScheduleReturns(&atc.ScheduleConfig{Cron: "@hourly"})
+			otherJob.ScheduleTriggeredAtReturns(time.Date(2020, 7, 24, 1, 0, 0, 0, time.UTC))
+			otherJob.TriggerScheduledBuildReturns(new(dbfakes.FakeBuild), true, nil)
+
+			fakeJobFactory.ScheduledJobsReturns(db.Jobs{fakeJob, otherJob}, nil)
+		})
+
+		It("still triggers the other jobs", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(otherJob.TriggerScheduledBuildCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when getting the scheduled jobs fails", func() {
+		BeforeEach(func() {
+			fakeJobFactory.ScheduledJobsReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+})
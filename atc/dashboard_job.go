@@ -11,6 +11,7 @@ type DashboardJob struct {
 	TeamName     string
 	Paused       bool
 	HasNewInputs bool
+	Schedule     *ScheduleConfig
 
 	FinishedBuild   *DashboardBuild
 	NextBuild       *DashboardBuild
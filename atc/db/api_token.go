@@ -0,0 +1,351 @@
+package db
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var ErrAPITokenAlreadyExists = errors.New("api token already exists")
+
+//go:generate counterfeiter . APIToken
+
+type APIToken interface {
+	ID() int
+	Name() string
+	TeamID() int
+	TeamName() string
+	Role() string
+
+	UserName() string
+	UserConnector() string
+	UserSub() string
+	UserID() string
+	UserGroups() []string
+	ServiceAccount() string
+
+	CreatedAt() time.Time
+	ExpiresAt() time.Time
+	LastUsedAt() time.Time
+
+	Revoke() error
+}
+
+// APITokenSpec describes an API token to create. A token belongs to either a
+// user or a service account of the team.
+type APITokenSpec struct {
+	Name      string
+	Role      string
+	ExpiresAt time.Time
+
+	UserName      string
+	UserConnector string
+	UserSub       string
+	UserID        string
+	UserGroups    []string
+
+	ServiceAccount string
+}
+
+var apiTokensQuery = psql.Select(
+	"a.id",
+	"a.name",
+	"a.team_id",
+	"t.name",
+	"a.role",
+	"a.user_name",
+	"a.user_connector",
+	"a.user_sub",
+	"a.user_id",
+	"a.user_groups",
+	"a.service_account",
+	"a.created_at",
+	"a.expires_at",
+	"a.last_used_at",
+).
+	From("api_tokens a").
+	Join("teams t ON a.team_id = t.id")
+
+type apiToken struct {
+	id       int
+	name     string
+	teamID   int
+	teamName string
+	role     string
+
+	userName       string
+	userConnector  string
+	userSub        string
+	userID         string
+	userGroups     []string
+	serviceAccount string
+
+	createdAt  time.Time
+	expiresAt  time.Time
+	lastUsedAt time.Time
+
+	conn Conn
+}
+
+func (t *apiToken) ID() int                { return t.id }
+func (t *apiToken) Name() string           { return t.name }
+func (t *apiToken) TeamID() int            { return t.teamID }
+func (t *apiToken) TeamName() string       { return t.teamName }
+func (t *apiToken) Role() string           { return t.role }
+func (t *apiToken) UserName() string       { return t.userName }
+func (t *apiToken) UserConnector() string  { return t.userConnector }
+func (t *apiToken) UserSub() string        { return t.userSub }
+func (t *apiToken) UserID() string         { return t.userID }
+func (t *apiToken) UserGroups() []string   { return t.userGroups }
+func (t *apiToken) ServiceAccount() string { return t.serviceAccount }
+func (t *apiToken) CreatedAt() time.Time   { return t.createdAt }
+func (t *apiToken) ExpiresAt() time.Time   { return t.expiresAt }
+func (t *apiToken) LastUsedAt() time.Time  { return t.lastUsedAt }
+
+func (t *apiToken) Revoke() error {
+	_, err := psql.Delete("api_tokens").
+		Where(sq.Eq{"id": t.id}).
+		RunWith(t.conn).
+		Exec()
+
+	return err
+}
+
+//go:generate counterfeiter . APITokenFactory
+
+type APITokenFactory interface {
+	// UseAPIToken finds the unexpired API token with the given secret and
+	// records that it has been used.
+	UseAPIToken(secret string) (APIToken, bool, error)
+}
+
+type apiTokenFactory struct {
+	conn Conn
+}
+
+func NewAPITokenFactory(conn Conn) APITokenFactory {
+	return &apiTokenFactory{
+		conn: conn,
+	}
+}
+
+// the last used time is only kept to the minute, so that a token which is
+// used by every request of a busy script doesn't write to the db every time
+const apiTokenLastUsedPrecision = time.Minute
+
+func (f *apiTokenFactory) UseAPIToken(secret string) (APIToken, bool, error) {
+	row := apiTokensQuery.
+		Where(sq.Eq{"a.token_hash": hashAPIToken(secret)}).
+		Where(sq.Expr("a.expires_at > now()")).
+		RunWith(f.conn).
+		QueryRow()
+
+	token := &apiToken{conn: f.conn}
+
+	err := scanAPIToken(token, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if time.Since(token.lastUsedAt) < apiTokenLastUsedPrecision {
+		return token, true, nil
+	}
+
+	err = psql.Update("api_tokens").
+		Set("last_used_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": token.id}).
+		Suffix("RETURNING last_used_at").
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&token.lastUsedAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			// revoked in the meantime
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return token, true, nil
+}
+
+func (t *team) CreateAPIToken(spec APITokenSpec) (APIToken, string, error) {
+	secret, err := generateAPIToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	var id int
+	err = psql.Insert("api_tokens").
+		Columns(
+			"team_id",
+			"name",
+			"token_hash",
+			"role",
+			"user_name",
+			"user_connector",
+			"user_sub",
+			"user_id",
+			"user_groups",
+			"service_account",
+			"expires_at",
+		).
+		Values(
+			t.id,
+			spec.Name,
+			hashAPIToken(secret),
+			spec.Role,
+			nullIfEmpty(spec.UserName),
+			nullIfEmpty(spec.UserConnector),
+			nullIfEmpty(spec.UserSub),
+			nullIfEmpty(spec.UserID),
+			pq.Array(spec.UserGroups),
+			nullIfEmpty(spec.ServiceAccount),
+			spec.ExpiresAt,
+		).
+		Suffix("RETURNING id").
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return nil, "", ErrAPITokenAlreadyExists
+		}
+		return nil, "", err
+	}
+
+	token := &apiToken{conn: t.conn}
+
+	err = scanAPIToken(token, apiTokensQuery.
+		Where(sq.Eq{"a.id": id}).
+		RunWith(t.conn).
+		QueryRow())
+	if err != nil {
+		return nil, "", err
+	}
+
+	return token, secret, nil
+}
+
+func (t *team) APITokens() ([]APIToken, error) {
+	rows, err := apiTokensQuery.
+		Where(sq.Eq{"a.team_id": t.id}).
+		OrderBy("a.name ASC").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	tokens := []APIToken{}
+	for rows.Next() {
+		token := &apiToken{conn: t.conn}
+
+		err = scanAPIToken(token, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+func (t *team) APIToken(name string) (APIToken, bool, error) {
+	row := apiTokensQuery.
+		Where(sq.Eq{
+			"a.team_id": t.id,
+			"a.name":    name,
+		}).
+		RunWith(t.conn).
+		QueryRow()
+
+	token := &apiToken{conn: t.conn}
+
+	err := scanAPIToken(token, row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return token, true, nil
+}
+
+func scanAPIToken(t *apiToken, row scannable) error {
+	var (
+		userName, userConnector, userSub, userID, serviceAccount sql.NullString
+		lastUsedAt                                               pq.NullTime
+	)
+
+	err := row.Scan(
+		&t.id,
+		&t.name,
+		&t.teamID,
+		&t.teamName,
+		&t.role,
+		&userName,
+		&userConnector,
+		&userSub,
+		&userID,
+		pq.Array(&t.userGroups),
+		&serviceAccount,
+		&t.createdAt,
+		&t.expiresAt,
+		&lastUsedAt,
+	)
+	if err != nil {
+		return err
+	}
+
+	t.userName = userName.String
+	t.userConnector = userConnector.String
+	t.userSub = userSub.String
+	t.userID = userID.String
+	t.serviceAccount = serviceAccount.String
+	t.lastUsedAt = lastUsedAt.Time
+
+	return nil
+}
+
+func nullIfEmpty(s string) interface{} {
+	if s == "" {
+		return nil
+	}
+
+	return s
+}
+
+func generateAPIToken() (string, error) {
+	secret := make([]byte, 32)
+
+	_, err := rand.Read(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return atc.APITokenPrefix + base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// API tokens are random enough that a plain hash keeps them safe; unlike
+// passwords they don't need a slow one.
+func hashAPIToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
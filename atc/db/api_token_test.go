@@ -0,0 +1,165 @@
+package db_test
+
+import (
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("APIToken", func() {
+	var (
+		apiTokenFactory db.APITokenFactory
+
+		userToken db.APIToken
+		secret    string
+	)
+
+	BeforeEach(func() {
+		apiTokenFactory = db.NewAPITokenFactory(dbConn)
+
+		var err error
+		userToken, secret, err = defaultTeam.CreateAPIToken(db.APITokenSpec{
+			Name:          "laptop",
+			Role:          "viewer",
+			ExpiresAt:     time.Now().Add(time.Hour),
+			UserName:      "some-user",
+			UserConnector: "github",
+			UserSub:       "some-sub",
+			UserID:        "some-user-id",
+			UserGroups:    []string{"some-org:some-team"},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("CreateAPIToken", func() {
+		It("returns the token", func() {
+			Expect(userToken.Name()).To(Equal("laptop"))
+			Expect(userToken.TeamID()).To(Equal(defaultTeam.ID()))
+			Expect(userToken.TeamName()).To(Equal(defaultTeam.Name()))
+			Expect(userToken.Role()).To(Equal("viewer"))
+			Expect(userToken.UserName()).To(Equal("some-user"))
+			Expect(userToken.UserConnector()).To(Equal("github"))
+			Expect(userToken.UserSub()).To(Equal("some-sub"))
+			Expect(userToken.UserID()).To(Equal("some-user-id"))
+			Expect(userToken.UserGroups()).To(Equal([]string{"some-org:some-team"}))
+			Expect(userToken.ServiceAccount()).To(BeEmpty())
+			Expect(userToken.LastUsedAt()).To(BeZero())
+		})
+
+		It("returns a secret which is not stored", func() {
+			Expect(secret).To(HavePrefix("concourse_"))
+
+			var count int
+			err := psql.Select("COUNT(*)").
+				From("api_tokens").
+				Where(sq.Eq{"token_hash": secret}).
+				RunWith(dbConn).
+				QueryRow().
+				Scan(&count)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+
+		Context("when the team already has a token with the name", func() {
+			It("returns ErrAPITokenAlreadyExists", func() {
+				_, _, err := defaultTeam.CreateAPIToken(db.APITokenSpec{
+					Name:           "laptop",
+					Role:           "member",
+					ExpiresAt:      time.Now().Add(time.Hour),
+					ServiceAccount: "ci-bot",
+				})
+				Expect(err).To(Equal(db.ErrAPITokenAlreadyExists))
+			})
+		})
+	})
+
+	Describe("APITokens", func() {
+		BeforeEach(func() {
+			_, _, err := defaultTeam.CreateAPIToken(db.APITokenSpec{
+				Name:           "ci",
+				Role:           "member",
+				ExpiresAt:      time.Now().Add(time.Hour),
+				ServiceAccount: "ci-bot",
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the team's tokens by name", func() {
+			tokens, err := defaultTeam.APITokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(HaveLen(2))
+			Expect(tokens[0].Name()).To(Equal("ci"))
+			Expect(tokens[0].ServiceAccount()).To(Equal("ci-bot"))
+			Expect(tokens[1].Name()).To(Equal("laptop"))
+		})
+	})
+
+	Describe("APIToken", func() {
+		It("finds the token by name", func() {
+			token, found, err := defaultTeam.APIToken("laptop")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(token.ID()).To(Equal(userToken.ID()))
+		})
+
+		It("does not find tokens which do not exist", func() {
+			_, found, err := defaultTeam.APIToken("bogus")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("UseAPIToken", func() {
+		It("finds the token and records that it was used", func() {
+			token, found, err := apiTokenFactory.UseAPIToken(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(token.ID()).To(Equal(userToken.ID()))
+			Expect(token.LastUsedAt()).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		It("does not find unknown tokens", func() {
+			_, found, err := apiTokenFactory.UseAPIToken("concourse_bogus")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when the token has expired", func() {
+			BeforeEach(func() {
+				_, err := psql.Update("api_tokens").
+					Set("expires_at", sq.Expr("now() - interval '1 minute'")).
+					Where(sq.Eq{"id": userToken.ID()}).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not find it", func() {
+				_, found, err := apiTokenFactory.UseAPIToken(secret)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the token has been revoked", func() {
+			BeforeEach(func() {
+				Expect(userToken.Revoke()).To(Succeed())
+			})
+
+			It("does not find it", func() {
+				_, found, err := apiTokenFactory.UseAPIToken(secret)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("is no longer listed", func() {
+				_, found, err := defaultTeam.APIToken("laptop")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,299 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . AuditEventRepository
+
+type AuditEventRepository interface {
+	// Record saves an audit event. The event's ID and time are assigned by
+	// the database.
+	Record(atc.AuditEvent) error
+
+	// AuditEvents returns the events matching the filter, newest first.
+	AuditEvents(AuditEventFilter, Page) ([]atc.AuditEvent, Pagination, error)
+
+	// RemoveExpiredAuditEvents deletes the events recorded longer ago than
+	// the retention period, returning how many were deleted.
+	RemoveExpiredAuditEvents(retention time.Duration) (int, error)
+}
+
+// AuditEventFilter narrows down the audit events to those which match all of
+// its non-zero fields.
+type AuditEventFilter struct {
+	TeamName     string
+	UserName     string
+	Action       string
+	PipelineName string
+	JobName      string
+	ResourceName string
+	BuildID      int
+
+	From time.Time
+	To   time.Time
+}
+
+func (filter AuditEventFilter) where() sq.And {
+	conditions := sq.And{}
+
+	eq := sq.Eq{}
+	for column, value := range map[string]string{
+		"team_name":     filter.TeamName,
+		"user_name":     filter.UserName,
+		"action":        filter.Action,
+		"pipeline_name": filter.PipelineName,
+		"job_name":      filter.JobName,
+		"resource_name": filter.ResourceName,
+	} {
+		if value != "" {
+			eq[column] = value
+		}
+	}
+
+	if filter.BuildID != 0 {
+		eq["build_id"] = filter.BuildID
+	}
+
+	if len(eq) > 0 {
+		conditions = append(conditions, eq)
+	}
+
+	if !filter.From.IsZero() {
+		conditions = append(conditions, sq.GtOrEq{"created_at": filter.From})
+	}
+
+	if !filter.To.IsZero() {
+		conditions = append(conditions, sq.LtOrEq{"created_at": filter.To})
+	}
+
+	return conditions
+}
+
+var auditEventsQuery = psql.Select(
+	"id",
+	"created_at",
+	"action",
+	"user_name",
+	"team_name",
+	"pipeline_name",
+	"job_name",
+	"resource_name",
+	"build_id",
+	"source_ip",
+	"forwarded_for",
+).
+	From("audit_events")
+
+type auditEventRepository struct {
+	conn Conn
+}
+
+func NewAuditEventRepository(conn Conn) AuditEventRepository {
+	return &auditEventRepository{
+		conn: conn,
+	}
+}
+
+func (repository *auditEventRepository) Record(event atc.AuditEvent) error {
+	var buildID interface{}
+	if event.BuildID != 0 {
+		buildID = event.BuildID
+	}
+
+	_, err := psql.Insert("audit_events").
+		Columns(
+			"action",
+			"user_name",
+			"team_name",
+			"pipeline_name",
+			"job_name",
+			"resource_name",
+			"build_id",
+			"source_ip",
+			"forwarded_for",
+		).
+		Values(
+			event.Action,
+			nullIfEmpty(event.UserName),
+			nullIfEmpty(event.TeamName),
+			nullIfEmpty(event.PipelineName),
+			nullIfEmpty(event.JobName),
+			nullIfEmpty(event.ResourceName),
+			buildID,
+			nullIfEmpty(event.SourceIP),
+			nullIfEmpty(event.ForwardedFor),
+		).
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
+func (repository *auditEventRepository) AuditEvents(filter AuditEventFilter, page Page) ([]atc.AuditEvent, Pagination, error) {
+	tx, err := repository.conn.Begin()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	defer Rollback(tx)
+
+	where := filter.where()
+
+	query := auditEventsQuery.
+		Where(where).
+		Limit(uint64(page.Limit))
+
+	var reverse bool
+	switch {
+	case page.Since != 0 && page.Until != 0:
+		if page.Until > page.Since {
+			return nil, Pagination{}, fmt.Errorf("invalid range boundaries")
+		}
+
+		query = query.
+			Where(sq.Gt{"id": page.Until}).
+			Where(sq.Lt{"id": page.Since}).
+			OrderBy("id DESC")
+	case page.Until != 0:
+		query = query.
+			Where(sq.Gt{"id": page.Until}).
+			OrderBy("id ASC")
+		reverse = true
+	case page.Since != 0:
+		query = query.
+			Where(sq.Lt{"id": page.Since}).
+			OrderBy("id DESC")
+	default:
+		query = query.OrderBy("id DESC")
+	}
+
+	rows, err := query.RunWith(tx).Query()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	defer Close(rows)
+
+	events := []atc.AuditEvent{}
+	for rows.Next() {
+		event, err := scanAuditEvent(rows)
+		if err != nil {
+			return nil, Pagination{}, err
+		}
+
+		events = append(events, event)
+	}
+
+	if reverse {
+		for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+			events[i], events[j] = events[j], events[i]
+		}
+	}
+
+	if len(events) == 0 {
+		return events, Pagination{}, nil
+	}
+
+	var minID, maxID int
+	err = psql.Select("COALESCE(MIN(id), 0)", "COALESCE(MAX(id), 0)").
+		From("audit_events").
+		Where(where).
+		RunWith(tx).
+		QueryRow().
+		Scan(&minID, &maxID)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	first := events[0]
+	last := events[len(events)-1]
+
+	var pagination Pagination
+	if first.ID < maxID {
+		pagination.Previous = &Page{
+			Until: first.ID,
+			Limit: page.Limit,
+		}
+	}
+
+	if last.ID > minID {
+		pagination.Next = &Page{
+			Since: last.ID,
+			Limit: page.Limit,
+		}
+	}
+
+	return events, pagination, nil
+}
+
+func (repository *auditEventRepository) RemoveExpiredAuditEvents(retention time.Duration) (int, error) {
+	result, err := psql.Delete("audit_events").
+		Where(sq.Gt{
+			"now() - created_at": fmt.Sprintf("%.0f seconds", retention.Seconds()),
+		}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(affected), nil
+}
+
+func scanAuditEvent(row scannable) (atc.AuditEvent, error) {
+	var (
+		event atc.AuditEvent
+
+		createdAt time.Time
+
+		userName, teamName, pipelineName, jobName, resourceName sql.NullString
+		sourceIP, forwardedFor                                  sql.NullString
+
+		buildID sql.NullInt64
+	)
+
+	err := row.Scan(
+		&event.ID,
+		&createdAt,
+		&event.Action,
+		&userName,
+		&teamName,
+		&pipelineName,
+		&jobName,
+		&resourceName,
+		&buildID,
+		&sourceIP,
+		&forwardedFor,
+	)
+	if err != nil {
+		return atc.AuditEvent{}, err
+	}
+
+	event.Time = createdAt.Unix()
+	event.UserName = userName.String
+	event.TeamName = teamName.String
+	event.PipelineName = pipelineName.String
+	event.JobName = jobName.String
+	event.ResourceName = resourceName.String
+	event.BuildID = int(buildID.Int64)
+	event.SourceIP = sourceIP.String
+	event.ForwardedFor = forwardedFor.String
+
+	return event, nil
+}
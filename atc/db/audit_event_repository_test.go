@@ -0,0 +1,147 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEventRepository", func() {
+	var repository db.AuditEventRepository
+
+	BeforeEach(func() {
+		repository = db.NewAuditEventRepository(dbConn)
+	})
+
+	Describe("Record", func() {
+		It("saves the event", func() {
+			err := repository.Record(atc.AuditEvent{
+				Action:       atc.PauseJob,
+				UserName:     "some-user",
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				SourceIP:     "10.0.0.1",
+				ForwardedFor: "1.2.3.4",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			events, _, err := repository.AuditEvents(db.AuditEventFilter{}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+
+			event := events[0]
+			Expect(event.ID).ToNot(BeZero())
+			Expect(event.Time).To(BeNumerically("~", time.Now().Unix(), 5))
+
+			event.ID = 0
+			event.Time = 0
+			Expect(event).To(Equal(atc.AuditEvent{
+				Action:       atc.PauseJob,
+				UserName:     "some-user",
+				TeamName:     "some-team",
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				SourceIP:     "10.0.0.1",
+				ForwardedFor: "1.2.3.4",
+			}))
+		})
+	})
+
+	Describe("AuditEvents", func() {
+		BeforeEach(func() {
+			for _, event := range []atc.AuditEvent{
+				{Action: atc.GetTeam, UserName: "some-user", TeamName: "some-team"},
+				{Action: atc.PauseJob, UserName: "some-user", TeamName: "some-team", PipelineName: "some-pipeline", JobName: "some-job"},
+				{Action: atc.AbortBuild, UserName: "other-user", BuildID: 42},
+				{Action: atc.PauseJob, UserName: "other-user", TeamName: "other-team", PipelineName: "some-pipeline", JobName: "some-job"},
+				{Action: atc.SetLogLevel, UserName: "some-user"},
+			} {
+				err := repository.Record(event)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		actions := func(events []atc.AuditEvent) []string {
+			names := []string{}
+			for _, event := range events {
+				names = append(names, event.Action+"/"+event.UserName)
+			}
+			return names
+		}
+
+		It("returns all the events, newest first", func() {
+			events, _, err := repository.AuditEvents(db.AuditEventFilter{}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actions(events)).To(Equal([]string{
+				"SetLogLevel/some-user",
+				"PauseJob/other-user",
+				"AbortBuild/other-user",
+				"PauseJob/some-user",
+				"GetTeam/some-user",
+			}))
+		})
+
+		It("filters the events", func() {
+			events, _, err := repository.AuditEvents(db.AuditEventFilter{TeamName: "some-team"}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actions(events)).To(Equal([]string{"PauseJob/some-user", "GetTeam/some-user"}))
+
+			events, _, err = repository.AuditEvents(db.AuditEventFilter{Action: atc.PauseJob, UserName: "other-user"}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actions(events)).To(Equal([]string{"PauseJob/other-user"}))
+
+			events, _, err = repository.AuditEvents(db.AuditEventFilter{BuildID: 42}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actions(events)).To(Equal([]string{"AbortBuild/other-user"}))
+
+			events, _, err = repository.AuditEvents(db.AuditEventFilter{From: time.Now().Add(time.Hour)}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(BeEmpty())
+		})
+
+		It("pages through the events", func() {
+			filter := db.AuditEventFilter{UserName: "some-user"}
+
+			page1, pagination, err := repository.AuditEvents(filter, db.Page{Limit: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actions(page1)).To(Equal([]string{"SetLogLevel/some-user", "PauseJob/some-user"}))
+			Expect(pagination.Previous).To(BeNil())
+			Expect(pagination.Next).To(Equal(&db.Page{Since: page1[1].ID, Limit: 2}))
+
+			page2, pagination, err := repository.AuditEvents(filter, *pagination.Next)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(actions(page2)).To(Equal([]string{"GetTeam/some-user"}))
+			Expect(pagination.Previous).To(Equal(&db.Page{Until: page2[0].ID, Limit: 2}))
+			Expect(pagination.Next).To(BeNil())
+
+			page1Again, _, err := repository.AuditEvents(filter, *pagination.Previous)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(page1Again).To(Equal(page1))
+		})
+	})
+
+	Describe("RemoveExpiredAuditEvents", func() {
+		BeforeEach(func() {
+			_, err := dbConn.Exec("INSERT INTO audit_events(action, created_at) VALUES('GetTeam', NOW() - '25 hours'::interval)")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = repository.Record(atc.AuditEvent{Action: atc.PauseJob})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes the events older than the retention period", func() {
+			removed, err := repository.RemoveExpiredAuditEvents(24 * time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+
+			events, _, err := repository.AuditEvents(db.AuditEventFilter{}, db.Page{Limit: 10})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Action).To(Equal(atc.PauseJob))
+		})
+	})
+})
@@ -65,9 +65,12 @@ var buildsQuery = psql.Select(`
 		j.name,
 		b.pipeline_id,
 		p.name,
+		p.instance_vars,
 		t.name,
 		b.nonce,
 		b.drained,
+		b.log_exported,
+		b.containers_retained_until,
 		b.aborted,
 		b.completed,
 		b.inputs_ready,
@@ -151,6 +154,13 @@ type Build interface {
 
 	IsDrained() bool
 	SetDrained(bool) error
+	IsLogExported() bool
+	SetLogExported(bool) error
+
+	ContainersRetainedUntil() time.Time
+	KeepContainersOnFailure(time.Duration) error
+	ReleaseContainers() error
+	MarkTaskFailed(atc.PlanID) error
 }
 
 type build struct {
@@ -183,9 +193,12 @@ type build struct {
 	endTime    time.Time
 	reapTime   time.Time
 
-	drained   bool
-	aborted   bool
-	completed bool
+	drained     bool
+	logExported bool
+	aborted     bool
+	completed   bool
+
+	containersRetainedUntil time.Time
 }
 
 func newEmptyBuild(conn Conn, lockFactory lock.LockFactory) *build {
@@ -225,6 +238,7 @@ func (b *build) ReapTime() time.Time  { return b.reapTime }
 func (b *build) Status() BuildStatus  { return b.status }
 func (b *build) IsScheduled() bool    { return b.scheduled }
 func (b *build) IsDrained() bool      { return b.drained }
+func (b *build) IsLogExported() bool  { return b.logExported }
 func (b *build) IsRunning() bool      { return !b.completed }
 func (b *build) IsAborted() bool      { return b.aborted }
 func (b *build) IsCompleted() bool    { return b.completed }
@@ -233,6 +247,10 @@ func (b *build) RerunOf() int         { return b.rerunOf }
 func (b *build) RerunOfName() string  { return b.rerunOfName }
 func (b *build) RerunNumber() int     { return b.rerunNumber }
 
+func (b *build) ContainersRetainedUntil() time.Time {
+	return b.containersRetainedUntil
+}
+
 func (b *build) Reload() (bool, error) {
 	row := buildsQuery.Where(sq.Eq{"b.id": b.id}).
 		RunWith(b.conn).
@@ -397,6 +415,7 @@ func (b *build) Finish(status BuildStatus) error {
 		Set("status", status).
 		Set("end_time", sq.Expr("now()")).
 		Set("completed", true).
+		Set("log_exported", !atc.EnableBuildLogExport).
 		Set("private_plan", nil).
 		Set("nonce", nil).
 		Where(sq.Eq{"id": b.id}).
@@ -423,6 +442,20 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
+	if status == BuildStatusFailed {
+		_, err = tx.Exec(`
+			UPDATE builds b
+			SET containers_retained_until = b.end_time + COALESCE(
+				b.keep_containers_on_failure,
+				(SELECT j.keep_containers_on_failure FROM jobs j WHERE j.id = b.job_id)
+			)
+			WHERE b.id = $1
+		`, b.id)
+		if err != nil {
+			return err
+		}
+	}
+
 	if b.jobID != 0 && status == BuildStatusSucceeded {
 		_, err = tx.Exec(`WITH caches AS (
 			SELECT resource_cache_id, build_id
@@ -536,6 +569,13 @@ func (b *build) Finish(status BuildStatus) error {
 			return err
 		}
 
+		// must happen before the job's latest completed build is updated, as
+		// notifications can filter on the status of the build before this one
+		err = enqueueBuildNotifications(tx, b.conn.EncryptionStrategy(), b.pipelineID, b.teamName, b.jobID, b.jobName, b.id, status)
+		if err != nil {
+			return err
+		}
+
 		err = updateTransitionBuildForJob(tx, b.jobID, b.id, status, b.rerunOf)
 		if err != nil {
 			return err
@@ -583,6 +623,84 @@ func (b *build) SetDrained(drained bool) error {
 	return err
 }
 
+func (b *build) SetLogExported(exported bool) error {
+	_, err := psql.Update("builds").
+		Set("log_exported", exported).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+
+	if err == nil {
+		b.logExported = exported
+	}
+	return err
+}
+
+// KeepContainersOnFailure keeps the containers of the failed tasks of the
+// build around for intercepting for the given duration if it fails, instead
+// of the duration configured for its job. If the build has already failed and its containers
+// have not been collected yet, they are kept from now on.
+func (b *build) KeepContainersOnFailure(retention time.Duration) error {
+	interval := fmt.Sprintf("'%d seconds'::interval", int(retention.Seconds()))
+
+	var retainedUntil pq.NullTime
+	err := psql.Update("builds").
+		Set("keep_containers_on_failure", sq.Expr(interval)).
+		Set("containers_retained_until", sq.Expr(`CASE
+			WHEN completed AND status = ? AND (interceptible OR containers_retained_until > now()) THEN now() + `+interval+`
+			ELSE containers_retained_until
+		END`, BuildStatusFailed)).
+		Where(sq.Eq{"id": b.id}).
+		Suffix("RETURNING containers_retained_until").
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&retainedUntil)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrBuildDisappeared
+		}
+
+		return err
+	}
+
+	b.containersRetainedUntil = retainedUntil.Time
+
+	return nil
+}
+
+// ReleaseContainers lets the containers of the build be collected as usual.
+func (b *build) ReleaseContainers() error {
+	_, err := psql.Update("builds").
+		Set("keep_containers_on_failure", nil).
+		Set("containers_retained_until", nil).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(b.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	b.containersRetainedUntil = time.Time{}
+
+	return nil
+}
+
+// MarkTaskFailed marks the container of the task step with the given plan as
+// failed. Only the containers of failed tasks are kept while the containers
+// of the build are retained.
+func (b *build) MarkTaskFailed(planID atc.PlanID) error {
+	_, err := psql.Update("containers").
+		Set("task_failed", true).
+		Where(sq.Eq{
+			"build_id":  b.id,
+			"plan_id":   planID,
+			"meta_type": string(ContainerTypeTask),
+		}).
+		RunWith(b.conn).
+		Exec()
+	return err
+}
+
 func (b *build) Delete() (bool, error) {
 	rows, err := psql.Delete("builds").
 		Where(sq.Eq{
@@ -684,6 +802,8 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 			PausedPipeline:      BuildPreparationStatusNotBlocking,
 			PausedJob:           BuildPreparationStatusNotBlocking,
 			MaxRunningBuilds:    BuildPreparationStatusNotBlocking,
+			TeamQuota:           BuildPreparationStatusNotBlocking,
+			ClusterQuota:        BuildPreparationStatusNotBlocking,
 			Inputs:              map[string]BuildPreparationStatus{},
 			InputsSatisfied:     BuildPreparationStatusNotBlocking,
 			MissingInputReasons: MissingInputReasons{},
@@ -727,6 +847,21 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReachedStatus = BuildPreparationStatusBlocking
 	}
 
+	quotas, err := quotasReached(b.conn, b.teamID)
+	if err != nil {
+		return BuildPreparation{}, false, err
+	}
+
+	teamQuotaStatus := BuildPreparationStatusNotBlocking
+	if quotas.Team {
+		teamQuotaStatus = BuildPreparationStatusBlocking
+	}
+
+	clusterQuotaStatus := BuildPreparationStatusNotBlocking
+	if quotas.Cluster {
+		clusterQuotaStatus = BuildPreparationStatusBlocking
+	}
+
 	tf := NewTeamFactory(b.conn, b.lockFactory)
 	t, found, err := tf.FindTeam(b.teamName)
 	if err != nil {
@@ -737,7 +872,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		return BuildPreparation{}, false, nil
 	}
 
-	pipeline, found, err := t.Pipeline(b.pipelineName)
+	pipeline, found, err := t.Pipeline(b.PipelineRef())
 	if err != nil {
 		return BuildPreparation{}, false, err
 	}
@@ -828,6 +963,8 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		PausedPipeline:      pausedPipelineStatus,
 		PausedJob:           pausedJobStatus,
 		MaxRunningBuilds:    maxInFlightReachedStatus,
+		TeamQuota:           teamQuotaStatus,
+		ClusterQuota:        clusterQuotaStatus,
 		Inputs:              inputs,
 		InputsSatisfied:     inputsSatisfiedStatus,
 		MissingInputReasons: missingInputReasons,
@@ -1454,9 +1591,9 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	var (
 		jobID, pipelineID, rerunOf, rerunNumber                             sql.NullInt64
 		schema, privatePlan, jobName, pipelineName, publicPlan, rerunOfName sql.NullString
-		createTime, startTime, endTime, reapTime                            pq.NullTime
-		nonce                                                               sql.NullString
-		drained, aborted, completed                                         bool
+		createTime, startTime, endTime, reapTime, containersRetainedUntil   pq.NullTime
+		nonce, pipelineInstanceVars                                         sql.NullString
+		drained, logExported, aborted, completed                            bool
 		status                                                              string
 	)
 
@@ -1478,9 +1615,12 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&jobName,
 		&pipelineID,
 		&pipelineName,
+		&pipelineInstanceVars,
 		&b.teamName,
 		&nonce,
 		&drained,
+		&logExported,
+		&containersRetainedUntil,
 		&aborted,
 		&completed,
 		&b.inputsReady,
@@ -1503,12 +1643,19 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.endTime = endTime.Time
 	b.reapTime = reapTime.Time
 	b.drained = drained
+	b.logExported = logExported
+	b.containersRetainedUntil = containersRetainedUntil.Time
 	b.aborted = aborted
 	b.completed = completed
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
 
+	b.pipelineInstanceVars, err = unmarshalInstanceVars(pipelineInstanceVars)
+	if err != nil {
+		return err
+	}
+
 	var (
 		noncense      *string
 		decryptedPlan []byte
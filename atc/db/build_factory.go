@@ -19,6 +19,7 @@ type BuildFactory interface {
 	PublicBuilds(Page) ([]Build, Pagination, error)
 	GetAllStartedBuilds() ([]Build, error)
 	GetDrainableBuilds() ([]Build, error)
+	GetLogExportableBuilds(afterID int, limit int) ([]Build, error)
 	// TODO: move to BuildLifecycle, new interface (see WorkerLifecycle)
 	MarkNonInterceptibleBuilds() error
 }
@@ -125,6 +126,22 @@ func (f *buildFactory) GetDrainableBuilds() ([]Build, error) {
 	return getBuilds(query, f.conn, f.lockFactory)
 }
 
+// GetLogExportableBuilds returns up to limit completed builds whose logs have
+// not been exported yet, in order of their IDs, starting after the given ID.
+// Builds whose events have already been reaped are left out, as there would
+// be nothing to export.
+func (f *buildFactory) GetLogExportableBuilds(afterID int, limit int) ([]Build, error) {
+	query := buildsQuery.Where(sq.Eq{
+		"b.completed":    true,
+		"b.log_exported": false,
+		"b.reap_time":    nil,
+	}).Where(sq.Gt{
+		"b.id": afterID,
+	}).OrderBy("b.id ASC").Limit(uint64(limit))
+
+	return getBuilds(query, f.conn, f.lockFactory)
+}
+
 func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
 	query := buildsQuery.Where(sq.Eq{
 		"b.status": BuildStatusStarted,
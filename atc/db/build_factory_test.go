@@ -116,13 +116,13 @@ var _ = Describe("BuildFactory", func() {
 				err = build2.Finish(db.BuildStatusErrored)
 				Expect(err).NotTo(HaveOccurred())
 
-				p, _, err := defaultTeam.SavePipeline("other-pipeline", atc.Config{
+				p, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-other-job",
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 				Expect(err).NotTo(HaveOccurred())
 
 				j, found, err := p.Job("some-other-job")
@@ -236,6 +236,27 @@ var _ = Describe("BuildFactory", func() {
 				Expect(i).To(BeFalse())
 			})
 		})
+
+		Context("builds with retained containers", func() {
+			It("marks them as usual, as only the containers of their failed tasks are kept", func() {
+				buildFactory = db.NewBuildFactory(dbConn, lockFactory, 0, 0)
+				build, err := defaultTeam.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.KeepContainersOnFailure(time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = buildFactory.MarkNonInterceptibleBuilds()
+				Expect(err).NotTo(HaveOccurred())
+
+				i, err := build.Interceptible()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(i).To(BeFalse())
+			})
+		})
 	})
 
 	Describe("VisibleBuilds", func() {
@@ -251,7 +272,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, db.ConfigVersion(1), false)
+			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -261,7 +282,7 @@ var _ = Describe("BuildFactory", func() {
 			build2, err = privateJob.CreateBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), false)
+			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -310,7 +331,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, db.ConfigVersion(1), false)
+			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -320,7 +341,7 @@ var _ = Describe("BuildFactory", func() {
 			build2, err = privateJob.CreateBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), false)
+			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -356,7 +377,7 @@ var _ = Describe("BuildFactory", func() {
 			Expect(err).NotTo(HaveOccurred())
 
 			config := atc.Config{Jobs: atc.JobConfigs{{Name: "some-job"}}}
-			privatePipeline, _, err := team.SavePipeline("private-pipeline", config, db.ConfigVersion(1), false)
+			privatePipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).NotTo(HaveOccurred())
 
 			privateJob, found, err := privatePipeline.Job("some-job")
@@ -366,7 +387,7 @@ var _ = Describe("BuildFactory", func() {
 			_, err = privateJob.CreateBuild()
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err := team.SavePipeline("public-pipeline", config, db.ConfigVersion(1), false)
+			publicPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).NotTo(HaveOccurred())
 			err = publicPipeline.Expose()
 			Expect(err).NotTo(HaveOccurred())
@@ -392,13 +413,13 @@ var _ = Describe("BuildFactory", func() {
 		var build2DB, build3DB, build4DB db.Build
 
 		BeforeEach(func() {
-			pipeline, _, err := team.SavePipeline("other-pipeline", atc.Config{
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 			Expect(err).NotTo(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -442,18 +463,130 @@ var _ = Describe("BuildFactory", func() {
 		})
 	})
 
+	Describe("GetLogExportableBuilds", func() {
+		var build2DB, build3DB, build4DB db.Build
+
+		BeforeEach(func() {
+			atc.EnableBuildLogExport = true
+
+			var err error
+			build2DB, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			build3DB, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			build4DB, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			started, err := build2DB.Start(atc.Plan{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			err = build3DB.Finish("succeeded")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build3DB.SetLogExported(true)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build4DB.Finish("failed")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build4DB.SetDrained(true)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			atc.EnableBuildLogExport = false
+		})
+
+		It("returns all builds that have been completed and whose logs have not been exported", func() {
+			builds, err := buildFactory.GetLogExportableBuilds(0, 10)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = build4DB.Reload()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(builds).To(ConsistOf(build4DB))
+		})
+
+		Context("with more exportable builds than the limit", func() {
+			var build5DB db.Build
+
+			BeforeEach(func() {
+				var err error
+				build5DB, err = team.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build5DB.Finish("succeeded")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = build4DB.Reload()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = build5DB.Reload()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns a page of builds in order of their IDs", func() {
+				builds, err := buildFactory.GetLogExportableBuilds(0, 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(Equal([]db.Build{build4DB}))
+
+				builds, err = buildFactory.GetLogExportableBuilds(build4DB.ID(), 1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(Equal([]db.Build{build5DB}))
+			})
+		})
+
+		Context("when a build's events have been reaped", func() {
+			BeforeEach(func() {
+				err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{build4DB.ID()})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not return it", func() {
+				builds, err := buildFactory.GetLogExportableBuilds(0, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(BeEmpty())
+			})
+		})
+
+		Context("when a build finished while no build log sink was configured", func() {
+			var build5DB db.Build
+
+			BeforeEach(func() {
+				atc.EnableBuildLogExport = false
+
+				var err error
+				build5DB, err = team.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build5DB.Finish("succeeded")
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not return it", func() {
+				builds, err := buildFactory.GetLogExportableBuilds(0, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(HaveLen(1))
+				Expect(builds[0].ID()).To(Equal(build4DB.ID()))
+			})
+		})
+	})
+
 	Describe("GetAllStartedBuilds", func() {
 		var build1DB db.Build
 		var build2DB db.Build
 
 		BeforeEach(func() {
-			pipeline, _, err := team.SavePipeline("other-pipeline", atc.Config{
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 			Expect(err).NotTo(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -496,13 +629,13 @@ var _ = Describe("BuildFactory", func() {
 		var build2DB db.Build
 
 		BeforeEach(func() {
-			pipeline, _, err := team.SavePipeline("other-pipeline", atc.Config{
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 			Expect(err).NotTo(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
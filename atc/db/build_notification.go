@@ -0,0 +1,264 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/lib/pq"
+)
+
+const (
+	BuildNotificationStatusPending   = "pending"
+	BuildNotificationStatusDelivered = "delivered"
+	BuildNotificationStatusFailed    = "failed"
+)
+
+//go:generate counterfeiter . BuildNotification
+
+// BuildNotification is the delivery of one of a pipeline's notifications about
+// a finished build. It is kept around as a log of the delivery once it has
+// either been delivered or has failed for good.
+type BuildNotification interface {
+	ID() int
+	BuildID() int
+	Name() string
+	Config() atc.NotificationConfig
+	PreviousStatus() BuildStatus
+	Status() string
+	Attempts() int
+	ResponseCode() int
+	LastError() string
+	CreatedAt() time.Time
+	DeliveredAt() time.Time
+
+	Delivered(responseCode int) error
+	Retry(responseCode int, reason string, at time.Time) error
+	Fail(responseCode int, reason string) error
+}
+
+var buildNotificationsQuery = psql.Select(
+	"n.id",
+	"n.build_id",
+	"n.name",
+	"n.config",
+	"n.nonce",
+	"n.previous_status",
+	"n.status",
+	"n.attempts",
+	"n.response_code",
+	"n.last_error",
+	"n.created_at",
+	"n.delivered_at",
+).
+	From("build_notifications n")
+
+type buildNotification struct {
+	id             int
+	buildID        int
+	name           string
+	config         atc.NotificationConfig
+	previousStatus BuildStatus
+	status         string
+	attempts       int
+	responseCode   int
+	lastError      string
+	createdAt      time.Time
+	deliveredAt    time.Time
+
+	conn Conn
+}
+
+func (n *buildNotification) ID() int                        { return n.id }
+func (n *buildNotification) BuildID() int                   { return n.buildID }
+func (n *buildNotification) Name() string                   { return n.name }
+func (n *buildNotification) Config() atc.NotificationConfig { return n.config }
+func (n *buildNotification) PreviousStatus() BuildStatus    { return n.previousStatus }
+func (n *buildNotification) Status() string                 { return n.status }
+func (n *buildNotification) Attempts() int                  { return n.attempts }
+func (n *buildNotification) ResponseCode() int              { return n.responseCode }
+func (n *buildNotification) LastError() string              { return n.lastError }
+func (n *buildNotification) CreatedAt() time.Time           { return n.createdAt }
+func (n *buildNotification) DeliveredAt() time.Time         { return n.deliveredAt }
+
+func (n *buildNotification) Delivered(responseCode int) error {
+	return n.update(sq.Eq{
+		"status":        BuildNotificationStatusDelivered,
+		"response_code": nullIfZero(responseCode),
+		"last_error":    nil,
+		"delivered_at":  sq.Expr("now()"),
+	})
+}
+
+func (n *buildNotification) Retry(responseCode int, reason string, at time.Time) error {
+	return n.update(sq.Eq{
+		"response_code":   nullIfZero(responseCode),
+		"last_error":      reason,
+		"next_attempt_at": at,
+	})
+}
+
+func (n *buildNotification) Fail(responseCode int, reason string) error {
+	return n.update(sq.Eq{
+		"status":        BuildNotificationStatusFailed,
+		"response_code": nullIfZero(responseCode),
+		"last_error":    reason,
+	})
+}
+
+// update records an attempt at delivering the notification.
+func (n *buildNotification) update(values map[string]interface{}) error {
+	values["attempts"] = sq.Expr("attempts + 1")
+
+	row := psql.Update("build_notifications").
+		SetMap(values).
+		Where(sq.Eq{"id": n.id}).
+		Suffix("RETURNING status, attempts, response_code, last_error, delivered_at").
+		RunWith(n.conn).
+		QueryRow()
+
+	var (
+		responseCode sql.NullInt64
+		lastError    sql.NullString
+		deliveredAt  pq.NullTime
+	)
+
+	err := row.Scan(&n.status, &n.attempts, &responseCode, &lastError, &deliveredAt)
+	if err != nil {
+		return err
+	}
+
+	n.responseCode = int(responseCode.Int64)
+	n.lastError = lastError.String
+	n.deliveredAt = deliveredAt.Time
+
+	return nil
+}
+
+func scanBuildNotification(n *buildNotification, row scannable) error {
+	var (
+		config         string
+		nonce          sql.NullString
+		previousStatus sql.NullString
+		responseCode   sql.NullInt64
+		lastError      sql.NullString
+		deliveredAt    pq.NullTime
+	)
+
+	err := row.Scan(&n.id, &n.buildID, &n.name, &config, &nonce, &previousStatus, &n.status, &n.attempts, &responseCode, &lastError, &n.createdAt, &deliveredAt)
+	if err != nil {
+		return err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := n.conn.EncryptionStrategy().Decrypt(config, noncense)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(decryptedConfig, &n.config)
+	if err != nil {
+		return err
+	}
+
+	n.previousStatus = BuildStatus(previousStatus.String)
+	n.responseCode = int(responseCode.Int64)
+	n.lastError = lastError.String
+	n.deliveredAt = deliveredAt.Time
+
+	return nil
+}
+
+func nullIfZero(i int) interface{} {
+	if i == 0 {
+		return nil
+	}
+
+	return i
+}
+
+// enqueueBuildNotifications queues the delivery of the pipeline's
+// notifications matching the finished build of the job.
+func enqueueBuildNotifications(tx Tx, es encryption.Strategy, pipelineID int, teamName string, jobID int, jobName string, buildID int, status BuildStatus) error {
+	var notificationsPayload, nonce sql.NullString
+	err := psql.Select("notifications", "notifications_nonce").
+		From("pipelines").
+		Where(sq.Eq{"id": pipelineID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&notificationsPayload, &nonce)
+	if err != nil {
+		return err
+	}
+
+	if !notificationsPayload.Valid {
+		return nil
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedNotifications, err := es.Decrypt(notificationsPayload.String, noncense)
+	if err != nil {
+		return err
+	}
+
+	var notifications atc.NotificationConfigs
+	err = json.Unmarshal(decryptedNotifications, &notifications)
+	if err != nil {
+		return err
+	}
+
+	if len(notifications) == 0 {
+		return nil
+	}
+
+	var previousStatus sql.NullString
+	err = psql.Select("b.status").
+		From("builds b").
+		JoinClause("INNER JOIN jobs j ON j.latest_completed_build_id = b.id").
+		Where(sq.Eq{"j.id": jobID}).
+		Where(sq.Lt{"b.id": buildID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&previousStatus)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	for _, notification := range notifications {
+		if !notification.Matches(teamName, jobName, atc.BuildStatus(status), atc.BuildStatus(previousStatus.String)) {
+			continue
+		}
+
+		config, err := json.Marshal(notification)
+		if err != nil {
+			return err
+		}
+
+		encryptedConfig, nonce, err := es.Encrypt(config)
+		if err != nil {
+			return err
+		}
+
+		_, err = psql.Insert("build_notifications").
+			Columns("build_id", "name", "config", "nonce", "previous_status").
+			Values(buildID, notification.Name, encryptedConfig, nonce, previousStatus).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . BuildNotificationFactory
+
+type BuildNotificationFactory interface {
+	// PendingNotifications returns the notifications that are due to be
+	// delivered, oldest first.
+	PendingNotifications(limit int) ([]BuildNotification, error)
+
+	// BuildNotifications returns the delivery log of a build's notifications.
+	BuildNotifications(buildID int) ([]BuildNotification, error)
+}
+
+type buildNotificationFactory struct {
+	conn Conn
+}
+
+func NewBuildNotificationFactory(conn Conn) BuildNotificationFactory {
+	return &buildNotificationFactory{
+		conn: conn,
+	}
+}
+
+func (f *buildNotificationFactory) PendingNotifications(limit int) ([]BuildNotification, error) {
+	rows, err := buildNotificationsQuery.
+		Where(sq.Eq{"n.status": BuildNotificationStatusPending}).
+		Where(sq.Expr("n.next_attempt_at <= now()")).
+		OrderBy("n.id ASC").
+		Limit(uint64(limit)).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return f.scanNotifications(rows)
+}
+
+func (f *buildNotificationFactory) BuildNotifications(buildID int) ([]BuildNotification, error) {
+	rows, err := buildNotificationsQuery.
+		Where(sq.Eq{"n.build_id": buildID}).
+		OrderBy("n.id ASC").
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return f.scanNotifications(rows)
+}
+
+func (f *buildNotificationFactory) scanNotifications(rows *sql.Rows) ([]BuildNotification, error) {
+	defer Close(rows)
+
+	notifications := []BuildNotification{}
+	for rows.Next() {
+		notification := &buildNotification{conn: f.conn}
+
+		err := scanBuildNotification(notification, rows)
+		if err != nil {
+			return nil, err
+		}
+
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
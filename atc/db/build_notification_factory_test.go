@@ -0,0 +1,190 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildNotificationFactory", func() {
+	var (
+		factory  db.BuildNotificationFactory
+		pipeline db.Pipeline
+		job      db.Job
+	)
+
+	BeforeEach(func() {
+		factory = db.NewBuildNotificationFactory(dbConn)
+
+		var err error
+		pipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "notifying-pipeline"}, atc.Config{
+			Jobs: atc.JobConfigs{
+				{Name: "some-job"},
+				{Name: "other-job"},
+			},
+			Notifications: atc.NotificationConfigs{
+				{
+					Name: "any-build",
+					Webhook: atc.NotificationWebhookConfig{
+						URL: "https://example.com/any",
+					},
+				},
+				{
+					Name:  "other-teams",
+					Teams: []string{"some-other-team"},
+					Webhook: atc.NotificationWebhookConfig{
+						URL: "https://example.com/other-teams",
+					},
+				},
+				{
+					Name:             "broken",
+					Jobs:             []string{"some-job"},
+					Statuses:         []atc.BuildStatus{atc.StatusFailed},
+					PreviousStatuses: []atc.BuildStatus{atc.StatusSucceeded},
+					Webhook: atc.NotificationWebhookConfig{
+						URL: "https://example.com/broken",
+					},
+				},
+			},
+		}, db.ConfigVersion(0), false, db.ConfigAuthor{})
+		Expect(err).NotTo(HaveOccurred())
+
+		var found bool
+		job, found, err = pipeline.Job("some-job")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+	})
+
+	finishBuild := func(job db.Job, status db.BuildStatus) db.Build {
+		build, err := job.CreateBuild()
+		Expect(err).NotTo(HaveOccurred())
+
+		err = build.Finish(status)
+		Expect(err).NotTo(HaveOccurred())
+
+		return build
+	}
+
+	notificationNames := func(notifications []db.BuildNotification) []string {
+		names := []string{}
+		for _, notification := range notifications {
+			names = append(names, notification.Name())
+		}
+
+		return names
+	}
+
+	It("saves the notifications with the pipeline config", func() {
+		config, err := pipeline.Config()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.Notifications).To(HaveLen(3))
+		Expect(config.Notifications[2].Webhook.URL).To(Equal("https://example.com/broken"))
+	})
+
+	It("enqueues the notifications matching a finished build", func() {
+		firstBuild := finishBuild(job, db.BuildStatusSucceeded)
+
+		notifications, err := factory.BuildNotifications(firstBuild.ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(notificationNames(notifications)).To(Equal([]string{"any-build"}))
+		Expect(notifications[0].PreviousStatus()).To(BeEmpty())
+		Expect(notifications[0].Status()).To(Equal(db.BuildNotificationStatusPending))
+		Expect(notifications[0].Config().Webhook.URL).To(Equal("https://example.com/any"))
+
+		secondBuild := finishBuild(job, db.BuildStatusFailed)
+
+		notifications, err = factory.BuildNotifications(secondBuild.ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(notificationNames(notifications)).To(Equal([]string{"any-build", "broken"}))
+		Expect(notifications[1].PreviousStatus()).To(Equal(db.BuildStatusSucceeded))
+
+		thirdBuild := finishBuild(job, db.BuildStatusFailed)
+
+		notifications, err = factory.BuildNotifications(thirdBuild.ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(notificationNames(notifications)).To(Equal([]string{"any-build"}))
+	})
+
+	It("does not enqueue notifications for other jobs", func() {
+		otherJob, found, err := pipeline.Job("other-job")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+
+		finishBuild(otherJob, db.BuildStatusSucceeded)
+		build := finishBuild(otherJob, db.BuildStatusFailed)
+
+		notifications, err := factory.BuildNotifications(build.ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(notificationNames(notifications)).To(Equal([]string{"any-build"}))
+	})
+
+	It("does not enqueue notifications for other teams", func() {
+		build := finishBuild(job, db.BuildStatusSucceeded)
+
+		notifications, err := factory.BuildNotifications(build.ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(notificationNames(notifications)).NotTo(ContainElement("other-teams"))
+	})
+
+	It("does not enqueue notifications for pipelines without any", func() {
+		build := finishBuild(defaultJob, db.BuildStatusFailed)
+
+		notifications, err := factory.BuildNotifications(build.ID())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(notifications).To(BeEmpty())
+	})
+
+	Describe("PendingNotifications", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			finishBuild(job, db.BuildStatusSucceeded)
+			build = finishBuild(job, db.BuildStatusFailed)
+		})
+
+		It("returns the notifications that are due, oldest first", func() {
+			notifications, err := factory.PendingNotifications(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications).To(HaveLen(3))
+			Expect(notifications[1].BuildID()).To(Equal(build.ID()))
+			Expect(notificationNames(notifications)).To(Equal([]string{"any-build", "any-build", "broken"}))
+		})
+
+		It("limits the number of notifications", func() {
+			notifications, err := factory.PendingNotifications(1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications).To(HaveLen(1))
+		})
+
+		It("does not return delivered, failed or postponed notifications", func() {
+			notifications, err := factory.PendingNotifications(10)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = notifications[0].Delivered(200)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications[0].Status()).To(Equal(db.BuildNotificationStatusDelivered))
+			Expect(notifications[0].Attempts()).To(Equal(1))
+			Expect(notifications[0].ResponseCode()).To(Equal(200))
+			Expect(notifications[0].DeliveredAt()).NotTo(BeZero())
+
+			err = notifications[1].Fail(500, "internal server error")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications[1].Status()).To(Equal(db.BuildNotificationStatusFailed))
+			Expect(notifications[1].LastError()).To(Equal("internal server error"))
+
+			err = notifications[2].Retry(0, "connection refused", time.Now().Add(time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications[2].Status()).To(Equal(db.BuildNotificationStatusPending))
+			Expect(notifications[2].Attempts()).To(Equal(1))
+			Expect(notifications[2].ResponseCode()).To(BeZero())
+
+			notifications, err = factory.PendingNotifications(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(notifications).To(BeEmpty())
+		})
+	})
+})
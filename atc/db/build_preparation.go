@@ -35,7 +35,10 @@ type BuildPreparation struct {
 	PausedPipeline      BuildPreparationStatus
 	PausedJob           BuildPreparationStatus
 	MaxRunningBuilds    BuildPreparationStatus
+	TeamQuota           BuildPreparationStatus
+	ClusterQuota        BuildPreparationStatus
 	Inputs              map[string]BuildPreparationStatus
 	InputsSatisfied     BuildPreparationStatus
 	MissingInputReasons MissingInputReasons
+	QueuePosition       int
 }
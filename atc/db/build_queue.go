@@ -0,0 +1,427 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+//go:generate counterfeiter . BuildQueue
+
+// BuildQueue orders the pending builds of every team for when the workers are
+// saturated.
+//
+// Within a team, builds of jobs with a higher priority come first. Across
+// teams, builds are interleaved so that the team with the fewest running
+// builds gets the next slot, which keeps one busy team from starving the
+// rest.
+type BuildQueue interface {
+	// FreeSlots returns how many more tasks can be placed on the workers
+	// which could run the given tasks of a team's build. The bool is false
+	// when the queue is disabled or the build runs no tasks, in which case
+	// the build is started as soon as it can be.
+	FreeSlots(teamID int, tasks []TaskPlacement) (int, bool, error)
+
+	// Position returns the 1-based position of the pending build among the
+	// queued builds competing with it, i.e. those with a task that could be
+	// placed on one of the workers its own tasks could be placed on. The bool
+	// is false when the queue is disabled or the build is not queued, e.g.
+	// because it is not pending, runs no tasks or its job has reached its max
+	// in flight.
+	Position(buildID int) (int, bool, error)
+}
+
+// TaskPlacement is what narrows down the workers a task step can be placed
+// on. An empty platform means the task can run on any platform, as its
+// config is only known once the build runs.
+type TaskPlacement struct {
+	Platform string
+	Tags     []string
+}
+
+// TaskPlacements returns the placement of each task step of the job.
+func TaskPlacements(config atc.JobConfig) []TaskPlacement {
+	var tasks []TaskPlacement
+	for _, plan := range config.Plans() {
+		if plan.Task == "" {
+			continue
+		}
+
+		task := TaskPlacement{Tags: plan.Tags}
+		if plan.TaskConfig != nil {
+			task.Platform = plan.TaskConfig.Platform
+		}
+
+		tasks = append(tasks, task)
+	}
+
+	return tasks
+}
+
+type buildQueue struct {
+	conn Conn
+
+	tasksPerWorker int
+}
+
+// NewBuildQueue returns a queue where each running worker takes
+// tasksPerWorker active tasks, as limited by the limit-active-tasks placement
+// strategy. Zero disables the queue.
+func NewBuildQueue(conn Conn, tasksPerWorker int) BuildQueue {
+	return &buildQueue{
+		conn:           conn,
+		tasksPerWorker: tasksPerWorker,
+	}
+}
+
+func (queue *buildQueue) FreeSlots(teamID int, tasks []TaskPlacement) (int, bool, error) {
+	if queue.tasksPerWorker == 0 || len(tasks) == 0 {
+		return 0, false, nil
+	}
+
+	rows, err := psql.Select("platform", "tags", "active_tasks").
+		From("workers").
+		Where(sq.And{
+			sq.Eq{"state": string(WorkerStateRunning)},
+			sq.Or{
+				sq.Eq{"team_id": nil},
+				sq.Eq{"team_id": teamID},
+			},
+		}).
+		RunWith(queue.conn).
+		Query()
+	if err != nil {
+		return 0, false, err
+	}
+
+	defer Close(rows)
+
+	free := 0
+	for rows.Next() {
+		var (
+			platform    sql.NullString
+			tags        []string
+			activeTasks int
+		)
+
+		err = rows.Scan(&platform, pq.Array(&tags), &activeTasks)
+		if err != nil {
+			return 0, false, err
+		}
+
+		if !canPlaceAny(tasks, platform.String, tags) {
+			continue
+		}
+
+		if activeTasks < queue.tasksPerWorker {
+			free += queue.tasksPerWorker - activeTasks
+		}
+	}
+
+	return free, true, nil
+}
+
+// canPlaceAny returns whether any of the tasks could be placed on a worker
+// with the given platform and tags. Like container placement, tagged tasks
+// need a worker with every one of their tags, and untagged tasks need an
+// untagged worker.
+func canPlaceAny(tasks []TaskPlacement, platform string, tags []string) bool {
+	for _, task := range tasks {
+		if task.Platform != "" && task.Platform != platform {
+			continue
+		}
+
+		if len(task.Tags) == 0 {
+			if len(tags) == 0 {
+				return true
+			}
+
+			continue
+		}
+
+		if hasTags(tags, task.Tags) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasTags(tags []string, wanted []string) bool {
+	for _, tag := range wanted {
+		found := false
+		for _, candidate := range tags {
+			if candidate == tag {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (queue *buildQueue) Position(buildID int) (int, bool, error) {
+	if queue.tasksPerWorker == 0 {
+		return 0, false, nil
+	}
+
+	pending, err := queue.pendingBuilds()
+	if err != nil {
+		return 0, false, err
+	}
+
+	var build queuedBuild
+	var found bool
+	for _, candidate := range pending {
+		if candidate.id == buildID {
+			build = candidate
+			found = true
+			break
+		}
+	}
+
+	if !found || len(build.tasks) == 0 {
+		return 0, false, nil
+	}
+
+	workers, err := queue.runningWorkers()
+	if err != nil {
+		return 0, false, err
+	}
+
+	var pool []queueWorker
+	for _, worker := range workers {
+		if worker.canRun(build.teamID, build.tasks) {
+			pool = append(pool, worker)
+		}
+	}
+
+	// builds that can't be placed on any of the same workers don't take the
+	// build's slots, so they don't hold it back
+	var competing []queuedBuild
+	for _, other := range pending {
+		if other.id == build.id || other.competesFor(pool) {
+			competing = append(competing, other)
+		}
+	}
+
+	running, err := queue.runningBuilds()
+	if err != nil {
+		return 0, false, err
+	}
+
+	for i, queued := range rankByShare(competing, running) {
+		if queued.id == build.id {
+			return i + 1, true, nil
+		}
+	}
+
+	return 0, false, nil
+}
+
+type queuedBuild struct {
+	id       int
+	teamID   int
+	priority int
+	order    int
+	tasks    []TaskPlacement
+
+	share int
+}
+
+func (build queuedBuild) competesFor(pool []queueWorker) bool {
+	if len(build.tasks) == 0 {
+		return false
+	}
+
+	for _, worker := range pool {
+		if worker.canRun(build.teamID, build.tasks) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type queueWorker struct {
+	platform string
+	tags     []string
+	teamID   sql.NullInt64
+}
+
+func (worker queueWorker) canRun(teamID int, tasks []TaskPlacement) bool {
+	if worker.teamID.Valid && int(worker.teamID.Int64) != teamID {
+		return false
+	}
+
+	return canPlaceAny(tasks, worker.platform, worker.tags)
+}
+
+// rankByShare orders the builds so that a team's n-th pending build is
+// ranked as if the team already had n more running builds, which makes teams
+// take turns for the free slots.
+func rankByShare(builds []queuedBuild, running map[int]int) []queuedBuild {
+	ranked := make([]queuedBuild, len(builds))
+	copy(ranked, builds)
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].priority != ranked[j].priority {
+			return ranked[i].priority > ranked[j].priority
+		}
+
+		if ranked[i].order != ranked[j].order {
+			return ranked[i].order < ranked[j].order
+		}
+
+		return ranked[i].id < ranked[j].id
+	})
+
+	queued := map[int]int{}
+	for i, build := range ranked {
+		queued[build.teamID]++
+		ranked[i].share = running[build.teamID] + queued[build.teamID]
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].share != ranked[j].share {
+			return ranked[i].share < ranked[j].share
+		}
+
+		if ranked[i].priority != ranked[j].priority {
+			return ranked[i].priority > ranked[j].priority
+		}
+
+		return ranked[i].id < ranked[j].id
+	})
+
+	return ranked
+}
+
+func (queue *buildQueue) pendingBuilds() ([]queuedBuild, error) {
+	rows, err := queue.conn.Query(pendingBuildsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	es := queue.conn.EncryptionStrategy()
+
+	var builds []queuedBuild
+	for rows.Next() {
+		var (
+			build     queuedBuild
+			rawConfig []byte
+			nonce     sql.NullString
+		)
+
+		err = rows.Scan(&build.id, &build.teamID, &build.priority, &build.order, &rawConfig, &nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decryptedConfig, err := es.Decrypt(string(rawConfig), noncense)
+		if err != nil {
+			return nil, err
+		}
+
+		var config atc.JobConfig
+		err = json.Unmarshal(decryptedConfig, &config)
+		if err != nil {
+			return nil, err
+		}
+
+		build.tasks = TaskPlacements(config)
+
+		builds = append(builds, build)
+	}
+
+	return builds, rows.Err()
+}
+
+func (queue *buildQueue) runningWorkers() ([]queueWorker, error) {
+	rows, err := psql.Select("platform", "tags", "team_id").
+		From("workers").
+		Where(sq.Eq{"state": string(WorkerStateRunning)}).
+		RunWith(queue.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var workers []queueWorker
+	for rows.Next() {
+		var (
+			worker   queueWorker
+			platform sql.NullString
+		)
+
+		err = rows.Scan(&platform, pq.Array(&worker.tags), &worker.teamID)
+		if err != nil {
+			return nil, err
+		}
+
+		worker.platform = platform.String
+
+		workers = append(workers, worker)
+	}
+
+	return workers, rows.Err()
+}
+
+func (queue *buildQueue) runningBuilds() (map[int]int, error) {
+	rows, err := psql.Select("team_id", "COUNT(*)").
+		From("builds").
+		Where(sq.Eq{"status": string(BuildStatusStarted)}).
+		GroupBy("team_id").
+		RunWith(queue.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	running := map[int]int{}
+	for rows.Next() {
+		var teamID, builds int
+		err = rows.Scan(&teamID, &builds)
+		if err != nil {
+			return nil, err
+		}
+
+		running[teamID] = builds
+	}
+
+	return running, rows.Err()
+}
+
+const pendingBuildsQuery = `
+	SELECT b.id, b.team_id, j.priority, COALESCE(b.rerun_of, b.id), j.config, j.nonce
+	FROM builds b
+	JOIN jobs j ON j.id = b.job_id
+	JOIN pipelines p ON p.id = j.pipeline_id
+	WHERE b.status = 'pending'
+	AND NOT b.aborted
+	AND j.active
+	AND NOT j.paused
+	AND NOT j.max_in_flight_reached
+	AND NOT p.paused
+`
@@ -0,0 +1,311 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildQueue", func() {
+	var queue db.BuildQueue
+
+	Describe("FreeSlots", func() {
+		var tasks []db.TaskPlacement
+
+		BeforeEach(func() {
+			tasks = []db.TaskPlacement{{}}
+		})
+
+		Context("when the queue is disabled", func() {
+			BeforeEach(func() {
+				queue = db.NewBuildQueue(dbConn, 0)
+			})
+
+			It("is not limited", func() {
+				_, limited, err := queue.FreeSlots(defaultTeam.ID(), tasks)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(limited).To(BeFalse())
+			})
+		})
+
+		Context("when the queue is enabled", func() {
+			BeforeEach(func() {
+				queue = db.NewBuildQueue(dbConn, 2)
+			})
+
+			It("gives each running worker that many slots", func() {
+				free, limited, err := queue.FreeSlots(defaultTeam.ID(), tasks)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(limited).To(BeTrue())
+				Expect(free).To(Equal(4))
+			})
+
+			It("takes away a slot for each active task", func() {
+				err := defaultWorker.IncreaseActiveTasks()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = defaultWorker.IncreaseActiveTasks()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = otherWorker.IncreaseActiveTasks()
+				Expect(err).ToNot(HaveOccurred())
+
+				free, _, err := queue.FreeSlots(defaultTeam.ID(), tasks)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(free).To(Equal(1))
+			})
+
+			Context("when the build runs no tasks", func() {
+				BeforeEach(func() {
+					tasks = nil
+				})
+
+				It("is not limited", func() {
+					_, limited, err := queue.FreeSlots(defaultTeam.ID(), tasks)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(limited).To(BeFalse())
+				})
+			})
+
+			Context("when some workers could not run the tasks", func() {
+				BeforeEach(func() {
+					otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
+					Expect(err).ToNot(HaveOccurred())
+
+					taggedWorker := otherWorkerPayload
+					taggedWorker.Name = "tagged-worker"
+					taggedWorker.GardenAddr = "3.4.5.6:7777"
+					taggedWorker.Tags = []string{"gpu"}
+					_, err = workerFactory.SaveWorker(taggedWorker, 0)
+					Expect(err).ToNot(HaveOccurred())
+
+					windowsWorker := otherWorkerPayload
+					windowsWorker.Name = "windows-worker"
+					windowsWorker.GardenAddr = "4.5.6.7:7777"
+					windowsWorker.Platform = "windows"
+					_, err = workerFactory.SaveWorker(windowsWorker, 0)
+					Expect(err).ToNot(HaveOccurred())
+
+					teamWorker := otherWorkerPayload
+					teamWorker.Name = "team-worker"
+					teamWorker.GardenAddr = "5.6.7.8:7777"
+					teamWorker.Team = otherTeam.Name()
+					_, err = otherTeam.SaveWorker(teamWorker, 0)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("only counts the slots of the workers the tasks could be placed on", func() {
+					free, _, err := queue.FreeSlots(defaultTeam.ID(), tasks)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(free).To(Equal(6))
+				})
+
+				Context("when the tasks run on a platform", func() {
+					BeforeEach(func() {
+						tasks = []db.TaskPlacement{{Platform: "windows"}}
+					})
+
+					It("counts the slots of the workers of that platform", func() {
+						free, _, err := queue.FreeSlots(defaultTeam.ID(), tasks)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(free).To(Equal(2))
+					})
+				})
+
+				Context("when the tasks are tagged", func() {
+					BeforeEach(func() {
+						tasks = []db.TaskPlacement{{Tags: []string{"gpu"}}}
+					})
+
+					It("counts the slots of the workers with their tags", func() {
+						free, _, err := queue.FreeSlots(defaultTeam.ID(), tasks)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(free).To(Equal(2))
+					})
+				})
+			})
+		})
+	})
+
+	Describe("Position", func() {
+		var (
+			lowBuild, highBuild, otherTeamBuild db.Build
+			lowJob, gpuJob, noTaskJob           db.Job
+		)
+
+		taskPlan := func(tags ...string) atc.PlanSequence {
+			return atc.PlanSequence{{Task: "some-task", Tags: tags}}
+		}
+
+		BeforeEach(func() {
+			queue = db.NewBuildQueue(dbConn, 1)
+
+			pipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "queued-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "low-job", Plan: taskPlan()},
+					{Name: "high-job", Priority: 10, Plan: taskPlan()},
+					{Name: "gpu-job", Plan: taskPlan("gpu")},
+					{Name: "no-task-job"},
+				},
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
+			Expect(err).ToNot(HaveOccurred())
+
+			var found bool
+			lowJob, found, err = pipeline.Job("low-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			highJob, found, err := pipeline.Job("high-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			gpuJob, found, err = pipeline.Job("gpu-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			noTaskJob, found, err = pipeline.Job("no-task-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			otherPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "some-job", Plan: taskPlan()},
+				},
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
+			Expect(err).ToNot(HaveOccurred())
+
+			otherJob, found, err := otherPipeline.Job("some-job")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			lowBuild, err = lowJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			highBuild, err = highJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			otherTeamBuild, err = otherJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		positions := func() []int {
+			var result []int
+			for _, build := range []db.Build{highBuild, otherTeamBuild, lowBuild} {
+				position, found, err := queue.Position(build.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				result = append(result, position)
+			}
+			return result
+		}
+
+		It("orders each team's builds by priority and takes turns between teams", func() {
+			Expect(positions()).To(Equal([]int{1, 2, 3}))
+		})
+
+		Context("when a team already has running builds", func() {
+			BeforeEach(func() {
+				build, err := lowJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				started, err := build.Start(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(started).To(BeTrue())
+			})
+
+			It("gives the other teams a turn first", func() {
+				Expect(positions()).To(Equal([]int{2, 1, 3}))
+			})
+		})
+
+		Context("when a build's tasks can only be placed on other workers", func() {
+			var gpuBuild db.Build
+
+			BeforeEach(func() {
+				gpuWorker := otherWorkerPayload
+				gpuWorker.Name = "gpu-worker"
+				gpuWorker.GardenAddr = "3.4.5.6:7777"
+				gpuWorker.Tags = []string{"gpu"}
+				_, err := workerFactory.SaveWorker(gpuWorker, 0)
+				Expect(err).ToNot(HaveOccurred())
+
+				gpuBuild, err = gpuJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("is not queued behind the builds waiting for the other workers", func() {
+				position, found, err := queue.Position(gpuBuild.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(position).To(Equal(1))
+			})
+
+			It("does not hold back the builds waiting for the other workers", func() {
+				Expect(positions()).To(Equal([]int{1, 2, 3}))
+			})
+		})
+
+		Context("when a build runs no tasks", func() {
+			var noTaskBuild db.Build
+
+			BeforeEach(func() {
+				var err error
+				noTaskBuild, err = noTaskJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not queue it", func() {
+				_, found, err := queue.Position(noTaskBuild.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("does not hold back the builds running tasks", func() {
+				Expect(positions()).To(Equal([]int{1, 2, 3}))
+			})
+		})
+
+		Context("when the job is paused", func() {
+			BeforeEach(func() {
+				err := lowJob.Pause()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not queue its builds", func() {
+				_, found, err := queue.Position(lowBuild.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the queue is disabled", func() {
+			BeforeEach(func() {
+				queue = db.NewBuildQueue(dbConn, 0)
+			})
+
+			It("does not queue any build", func() {
+				_, found, err := queue.Position(highBuild.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the build is not pending", func() {
+			BeforeEach(func() {
+				err := highBuild.Finish(db.BuildStatusSucceeded)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not queue it", func() {
+				_, found, err := queue.Position(highBuild.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -82,6 +82,171 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("LogExported", func() {
+		It("defaults log exported to false in the beginning", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.IsLogExported()).To(BeFalse())
+		})
+
+		It("has log exported set to true after an export and a reload", func() {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SetLogExported(true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.IsLogExported()).To(BeTrue())
+
+			_, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.IsLogExported()).To(BeTrue())
+		})
+
+		Context("when the build finishes while a build log sink is configured", func() {
+			BeforeEach(func() {
+				atc.EnableBuildLogExport = true
+			})
+
+			AfterEach(func() {
+				atc.EnableBuildLogExport = false
+			})
+
+			It("leaves it to be exported", func() {
+				build, err := team.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.IsLogExported()).To(BeFalse())
+			})
+		})
+
+		Context("when the build finishes while no build log sink is configured", func() {
+			It("does not leave it to be exported", func() {
+				build, err := team.CreateOneOffBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.IsLogExported()).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("ContainersRetainedUntil", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = team.CreateOneOffBuild()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("does not retain containers by default", func() {
+			err := build.Finish(db.BuildStatusFailed)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = build.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.ContainersRetainedUntil()).To(BeZero())
+		})
+
+		Context("when the build keeps its containers on failure", func() {
+			BeforeEach(func() {
+				err := build.KeepContainersOnFailure(time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.ContainersRetainedUntil()).To(BeZero())
+			})
+
+			It("retains them once the build fails", func() {
+				err := build.Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.ContainersRetainedUntil()).To(BeTemporally("~", build.EndTime().Add(time.Hour), time.Second))
+			})
+
+			It("does not retain them if the build succeeds", func() {
+				err := build.Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.ContainersRetainedUntil()).To(BeZero())
+			})
+
+			It("no longer retains them once released", func() {
+				err := build.Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.ReleaseContainers()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.ContainersRetainedUntil()).To(BeZero())
+
+				_, err = build.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.ContainersRetainedUntil()).To(BeZero())
+			})
+		})
+
+		Context("when the build has already failed", func() {
+			BeforeEach(func() {
+				err := build.Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("retains its containers from now on", func() {
+				err := build.KeepContainersOnFailure(time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.ContainersRetainedUntil()).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+			})
+
+			It("does not retain them once they are no longer interceptible", func() {
+				err := build.SetInterceptible(false)
+				Expect(err).NotTo(HaveOccurred())
+
+				err = build.KeepContainersOnFailure(time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(build.ContainersRetainedUntil()).To(BeZero())
+			})
+		})
+
+		Context("when the job keeps containers on failure", func() {
+			It("retains the containers of its failed builds", func() {
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "retaining-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name:                    "some-job",
+							KeepContainersOnFailure: "30m",
+						},
+					},
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
+				Expect(err).NotTo(HaveOccurred())
+
+				job, found, err := pipeline.Job("some-job")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				jobBuild, err := job.CreateBuild()
+				Expect(err).NotTo(HaveOccurred())
+
+				err = jobBuild.Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = jobBuild.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(jobBuild.ContainersRetainedUntil()).To(BeTemporally("~", jobBuild.EndTime().Add(30*time.Minute), time.Second))
+			})
+		})
+	})
+
 	Describe("Start", func() {
 		var err error
 		var started bool
@@ -241,7 +406,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -744,7 +909,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -828,7 +993,7 @@ var _ = Describe("Build", func() {
 					},
 				}
 
-				otherPipeline, _, err := team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+				otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, found, err := otherPipeline.Resource("some-explicit-resource")
@@ -928,7 +1093,7 @@ var _ = Describe("Build", func() {
 					},
 				}
 
-				otherPipeline, _, err := team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+				otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, found, err := otherPipeline.Resource("some-explicit-resource")
@@ -1058,7 +1223,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -1271,13 +1436,13 @@ var _ = Describe("Build", func() {
 		Context("when a job build", func() {
 			BeforeEach(func() {
 				var err error
-				createdPipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				createdPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
 						},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := createdPipeline.Job("some-job")
@@ -1320,6 +1485,8 @@ var _ = Describe("Build", func() {
 				PausedPipeline:      db.BuildPreparationStatusNotBlocking,
 				PausedJob:           db.BuildPreparationStatusNotBlocking,
 				MaxRunningBuilds:    db.BuildPreparationStatusNotBlocking,
+				TeamQuota:           db.BuildPreparationStatusNotBlocking,
+				ClusterQuota:        db.BuildPreparationStatusNotBlocking,
 				Inputs:              map[string]db.BuildPreparationStatus{},
 				InputsSatisfied:     db.BuildPreparationStatusNotBlocking,
 				MissingInputReasons: db.MissingInputReasons{},
@@ -1369,7 +1536,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Resources: atc.ResourceConfigs{
 						{
 							Name: "some-resource",
@@ -1390,7 +1557,7 @@ var _ = Describe("Build", func() {
 							},
 						},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -1546,6 +1713,52 @@ var _ = Describe("Build", func() {
 						})
 					})
 
+					Context("when the team's quota is reached", func() {
+						BeforeEach(func() {
+							err := db.NewQuotaRepository(dbConn).SetTeamQuota(team.ID(), atc.Quota{Builds: 1})
+							Expect(err).NotTo(HaveOccurred())
+
+							runningBuild, err := team.CreateOneOffBuild()
+							Expect(err).NotTo(HaveOccurred())
+
+							started, err := runningBuild.Start(atc.Plan{})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(started).To(BeTrue())
+
+							expectedBuildPrep.TeamQuota = db.BuildPreparationStatusBlocking
+						})
+
+						It("returns build preparation with the team quota reached", func() {
+							buildPrep, found, err := build.Preparation()
+							Expect(err).NotTo(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(buildPrep).To(Equal(expectedBuildPrep))
+						})
+					})
+
+					Context("when the cluster's quota is reached", func() {
+						BeforeEach(func() {
+							err := db.NewQuotaRepository(dbConn).SetClusterQuota(atc.Quota{Builds: 1})
+							Expect(err).NotTo(HaveOccurred())
+
+							runningBuild, err := defaultTeam.CreateOneOffBuild()
+							Expect(err).NotTo(HaveOccurred())
+
+							started, err := runningBuild.Start(atc.Plan{})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(started).To(BeTrue())
+
+							expectedBuildPrep.ClusterQuota = db.BuildPreparationStatusBlocking
+						})
+
+						It("returns build preparation with the cluster quota reached", func() {
+							buildPrep, found, err := build.Preparation()
+							Expect(err).NotTo(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(buildPrep).To(Equal(expectedBuildPrep))
+						})
+					})
+
 					Context("when max running builds is reached", func() {
 						BeforeEach(func() {
 							var found bool
@@ -1564,7 +1777,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(scheduled).To(BeTrue())
 
-							pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+							pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -1586,7 +1799,7 @@ var _ = Describe("Build", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(2), false)
+							}, db.ConfigVersion(2), false, db.ConfigAuthor{})
 							Expect(err).ToNot(HaveOccurred())
 
 							job, found, err = pipeline.Job("some-job")
@@ -1625,7 +1838,7 @@ var _ = Describe("Build", func() {
 					Context("when max running builds is de-reached", func() {
 						BeforeEach(func() {
 							var err error
-							pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+							pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -1647,7 +1860,7 @@ var _ = Describe("Build", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(2), false)
+							}, db.ConfigVersion(2), false, db.ConfigAuthor{})
 							Expect(err).ToNot(HaveOccurred())
 
 							var found bool
@@ -1756,7 +1969,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					err = job.SaveNextInputMapping(db.InputMapping{
@@ -1801,7 +2014,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					setupTx, err := dbConn.Begin()
@@ -1911,7 +2124,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2144,7 +2357,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2382,7 +2595,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err := team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -445,7 +445,7 @@ var _ = Describe("CheckFactory", func() {
 		Context("when the resources are used", func() {
 
 			BeforeEach(func() {
-				defaultPipeline, _, err = defaultTeam.SavePipeline("default-pipeline", atc.Config{
+				defaultPipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "default-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -480,7 +480,7 @@ var _ = Describe("CheckFactory", func() {
 							},
 						},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).NotTo(HaveOccurred())
 
 				_, found, err := defaultPipeline.Resource("some-resource")
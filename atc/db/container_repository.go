@@ -233,6 +233,11 @@ func (repository *containerRepository) FindOrphanedContainers() ([]CreatingConta
 			sq.And{
 				sq.NotEq{"c.build_id": nil},
 				sq.Eq{"b.interceptible": false},
+				sq.Or{
+					sq.Eq{"c.task_failed": false},
+					sq.Eq{"b.containers_retained_until": nil},
+					sq.Expr("b.containers_retained_until < now()"),
+				},
 			},
 			sq.And{
 				sq.NotEq{"c.image_check_container_id": nil},
@@ -287,6 +287,61 @@ var _ = Describe("ContainerRepository", func() {
 				})
 			})
 
+			Context("when the build is non-interceptible but retains its containers", func() {
+				BeforeEach(func() {
+					err := build.Finish(db.BuildStatusFailed)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = build.KeepContainersOnFailure(time.Hour)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = build.SetInterceptible(false)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("finds the container for deletion if its task did not fail", func() {
+					creatingContainers, _, _, err := containerRepository.FindOrphanedContainers()
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(creatingContainers).To(HaveLen(1))
+					Expect(creatingContainers[0].Handle()).To(Equal(creatingContainer.Handle()))
+				})
+
+				Context("when its task failed", func() {
+					BeforeEach(func() {
+						err := build.MarkTaskFailed("simple-plan")
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("does not find container for deletion", func() {
+						creatingContainers, createdContainers, destroyingContainers, err := containerRepository.FindOrphanedContainers()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(creatingContainers).To(BeEmpty())
+						Expect(createdContainers).To(BeEmpty())
+						Expect(destroyingContainers).To(BeEmpty())
+					})
+				})
+
+				Context("when the containers are released", func() {
+					BeforeEach(func() {
+						err := build.MarkTaskFailed("simple-plan")
+						Expect(err).NotTo(HaveOccurred())
+
+						err = build.ReleaseContainers()
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("finds container for deletion", func() {
+						creatingContainers, _, _, err := containerRepository.FindOrphanedContainers()
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(creatingContainers).To(HaveLen(1))
+						Expect(creatingContainers[0].Handle()).To(Equal(creatingContainer.Handle()))
+					})
+				})
+			})
+
 			Context("when build is deleted", func() {
 				BeforeEach(func() {
 					err := defaultPipeline.Destroy()
@@ -155,7 +155,7 @@ var _ = BeforeEach(func() {
 	otherWorker, err = workerFactory.SaveWorker(otherWorkerPayload, 0)
 	Expect(err).NotTo(HaveOccurred())
 
-	defaultPipeline, _, err = defaultTeam.SavePipeline("default-pipeline", atc.Config{
+	defaultPipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "default-pipeline"}, atc.Config{
 		Jobs: atc.JobConfigs{
 			{
 				Name: "some-job",
@@ -179,7 +179,7 @@ var _ = BeforeEach(func() {
 				},
 			},
 		},
-	}, db.ConfigVersion(0), false)
+	}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 	Expect(err).NotTo(HaveOccurred())
 
 	var found bool
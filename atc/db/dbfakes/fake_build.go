@@ -94,6 +94,16 @@ type FakeBuild struct {
 		result1 []db.WorkerArtifact
 		result2 error
 	}
+	ContainersRetainedUntilStub        func() time.Time
+	containersRetainedUntilMutex       sync.RWMutex
+	containersRetainedUntilArgsForCall []struct {
+	}
+	containersRetainedUntilReturns struct {
+		result1 time.Time
+	}
+	containersRetainedUntilReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	DeleteStub        func() (bool, error)
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -212,6 +222,16 @@ type FakeBuild struct {
 	isDrainedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsLogExportedStub        func() bool
+	isLogExportedMutex       sync.RWMutex
+	isLogExportedArgsForCall []struct {
+	}
+	isLogExportedReturns struct {
+		result1 bool
+	}
+	isLogExportedReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsManuallyTriggeredStub        func() bool
 	isManuallyTriggeredMutex       sync.RWMutex
 	isManuallyTriggeredArgsForCall []struct {
@@ -273,6 +293,17 @@ type FakeBuild struct {
 	jobNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	KeepContainersOnFailureStub        func(time.Duration) error
+	keepContainersOnFailureMutex       sync.RWMutex
+	keepContainersOnFailureArgsForCall []struct {
+		arg1 time.Duration
+	}
+	keepContainersOnFailureReturns struct {
+		result1 error
+	}
+	keepContainersOnFailureReturnsOnCall map[int]struct {
+		result1 error
+	}
 	MarkAsAbortedStub        func() error
 	markAsAbortedMutex       sync.RWMutex
 	markAsAbortedArgsForCall []struct {
@@ -283,6 +314,17 @@ type FakeBuild struct {
 	markAsAbortedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MarkTaskFailedStub        func(atc.PlanID) error
+	markTaskFailedMutex       sync.RWMutex
+	markTaskFailedArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	markTaskFailedReturns struct {
+		result1 error
+	}
+	markTaskFailedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -317,6 +359,16 @@ type FakeBuild struct {
 	pipelineIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	PipelineInstanceVarsStub        func() atc.InstanceVars
+	pipelineInstanceVarsMutex       sync.RWMutex
+	pipelineInstanceVarsArgsForCall []struct {
+	}
+	pipelineInstanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	pipelineInstanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	PipelineNameStub        func() string
 	pipelineNameMutex       sync.RWMutex
 	pipelineNameArgsForCall []struct {
@@ -327,6 +379,16 @@ type FakeBuild struct {
 	pipelineNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PipelineRefStub        func() atc.PipelineRef
+	pipelineRefMutex       sync.RWMutex
+	pipelineRefArgsForCall []struct {
+	}
+	pipelineRefReturns struct {
+		result1 atc.PipelineRef
+	}
+	pipelineRefReturnsOnCall map[int]struct {
+		result1 atc.PipelineRef
+	}
 	PreparationStub        func() (db.BuildPreparation, bool, error)
 	preparationMutex       sync.RWMutex
 	preparationArgsForCall []struct {
@@ -371,6 +433,16 @@ type FakeBuild struct {
 	reapTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	ReleaseContainersStub        func() error
+	releaseContainersMutex       sync.RWMutex
+	releaseContainersArgsForCall []struct {
+	}
+	releaseContainersReturns struct {
+		result1 error
+	}
+	releaseContainersReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -510,6 +582,17 @@ type FakeBuild struct {
 	setInterceptibleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetLogExportedStub        func(bool) error
+	setLogExportedMutex       sync.RWMutex
+	setLogExportedArgsForCall []struct {
+		arg1 bool
+	}
+	setLogExportedReturns struct {
+		result1 error
+	}
+	setLogExportedReturnsOnCall map[int]struct {
+		result1 error
+	}
 	StartStub        func(atc.Plan) (bool, error)
 	startMutex       sync.RWMutex
 	startArgsForCall []struct {
@@ -923,6 +1006,58 @@ func (fake *FakeBuild) ArtifactsReturnsOnCall(i int, result1 []db.WorkerArtifact
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) ContainersRetainedUntil() time.Time {
+	fake.containersRetainedUntilMutex.Lock()
+	ret, specificReturn := fake.containersRetainedUntilReturnsOnCall[len(fake.containersRetainedUntilArgsForCall)]
+	fake.containersRetainedUntilArgsForCall = append(fake.containersRetainedUntilArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ContainersRetainedUntil", []interface{}{})
+	fake.containersRetainedUntilMutex.Unlock()
+	if fake.ContainersRetainedUntilStub != nil {
+		return fake.ContainersRetainedUntilStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.containersRetainedUntilReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ContainersRetainedUntilCallCount() int {
+	fake.containersRetainedUntilMutex.RLock()
+	defer fake.containersRetainedUntilMutex.RUnlock()
+	return len(fake.containersRetainedUntilArgsForCall)
+}
+
+func (fake *FakeBuild) ContainersRetainedUntilCalls(stub func() time.Time) {
+	fake.containersRetainedUntilMutex.Lock()
+	defer fake.containersRetainedUntilMutex.Unlock()
+	fake.ContainersRetainedUntilStub = stub
+}
+
+func (fake *FakeBuild) ContainersRetainedUntilReturns(result1 time.Time) {
+	fake.containersRetainedUntilMutex.Lock()
+	defer fake.containersRetainedUntilMutex.Unlock()
+	fake.ContainersRetainedUntilStub = nil
+	fake.containersRetainedUntilReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeBuild) ContainersRetainedUntilReturnsOnCall(i int, result1 time.Time) {
+	fake.containersRetainedUntilMutex.Lock()
+	defer fake.containersRetainedUntilMutex.Unlock()
+	fake.ContainersRetainedUntilStub = nil
+	if fake.containersRetainedUntilReturnsOnCall == nil {
+		fake.containersRetainedUntilReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.containersRetainedUntilReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeBuild) Delete() (bool, error) {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1520,6 +1655,58 @@ func (fake *FakeBuild) IsDrainedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeBuild) IsLogExported() bool {
+	fake.isLogExportedMutex.Lock()
+	ret, specificReturn := fake.isLogExportedReturnsOnCall[len(fake.isLogExportedArgsForCall)]
+	fake.isLogExportedArgsForCall = append(fake.isLogExportedArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsLogExported", []interface{}{})
+	fake.isLogExportedMutex.Unlock()
+	if fake.IsLogExportedStub != nil {
+		return fake.IsLogExportedStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isLogExportedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) IsLogExportedCallCount() int {
+	fake.isLogExportedMutex.RLock()
+	defer fake.isLogExportedMutex.RUnlock()
+	return len(fake.isLogExportedArgsForCall)
+}
+
+func (fake *FakeBuild) IsLogExportedCalls(stub func() bool) {
+	fake.isLogExportedMutex.Lock()
+	defer fake.isLogExportedMutex.Unlock()
+	fake.IsLogExportedStub = stub
+}
+
+func (fake *FakeBuild) IsLogExportedReturns(result1 bool) {
+	fake.isLogExportedMutex.Lock()
+	defer fake.isLogExportedMutex.Unlock()
+	fake.IsLogExportedStub = nil
+	fake.isLogExportedReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBuild) IsLogExportedReturnsOnCall(i int, result1 bool) {
+	fake.isLogExportedMutex.Lock()
+	defer fake.isLogExportedMutex.Unlock()
+	fake.IsLogExportedStub = nil
+	if fake.isLogExportedReturnsOnCall == nil {
+		fake.isLogExportedReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isLogExportedReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeBuild) IsManuallyTriggered() bool {
 	fake.isManuallyTriggeredMutex.Lock()
 	ret, specificReturn := fake.isManuallyTriggeredReturnsOnCall[len(fake.isManuallyTriggeredArgsForCall)]
@@ -1840,6 +2027,66 @@ func (fake *FakeBuild) JobNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) KeepContainersOnFailure(arg1 time.Duration) error {
+	fake.keepContainersOnFailureMutex.Lock()
+	ret, specificReturn := fake.keepContainersOnFailureReturnsOnCall[len(fake.keepContainersOnFailureArgsForCall)]
+	fake.keepContainersOnFailureArgsForCall = append(fake.keepContainersOnFailureArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("KeepContainersOnFailure", []interface{}{arg1})
+	fake.keepContainersOnFailureMutex.Unlock()
+	if fake.KeepContainersOnFailureStub != nil {
+		return fake.KeepContainersOnFailureStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.keepContainersOnFailureReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) KeepContainersOnFailureCallCount() int {
+	fake.keepContainersOnFailureMutex.RLock()
+	defer fake.keepContainersOnFailureMutex.RUnlock()
+	return len(fake.keepContainersOnFailureArgsForCall)
+}
+
+func (fake *FakeBuild) KeepContainersOnFailureCalls(stub func(time.Duration) error) {
+	fake.keepContainersOnFailureMutex.Lock()
+	defer fake.keepContainersOnFailureMutex.Unlock()
+	fake.KeepContainersOnFailureStub = stub
+}
+
+func (fake *FakeBuild) KeepContainersOnFailureArgsForCall(i int) time.Duration {
+	fake.keepContainersOnFailureMutex.RLock()
+	defer fake.keepContainersOnFailureMutex.RUnlock()
+	argsForCall := fake.keepContainersOnFailureArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) KeepContainersOnFailureReturns(result1 error) {
+	fake.keepContainersOnFailureMutex.Lock()
+	defer fake.keepContainersOnFailureMutex.Unlock()
+	fake.KeepContainersOnFailureStub = nil
+	fake.keepContainersOnFailureReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) KeepContainersOnFailureReturnsOnCall(i int, result1 error) {
+	fake.keepContainersOnFailureMutex.Lock()
+	defer fake.keepContainersOnFailureMutex.Unlock()
+	fake.KeepContainersOnFailureStub = nil
+	if fake.keepContainersOnFailureReturnsOnCall == nil {
+		fake.keepContainersOnFailureReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.keepContainersOnFailureReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) MarkAsAborted() error {
 	fake.markAsAbortedMutex.Lock()
 	ret, specificReturn := fake.markAsAbortedReturnsOnCall[len(fake.markAsAbortedArgsForCall)]
@@ -1892,6 +2139,66 @@ func (fake *FakeBuild) MarkAsAbortedReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) MarkTaskFailed(arg1 atc.PlanID) error {
+	fake.markTaskFailedMutex.Lock()
+	ret, specificReturn := fake.markTaskFailedReturnsOnCall[len(fake.markTaskFailedArgsForCall)]
+	fake.markTaskFailedArgsForCall = append(fake.markTaskFailedArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	fake.recordInvocation("MarkTaskFailed", []interface{}{arg1})
+	fake.markTaskFailedMutex.Unlock()
+	if fake.MarkTaskFailedStub != nil {
+		return fake.MarkTaskFailedStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markTaskFailedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) MarkTaskFailedCallCount() int {
+	fake.markTaskFailedMutex.RLock()
+	defer fake.markTaskFailedMutex.RUnlock()
+	return len(fake.markTaskFailedArgsForCall)
+}
+
+func (fake *FakeBuild) MarkTaskFailedCalls(stub func(atc.PlanID) error) {
+	fake.markTaskFailedMutex.Lock()
+	defer fake.markTaskFailedMutex.Unlock()
+	fake.MarkTaskFailedStub = stub
+}
+
+func (fake *FakeBuild) MarkTaskFailedArgsForCall(i int) atc.PlanID {
+	fake.markTaskFailedMutex.RLock()
+	defer fake.markTaskFailedMutex.RUnlock()
+	argsForCall := fake.markTaskFailedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) MarkTaskFailedReturns(result1 error) {
+	fake.markTaskFailedMutex.Lock()
+	defer fake.markTaskFailedMutex.Unlock()
+	fake.MarkTaskFailedStub = nil
+	fake.markTaskFailedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) MarkTaskFailedReturnsOnCall(i int, result1 error) {
+	fake.markTaskFailedMutex.Lock()
+	defer fake.markTaskFailedMutex.Unlock()
+	fake.MarkTaskFailedStub = nil
+	if fake.markTaskFailedReturnsOnCall == nil {
+		fake.markTaskFailedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markTaskFailedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -2054,6 +2361,58 @@ func (fake *FakeBuild) PipelineIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) PipelineInstanceVars() atc.InstanceVars {
+	fake.pipelineInstanceVarsMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceVarsReturnsOnCall[len(fake.pipelineInstanceVarsArgsForCall)]
+	fake.pipelineInstanceVarsArgsForCall = append(fake.pipelineInstanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineInstanceVars", []interface{}{})
+	fake.pipelineInstanceVarsMutex.Unlock()
+	if fake.PipelineInstanceVarsStub != nil {
+		return fake.PipelineInstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineInstanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsCallCount() int {
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	return len(fake.pipelineInstanceVarsArgsForCall)
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = stub
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	fake.pipelineInstanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	if fake.pipelineInstanceVarsReturnsOnCall == nil {
+		fake.pipelineInstanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.pipelineInstanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakeBuild) PipelineName() string {
 	fake.pipelineNameMutex.Lock()
 	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
@@ -2106,6 +2465,58 @@ func (fake *FakeBuild) PipelineNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) PipelineRef() atc.PipelineRef {
+	fake.pipelineRefMutex.Lock()
+	ret, specificReturn := fake.pipelineRefReturnsOnCall[len(fake.pipelineRefArgsForCall)]
+	fake.pipelineRefArgsForCall = append(fake.pipelineRefArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineRef", []interface{}{})
+	fake.pipelineRefMutex.Unlock()
+	if fake.PipelineRefStub != nil {
+		return fake.PipelineRefStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineRefReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PipelineRefCallCount() int {
+	fake.pipelineRefMutex.RLock()
+	defer fake.pipelineRefMutex.RUnlock()
+	return len(fake.pipelineRefArgsForCall)
+}
+
+func (fake *FakeBuild) PipelineRefCalls(stub func() atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = stub
+}
+
+func (fake *FakeBuild) PipelineRefReturns(result1 atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = nil
+	fake.pipelineRefReturns = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
+func (fake *FakeBuild) PipelineRefReturnsOnCall(i int, result1 atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = nil
+	if fake.pipelineRefReturnsOnCall == nil {
+		fake.pipelineRefReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineRef
+		})
+	}
+	fake.pipelineRefReturnsOnCall[i] = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
 func (fake *FakeBuild) Preparation() (db.BuildPreparation, bool, error) {
 	fake.preparationMutex.Lock()
 	ret, specificReturn := fake.preparationReturnsOnCall[len(fake.preparationArgsForCall)]
@@ -2320,6 +2731,58 @@ func (fake *FakeBuild) ReapTimeReturnsOnCall(i int, result1 time.Time) {
 	}{result1}
 }
 
+func (fake *FakeBuild) ReleaseContainers() error {
+	fake.releaseContainersMutex.Lock()
+	ret, specificReturn := fake.releaseContainersReturnsOnCall[len(fake.releaseContainersArgsForCall)]
+	fake.releaseContainersArgsForCall = append(fake.releaseContainersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ReleaseContainers", []interface{}{})
+	fake.releaseContainersMutex.Unlock()
+	if fake.ReleaseContainersStub != nil {
+		return fake.ReleaseContainersStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.releaseContainersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ReleaseContainersCallCount() int {
+	fake.releaseContainersMutex.RLock()
+	defer fake.releaseContainersMutex.RUnlock()
+	return len(fake.releaseContainersArgsForCall)
+}
+
+func (fake *FakeBuild) ReleaseContainersCalls(stub func() error) {
+	fake.releaseContainersMutex.Lock()
+	defer fake.releaseContainersMutex.Unlock()
+	fake.ReleaseContainersStub = stub
+}
+
+func (fake *FakeBuild) ReleaseContainersReturns(result1 error) {
+	fake.releaseContainersMutex.Lock()
+	defer fake.releaseContainersMutex.Unlock()
+	fake.ReleaseContainersStub = nil
+	fake.releaseContainersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) ReleaseContainersReturnsOnCall(i int, result1 error) {
+	fake.releaseContainersMutex.Lock()
+	defer fake.releaseContainersMutex.Unlock()
+	fake.ReleaseContainersStub = nil
+	if fake.releaseContainersReturnsOnCall == nil {
+		fake.releaseContainersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseContainersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -3002,6 +3465,66 @@ func (fake *FakeBuild) SetInterceptibleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) SetLogExported(arg1 bool) error {
+	fake.setLogExportedMutex.Lock()
+	ret, specificReturn := fake.setLogExportedReturnsOnCall[len(fake.setLogExportedArgsForCall)]
+	fake.setLogExportedArgsForCall = append(fake.setLogExportedArgsForCall, struct {
+		arg1 bool
+	}{arg1})
+	fake.recordInvocation("SetLogExported", []interface{}{arg1})
+	fake.setLogExportedMutex.Unlock()
+	if fake.SetLogExportedStub != nil {
+		return fake.SetLogExportedStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setLogExportedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SetLogExportedCallCount() int {
+	fake.setLogExportedMutex.RLock()
+	defer fake.setLogExportedMutex.RUnlock()
+	return len(fake.setLogExportedArgsForCall)
+}
+
+func (fake *FakeBuild) SetLogExportedCalls(stub func(bool) error) {
+	fake.setLogExportedMutex.Lock()
+	defer fake.setLogExportedMutex.Unlock()
+	fake.SetLogExportedStub = stub
+}
+
+func (fake *FakeBuild) SetLogExportedArgsForCall(i int) bool {
+	fake.setLogExportedMutex.RLock()
+	defer fake.setLogExportedMutex.RUnlock()
+	argsForCall := fake.setLogExportedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) SetLogExportedReturns(result1 error) {
+	fake.setLogExportedMutex.Lock()
+	defer fake.setLogExportedMutex.Unlock()
+	fake.SetLogExportedStub = nil
+	fake.setLogExportedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SetLogExportedReturnsOnCall(i int, result1 error) {
+	fake.setLogExportedMutex.Lock()
+	defer fake.setLogExportedMutex.Unlock()
+	fake.SetLogExportedStub = nil
+	if fake.setLogExportedReturnsOnCall == nil {
+		fake.setLogExportedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setLogExportedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Start(arg1 atc.Plan) (bool, error) {
 	fake.startMutex.Lock()
 	ret, specificReturn := fake.startReturnsOnCall[len(fake.startArgsForCall)]
@@ -3288,6 +3811,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
 	defer fake.artifactsMutex.RUnlock()
+	fake.containersRetainedUntilMutex.RLock()
+	defer fake.containersRetainedUntilMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.endTimeMutex.RLock()
@@ -3310,6 +3835,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.isCompletedMutex.RUnlock()
 	fake.isDrainedMutex.RLock()
 	defer fake.isDrainedMutex.RUnlock()
+	fake.isLogExportedMutex.RLock()
+	defer fake.isLogExportedMutex.RUnlock()
 	fake.isManuallyTriggeredMutex.RLock()
 	defer fake.isManuallyTriggeredMutex.RUnlock()
 	fake.isNewerThanLastCheckOfMutex.RLock()
@@ -3322,16 +3849,24 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.jobIDMutex.RUnlock()
 	fake.jobNameMutex.RLock()
 	defer fake.jobNameMutex.RUnlock()
+	fake.keepContainersOnFailureMutex.RLock()
+	defer fake.keepContainersOnFailureMutex.RUnlock()
 	fake.markAsAbortedMutex.RLock()
 	defer fake.markAsAbortedMutex.RUnlock()
+	fake.markTaskFailedMutex.RLock()
+	defer fake.markTaskFailedMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
+	fake.pipelineRefMutex.RLock()
+	defer fake.pipelineRefMutex.RUnlock()
 	fake.preparationMutex.RLock()
 	defer fake.preparationMutex.RUnlock()
 	fake.privatePlanMutex.RLock()
@@ -3340,6 +3875,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.publicPlanMutex.RUnlock()
 	fake.reapTimeMutex.RLock()
 	defer fake.reapTimeMutex.RUnlock()
+	fake.releaseContainersMutex.RLock()
+	defer fake.releaseContainersMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
 	fake.rerunNumberMutex.RLock()
@@ -3364,6 +3901,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.setDrainedMutex.RUnlock()
 	fake.setInterceptibleMutex.RLock()
 	defer fake.setInterceptibleMutex.RUnlock()
+	fake.setLogExportedMutex.RLock()
+	defer fake.setLogExportedMutex.RUnlock()
 	fake.startMutex.RLock()
 	defer fake.startMutex.RUnlock()
 	fake.startTimeMutex.RLock()
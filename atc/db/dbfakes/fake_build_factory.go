@@ -62,6 +62,20 @@ type FakeBuildFactory struct {
 		result1 []db.Build
 		result2 error
 	}
+	GetLogExportableBuildsStub        func(int, int) ([]db.Build, error)
+	getLogExportableBuildsMutex       sync.RWMutex
+	getLogExportableBuildsArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	getLogExportableBuildsReturns struct {
+		result1 []db.Build
+		result2 error
+	}
+	getLogExportableBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 error
+	}
 	MarkNonInterceptibleBuildsStub        func() error
 	markNonInterceptibleBuildsMutex       sync.RWMutex
 	markNonInterceptibleBuildsArgsForCall []struct {
@@ -349,6 +363,70 @@ func (fake *FakeBuildFactory) GetDrainableBuildsReturnsOnCall(i int, result1 []d
 	}{result1, result2}
 }
 
+func (fake *FakeBuildFactory) GetLogExportableBuilds(arg1 int, arg2 int) ([]db.Build, error) {
+	fake.getLogExportableBuildsMutex.Lock()
+	ret, specificReturn := fake.getLogExportableBuildsReturnsOnCall[len(fake.getLogExportableBuildsArgsForCall)]
+	fake.getLogExportableBuildsArgsForCall = append(fake.getLogExportableBuildsArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("GetLogExportableBuilds", []interface{}{arg1, arg2})
+	fake.getLogExportableBuildsMutex.Unlock()
+	if fake.GetLogExportableBuildsStub != nil {
+		return fake.GetLogExportableBuildsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getLogExportableBuildsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildFactory) GetLogExportableBuildsCallCount() int {
+	fake.getLogExportableBuildsMutex.RLock()
+	defer fake.getLogExportableBuildsMutex.RUnlock()
+	return len(fake.getLogExportableBuildsArgsForCall)
+}
+
+func (fake *FakeBuildFactory) GetLogExportableBuildsCalls(stub func(int, int) ([]db.Build, error)) {
+	fake.getLogExportableBuildsMutex.Lock()
+	defer fake.getLogExportableBuildsMutex.Unlock()
+	fake.GetLogExportableBuildsStub = stub
+}
+
+func (fake *FakeBuildFactory) GetLogExportableBuildsArgsForCall(i int) (int, int) {
+	fake.getLogExportableBuildsMutex.RLock()
+	defer fake.getLogExportableBuildsMutex.RUnlock()
+	argsForCall := fake.getLogExportableBuildsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuildFactory) GetLogExportableBuildsReturns(result1 []db.Build, result2 error) {
+	fake.getLogExportableBuildsMutex.Lock()
+	defer fake.getLogExportableBuildsMutex.Unlock()
+	fake.GetLogExportableBuildsStub = nil
+	fake.getLogExportableBuildsReturns = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildFactory) GetLogExportableBuildsReturnsOnCall(i int, result1 []db.Build, result2 error) {
+	fake.getLogExportableBuildsMutex.Lock()
+	defer fake.getLogExportableBuildsMutex.Unlock()
+	fake.GetLogExportableBuildsStub = nil
+	if fake.getLogExportableBuildsReturnsOnCall == nil {
+		fake.getLogExportableBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 error
+		})
+	}
+	fake.getLogExportableBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuildFactory) MarkNonInterceptibleBuilds() error {
 	fake.markNonInterceptibleBuildsMutex.Lock()
 	ret, specificReturn := fake.markNonInterceptibleBuildsReturnsOnCall[len(fake.markNonInterceptibleBuildsArgsForCall)]
@@ -550,6 +628,8 @@ func (fake *FakeBuildFactory) Invocations() map[string][][]interface{} {
 	defer fake.getAllStartedBuildsMutex.RUnlock()
 	fake.getDrainableBuildsMutex.RLock()
 	defer fake.getDrainableBuildsMutex.RUnlock()
+	fake.getLogExportableBuildsMutex.RLock()
+	defer fake.getLogExportableBuildsMutex.RUnlock()
 	fake.markNonInterceptibleBuildsMutex.RLock()
 	defer fake.markNonInterceptibleBuildsMutex.RUnlock()
 	fake.publicBuildsMutex.RLock()
@@ -312,6 +312,16 @@ type FakeJob struct {
 	pipelineIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	PipelineInstanceVarsStub        func() atc.InstanceVars
+	pipelineInstanceVarsMutex       sync.RWMutex
+	pipelineInstanceVarsArgsForCall []struct {
+	}
+	pipelineInstanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	pipelineInstanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	PipelineNameStub        func() string
 	pipelineNameMutex       sync.RWMutex
 	pipelineNameArgsForCall []struct {
@@ -322,6 +332,16 @@ type FakeJob struct {
 	pipelineNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PipelineRefStub        func() atc.PipelineRef
+	pipelineRefMutex       sync.RWMutex
+	pipelineRefArgsForCall []struct {
+	}
+	pipelineRefReturns struct {
+		result1 atc.PipelineRef
+	}
+	pipelineRefReturnsOnCall map[int]struct {
+		result1 atc.PipelineRef
+	}
 	PublicStub        func() bool
 	publicMutex       sync.RWMutex
 	publicArgsForCall []struct {
@@ -379,6 +399,16 @@ type FakeJob struct {
 	saveNextInputMappingReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ScheduleStub        func() *atc.ScheduleConfig
+	scheduleMutex       sync.RWMutex
+	scheduleArgsForCall []struct {
+	}
+	scheduleReturns struct {
+		result1 *atc.ScheduleConfig
+	}
+	scheduleReturnsOnCall map[int]struct {
+		result1 *atc.ScheduleConfig
+	}
 	ScheduleBuildStub        func(db.Build) (bool, error)
 	scheduleBuildMutex       sync.RWMutex
 	scheduleBuildArgsForCall []struct {
@@ -402,6 +432,16 @@ type FakeJob struct {
 	scheduleRequestedTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	ScheduleTriggeredAtStub        func() time.Time
+	scheduleTriggeredAtMutex       sync.RWMutex
+	scheduleTriggeredAtArgsForCall []struct {
+	}
+	scheduleTriggeredAtReturns struct {
+		result1 time.Time
+	}
+	scheduleTriggeredAtReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	SetHasNewInputsStub        func(bool) error
 	setHasNewInputsMutex       sync.RWMutex
 	setHasNewInputsArgsForCall []struct {
@@ -443,6 +483,21 @@ type FakeJob struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TriggerScheduledBuildStub        func(time.Time) (db.Build, bool, error)
+	triggerScheduledBuildMutex       sync.RWMutex
+	triggerScheduledBuildArgsForCall []struct {
+		arg1 time.Time
+	}
+	triggerScheduledBuildReturns struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
+	triggerScheduledBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
 	UnpauseStub        func() error
 	unpauseMutex       sync.RWMutex
 	unpauseArgsForCall []struct {
@@ -1886,6 +1941,58 @@ func (fake *FakeJob) PipelineIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) PipelineInstanceVars() atc.InstanceVars {
+	fake.pipelineInstanceVarsMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceVarsReturnsOnCall[len(fake.pipelineInstanceVarsArgsForCall)]
+	fake.pipelineInstanceVarsArgsForCall = append(fake.pipelineInstanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineInstanceVars", []interface{}{})
+	fake.pipelineInstanceVarsMutex.Unlock()
+	if fake.PipelineInstanceVarsStub != nil {
+		return fake.PipelineInstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineInstanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) PipelineInstanceVarsCallCount() int {
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	return len(fake.pipelineInstanceVarsArgsForCall)
+}
+
+func (fake *FakeJob) PipelineInstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = stub
+}
+
+func (fake *FakeJob) PipelineInstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	fake.pipelineInstanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakeJob) PipelineInstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	if fake.pipelineInstanceVarsReturnsOnCall == nil {
+		fake.pipelineInstanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.pipelineInstanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakeJob) PipelineName() string {
 	fake.pipelineNameMutex.Lock()
 	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
@@ -1938,6 +2045,58 @@ func (fake *FakeJob) PipelineNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) PipelineRef() atc.PipelineRef {
+	fake.pipelineRefMutex.Lock()
+	ret, specificReturn := fake.pipelineRefReturnsOnCall[len(fake.pipelineRefArgsForCall)]
+	fake.pipelineRefArgsForCall = append(fake.pipelineRefArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineRef", []interface{}{})
+	fake.pipelineRefMutex.Unlock()
+	if fake.PipelineRefStub != nil {
+		return fake.PipelineRefStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineRefReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) PipelineRefCallCount() int {
+	fake.pipelineRefMutex.RLock()
+	defer fake.pipelineRefMutex.RUnlock()
+	return len(fake.pipelineRefArgsForCall)
+}
+
+func (fake *FakeJob) PipelineRefCalls(stub func() atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = stub
+}
+
+func (fake *FakeJob) PipelineRefReturns(result1 atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = nil
+	fake.pipelineRefReturns = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
+func (fake *FakeJob) PipelineRefReturnsOnCall(i int, result1 atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = nil
+	if fake.pipelineRefReturnsOnCall == nil {
+		fake.pipelineRefReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineRef
+		})
+	}
+	fake.pipelineRefReturnsOnCall[i] = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
 func (fake *FakeJob) Public() bool {
 	fake.publicMutex.Lock()
 	ret, specificReturn := fake.publicReturnsOnCall[len(fake.publicArgsForCall)]
@@ -2221,6 +2380,58 @@ func (fake *FakeJob) SaveNextInputMappingReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeJob) Schedule() *atc.ScheduleConfig {
+	fake.scheduleMutex.Lock()
+	ret, specificReturn := fake.scheduleReturnsOnCall[len(fake.scheduleArgsForCall)]
+	fake.scheduleArgsForCall = append(fake.scheduleArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Schedule", []interface{}{})
+	fake.scheduleMutex.Unlock()
+	if fake.ScheduleStub != nil {
+		return fake.ScheduleStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scheduleReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) ScheduleCallCount() int {
+	fake.scheduleMutex.RLock()
+	defer fake.scheduleMutex.RUnlock()
+	return len(fake.scheduleArgsForCall)
+}
+
+func (fake *FakeJob) ScheduleCalls(stub func() *atc.ScheduleConfig) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = stub
+}
+
+func (fake *FakeJob) ScheduleReturns(result1 *atc.ScheduleConfig) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = nil
+	fake.scheduleReturns = struct {
+		result1 *atc.ScheduleConfig
+	}{result1}
+}
+
+func (fake *FakeJob) ScheduleReturnsOnCall(i int, result1 *atc.ScheduleConfig) {
+	fake.scheduleMutex.Lock()
+	defer fake.scheduleMutex.Unlock()
+	fake.ScheduleStub = nil
+	if fake.scheduleReturnsOnCall == nil {
+		fake.scheduleReturnsOnCall = make(map[int]struct {
+			result1 *atc.ScheduleConfig
+		})
+	}
+	fake.scheduleReturnsOnCall[i] = struct {
+		result1 *atc.ScheduleConfig
+	}{result1}
+}
+
 func (fake *FakeJob) ScheduleBuild(arg1 db.Build) (bool, error) {
 	fake.scheduleBuildMutex.Lock()
 	ret, specificReturn := fake.scheduleBuildReturnsOnCall[len(fake.scheduleBuildArgsForCall)]
@@ -2336,6 +2547,58 @@ func (fake *FakeJob) ScheduleRequestedTimeReturnsOnCall(i int, result1 time.Time
 	}{result1}
 }
 
+func (fake *FakeJob) ScheduleTriggeredAt() time.Time {
+	fake.scheduleTriggeredAtMutex.Lock()
+	ret, specificReturn := fake.scheduleTriggeredAtReturnsOnCall[len(fake.scheduleTriggeredAtArgsForCall)]
+	fake.scheduleTriggeredAtArgsForCall = append(fake.scheduleTriggeredAtArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ScheduleTriggeredAt", []interface{}{})
+	fake.scheduleTriggeredAtMutex.Unlock()
+	if fake.ScheduleTriggeredAtStub != nil {
+		return fake.ScheduleTriggeredAtStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.scheduleTriggeredAtReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) ScheduleTriggeredAtCallCount() int {
+	fake.scheduleTriggeredAtMutex.RLock()
+	defer fake.scheduleTriggeredAtMutex.RUnlock()
+	return len(fake.scheduleTriggeredAtArgsForCall)
+}
+
+func (fake *FakeJob) ScheduleTriggeredAtCalls(stub func() time.Time) {
+	fake.scheduleTriggeredAtMutex.Lock()
+	defer fake.scheduleTriggeredAtMutex.Unlock()
+	fake.ScheduleTriggeredAtStub = stub
+}
+
+func (fake *FakeJob) ScheduleTriggeredAtReturns(result1 time.Time) {
+	fake.scheduleTriggeredAtMutex.Lock()
+	defer fake.scheduleTriggeredAtMutex.Unlock()
+	fake.ScheduleTriggeredAtStub = nil
+	fake.scheduleTriggeredAtReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) ScheduleTriggeredAtReturnsOnCall(i int, result1 time.Time) {
+	fake.scheduleTriggeredAtMutex.Lock()
+	defer fake.scheduleTriggeredAtMutex.Unlock()
+	fake.ScheduleTriggeredAtStub = nil
+	if fake.scheduleTriggeredAtReturnsOnCall == nil {
+		fake.scheduleTriggeredAtReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.scheduleTriggeredAtReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeJob) SetHasNewInputs(arg1 bool) error {
 	fake.setHasNewInputsMutex.Lock()
 	ret, specificReturn := fake.setHasNewInputsReturnsOnCall[len(fake.setHasNewInputsArgsForCall)]
@@ -2552,6 +2815,72 @@ func (fake *FakeJob) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) TriggerScheduledBuild(arg1 time.Time) (db.Build, bool, error) {
+	fake.triggerScheduledBuildMutex.Lock()
+	ret, specificReturn := fake.triggerScheduledBuildReturnsOnCall[len(fake.triggerScheduledBuildArgsForCall)]
+	fake.triggerScheduledBuildArgsForCall = append(fake.triggerScheduledBuildArgsForCall, struct {
+		arg1 time.Time
+	}{arg1})
+	fake.recordInvocation("TriggerScheduledBuild", []interface{}{arg1})
+	fake.triggerScheduledBuildMutex.Unlock()
+	if fake.TriggerScheduledBuildStub != nil {
+		return fake.TriggerScheduledBuildStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.triggerScheduledBuildReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJob) TriggerScheduledBuildCallCount() int {
+	fake.triggerScheduledBuildMutex.RLock()
+	defer fake.triggerScheduledBuildMutex.RUnlock()
+	return len(fake.triggerScheduledBuildArgsForCall)
+}
+
+func (fake *FakeJob) TriggerScheduledBuildCalls(stub func(time.Time) (db.Build, bool, error)) {
+	fake.triggerScheduledBuildMutex.Lock()
+	defer fake.triggerScheduledBuildMutex.Unlock()
+	fake.TriggerScheduledBuildStub = stub
+}
+
+func (fake *FakeJob) TriggerScheduledBuildArgsForCall(i int) time.Time {
+	fake.triggerScheduledBuildMutex.RLock()
+	defer fake.triggerScheduledBuildMutex.RUnlock()
+	argsForCall := fake.triggerScheduledBuildArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) TriggerScheduledBuildReturns(result1 db.Build, result2 bool, result3 error) {
+	fake.triggerScheduledBuildMutex.Lock()
+	defer fake.triggerScheduledBuildMutex.Unlock()
+	fake.TriggerScheduledBuildStub = nil
+	fake.triggerScheduledBuildReturns = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJob) TriggerScheduledBuildReturnsOnCall(i int, result1 db.Build, result2 bool, result3 error) {
+	fake.triggerScheduledBuildMutex.Lock()
+	defer fake.triggerScheduledBuildMutex.Unlock()
+	fake.TriggerScheduledBuildStub = nil
+	if fake.triggerScheduledBuildReturnsOnCall == nil {
+		fake.triggerScheduledBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.triggerScheduledBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeJob) Unpause() error {
 	fake.unpauseMutex.Lock()
 	ret, specificReturn := fake.unpauseReturnsOnCall[len(fake.unpauseArgsForCall)]
@@ -2777,8 +3106,12 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
+	fake.pipelineRefMutex.RLock()
+	defer fake.pipelineRefMutex.RUnlock()
 	fake.publicMutex.RLock()
 	defer fake.publicMutex.RUnlock()
 	fake.reloadMutex.RLock()
@@ -2789,10 +3122,14 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.rerunBuildMutex.RUnlock()
 	fake.saveNextInputMappingMutex.RLock()
 	defer fake.saveNextInputMappingMutex.RUnlock()
+	fake.scheduleMutex.RLock()
+	defer fake.scheduleMutex.RUnlock()
 	fake.scheduleBuildMutex.RLock()
 	defer fake.scheduleBuildMutex.RUnlock()
 	fake.scheduleRequestedTimeMutex.RLock()
 	defer fake.scheduleRequestedTimeMutex.RUnlock()
+	fake.scheduleTriggeredAtMutex.RLock()
+	defer fake.scheduleTriggeredAtMutex.RUnlock()
 	fake.setHasNewInputsMutex.RLock()
 	defer fake.setHasNewInputsMutex.RUnlock()
 	fake.tagsMutex.RLock()
@@ -2801,6 +3138,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.triggerScheduledBuildMutex.RLock()
+	defer fake.triggerScheduledBuildMutex.RUnlock()
 	fake.unpauseMutex.RLock()
 	defer fake.unpauseMutex.RUnlock()
 	fake.updateFirstLoggedBuildIDMutex.RLock()
@@ -33,6 +33,18 @@ type FakeJobFactory struct {
 		result1 db.SchedulerJobs
 		result2 error
 	}
+	ScheduledJobsStub        func() (db.Jobs, error)
+	scheduledJobsMutex       sync.RWMutex
+	scheduledJobsArgsForCall []struct {
+	}
+	scheduledJobsReturns struct {
+		result1 db.Jobs
+		result2 error
+	}
+	scheduledJobsReturnsOnCall map[int]struct {
+		result1 db.Jobs
+		result2 error
+	}
 	VisibleJobsStub        func([]string) (atc.Dashboard, error)
 	visibleJobsMutex       sync.RWMutex
 	visibleJobsArgsForCall []struct {
@@ -160,6 +172,61 @@ func (fake *FakeJobFactory) JobsToScheduleReturnsOnCall(i int, result1 db.Schedu
 	}{result1, result2}
 }
 
+func (fake *FakeJobFactory) ScheduledJobs() (db.Jobs, error) {
+	fake.scheduledJobsMutex.Lock()
+	ret, specificReturn := fake.scheduledJobsReturnsOnCall[len(fake.scheduledJobsArgsForCall)]
+	fake.scheduledJobsArgsForCall = append(fake.scheduledJobsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ScheduledJobs", []interface{}{})
+	fake.scheduledJobsMutex.Unlock()
+	if fake.ScheduledJobsStub != nil {
+		return fake.ScheduledJobsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.scheduledJobsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJobFactory) ScheduledJobsCallCount() int {
+	fake.scheduledJobsMutex.RLock()
+	defer fake.scheduledJobsMutex.RUnlock()
+	return len(fake.scheduledJobsArgsForCall)
+}
+
+func (fake *FakeJobFactory) ScheduledJobsCalls(stub func() (db.Jobs, error)) {
+	fake.scheduledJobsMutex.Lock()
+	defer fake.scheduledJobsMutex.Unlock()
+	fake.ScheduledJobsStub = stub
+}
+
+func (fake *FakeJobFactory) ScheduledJobsReturns(result1 db.Jobs, result2 error) {
+	fake.scheduledJobsMutex.Lock()
+	defer fake.scheduledJobsMutex.Unlock()
+	fake.ScheduledJobsStub = nil
+	fake.scheduledJobsReturns = struct {
+		result1 db.Jobs
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJobFactory) ScheduledJobsReturnsOnCall(i int, result1 db.Jobs, result2 error) {
+	fake.scheduledJobsMutex.Lock()
+	defer fake.scheduledJobsMutex.Unlock()
+	fake.ScheduledJobsStub = nil
+	if fake.scheduledJobsReturnsOnCall == nil {
+		fake.scheduledJobsReturnsOnCall = make(map[int]struct {
+			result1 db.Jobs
+			result2 error
+		})
+	}
+	fake.scheduledJobsReturnsOnCall[i] = struct {
+		result1 db.Jobs
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJobFactory) VisibleJobs(arg1 []string) (atc.Dashboard, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -235,6 +302,8 @@ func (fake *FakeJobFactory) Invocations() map[string][][]interface{} {
 	defer fake.allActiveJobsMutex.RUnlock()
 	fake.jobsToScheduleMutex.RLock()
 	defer fake.jobsToScheduleMutex.RUnlock()
+	fake.scheduledJobsMutex.RLock()
+	defer fake.scheduledJobsMutex.RUnlock()
 	fake.visibleJobsMutex.RLock()
 	defer fake.visibleJobsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
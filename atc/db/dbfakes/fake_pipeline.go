@@ -100,6 +100,33 @@ type FakePipeline struct {
 		result1 atc.Config
 		result2 error
 	}
+	ConfigAtVersionStub        func(db.ConfigVersion) (db.PipelineConfigVersion, bool, error)
+	configAtVersionMutex       sync.RWMutex
+	configAtVersionArgsForCall []struct {
+		arg1 db.ConfigVersion
+	}
+	configAtVersionReturns struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	configAtVersionReturnsOnCall map[int]struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	ConfigHistoryStub        func() ([]db.PipelineConfigVersion, error)
+	configHistoryMutex       sync.RWMutex
+	configHistoryArgsForCall []struct {
+	}
+	configHistoryReturns struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}
+	configHistoryReturnsOnCall map[int]struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}
 	ConfigVersionStub        func() db.ConfigVersion
 	configVersionMutex       sync.RWMutex
 	configVersionArgsForCall []struct {
@@ -236,6 +263,16 @@ type FakePipeline struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InstanceVarsStub        func() atc.InstanceVars
+	instanceVarsMutex       sync.RWMutex
+	instanceVarsArgsForCall []struct {
+	}
+	instanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	instanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	JobStub        func(string) (db.Job, bool, error)
 	jobMutex       sync.RWMutex
 	jobArgsForCall []struct {
@@ -295,6 +332,16 @@ type FakePipeline struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationsStub        func() atc.NotificationConfigs
+	notificationsMutex       sync.RWMutex
+	notificationsArgsForCall []struct {
+	}
+	notificationsReturns struct {
+		result1 atc.NotificationConfigs
+	}
+	notificationsReturnsOnCall map[int]struct {
+		result1 atc.NotificationConfigs
+	}
 	PauseStub        func() error
 	pauseMutex       sync.RWMutex
 	pauseArgsForCall []struct {
@@ -325,6 +372,16 @@ type FakePipeline struct {
 	publicReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	RefStub        func() atc.PipelineRef
+	refMutex       sync.RWMutex
+	refArgsForCall []struct {
+	}
+	refReturns struct {
+		result1 atc.PipelineRef
+	}
+	refReturnsOnCall map[int]struct {
+		result1 atc.PipelineRef
+	}
 	ReloadStub        func() (bool, error)
 	reloadMutex       sync.RWMutex
 	reloadArgsForCall []struct {
@@ -915,6 +972,127 @@ func (fake *FakePipeline) ConfigReturnsOnCall(i int, result1 atc.Config, result2
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) ConfigAtVersion(arg1 db.ConfigVersion) (db.PipelineConfigVersion, bool, error) {
+	fake.configAtVersionMutex.Lock()
+	ret, specificReturn := fake.configAtVersionReturnsOnCall[len(fake.configAtVersionArgsForCall)]
+	fake.configAtVersionArgsForCall = append(fake.configAtVersionArgsForCall, struct {
+		arg1 db.ConfigVersion
+	}{arg1})
+	fake.recordInvocation("ConfigAtVersion", []interface{}{arg1})
+	fake.configAtVersionMutex.Unlock()
+	if fake.ConfigAtVersionStub != nil {
+		return fake.ConfigAtVersionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.configAtVersionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePipeline) ConfigAtVersionCallCount() int {
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
+	return len(fake.configAtVersionArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigAtVersionCalls(stub func(db.ConfigVersion) (db.PipelineConfigVersion, bool, error)) {
+	fake.configAtVersionMutex.Lock()
+	defer fake.configAtVersionMutex.Unlock()
+	fake.ConfigAtVersionStub = stub
+}
+
+func (fake *FakePipeline) ConfigAtVersionArgsForCall(i int) db.ConfigVersion {
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
+	argsForCall := fake.configAtVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) ConfigAtVersionReturns(result1 db.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.configAtVersionMutex.Lock()
+	defer fake.configAtVersionMutex.Unlock()
+	fake.ConfigAtVersionStub = nil
+	fake.configAtVersionReturns = struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) ConfigAtVersionReturnsOnCall(i int, result1 db.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.configAtVersionMutex.Lock()
+	defer fake.configAtVersionMutex.Unlock()
+	fake.ConfigAtVersionStub = nil
+	if fake.configAtVersionReturnsOnCall == nil {
+		fake.configAtVersionReturnsOnCall = make(map[int]struct {
+			result1 db.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.configAtVersionReturnsOnCall[i] = struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) ConfigHistory() ([]db.PipelineConfigVersion, error) {
+	fake.configHistoryMutex.Lock()
+	ret, specificReturn := fake.configHistoryReturnsOnCall[len(fake.configHistoryArgsForCall)]
+	fake.configHistoryArgsForCall = append(fake.configHistoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ConfigHistory", []interface{}{})
+	fake.configHistoryMutex.Unlock()
+	if fake.ConfigHistoryStub != nil {
+		return fake.ConfigHistoryStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.configHistoryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) ConfigHistoryCallCount() int {
+	fake.configHistoryMutex.RLock()
+	defer fake.configHistoryMutex.RUnlock()
+	return len(fake.configHistoryArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigHistoryCalls(stub func() ([]db.PipelineConfigVersion, error)) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = stub
+}
+
+func (fake *FakePipeline) ConfigHistoryReturns(result1 []db.PipelineConfigVersion, result2 error) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = nil
+	fake.configHistoryReturns = struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigHistoryReturnsOnCall(i int, result1 []db.PipelineConfigVersion, result2 error) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = nil
+	if fake.configHistoryReturnsOnCall == nil {
+		fake.configHistoryReturnsOnCall = make(map[int]struct {
+			result1 []db.PipelineConfigVersion
+			result2 error
+		})
+	}
+	fake.configHistoryReturnsOnCall[i] = struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePipeline) ConfigVersion() db.ConfigVersion {
 	fake.configVersionMutex.Lock()
 	ret, specificReturn := fake.configVersionReturnsOnCall[len(fake.configVersionArgsForCall)]
@@ -1593,6 +1771,58 @@ func (fake *FakePipeline) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakePipeline) InstanceVars() atc.InstanceVars {
+	fake.instanceVarsMutex.Lock()
+	ret, specificReturn := fake.instanceVarsReturnsOnCall[len(fake.instanceVarsArgsForCall)]
+	fake.instanceVarsArgsForCall = append(fake.instanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InstanceVars", []interface{}{})
+	fake.instanceVarsMutex.Unlock()
+	if fake.InstanceVarsStub != nil {
+		return fake.InstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.instanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) InstanceVarsCallCount() int {
+	fake.instanceVarsMutex.RLock()
+	defer fake.instanceVarsMutex.RUnlock()
+	return len(fake.instanceVarsArgsForCall)
+}
+
+func (fake *FakePipeline) InstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = stub
+}
+
+func (fake *FakePipeline) InstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = nil
+	fake.instanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakePipeline) InstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = nil
+	if fake.instanceVarsReturnsOnCall == nil {
+		fake.instanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.instanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakePipeline) Job(arg1 string) (db.Job, bool, error) {
 	fake.jobMutex.Lock()
 	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
@@ -1873,6 +2103,58 @@ func (fake *FakePipeline) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakePipeline) Notifications() atc.NotificationConfigs {
+	fake.notificationsMutex.Lock()
+	ret, specificReturn := fake.notificationsReturnsOnCall[len(fake.notificationsArgsForCall)]
+	fake.notificationsArgsForCall = append(fake.notificationsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Notifications", []interface{}{})
+	fake.notificationsMutex.Unlock()
+	if fake.NotificationsStub != nil {
+		return fake.NotificationsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.notificationsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) NotificationsCallCount() int {
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
+	return len(fake.notificationsArgsForCall)
+}
+
+func (fake *FakePipeline) NotificationsCalls(stub func() atc.NotificationConfigs) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = stub
+}
+
+func (fake *FakePipeline) NotificationsReturns(result1 atc.NotificationConfigs) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	fake.notificationsReturns = struct {
+		result1 atc.NotificationConfigs
+	}{result1}
+}
+
+func (fake *FakePipeline) NotificationsReturnsOnCall(i int, result1 atc.NotificationConfigs) {
+	fake.notificationsMutex.Lock()
+	defer fake.notificationsMutex.Unlock()
+	fake.NotificationsStub = nil
+	if fake.notificationsReturnsOnCall == nil {
+		fake.notificationsReturnsOnCall = make(map[int]struct {
+			result1 atc.NotificationConfigs
+		})
+	}
+	fake.notificationsReturnsOnCall[i] = struct {
+		result1 atc.NotificationConfigs
+	}{result1}
+}
+
 func (fake *FakePipeline) Pause() error {
 	fake.pauseMutex.Lock()
 	ret, specificReturn := fake.pauseReturnsOnCall[len(fake.pauseArgsForCall)]
@@ -2029,6 +2311,58 @@ func (fake *FakePipeline) PublicReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakePipeline) Ref() atc.PipelineRef {
+	fake.refMutex.Lock()
+	ret, specificReturn := fake.refReturnsOnCall[len(fake.refArgsForCall)]
+	fake.refArgsForCall = append(fake.refArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Ref", []interface{}{})
+	fake.refMutex.Unlock()
+	if fake.RefStub != nil {
+		return fake.RefStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.refReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) RefCallCount() int {
+	fake.refMutex.RLock()
+	defer fake.refMutex.RUnlock()
+	return len(fake.refArgsForCall)
+}
+
+func (fake *FakePipeline) RefCalls(stub func() atc.PipelineRef) {
+	fake.refMutex.Lock()
+	defer fake.refMutex.Unlock()
+	fake.RefStub = stub
+}
+
+func (fake *FakePipeline) RefReturns(result1 atc.PipelineRef) {
+	fake.refMutex.Lock()
+	defer fake.refMutex.Unlock()
+	fake.RefStub = nil
+	fake.refReturns = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
+func (fake *FakePipeline) RefReturnsOnCall(i int, result1 atc.PipelineRef) {
+	fake.refMutex.Lock()
+	defer fake.refMutex.Unlock()
+	fake.RefStub = nil
+	if fake.refReturnsOnCall == nil {
+		fake.refReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineRef
+		})
+	}
+	fake.refReturnsOnCall[i] = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
 func (fake *FakePipeline) Reload() (bool, error) {
 	fake.reloadMutex.Lock()
 	ret, specificReturn := fake.reloadReturnsOnCall[len(fake.reloadArgsForCall)]
@@ -2874,6 +3208,10 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.checkPausedMutex.RUnlock()
 	fake.configMutex.RLock()
 	defer fake.configMutex.RUnlock()
+	fake.configAtVersionMutex.RLock()
+	defer fake.configAtVersionMutex.RUnlock()
+	fake.configHistoryMutex.RLock()
+	defer fake.configHistoryMutex.RUnlock()
 	fake.configVersionMutex.RLock()
 	defer fake.configVersionMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
@@ -2898,6 +3236,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.hideMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.instanceVarsMutex.RLock()
+	defer fake.instanceVarsMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
 	fake.jobsMutex.RLock()
@@ -2908,12 +3248,16 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.loadDebugVersionsDBMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationsMutex.RLock()
+	defer fake.notificationsMutex.RUnlock()
 	fake.pauseMutex.RLock()
 	defer fake.pauseMutex.RUnlock()
 	fake.pausedMutex.RLock()
 	defer fake.pausedMutex.RUnlock()
 	fake.publicMutex.RLock()
 	defer fake.publicMutex.RUnlock()
+	fake.refMutex.RLock()
+	defer fake.refMutex.RUnlock()
 	fake.reloadMutex.RLock()
 	defer fake.reloadMutex.RUnlock()
 	fake.renameMutex.RLock()
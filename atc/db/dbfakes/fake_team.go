@@ -12,6 +12,33 @@ import (
 )
 
 type FakeTeam struct {
+	APITokenStub        func(string) (db.APIToken, bool, error)
+	aPITokenMutex       sync.RWMutex
+	aPITokenArgsForCall []struct {
+		arg1 string
+	}
+	aPITokenReturns struct {
+		result1 db.APIToken
+		result2 bool
+		result3 error
+	}
+	aPITokenReturnsOnCall map[int]struct {
+		result1 db.APIToken
+		result2 bool
+		result3 error
+	}
+	APITokensStub        func() ([]db.APIToken, error)
+	aPITokensMutex       sync.RWMutex
+	aPITokensArgsForCall []struct {
+	}
+	aPITokensReturns struct {
+		result1 []db.APIToken
+		result2 error
+	}
+	aPITokensReturnsOnCall map[int]struct {
+		result1 []db.APIToken
+		result2 error
+	}
 	AdminStub        func() bool
 	adminMutex       sync.RWMutex
 	adminArgsForCall []struct {
@@ -74,6 +101,21 @@ type FakeTeam struct {
 		result1 []db.Container
 		result2 error
 	}
+	CreateAPITokenStub        func(db.APITokenSpec) (db.APIToken, string, error)
+	createAPITokenMutex       sync.RWMutex
+	createAPITokenArgsForCall []struct {
+		arg1 db.APITokenSpec
+	}
+	createAPITokenReturns struct {
+		result1 db.APIToken
+		result2 string
+		result3 error
+	}
+	createAPITokenReturnsOnCall map[int]struct {
+		result1 db.APIToken
+		result2 string
+		result3 error
+	}
 	CreateOneOffBuildStub        func() (db.Build, error)
 	createOneOffBuildMutex       sync.RWMutex
 	createOneOffBuildArgsForCall []struct {
@@ -109,11 +151,11 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	FindCheckContainersStub        func(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
+	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.Secrets
 		arg5 creds.VarSourcePool
@@ -274,10 +316,10 @@ type FakeTeam struct {
 	orderPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PipelineStub        func(string) (db.Pipeline, bool, error)
+	PipelineStub        func(atc.PipelineRef) (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineReturns struct {
 		result1 db.Pipeline
@@ -339,13 +381,24 @@ type FakeTeam struct {
 	renameReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SavePipelineStub        func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
+	RolesStub        func() atc.TeamRoles
+	rolesMutex       sync.RWMutex
+	rolesArgsForCall []struct {
+	}
+	rolesReturns struct {
+		result1 atc.TeamRoles
+	}
+	rolesReturnsOnCall map[int]struct {
+		result1 atc.TeamRoles
+	}
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, db.ConfigAuthor) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
+		arg5 db.ConfigAuthor
 	}
 	savePipelineReturns struct {
 		result1 db.Pipeline
@@ -371,10 +424,11 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
-	UpdateProviderAuthStub        func(atc.TeamAuth) error
+	UpdateProviderAuthStub        func(atc.TeamAuth, atc.TeamRoles) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
 		arg1 atc.TeamAuth
+		arg2 atc.TeamRoles
 	}
 	updateProviderAuthReturns struct {
 		result1 error
@@ -398,6 +452,127 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTeam) APIToken(arg1 string) (db.APIToken, bool, error) {
+	fake.aPITokenMutex.Lock()
+	ret, specificReturn := fake.aPITokenReturnsOnCall[len(fake.aPITokenArgsForCall)]
+	fake.aPITokenArgsForCall = append(fake.aPITokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("APIToken", []interface{}{arg1})
+	fake.aPITokenMutex.Unlock()
+	if fake.APITokenStub != nil {
+		return fake.APITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.aPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) APITokenCallCount() int {
+	fake.aPITokenMutex.RLock()
+	defer fake.aPITokenMutex.RUnlock()
+	return len(fake.aPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) APITokenCalls(stub func(string) (db.APIToken, bool, error)) {
+	fake.aPITokenMutex.Lock()
+	defer fake.aPITokenMutex.Unlock()
+	fake.APITokenStub = stub
+}
+
+func (fake *FakeTeam) APITokenArgsForCall(i int) string {
+	fake.aPITokenMutex.RLock()
+	defer fake.aPITokenMutex.RUnlock()
+	argsForCall := fake.aPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) APITokenReturns(result1 db.APIToken, result2 bool, result3 error) {
+	fake.aPITokenMutex.Lock()
+	defer fake.aPITokenMutex.Unlock()
+	fake.APITokenStub = nil
+	fake.aPITokenReturns = struct {
+		result1 db.APIToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) APITokenReturnsOnCall(i int, result1 db.APIToken, result2 bool, result3 error) {
+	fake.aPITokenMutex.Lock()
+	defer fake.aPITokenMutex.Unlock()
+	fake.APITokenStub = nil
+	if fake.aPITokenReturnsOnCall == nil {
+		fake.aPITokenReturnsOnCall = make(map[int]struct {
+			result1 db.APIToken
+			result2 bool
+			result3 error
+		})
+	}
+	fake.aPITokenReturnsOnCall[i] = struct {
+		result1 db.APIToken
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) APITokens() ([]db.APIToken, error) {
+	fake.aPITokensMutex.Lock()
+	ret, specificReturn := fake.aPITokensReturnsOnCall[len(fake.aPITokensArgsForCall)]
+	fake.aPITokensArgsForCall = append(fake.aPITokensArgsForCall, struct {
+	}{})
+	fake.recordInvocation("APITokens", []interface{}{})
+	fake.aPITokensMutex.Unlock()
+	if fake.APITokensStub != nil {
+		return fake.APITokensStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.aPITokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) APITokensCallCount() int {
+	fake.aPITokensMutex.RLock()
+	defer fake.aPITokensMutex.RUnlock()
+	return len(fake.aPITokensArgsForCall)
+}
+
+func (fake *FakeTeam) APITokensCalls(stub func() ([]db.APIToken, error)) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = stub
+}
+
+func (fake *FakeTeam) APITokensReturns(result1 []db.APIToken, result2 error) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = nil
+	fake.aPITokensReturns = struct {
+		result1 []db.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) APITokensReturnsOnCall(i int, result1 []db.APIToken, result2 error) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = nil
+	if fake.aPITokensReturnsOnCall == nil {
+		fake.aPITokensReturnsOnCall = make(map[int]struct {
+			result1 []db.APIToken
+			result2 error
+		})
+	}
+	fake.aPITokensReturnsOnCall[i] = struct {
+		result1 []db.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Admin() bool {
 	fake.adminMutex.Lock()
 	ret, specificReturn := fake.adminReturnsOnCall[len(fake.adminArgsForCall)]
@@ -689,6 +864,72 @@ func (fake *FakeTeam) ContainersReturnsOnCall(i int, result1 []db.Container, res
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateAPIToken(arg1 db.APITokenSpec) (db.APIToken, string, error) {
+	fake.createAPITokenMutex.Lock()
+	ret, specificReturn := fake.createAPITokenReturnsOnCall[len(fake.createAPITokenArgsForCall)]
+	fake.createAPITokenArgsForCall = append(fake.createAPITokenArgsForCall, struct {
+		arg1 db.APITokenSpec
+	}{arg1})
+	fake.recordInvocation("CreateAPIToken", []interface{}{arg1})
+	fake.createAPITokenMutex.Unlock()
+	if fake.CreateAPITokenStub != nil {
+		return fake.CreateAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) CreateAPITokenCallCount() int {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	return len(fake.createAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateAPITokenCalls(stub func(db.APITokenSpec) (db.APIToken, string, error)) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = stub
+}
+
+func (fake *FakeTeam) CreateAPITokenArgsForCall(i int) db.APITokenSpec {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	argsForCall := fake.createAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateAPITokenReturns(result1 db.APIToken, result2 string, result3 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	fake.createAPITokenReturns = struct {
+		result1 db.APIToken
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) CreateAPITokenReturnsOnCall(i int, result1 db.APIToken, result2 string, result3 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	if fake.createAPITokenReturnsOnCall == nil {
+		fake.createAPITokenReturnsOnCall = make(map[int]struct {
+			result1 db.APIToken
+			result2 string
+			result3 error
+		})
+	}
+	fake.createAPITokenReturnsOnCall[i] = struct {
+		result1 db.APIToken
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) CreateOneOffBuild() (db.Build, error) {
 	fake.createOneOffBuildMutex.Lock()
 	ret, specificReturn := fake.createOneOffBuildReturnsOnCall[len(fake.createOneOffBuildArgsForCall)]
@@ -859,12 +1100,12 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 string, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
+func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
 	fake.findCheckContainersArgsForCall = append(fake.findCheckContainersArgsForCall, struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.Secrets
 		arg5 creds.VarSourcePool
@@ -887,13 +1128,13 @@ func (fake *FakeTeam) FindCheckContainersCallCount() int {
 	return len(fake.findCheckContainersArgsForCall)
 }
 
-func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)) {
+func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)) {
 	fake.findCheckContainersMutex.Lock()
 	defer fake.findCheckContainersMutex.Unlock()
 	fake.FindCheckContainersStub = stub
 }
 
-func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) {
+func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) {
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	argsForCall := fake.findCheckContainersArgsForCall[i]
@@ -1618,11 +1859,11 @@ func (fake *FakeTeam) OrderPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) Pipeline(arg1 string) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
 	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("Pipeline", []interface{}{arg1})
 	fake.pipelineMutex.Unlock()
@@ -1642,13 +1883,13 @@ func (fake *FakeTeam) PipelineCallCount() int {
 	return len(fake.pipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineCalls(stub func(string) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) PipelineCalls(stub func(atc.PipelineRef) (db.Pipeline, bool, error)) {
 	fake.pipelineMutex.Lock()
 	defer fake.pipelineMutex.Unlock()
 	fake.PipelineStub = stub
 }
 
-func (fake *FakeTeam) PipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	argsForCall := fake.pipelineArgsForCall[i]
@@ -1920,19 +2161,72 @@ func (fake *FakeTeam) RenameReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) Roles() atc.TeamRoles {
+	fake.rolesMutex.Lock()
+	ret, specificReturn := fake.rolesReturnsOnCall[len(fake.rolesArgsForCall)]
+	fake.rolesArgsForCall = append(fake.rolesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Roles", []interface{}{})
+	fake.rolesMutex.Unlock()
+	if fake.RolesStub != nil {
+		return fake.RolesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.rolesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) RolesCallCount() int {
+	fake.rolesMutex.RLock()
+	defer fake.rolesMutex.RUnlock()
+	return len(fake.rolesArgsForCall)
+}
+
+func (fake *FakeTeam) RolesCalls(stub func() atc.TeamRoles) {
+	fake.rolesMutex.Lock()
+	defer fake.rolesMutex.Unlock()
+	fake.RolesStub = stub
+}
+
+func (fake *FakeTeam) RolesReturns(result1 atc.TeamRoles) {
+	fake.rolesMutex.Lock()
+	defer fake.rolesMutex.Unlock()
+	fake.RolesStub = nil
+	fake.rolesReturns = struct {
+		result1 atc.TeamRoles
+	}{result1}
+}
+
+func (fake *FakeTeam) RolesReturnsOnCall(i int, result1 atc.TeamRoles) {
+	fake.rolesMutex.Lock()
+	defer fake.rolesMutex.Unlock()
+	fake.RolesStub = nil
+	if fake.rolesReturnsOnCall == nil {
+		fake.rolesReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamRoles
+		})
+	}
+	fake.rolesReturnsOnCall[i] = struct {
+		result1 atc.TeamRoles
+	}{result1}
+}
+
+func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool, arg5 db.ConfigAuthor) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4})
+		arg5 db.ConfigAuthor
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.savePipelineMutex.Unlock()
 	if fake.SavePipelineStub != nil {
-		return fake.SavePipelineStub(arg1, arg2, arg3, arg4)
+		return fake.SavePipelineStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -1947,17 +2241,17 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineCalls(stub func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, db.ConfigAuthor) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, db.ConfigVersion, bool) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool, db.ConfigAuthor) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeTeam) SavePipelineReturns(result1 db.Pipeline, result2 bool, result3 error) {
@@ -2053,16 +2347,17 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
+func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth, arg2 atc.TeamRoles) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
 	fake.updateProviderAuthArgsForCall = append(fake.updateProviderAuthArgsForCall, struct {
 		arg1 atc.TeamAuth
-	}{arg1})
-	fake.recordInvocation("UpdateProviderAuth", []interface{}{arg1})
+		arg2 atc.TeamRoles
+	}{arg1, arg2})
+	fake.recordInvocation("UpdateProviderAuth", []interface{}{arg1, arg2})
 	fake.updateProviderAuthMutex.Unlock()
 	if fake.UpdateProviderAuthStub != nil {
-		return fake.UpdateProviderAuthStub(arg1)
+		return fake.UpdateProviderAuthStub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1
@@ -2077,17 +2372,17 @@ func (fake *FakeTeam) UpdateProviderAuthCallCount() int {
 	return len(fake.updateProviderAuthArgsForCall)
 }
 
-func (fake *FakeTeam) UpdateProviderAuthCalls(stub func(atc.TeamAuth) error) {
+func (fake *FakeTeam) UpdateProviderAuthCalls(stub func(atc.TeamAuth, atc.TeamRoles) error) {
 	fake.updateProviderAuthMutex.Lock()
 	defer fake.updateProviderAuthMutex.Unlock()
 	fake.UpdateProviderAuthStub = stub
 }
 
-func (fake *FakeTeam) UpdateProviderAuthArgsForCall(i int) atc.TeamAuth {
+func (fake *FakeTeam) UpdateProviderAuthArgsForCall(i int) (atc.TeamAuth, atc.TeamRoles) {
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
 	argsForCall := fake.updateProviderAuthArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeTeam) UpdateProviderAuthReturns(result1 error) {
@@ -2171,6 +2466,10 @@ func (fake *FakeTeam) WorkersReturnsOnCall(i int, result1 []db.Worker, result2 e
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.aPITokenMutex.RLock()
+	defer fake.aPITokenMutex.RUnlock()
+	fake.aPITokensMutex.RLock()
+	defer fake.aPITokensMutex.RUnlock()
 	fake.adminMutex.RLock()
 	defer fake.adminMutex.RUnlock()
 	fake.authMutex.RLock()
@@ -2181,6 +2480,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.buildsWithTimeMutex.RUnlock()
 	fake.containersMutex.RLock()
 	defer fake.containersMutex.RUnlock()
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
@@ -2221,6 +2522,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.publicPipelinesMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
+	fake.rolesMutex.RLock()
+	defer fake.rolesMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
@@ -42,6 +42,40 @@ type FakeWorker struct {
 	activeVolumesReturnsOnCall map[int]struct {
 		result1 int
 	}
+	AllocatableCPUStub        func() uint64
+	allocatableCPUMutex       sync.RWMutex
+	allocatableCPUArgsForCall []struct {
+	}
+	allocatableCPUReturns struct {
+		result1 uint64
+	}
+	allocatableCPUReturnsOnCall map[int]struct {
+		result1 uint64
+	}
+	AllocatableMemoryStub        func() uint64
+	allocatableMemoryMutex       sync.RWMutex
+	allocatableMemoryArgsForCall []struct {
+	}
+	allocatableMemoryReturns struct {
+		result1 uint64
+	}
+	allocatableMemoryReturnsOnCall map[int]struct {
+		result1 uint64
+	}
+	AllocatedResourcesStub        func() (uint64, uint64, error)
+	allocatedResourcesMutex       sync.RWMutex
+	allocatedResourcesArgsForCall []struct {
+	}
+	allocatedResourcesReturns struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
+	allocatedResourcesReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
 	BaggageclaimURLStub        func() *string
 	baggageclaimURLMutex       sync.RWMutex
 	baggageclaimURLArgsForCall []struct {
@@ -62,6 +96,29 @@ type FakeWorker struct {
 	certsPathReturnsOnCall map[int]struct {
 		result1 *string
 	}
+	CordonStub        func(db.WorkerCordon) error
+	cordonMutex       sync.RWMutex
+	cordonArgsForCall []struct {
+		arg1 db.WorkerCordon
+	}
+	cordonReturns struct {
+		result1 error
+	}
+	cordonReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CordonWindowStub        func() (db.WorkerCordon, bool)
+	cordonWindowMutex       sync.RWMutex
+	cordonWindowArgsForCall []struct {
+	}
+	cordonWindowReturns struct {
+		result1 db.WorkerCordon
+		result2 bool
+	}
+	cordonWindowReturnsOnCall map[int]struct {
+		result1 db.WorkerCordon
+		result2 bool
+	}
 	CreateContainerStub        func(db.ContainerOwner, db.ContainerMetadata) (db.CreatingContainer, error)
 	createContainerMutex       sync.RWMutex
 	createContainerArgsForCall []struct {
@@ -86,6 +143,17 @@ type FakeWorker struct {
 	decreaseActiveTasksReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DecreaseAllocatedResourcesStub        func(atc.ContainerLimits) error
+	decreaseAllocatedResourcesMutex       sync.RWMutex
+	decreaseAllocatedResourcesArgsForCall []struct {
+		arg1 atc.ContainerLimits
+	}
+	decreaseAllocatedResourcesReturns struct {
+		result1 error
+	}
+	decreaseAllocatedResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DeleteStub        func() error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -171,6 +239,17 @@ type FakeWorker struct {
 	increaseActiveTasksReturnsOnCall map[int]struct {
 		result1 error
 	}
+	IncreaseAllocatedResourcesStub        func(atc.ContainerLimits) error
+	increaseAllocatedResourcesMutex       sync.RWMutex
+	increaseAllocatedResourcesArgsForCall []struct {
+		arg1 atc.ContainerLimits
+	}
+	increaseAllocatedResourcesReturns struct {
+		result1 error
+	}
+	increaseAllocatedResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	LandStub        func() error
 	landMutex       sync.RWMutex
 	landArgsForCall []struct {
@@ -317,6 +396,26 @@ type FakeWorker struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UncordonStub        func() error
+	uncordonMutex       sync.RWMutex
+	uncordonArgsForCall []struct {
+	}
+	uncordonReturns struct {
+		result1 error
+	}
+	uncordonReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UsageStub        func() *atc.WorkerUsage
+	usageMutex       sync.RWMutex
+	usageArgsForCall []struct {
+	}
+	usageReturns struct {
+		result1 *atc.WorkerUsage
+	}
+	usageReturnsOnCall map[int]struct {
+		result1 *atc.WorkerUsage
+	}
 	VersionStub        func() *string
 	versionMutex       sync.RWMutex
 	versionArgsForCall []struct {
@@ -490,6 +589,168 @@ func (fake *FakeWorker) ActiveVolumesReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeWorker) AllocatableCPU() uint64 {
+	fake.allocatableCPUMutex.Lock()
+	ret, specificReturn := fake.allocatableCPUReturnsOnCall[len(fake.allocatableCPUArgsForCall)]
+	fake.allocatableCPUArgsForCall = append(fake.allocatableCPUArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AllocatableCPU", []interface{}{})
+	fake.allocatableCPUMutex.Unlock()
+	if fake.AllocatableCPUStub != nil {
+		return fake.AllocatableCPUStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.allocatableCPUReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AllocatableCPUCallCount() int {
+	fake.allocatableCPUMutex.RLock()
+	defer fake.allocatableCPUMutex.RUnlock()
+	return len(fake.allocatableCPUArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatableCPUCalls(stub func() uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = stub
+}
+
+func (fake *FakeWorker) AllocatableCPUReturns(result1 uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = nil
+	fake.allocatableCPUReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableCPUReturnsOnCall(i int, result1 uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = nil
+	if fake.allocatableCPUReturnsOnCall == nil {
+		fake.allocatableCPUReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.allocatableCPUReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableMemory() uint64 {
+	fake.allocatableMemoryMutex.Lock()
+	ret, specificReturn := fake.allocatableMemoryReturnsOnCall[len(fake.allocatableMemoryArgsForCall)]
+	fake.allocatableMemoryArgsForCall = append(fake.allocatableMemoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AllocatableMemory", []interface{}{})
+	fake.allocatableMemoryMutex.Unlock()
+	if fake.AllocatableMemoryStub != nil {
+		return fake.AllocatableMemoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.allocatableMemoryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AllocatableMemoryCallCount() int {
+	fake.allocatableMemoryMutex.RLock()
+	defer fake.allocatableMemoryMutex.RUnlock()
+	return len(fake.allocatableMemoryArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatableMemoryCalls(stub func() uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = stub
+}
+
+func (fake *FakeWorker) AllocatableMemoryReturns(result1 uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = nil
+	fake.allocatableMemoryReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableMemoryReturnsOnCall(i int, result1 uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = nil
+	if fake.allocatableMemoryReturnsOnCall == nil {
+		fake.allocatableMemoryReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.allocatableMemoryReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatedResources() (uint64, uint64, error) {
+	fake.allocatedResourcesMutex.Lock()
+	ret, specificReturn := fake.allocatedResourcesReturnsOnCall[len(fake.allocatedResourcesArgsForCall)]
+	fake.allocatedResourcesArgsForCall = append(fake.allocatedResourcesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AllocatedResources", []interface{}{})
+	fake.allocatedResourcesMutex.Unlock()
+	if fake.AllocatedResourcesStub != nil {
+		return fake.AllocatedResourcesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.allocatedResourcesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorker) AllocatedResourcesCallCount() int {
+	fake.allocatedResourcesMutex.RLock()
+	defer fake.allocatedResourcesMutex.RUnlock()
+	return len(fake.allocatedResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatedResourcesCalls(stub func() (uint64, uint64, error)) {
+	fake.allocatedResourcesMutex.Lock()
+	defer fake.allocatedResourcesMutex.Unlock()
+	fake.AllocatedResourcesStub = stub
+}
+
+func (fake *FakeWorker) AllocatedResourcesReturns(result1 uint64, result2 uint64, result3 error) {
+	fake.allocatedResourcesMutex.Lock()
+	defer fake.allocatedResourcesMutex.Unlock()
+	fake.AllocatedResourcesStub = nil
+	fake.allocatedResourcesReturns = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) AllocatedResourcesReturnsOnCall(i int, result1 uint64, result2 uint64, result3 error) {
+	fake.allocatedResourcesMutex.Lock()
+	defer fake.allocatedResourcesMutex.Unlock()
+	fake.AllocatedResourcesStub = nil
+	if fake.allocatedResourcesReturnsOnCall == nil {
+		fake.allocatedResourcesReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.allocatedResourcesReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeWorker) BaggageclaimURL() *string {
 	fake.baggageclaimURLMutex.Lock()
 	ret, specificReturn := fake.baggageclaimURLReturnsOnCall[len(fake.baggageclaimURLArgsForCall)]
@@ -594,6 +855,121 @@ func (fake *FakeWorker) CertsPathReturnsOnCall(i int, result1 *string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Cordon(arg1 db.WorkerCordon) error {
+	fake.cordonMutex.Lock()
+	ret, specificReturn := fake.cordonReturnsOnCall[len(fake.cordonArgsForCall)]
+	fake.cordonArgsForCall = append(fake.cordonArgsForCall, struct {
+		arg1 db.WorkerCordon
+	}{arg1})
+	fake.recordInvocation("Cordon", []interface{}{arg1})
+	fake.cordonMutex.Unlock()
+	if fake.CordonStub != nil {
+		return fake.CordonStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) CordonCallCount() int {
+	fake.cordonMutex.RLock()
+	defer fake.cordonMutex.RUnlock()
+	return len(fake.cordonArgsForCall)
+}
+
+func (fake *FakeWorker) CordonCalls(stub func(db.WorkerCordon) error) {
+	fake.cordonMutex.Lock()
+	defer fake.cordonMutex.Unlock()
+	fake.CordonStub = stub
+}
+
+func (fake *FakeWorker) CordonArgsForCall(i int) db.WorkerCordon {
+	fake.cordonMutex.RLock()
+	defer fake.cordonMutex.RUnlock()
+	argsForCall := fake.cordonArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) CordonReturns(result1 error) {
+	fake.cordonMutex.Lock()
+	defer fake.cordonMutex.Unlock()
+	fake.CordonStub = nil
+	fake.cordonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) CordonReturnsOnCall(i int, result1 error) {
+	fake.cordonMutex.Lock()
+	defer fake.cordonMutex.Unlock()
+	fake.CordonStub = nil
+	if fake.cordonReturnsOnCall == nil {
+		fake.cordonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) CordonWindow() (db.WorkerCordon, bool) {
+	fake.cordonWindowMutex.Lock()
+	ret, specificReturn := fake.cordonWindowReturnsOnCall[len(fake.cordonWindowArgsForCall)]
+	fake.cordonWindowArgsForCall = append(fake.cordonWindowArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CordonWindow", []interface{}{})
+	fake.cordonWindowMutex.Unlock()
+	if fake.CordonWindowStub != nil {
+		return fake.CordonWindowStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.cordonWindowReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorker) CordonWindowCallCount() int {
+	fake.cordonWindowMutex.RLock()
+	defer fake.cordonWindowMutex.RUnlock()
+	return len(fake.cordonWindowArgsForCall)
+}
+
+func (fake *FakeWorker) CordonWindowCalls(stub func() (db.WorkerCordon, bool)) {
+	fake.cordonWindowMutex.Lock()
+	defer fake.cordonWindowMutex.Unlock()
+	fake.CordonWindowStub = stub
+}
+
+func (fake *FakeWorker) CordonWindowReturns(result1 db.WorkerCordon, result2 bool) {
+	fake.cordonWindowMutex.Lock()
+	defer fake.cordonWindowMutex.Unlock()
+	fake.CordonWindowStub = nil
+	fake.cordonWindowReturns = struct {
+		result1 db.WorkerCordon
+		result2 bool
+	}{result1, result2}
+}
+
+func (fake *FakeWorker) CordonWindowReturnsOnCall(i int, result1 db.WorkerCordon, result2 bool) {
+	fake.cordonWindowMutex.Lock()
+	defer fake.cordonWindowMutex.Unlock()
+	fake.CordonWindowStub = nil
+	if fake.cordonWindowReturnsOnCall == nil {
+		fake.cordonWindowReturnsOnCall = make(map[int]struct {
+			result1 db.WorkerCordon
+			result2 bool
+		})
+	}
+	fake.cordonWindowReturnsOnCall[i] = struct {
+		result1 db.WorkerCordon
+		result2 bool
+	}{result1, result2}
+}
+
 func (fake *FakeWorker) CreateContainer(arg1 db.ContainerOwner, arg2 db.ContainerMetadata) (db.CreatingContainer, error) {
 	fake.createContainerMutex.Lock()
 	ret, specificReturn := fake.createContainerReturnsOnCall[len(fake.createContainerArgsForCall)]
@@ -710,6 +1086,66 @@ func (fake *FakeWorker) DecreaseActiveTasksReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) DecreaseAllocatedResources(arg1 atc.ContainerLimits) error {
+	fake.decreaseAllocatedResourcesMutex.Lock()
+	ret, specificReturn := fake.decreaseAllocatedResourcesReturnsOnCall[len(fake.decreaseAllocatedResourcesArgsForCall)]
+	fake.decreaseAllocatedResourcesArgsForCall = append(fake.decreaseAllocatedResourcesArgsForCall, struct {
+		arg1 atc.ContainerLimits
+	}{arg1})
+	fake.recordInvocation("DecreaseAllocatedResources", []interface{}{arg1})
+	fake.decreaseAllocatedResourcesMutex.Unlock()
+	if fake.DecreaseAllocatedResourcesStub != nil {
+		return fake.DecreaseAllocatedResourcesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.decreaseAllocatedResourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) DecreaseAllocatedResourcesCallCount() int {
+	fake.decreaseAllocatedResourcesMutex.RLock()
+	defer fake.decreaseAllocatedResourcesMutex.RUnlock()
+	return len(fake.decreaseAllocatedResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) DecreaseAllocatedResourcesCalls(stub func(atc.ContainerLimits) error) {
+	fake.decreaseAllocatedResourcesMutex.Lock()
+	defer fake.decreaseAllocatedResourcesMutex.Unlock()
+	fake.DecreaseAllocatedResourcesStub = stub
+}
+
+func (fake *FakeWorker) DecreaseAllocatedResourcesArgsForCall(i int) atc.ContainerLimits {
+	fake.decreaseAllocatedResourcesMutex.RLock()
+	defer fake.decreaseAllocatedResourcesMutex.RUnlock()
+	argsForCall := fake.decreaseAllocatedResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) DecreaseAllocatedResourcesReturns(result1 error) {
+	fake.decreaseAllocatedResourcesMutex.Lock()
+	defer fake.decreaseAllocatedResourcesMutex.Unlock()
+	fake.DecreaseAllocatedResourcesStub = nil
+	fake.decreaseAllocatedResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) DecreaseAllocatedResourcesReturnsOnCall(i int, result1 error) {
+	fake.decreaseAllocatedResourcesMutex.Lock()
+	defer fake.decreaseAllocatedResourcesMutex.Unlock()
+	fake.DecreaseAllocatedResourcesStub = nil
+	if fake.decreaseAllocatedResourcesReturnsOnCall == nil {
+		fake.decreaseAllocatedResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.decreaseAllocatedResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Delete() error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -1140,6 +1576,66 @@ func (fake *FakeWorker) IncreaseActiveTasksReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) IncreaseAllocatedResources(arg1 atc.ContainerLimits) error {
+	fake.increaseAllocatedResourcesMutex.Lock()
+	ret, specificReturn := fake.increaseAllocatedResourcesReturnsOnCall[len(fake.increaseAllocatedResourcesArgsForCall)]
+	fake.increaseAllocatedResourcesArgsForCall = append(fake.increaseAllocatedResourcesArgsForCall, struct {
+		arg1 atc.ContainerLimits
+	}{arg1})
+	fake.recordInvocation("IncreaseAllocatedResources", []interface{}{arg1})
+	fake.increaseAllocatedResourcesMutex.Unlock()
+	if fake.IncreaseAllocatedResourcesStub != nil {
+		return fake.IncreaseAllocatedResourcesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.increaseAllocatedResourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) IncreaseAllocatedResourcesCallCount() int {
+	fake.increaseAllocatedResourcesMutex.RLock()
+	defer fake.increaseAllocatedResourcesMutex.RUnlock()
+	return len(fake.increaseAllocatedResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) IncreaseAllocatedResourcesCalls(stub func(atc.ContainerLimits) error) {
+	fake.increaseAllocatedResourcesMutex.Lock()
+	defer fake.increaseAllocatedResourcesMutex.Unlock()
+	fake.IncreaseAllocatedResourcesStub = stub
+}
+
+func (fake *FakeWorker) IncreaseAllocatedResourcesArgsForCall(i int) atc.ContainerLimits {
+	fake.increaseAllocatedResourcesMutex.RLock()
+	defer fake.increaseAllocatedResourcesMutex.RUnlock()
+	argsForCall := fake.increaseAllocatedResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) IncreaseAllocatedResourcesReturns(result1 error) {
+	fake.increaseAllocatedResourcesMutex.Lock()
+	defer fake.increaseAllocatedResourcesMutex.Unlock()
+	fake.IncreaseAllocatedResourcesStub = nil
+	fake.increaseAllocatedResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) IncreaseAllocatedResourcesReturnsOnCall(i int, result1 error) {
+	fake.increaseAllocatedResourcesMutex.Lock()
+	defer fake.increaseAllocatedResourcesMutex.Unlock()
+	fake.IncreaseAllocatedResourcesStub = nil
+	if fake.increaseAllocatedResourcesReturnsOnCall == nil {
+		fake.increaseAllocatedResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.increaseAllocatedResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Land() error {
 	fake.landMutex.Lock()
 	ret, specificReturn := fake.landReturnsOnCall[len(fake.landArgsForCall)]
@@ -1877,6 +2373,110 @@ func (fake *FakeWorker) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) Uncordon() error {
+	fake.uncordonMutex.Lock()
+	ret, specificReturn := fake.uncordonReturnsOnCall[len(fake.uncordonArgsForCall)]
+	fake.uncordonArgsForCall = append(fake.uncordonArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Uncordon", []interface{}{})
+	fake.uncordonMutex.Unlock()
+	if fake.UncordonStub != nil {
+		return fake.UncordonStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uncordonReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) UncordonCallCount() int {
+	fake.uncordonMutex.RLock()
+	defer fake.uncordonMutex.RUnlock()
+	return len(fake.uncordonArgsForCall)
+}
+
+func (fake *FakeWorker) UncordonCalls(stub func() error) {
+	fake.uncordonMutex.Lock()
+	defer fake.uncordonMutex.Unlock()
+	fake.UncordonStub = stub
+}
+
+func (fake *FakeWorker) UncordonReturns(result1 error) {
+	fake.uncordonMutex.Lock()
+	defer fake.uncordonMutex.Unlock()
+	fake.UncordonStub = nil
+	fake.uncordonReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) UncordonReturnsOnCall(i int, result1 error) {
+	fake.uncordonMutex.Lock()
+	defer fake.uncordonMutex.Unlock()
+	fake.UncordonStub = nil
+	if fake.uncordonReturnsOnCall == nil {
+		fake.uncordonReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) Usage() *atc.WorkerUsage {
+	fake.usageMutex.Lock()
+	ret, specificReturn := fake.usageReturnsOnCall[len(fake.usageArgsForCall)]
+	fake.usageArgsForCall = append(fake.usageArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Usage", []interface{}{})
+	fake.usageMutex.Unlock()
+	if fake.UsageStub != nil {
+		return fake.UsageStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.usageReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) UsageCallCount() int {
+	fake.usageMutex.RLock()
+	defer fake.usageMutex.RUnlock()
+	return len(fake.usageArgsForCall)
+}
+
+func (fake *FakeWorker) UsageCalls(stub func() *atc.WorkerUsage) {
+	fake.usageMutex.Lock()
+	defer fake.usageMutex.Unlock()
+	fake.UsageStub = stub
+}
+
+func (fake *FakeWorker) UsageReturns(result1 *atc.WorkerUsage) {
+	fake.usageMutex.Lock()
+	defer fake.usageMutex.Unlock()
+	fake.UsageStub = nil
+	fake.usageReturns = struct {
+		result1 *atc.WorkerUsage
+	}{result1}
+}
+
+func (fake *FakeWorker) UsageReturnsOnCall(i int, result1 *atc.WorkerUsage) {
+	fake.usageMutex.Lock()
+	defer fake.usageMutex.Unlock()
+	fake.UsageStub = nil
+	if fake.usageReturnsOnCall == nil {
+		fake.usageReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerUsage
+		})
+	}
+	fake.usageReturnsOnCall[i] = struct {
+		result1 *atc.WorkerUsage
+	}{result1}
+}
+
 func (fake *FakeWorker) Version() *string {
 	fake.versionMutex.Lock()
 	ret, specificReturn := fake.versionReturnsOnCall[len(fake.versionArgsForCall)]
@@ -1938,14 +2538,26 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.activeTasksMutex.RUnlock()
 	fake.activeVolumesMutex.RLock()
 	defer fake.activeVolumesMutex.RUnlock()
+	fake.allocatableCPUMutex.RLock()
+	defer fake.allocatableCPUMutex.RUnlock()
+	fake.allocatableMemoryMutex.RLock()
+	defer fake.allocatableMemoryMutex.RUnlock()
+	fake.allocatedResourcesMutex.RLock()
+	defer fake.allocatedResourcesMutex.RUnlock()
 	fake.baggageclaimURLMutex.RLock()
 	defer fake.baggageclaimURLMutex.RUnlock()
 	fake.certsPathMutex.RLock()
 	defer fake.certsPathMutex.RUnlock()
+	fake.cordonMutex.RLock()
+	defer fake.cordonMutex.RUnlock()
+	fake.cordonWindowMutex.RLock()
+	defer fake.cordonWindowMutex.RUnlock()
 	fake.createContainerMutex.RLock()
 	defer fake.createContainerMutex.RUnlock()
 	fake.decreaseActiveTasksMutex.RLock()
 	defer fake.decreaseActiveTasksMutex.RUnlock()
+	fake.decreaseAllocatedResourcesMutex.RLock()
+	defer fake.decreaseAllocatedResourcesMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
@@ -1962,6 +2574,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.hTTPSProxyURLMutex.RUnlock()
 	fake.increaseActiveTasksMutex.RLock()
 	defer fake.increaseActiveTasksMutex.RUnlock()
+	fake.increaseAllocatedResourcesMutex.RLock()
+	defer fake.increaseAllocatedResourcesMutex.RUnlock()
 	fake.landMutex.RLock()
 	defer fake.landMutex.RUnlock()
 	fake.nameMutex.RLock()
@@ -1990,6 +2604,10 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.uncordonMutex.RLock()
+	defer fake.uncordonMutex.RUnlock()
+	fake.usageMutex.RLock()
+	defer fake.usageMutex.RUnlock()
 	fake.versionMutex.RLock()
 	defer fake.versionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
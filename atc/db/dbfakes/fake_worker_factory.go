@@ -22,6 +22,18 @@ type FakeWorkerFactory struct {
 		result1 map[string]int
 		result2 error
 	}
+	CordonTaggedWorkersStub        func([]string, db.WorkerCordon) error
+	cordonTaggedWorkersMutex       sync.RWMutex
+	cordonTaggedWorkersArgsForCall []struct {
+		arg1 []string
+		arg2 db.WorkerCordon
+	}
+	cordonTaggedWorkersReturns struct {
+		result1 error
+	}
+	cordonTaggedWorkersReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FindWorkersForContainerByOwnerStub        func(db.ContainerOwner) ([]db.Worker, error)
 	findWorkersForContainerByOwnerMutex       sync.RWMutex
 	findWorkersForContainerByOwnerArgsForCall []struct {
@@ -78,6 +90,19 @@ type FakeWorkerFactory struct {
 		result1 db.Worker
 		result2 error
 	}
+	UncordonTaggedWorkersStub        func([]string) (bool, error)
+	uncordonTaggedWorkersMutex       sync.RWMutex
+	uncordonTaggedWorkersArgsForCall []struct {
+		arg1 []string
+	}
+	uncordonTaggedWorkersReturns struct {
+		result1 bool
+		result2 error
+	}
+	uncordonTaggedWorkersReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	VisibleWorkersStub        func([]string) ([]db.Worker, error)
 	visibleWorkersMutex       sync.RWMutex
 	visibleWorkersArgsForCall []struct {
@@ -162,6 +187,72 @@ func (fake *FakeWorkerFactory) BuildContainersCountPerWorkerReturnsOnCall(i int,
 	}{result1, result2}
 }
 
+func (fake *FakeWorkerFactory) CordonTaggedWorkers(arg1 []string, arg2 db.WorkerCordon) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.cordonTaggedWorkersMutex.Lock()
+	ret, specificReturn := fake.cordonTaggedWorkersReturnsOnCall[len(fake.cordonTaggedWorkersArgsForCall)]
+	fake.cordonTaggedWorkersArgsForCall = append(fake.cordonTaggedWorkersArgsForCall, struct {
+		arg1 []string
+		arg2 db.WorkerCordon
+	}{arg1Copy, arg2})
+	fake.recordInvocation("CordonTaggedWorkers", []interface{}{arg1Copy, arg2})
+	fake.cordonTaggedWorkersMutex.Unlock()
+	if fake.CordonTaggedWorkersStub != nil {
+		return fake.CordonTaggedWorkersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonTaggedWorkersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkerFactory) CordonTaggedWorkersCallCount() int {
+	fake.cordonTaggedWorkersMutex.RLock()
+	defer fake.cordonTaggedWorkersMutex.RUnlock()
+	return len(fake.cordonTaggedWorkersArgsForCall)
+}
+
+func (fake *FakeWorkerFactory) CordonTaggedWorkersCalls(stub func([]string, db.WorkerCordon) error) {
+	fake.cordonTaggedWorkersMutex.Lock()
+	defer fake.cordonTaggedWorkersMutex.Unlock()
+	fake.CordonTaggedWorkersStub = stub
+}
+
+func (fake *FakeWorkerFactory) CordonTaggedWorkersArgsForCall(i int) ([]string, db.WorkerCordon) {
+	fake.cordonTaggedWorkersMutex.RLock()
+	defer fake.cordonTaggedWorkersMutex.RUnlock()
+	argsForCall := fake.cordonTaggedWorkersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWorkerFactory) CordonTaggedWorkersReturns(result1 error) {
+	fake.cordonTaggedWorkersMutex.Lock()
+	defer fake.cordonTaggedWorkersMutex.Unlock()
+	fake.CordonTaggedWorkersStub = nil
+	fake.cordonTaggedWorkersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorkerFactory) CordonTaggedWorkersReturnsOnCall(i int, result1 error) {
+	fake.cordonTaggedWorkersMutex.Lock()
+	defer fake.cordonTaggedWorkersMutex.Unlock()
+	fake.CordonTaggedWorkersStub = nil
+	if fake.cordonTaggedWorkersReturnsOnCall == nil {
+		fake.cordonTaggedWorkersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonTaggedWorkersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorkerFactory) FindWorkersForContainerByOwner(arg1 db.ContainerOwner) ([]db.Worker, error) {
 	fake.findWorkersForContainerByOwnerMutex.Lock()
 	ret, specificReturn := fake.findWorkersForContainerByOwnerReturnsOnCall[len(fake.findWorkersForContainerByOwnerArgsForCall)]
@@ -419,6 +510,74 @@ func (fake *FakeWorkerFactory) SaveWorkerReturnsOnCall(i int, result1 db.Worker,
 	}{result1, result2}
 }
 
+func (fake *FakeWorkerFactory) UncordonTaggedWorkers(arg1 []string) (bool, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.uncordonTaggedWorkersMutex.Lock()
+	ret, specificReturn := fake.uncordonTaggedWorkersReturnsOnCall[len(fake.uncordonTaggedWorkersArgsForCall)]
+	fake.uncordonTaggedWorkersArgsForCall = append(fake.uncordonTaggedWorkersArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("UncordonTaggedWorkers", []interface{}{arg1Copy})
+	fake.uncordonTaggedWorkersMutex.Unlock()
+	if fake.UncordonTaggedWorkersStub != nil {
+		return fake.UncordonTaggedWorkersStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.uncordonTaggedWorkersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerFactory) UncordonTaggedWorkersCallCount() int {
+	fake.uncordonTaggedWorkersMutex.RLock()
+	defer fake.uncordonTaggedWorkersMutex.RUnlock()
+	return len(fake.uncordonTaggedWorkersArgsForCall)
+}
+
+func (fake *FakeWorkerFactory) UncordonTaggedWorkersCalls(stub func([]string) (bool, error)) {
+	fake.uncordonTaggedWorkersMutex.Lock()
+	defer fake.uncordonTaggedWorkersMutex.Unlock()
+	fake.UncordonTaggedWorkersStub = stub
+}
+
+func (fake *FakeWorkerFactory) UncordonTaggedWorkersArgsForCall(i int) []string {
+	fake.uncordonTaggedWorkersMutex.RLock()
+	defer fake.uncordonTaggedWorkersMutex.RUnlock()
+	argsForCall := fake.uncordonTaggedWorkersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorkerFactory) UncordonTaggedWorkersReturns(result1 bool, result2 error) {
+	fake.uncordonTaggedWorkersMutex.Lock()
+	defer fake.uncordonTaggedWorkersMutex.Unlock()
+	fake.UncordonTaggedWorkersStub = nil
+	fake.uncordonTaggedWorkersReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerFactory) UncordonTaggedWorkersReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.uncordonTaggedWorkersMutex.Lock()
+	defer fake.uncordonTaggedWorkersMutex.Unlock()
+	fake.UncordonTaggedWorkersStub = nil
+	if fake.uncordonTaggedWorkersReturnsOnCall == nil {
+		fake.uncordonTaggedWorkersReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.uncordonTaggedWorkersReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorkerFactory) VisibleWorkers(arg1 []string) ([]db.Worker, error) {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -547,6 +706,8 @@ func (fake *FakeWorkerFactory) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.buildContainersCountPerWorkerMutex.RLock()
 	defer fake.buildContainersCountPerWorkerMutex.RUnlock()
+	fake.cordonTaggedWorkersMutex.RLock()
+	defer fake.cordonTaggedWorkersMutex.RUnlock()
 	fake.findWorkersForContainerByOwnerMutex.RLock()
 	defer fake.findWorkersForContainerByOwnerMutex.RUnlock()
 	fake.getWorkerMutex.RLock()
@@ -555,6 +716,8 @@ func (fake *FakeWorkerFactory) Invocations() map[string][][]interface{} {
 	defer fake.heartbeatWorkerMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.uncordonTaggedWorkersMutex.RLock()
+	defer fake.uncordonTaggedWorkersMutex.RUnlock()
 	fake.visibleWorkersMutex.RLock()
 	defer fake.visibleWorkersMutex.RUnlock()
 	fake.workersMutex.RLock()
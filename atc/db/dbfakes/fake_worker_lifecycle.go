@@ -68,6 +68,18 @@ type FakeWorkerLifecycle struct {
 		result1 []string
 		result2 error
 	}
+	UncordonExpiredWorkersStub        func() ([]string, error)
+	uncordonExpiredWorkersMutex       sync.RWMutex
+	uncordonExpiredWorkersArgsForCall []struct {
+	}
+	uncordonExpiredWorkersReturns struct {
+		result1 []string
+		result2 error
+	}
+	uncordonExpiredWorkersReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -347,6 +359,61 @@ func (fake *FakeWorkerLifecycle) StallUnresponsiveWorkersReturnsOnCall(i int, re
 	}{result1, result2}
 }
 
+func (fake *FakeWorkerLifecycle) UncordonExpiredWorkers() ([]string, error) {
+	fake.uncordonExpiredWorkersMutex.Lock()
+	ret, specificReturn := fake.uncordonExpiredWorkersReturnsOnCall[len(fake.uncordonExpiredWorkersArgsForCall)]
+	fake.uncordonExpiredWorkersArgsForCall = append(fake.uncordonExpiredWorkersArgsForCall, struct {
+	}{})
+	fake.recordInvocation("UncordonExpiredWorkers", []interface{}{})
+	fake.uncordonExpiredWorkersMutex.Unlock()
+	if fake.UncordonExpiredWorkersStub != nil {
+		return fake.UncordonExpiredWorkersStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.uncordonExpiredWorkersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWorkerLifecycle) UncordonExpiredWorkersCallCount() int {
+	fake.uncordonExpiredWorkersMutex.RLock()
+	defer fake.uncordonExpiredWorkersMutex.RUnlock()
+	return len(fake.uncordonExpiredWorkersArgsForCall)
+}
+
+func (fake *FakeWorkerLifecycle) UncordonExpiredWorkersCalls(stub func() ([]string, error)) {
+	fake.uncordonExpiredWorkersMutex.Lock()
+	defer fake.uncordonExpiredWorkersMutex.Unlock()
+	fake.UncordonExpiredWorkersStub = stub
+}
+
+func (fake *FakeWorkerLifecycle) UncordonExpiredWorkersReturns(result1 []string, result2 error) {
+	fake.uncordonExpiredWorkersMutex.Lock()
+	defer fake.uncordonExpiredWorkersMutex.Unlock()
+	fake.UncordonExpiredWorkersStub = nil
+	fake.uncordonExpiredWorkersReturns = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWorkerLifecycle) UncordonExpiredWorkersReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.uncordonExpiredWorkersMutex.Lock()
+	defer fake.uncordonExpiredWorkersMutex.Unlock()
+	fake.UncordonExpiredWorkersStub = nil
+	if fake.uncordonExpiredWorkersReturnsOnCall == nil {
+		fake.uncordonExpiredWorkersReturnsOnCall = make(map[int]struct {
+			result1 []string
+			result2 error
+		})
+	}
+	fake.uncordonExpiredWorkersReturnsOnCall[i] = struct {
+		result1 []string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeWorkerLifecycle) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -360,6 +427,8 @@ func (fake *FakeWorkerLifecycle) Invocations() map[string][][]interface{} {
 	defer fake.landFinishedLandingWorkersMutex.RUnlock()
 	fake.stallUnresponsiveWorkersMutex.RLock()
 	defer fake.stallUnresponsiveWorkersMutex.RUnlock()
+	fake.uncordonExpiredWorkersMutex.RLock()
+	defer fake.uncordonExpiredWorkersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
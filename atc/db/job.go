@@ -27,6 +27,23 @@ type InputConfig struct {
 	PinnedVersion   atc.Version
 	ResourceID      int
 	JobID           int
+
+	// PassedResourceIDs maps each passed job in another pipeline to the
+	// resource its builds' versions are recorded under, i.e. its resource
+	// sharing this input's resource config scope. The resource is zero when
+	// there is no such resource, in which case no version can pass.
+	PassedResourceIDs map[int]int
+}
+
+// PassedResourceID returns the resource whose versions the passed job's builds
+// are recorded under.
+func (cfg InputConfig) PassedResourceID(passedJobID int) int {
+	resourceID, found := cfg.PassedResourceIDs[passedJobID]
+	if found {
+		return resourceID
+	}
+
+	return cfg.ResourceID
 }
 
 func (cfgs InputConfigs) String() string {
@@ -66,6 +83,8 @@ type Job interface {
 	ScheduleRequestedTime() time.Time
 	MaxInFlight() int
 	DisableManualTrigger() bool
+	Schedule() *atc.ScheduleConfig
+	ScheduleTriggeredAt() time.Time
 
 	Config() (atc.JobConfig, error)
 	Inputs() ([]atc.JobInput, error)
@@ -81,6 +100,11 @@ type Job interface {
 	CreateBuild() (Build, error)
 	RerunBuild(Build) (Build, error)
 
+	// TriggerScheduledBuild creates a build for the schedule having fired at
+	// the given time. It returns false if the schedule has been triggered
+	// since the job was loaded, e.g. by another ATC.
+	TriggerScheduledBuild(firedAt time.Time) (Build, bool, error)
+
 	RequestSchedule() error
 	UpdateLastScheduled(time.Time) error
 
@@ -104,7 +128,7 @@ type Job interface {
 	HasNewInputs() bool
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.instance_vars", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger", "j.schedule", "j.schedule_triggered_at").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -134,6 +158,8 @@ type job struct {
 	scheduleRequestedTime time.Time
 	maxInFlight           int
 	disableManualTrigger  bool
+	schedule              *atc.ScheduleConfig
+	scheduleTriggeredAt   time.Time
 
 	config    *atc.JobConfig
 	rawConfig []byte
@@ -195,6 +221,8 @@ func (j *job) HasNewInputs() bool               { return j.hasNewInputs }
 func (j *job) ScheduleRequestedTime() time.Time { return j.scheduleRequestedTime }
 func (j *job) MaxInFlight() int                 { return j.maxInFlight }
 func (j *job) DisableManualTrigger() bool       { return j.disableManualTrigger }
+func (j *job) Schedule() *atc.ScheduleConfig    { return j.schedule }
+func (j *job) ScheduleTriggeredAt() time.Time   { return j.scheduleTriggeredAt }
 
 func (j *job) Config() (atc.JobConfig, error) {
 	if j.config != nil {
@@ -288,14 +316,77 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 		inputs = append(inputs, inputConfig)
 	}
 
+	passedResourceIDs, err := j.crossPipelinePassedResourceIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, input := range inputs {
+		inputs[i].PassedResourceIDs = passedResourceIDs[input.Name]
+	}
+
 	return inputs, nil
 }
 
+// crossPipelinePassedResourceIDs finds, for each input's passed jobs in other
+// pipelines, the resource the passed job uses which shares a resource config
+// scope with the input's resource. The result is keyed by input name.
+func (j *job) crossPipelinePassedResourceIDs() (map[string]map[int]int, error) {
+	rows, err := j.conn.Query(`
+		SELECT ji.name, ji.passed_job_id, pr.id
+		FROM job_inputs ji
+		JOIN jobs j ON j.id = ji.job_id
+		JOIN jobs pj ON pj.id = ji.passed_job_id
+		JOIN resources ir ON ir.id = ji.resource_id
+		LEFT JOIN LATERAL (
+			SELECT r.id
+			FROM resources r
+			WHERE r.pipeline_id = pj.pipeline_id
+			AND r.active
+			AND r.resource_config_scope_id = ir.resource_config_scope_id
+			AND (
+				EXISTS (SELECT 1 FROM job_inputs WHERE job_id = pj.id AND resource_id = r.id)
+				OR EXISTS (SELECT 1 FROM job_outputs WHERE job_id = pj.id AND resource_id = r.id)
+			)
+			ORDER BY r.id
+			LIMIT 1
+		) pr ON true
+		WHERE ji.job_id = $1
+		AND pj.pipeline_id != j.pipeline_id
+	`, j.id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	passedResourceIDs := map[string]map[int]int{}
+	for rows.Next() {
+		var inputName string
+		var passedJobID int
+		var resourceID sql.NullInt64
+
+		err = rows.Scan(&inputName, &passedJobID, &resourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		if passedResourceIDs[inputName] == nil {
+			passedResourceIDs[inputName] = map[int]int{}
+		}
+
+		passedResourceIDs[inputName][passedJobID] = int(resourceID.Int64)
+	}
+
+	return passedResourceIDs, nil
+}
+
 func (j *job) Inputs() ([]atc.JobInput, error) {
-	rows, err := psql.Select("ji.name", "r.name", "array_agg(p.name ORDER BY p.id)", "ji.trigger", "ji.version").
+	rows, err := psql.Select("ji.name", "r.name", "array_agg("+passedJobNameColumn("p", "pp", "r.pipeline_id")+" ORDER BY p.id)", "ji.trigger", "ji.version").
 		From("job_inputs ji").
 		Join("resources r ON r.id = ji.resource_id").
 		LeftJoin("jobs p ON p.id = ji.passed_job_id").
+		LeftJoin("pipelines pp ON pp.id = p.pipeline_id").
 		Where(sq.Eq{
 			"ji.job_id": j.id,
 		}).
@@ -350,6 +441,17 @@ func (j *job) Inputs() ([]atc.JobInput, error) {
 	return inputs, nil
 }
 
+// passedJobNameColumn names a passed job the way it is written in the config:
+// by its name when it is in the same pipeline, or as pipeline/job otherwise.
+func passedJobNameColumn(jobTable, pipelineTable, pipelineIDColumn string) string {
+	return fmt.Sprintf(
+		"CASE WHEN %[1]s.pipeline_id = %[3]s THEN %[1]s.name ELSE %[2]s.name || '/' || %[1]s.name END",
+		jobTable,
+		pipelineTable,
+		pipelineIDColumn,
+	)
+}
+
 func (j *job) Outputs() ([]atc.JobOutput, error) {
 	rows, err := psql.Select("jo.name", "r.name").
 		From("job_outputs jo").
@@ -769,6 +871,64 @@ func (j *job) CreateBuild() (Build, error) {
 
 	defer Rollback(tx)
 
+	build, err := j.createManuallyTriggeredBuild(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
+func (j *job) TriggerScheduledBuild(firedAt time.Time) (Build, bool, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("jobs").
+		Set("schedule_triggered_at", firedAt).
+		Where(sq.Eq{
+			"id":                    j.id,
+			"schedule_triggered_at": j.scheduleTriggeredAt,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, false, nil
+	}
+
+	build, err := j.createManuallyTriggeredBuild(tx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	j.scheduleTriggeredAt = firedAt
+
+	return build, true, nil
+}
+
+func (j *job) createManuallyTriggeredBuild(tx Tx) (Build, error) {
 	buildName, err := j.getNewBuildName(tx)
 	if err != nil {
 		return nil, err
@@ -802,11 +962,6 @@ func (j *job) CreateBuild() (Build, error) {
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return nil, err
-	}
-
 	return build, nil
 }
 
@@ -1353,10 +1508,13 @@ func (j *job) isPipelineOrJobPaused(tx Tx) (bool, error) {
 
 func scanJob(j *job, row scannable) error {
 	var (
-		nonce sql.NullString
+		nonce                sql.NullString
+		pipelineInstanceVars sql.NullString
+		schedule             sql.NullString
+		scheduleTriggeredAt  pq.NullTime
 	)
 
-	err := row.Scan(&j.id, &j.name, &j.rawConfig, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger)
+	err := row.Scan(&j.id, &j.name, &j.rawConfig, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger, &schedule, &scheduleTriggeredAt)
 	if err != nil {
 		return err
 	}
@@ -1365,6 +1523,21 @@ func scanJob(j *job, row scannable) error {
 		j.nonce = &nonce.String
 	}
 
+	j.schedule = nil
+	if schedule.Valid {
+		err = json.Unmarshal([]byte(schedule.String), &j.schedule)
+		if err != nil {
+			return err
+		}
+	}
+
+	j.scheduleTriggeredAt = scheduleTriggeredAt.Time
+
+	j.pipelineInstanceVars, err = unmarshalInstanceVars(pipelineInstanceVars)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
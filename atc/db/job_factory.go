@@ -21,6 +21,10 @@ type JobFactory interface {
 	VisibleJobs([]string) (atc.Dashboard, error)
 	AllActiveJobs() (atc.Dashboard, error)
 	JobsToSchedule() (SchedulerJobs, error)
+
+	// ScheduledJobs returns the active jobs with a schedule configured which
+	// are neither paused nor in a paused or archived pipeline.
+	ScheduledJobs() (Jobs, error)
 }
 
 type jobFactory struct {
@@ -46,9 +50,10 @@ type SchedulerJob struct {
 type SchedulerResources []SchedulerResource
 
 type SchedulerResource struct {
-	Name   string
-	Type   string
-	Source atc.Source
+	Name     string
+	Type     string
+	Source   atc.Source
+	Prefetch bool
 }
 
 func (resources SchedulerResources) Lookup(name string) (SchedulerResource, bool) {
@@ -61,6 +66,25 @@ func (resources SchedulerResources) Lookup(name string) (SchedulerResource, bool
 	return SchedulerResource{}, false
 }
 
+func (j *jobFactory) ScheduledJobs() (Jobs, error) {
+	rows, err := jobsQuery.
+		Where(sq.NotEq{"j.schedule": nil}).
+		Where(sq.Eq{
+			"j.active":   true,
+			"j.paused":   false,
+			"p.paused":   false,
+			"p.archived": false,
+		}).
+		OrderBy("j.id ASC").
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobs(j.conn, j.lockFactory, rows)
+}
+
 func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -134,9 +158,10 @@ func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 			}
 
 			schedulerResources = append(schedulerResources, SchedulerResource{
-				Name:   name,
-				Type:   type_,
-				Source: config.Source,
+				Name:     name,
+				Type:     type_,
+				Source:   config.Source,
+				Prefetch: config.Prefetch,
 			})
 		}
 
@@ -267,7 +292,7 @@ func (d dashboardFactory) buildDashboard() (atc.Dashboard, error) {
 }
 
 func (d dashboardFactory) constructJobsForDashboard() (atc.Dashboard, error) {
-	rows, err := psql.Select("j.id", "j.name", "p.name", "j.paused", "j.has_new_inputs", "j.tags", "tm.name",
+	rows, err := psql.Select("j.id", "j.name", "p.name", "j.paused", "j.has_new_inputs", "j.tags", "tm.name", "j.schedule",
 		"l.id", "l.name", "l.status", "l.start_time", "l.end_time",
 		"n.id", "n.name", "n.status", "n.start_time", "n.end_time",
 		"t.id", "t.name", "t.status", "t.start_time", "t.end_time").
@@ -300,11 +325,12 @@ func (d dashboardFactory) constructJobsForDashboard() (atc.Dashboard, error) {
 	var dashboard atc.Dashboard
 	for rows.Next() {
 		var (
-			f, n, t nullableBuild
+			f, n, t  nullableBuild
+			schedule sql.NullString
 		)
 
 		j := atc.DashboardJob{}
-		err = rows.Scan(&j.ID, &j.Name, &j.PipelineName, &j.Paused, &j.HasNewInputs, pq.Array(&j.Groups), &j.TeamName,
+		err = rows.Scan(&j.ID, &j.Name, &j.PipelineName, &j.Paused, &j.HasNewInputs, pq.Array(&j.Groups), &j.TeamName, &schedule,
 			&f.id, &f.name, &f.status, &f.startTime, &f.endTime,
 			&n.id, &n.name, &n.status, &n.startTime, &n.endTime,
 			&t.id, &t.name, &t.status, &t.startTime, &t.endTime)
@@ -312,6 +338,13 @@ func (d dashboardFactory) constructJobsForDashboard() (atc.Dashboard, error) {
 			return nil, err
 		}
 
+		if schedule.Valid {
+			err = json.Unmarshal([]byte(schedule.String), &j.Schedule)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		if f.id.Valid {
 			j.FinishedBuild = &atc.DashboardBuild{
 				ID:           int(f.id.Int64),
@@ -358,13 +391,14 @@ func (d dashboardFactory) constructJobsForDashboard() (atc.Dashboard, error) {
 }
 
 func (d dashboardFactory) fetchJobInputs() (map[int][]atc.DashboardJobInput, error) {
-	rows, err := psql.Select("j.id", "i.name", "r.name", "array_agg(jp.name ORDER BY jp.id)", "i.trigger").
+	rows, err := psql.Select("j.id", "i.name", "r.name", "array_agg("+passedJobNameColumn("jp", "jpp", "j.pipeline_id")+" ORDER BY jp.id)", "i.trigger").
 		From("job_inputs i").
 		Join("jobs j ON j.id = i.job_id").
 		Join("pipelines p ON p.id = j.pipeline_id").
 		Join("teams tm ON tm.id = p.team_id").
 		Join("resources r ON r.id = i.resource_id").
 		LeftJoin("jobs jp ON jp.id = i.passed_job_id").
+		LeftJoin("pipelines jpp ON jpp.id = jp.pipeline_id").
 		Where(sq.Eq{
 			"j.active": true,
 		}).
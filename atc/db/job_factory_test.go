@@ -22,7 +22,7 @@ var _ = Describe("Job Factory", func() {
 			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
 			Expect(err).NotTo(HaveOccurred())
 
-			publicPipeline, _, err = otherTeam.SavePipeline("public-pipeline", atc.Config{
+			publicPipeline, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "public-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "public-pipeline-job-1",
@@ -82,11 +82,11 @@ var _ = Describe("Job Factory", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(publicPipeline.Expose()).To(Succeed())
 
-			_, _, err = otherTeam.SavePipeline("private-pipeline", atc.Config{
+			_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "private-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "private-pipeline-job",
@@ -106,7 +106,7 @@ var _ = Describe("Job Factory", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -318,6 +318,58 @@ var _ = Describe("Job Factory", func() {
 		})
 	})
 
+	Describe("ScheduledJobs", func() {
+		var scheduledPipeline db.Pipeline
+
+		BeforeEach(func() {
+			var err error
+			scheduledPipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "scheduled-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{Name: "nightly", Schedule: &atc.ScheduleConfig{Cron: "@daily"}},
+					{Name: "hourly", Schedule: &atc.ScheduleConfig{Cron: "@hourly"}},
+					{Name: "unscheduled"},
+				},
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the jobs with a schedule", func() {
+			jobs, err := jobFactory.ScheduledJobs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobs).To(HaveLen(2))
+			Expect(jobs[0].Name()).To(Equal("nightly"))
+			Expect(jobs[0].Schedule()).To(Equal(&atc.ScheduleConfig{Cron: "@daily"}))
+			Expect(jobs[1].Name()).To(Equal("hourly"))
+		})
+
+		It("does not return paused jobs", func() {
+			job, found, err := scheduledPipeline.Job("nightly")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(job.Pause()).To(Succeed())
+
+			jobs, err := jobFactory.ScheduledJobs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobs).To(HaveLen(1))
+			Expect(jobs[0].Name()).To(Equal("hourly"))
+		})
+
+		It("does not return jobs of paused or archived pipelines", func() {
+			Expect(scheduledPipeline.Pause()).To(Succeed())
+
+			jobs, err := jobFactory.ScheduledJobs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobs).To(BeEmpty())
+
+			Expect(scheduledPipeline.Unpause()).To(Succeed())
+			Expect(scheduledPipeline.Archive()).To(Succeed())
+
+			jobs, err = jobFactory.ScheduledJobs()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobs).To(BeEmpty())
+		})
+	})
+
 	Describe("JobsToSchedule", func() {
 		var (
 			job1 db.Job
@@ -332,11 +384,11 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job has a requested schedule time later than the last scheduled", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -358,11 +410,11 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job has a requested schedule time earlier than the last scheduled", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -383,11 +435,11 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job has a requested schedule time is the same as the last scheduled", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -415,11 +467,11 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when there are multiple jobs with different times", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -433,22 +485,22 @@ var _ = Describe("Job Factory", func() {
 				team, err := teamFactory.CreateTeam(atc.Team{Name: "some-team"})
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline2, _, err := team.SavePipeline("fake-pipeline-two", atc.Config{
+				pipeline2, _, err := team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				job2, found, err = pipeline2.Job("job-fake")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 
-				pipeline3, _, err := team.SavePipeline("fake-pipeline-three", atc.Config{
+				pipeline3, _, err := team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-three"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake-two"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				job3, found, err = pipeline3.Job("job-fake-two")
@@ -473,11 +525,11 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job is paused but has a later schedule requested time", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -501,11 +553,11 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the job is inactive but has a later schedule requested time", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -516,7 +568,7 @@ var _ = Describe("Job Factory", func() {
 				err = job1.RequestSchedule()
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = defaultTeam.SavePipeline("fake-pipeline", atc.Config{}, pipeline1.ConfigVersion(), false)
+				_, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{}, pipeline1.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -529,11 +581,11 @@ var _ = Describe("Job Factory", func() {
 
 		Context("when the pipeline is paused but it's job has a later schedule requested time", func() {
 			BeforeEach(func() {
-				pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -558,11 +610,11 @@ var _ = Describe("Job Factory", func() {
 		Describe("scheduler jobs resources", func() {
 			Context("when the job needed to be schedule has no resources", func() {
 				BeforeEach(func() {
-					pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+					pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{Name: "job-name"},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -585,7 +637,7 @@ var _ = Describe("Job Factory", func() {
 
 			Context("when the job needed to be schedule uses resources", func() {
 				BeforeEach(func() {
-					pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+					pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "job-name",
@@ -609,7 +661,7 @@ var _ = Describe("Job Factory", func() {
 								Name: "unused-resource",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -639,7 +691,7 @@ var _ = Describe("Job Factory", func() {
 
 			Context("when multiple jobs needed to be schedule uses resources", func() {
 				BeforeEach(func() {
-					pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+					pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "job-1",
@@ -676,10 +728,10 @@ var _ = Describe("Job Factory", func() {
 								Type: "some-type",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
-					pipeline2, _, err := defaultTeam.SavePipeline("fake-pipeline-2", atc.Config{
+					pipeline2, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-2"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "job-3",
@@ -704,7 +756,7 @@ var _ = Describe("Job Factory", func() {
 								Type: "other-type",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -770,7 +822,7 @@ var _ = Describe("Job Factory", func() {
 
 			Context("when the job needed to be schedule uses resources as puts", func() {
 				BeforeEach(func() {
-					pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+					pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "job-name",
@@ -794,7 +846,7 @@ var _ = Describe("Job Factory", func() {
 								Name: "unused-resource",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -823,7 +875,7 @@ var _ = Describe("Job Factory", func() {
 
 			Context("when the job needed to be schedule uses the resource as a put and a get", func() {
 				BeforeEach(func() {
-					pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+					pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "job-name",
@@ -850,7 +902,7 @@ var _ = Describe("Job Factory", func() {
 								Name: "unused-resource",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -881,7 +933,7 @@ var _ = Describe("Job Factory", func() {
 		Describe("schedule jobs resource types", func() {
 			Context("when the pipeline for the job needed to be scheduled uses custom resource types", func() {
 				BeforeEach(func() {
-					pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+					pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{Name: "job-name"},
 						},
@@ -891,7 +943,7 @@ var _ = Describe("Job Factory", func() {
 								Type: "other-type",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -921,7 +973,7 @@ var _ = Describe("Job Factory", func() {
 
 			Context("when multiple job from different pipelines uses custom resource types", func() {
 				BeforeEach(func() {
-					pipeline1, _, err := defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+					pipeline1, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{Name: "job-1"},
 							{Name: "job-2"},
@@ -932,10 +984,10 @@ var _ = Describe("Job Factory", func() {
 								Type: "other-type",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
-					pipeline2, _, err := defaultTeam.SavePipeline("fake-pipeline-2", atc.Config{
+					pipeline2, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-2"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{Name: "job-3"},
 						},
@@ -949,7 +1001,7 @@ var _ = Describe("Job Factory", func() {
 								Type: "other-type-2",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -23,7 +23,7 @@ var _ = Describe("Job", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", atc.Config{
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{
 					Name: "some-job",
@@ -86,7 +86,7 @@ var _ = Describe("Job", func() {
 					Type: "some-type",
 				},
 			},
-		}, db.ConfigVersion(0), false)
+		}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -172,6 +172,89 @@ var _ = Describe("Job", func() {
 
 	})
 
+	Describe("TriggerScheduledBuild", func() {
+		var (
+			scheduledPipeline db.Pipeline
+			scheduledJob      db.Job
+		)
+
+		BeforeEach(func() {
+			var err error
+			scheduledPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "scheduled-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name:     "nightly",
+						Schedule: &atc.ScheduleConfig{Cron: "0 2 * * *", Location: "America/Toronto"},
+					},
+				},
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
+			Expect(err).ToNot(HaveOccurred())
+
+			var found bool
+			scheduledJob, found, err = scheduledPipeline.Job("nightly")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		It("loads the schedule and counts it from when the job was saved", func() {
+			Expect(scheduledJob.Schedule()).To(Equal(&atc.ScheduleConfig{Cron: "0 2 * * *", Location: "America/Toronto"}))
+			Expect(scheduledJob.ScheduleTriggeredAt()).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		It("creates a manually triggered build and records when the schedule fired", func() {
+			firedAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+			build, created, err := scheduledJob.TriggerScheduledBuild(firedAt)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+			Expect(build.JobName()).To(Equal("nightly"))
+			Expect(build.IsManuallyTriggered()).To(BeTrue())
+			Expect(build.Status()).To(Equal(db.BuildStatusPending))
+
+			found, err := scheduledJob.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(scheduledJob.ScheduleTriggeredAt()).To(BeTemporally("==", firedAt))
+		})
+
+		It("does not create a build when the schedule was triggered since the job was loaded", func() {
+			staleJob, found, err := scheduledPipeline.Job("nightly")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			_, created, err := scheduledJob.TriggerScheduledBuild(time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeTrue())
+
+			_, created, err = staleJob.TriggerScheduledBuild(time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(created).To(BeFalse())
+		})
+
+		It("keeps when the schedule last fired when the pipeline is set again", func() {
+			firedAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+			_, _, err := scheduledJob.TriggerScheduledBuild(firedAt)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: "scheduled-pipeline"}, atc.Config{
+				Jobs: atc.JobConfigs{
+					{
+						Name:     "nightly",
+						Schedule: &atc.ScheduleConfig{Cron: "0 3 * * *"},
+					},
+				},
+			}, db.ConfigVersion(1), false, db.ConfigAuthor{})
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := scheduledJob.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(scheduledJob.Schedule()).To(Equal(&atc.ScheduleConfig{Cron: "0 3 * * *"}))
+			Expect(scheduledJob.ScheduleTriggeredAt()).To(BeTemporally("==", firedAt))
+		})
+	})
+
 	Describe("FinishedAndNextBuild", func() {
 		var otherPipeline db.Pipeline
 		var otherJob db.Job
@@ -179,11 +262,11 @@ var _ = Describe("Job", func() {
 		BeforeEach(func() {
 			var created bool
 			var err error
-			otherPipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+			otherPipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -409,7 +492,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -646,7 +729,7 @@ var _ = Describe("Job", func() {
 		saveMaxInFlightPipeline := func() {
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -711,7 +794,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, pipeline.ConfigVersion(), false)
+				}, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 			})
 		}
@@ -719,7 +802,7 @@ var _ = Describe("Job", func() {
 		saveSerialGroupsPipeline := func() {
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -790,7 +873,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, pipeline.ConfigVersion(), false)
+				}, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 			})
 		}
@@ -819,13 +902,13 @@ var _ = Describe("Job", func() {
 				BeforeEach(func() {
 					var created bool
 					var err error
-					pipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+					pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "some-job",
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -1254,7 +1337,7 @@ var _ = Describe("Job", func() {
 			Expect(setupTx.Commit()).To(Succeed())
 
 			var created bool
-			pipeline, created, err = team.SavePipeline("build-inputs-pipeline", atc.Config{
+			pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "build-inputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -1291,7 +1374,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -1485,7 +1568,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -1668,7 +1751,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild()
@@ -2120,7 +2203,7 @@ var _ = Describe("Job", func() {
 		Context("when there is an input configured for the job", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2150,7 +2233,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2191,7 +2274,7 @@ var _ = Describe("Job", func() {
 		Context("when the input is pinned through the get step", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2211,7 +2294,7 @@ var _ = Describe("Job", func() {
 							Source: atc.Source{"some": "source"},
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2289,7 +2372,7 @@ var _ = Describe("Job", func() {
 		Context("when the input is pinned through the resource config", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2309,7 +2392,7 @@ var _ = Describe("Job", func() {
 							Version: atc.Version{"some": "version"},
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2337,7 +2420,7 @@ var _ = Describe("Job", func() {
 		Context("when the input is pinned through the api", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2356,7 +2439,7 @@ var _ = Describe("Job", func() {
 							Source: atc.Source{"some": "source"},
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2415,7 +2498,7 @@ var _ = Describe("Job", func() {
 		Context("when there are multiple inputs", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2456,7 +2539,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2500,7 +2583,7 @@ var _ = Describe("Job", func() {
 		Context("when the job has puts and tasks", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2528,7 +2611,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2551,13 +2634,135 @@ var _ = Describe("Job", func() {
 				}))
 			})
 		})
+
+		Context("when an input has passed constraints on a job in another pipeline", func() {
+			var (
+				upstreamPipeline db.Pipeline
+				upstreamJob      db.Job
+			)
+
+			BeforeEach(func() {
+				atc.EnableGlobalResources = true
+
+				var err error
+				upstreamPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "upstream-job",
+							Plan: atc.PlanSequence{
+								{
+									Get: "upstream-resource",
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "upstream-resource",
+							Type:   "some-type",
+							Source: atc.Source{"some": "source"},
+						},
+					},
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+
+				var found bool
+				upstreamJob, found, err = upstreamPipeline.Job("upstream-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "some-job",
+							Plan: atc.PlanSequence{
+								{
+									Get:     "some-resource",
+									Passed:  []string{"upstream-pipeline/upstream-job"},
+									Trigger: true,
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "some-resource",
+							Type:   "some-type",
+							Source: atc.Source{"some": "source"},
+						},
+					},
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+
+				inputsJob, found, err = inputsPipeline.Job("some-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			AfterEach(func() {
+				atc.EnableGlobalResources = false
+			})
+
+			Context("when the resources have not been checked", func() {
+				It("has no resource to take the job's versions from", func() {
+					someResource, found, err := inputsPipeline.Resource("some-resource")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					Expect(inputs).To(Equal(db.InputConfigs{
+						{
+							Name:       "some-resource",
+							JobID:      inputsJob.ID(),
+							ResourceID: someResource.ID(),
+							Passed: db.JobSet{
+								upstreamJob.ID(): true,
+							},
+							Trigger: true,
+							PassedResourceIDs: map[int]int{
+								upstreamJob.ID(): 0,
+							},
+						},
+					}))
+				})
+			})
+
+			Context("when the resources share a resource config scope", func() {
+				var upstreamResource db.Resource
+
+				BeforeEach(func() {
+					var found bool
+					var err error
+					upstreamResource, found, err = upstreamPipeline.Resource("upstream-resource")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					_, err = upstreamResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+					Expect(err).ToNot(HaveOccurred())
+
+					someResource, found, err := inputsPipeline.Resource("some-resource")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					_, err = someResource.SetResourceConfig(atc.Source{"some": "source"}, atc.VersionedResourceTypes{})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("takes the job's versions from the resource in its pipeline", func() {
+					Expect(inputs).To(HaveLen(1))
+					Expect(inputs[0].PassedResourceIDs).To(Equal(map[int]int{
+						upstreamJob.ID(): upstreamResource.ID(),
+					}))
+					Expect(inputs[0].PassedResourceID(upstreamJob.ID())).To(Equal(upstreamResource.ID()))
+				})
+			})
+		})
 	})
 
 	Describe("Inputs", func() {
 		var inputsJob db.Job
 
 		BeforeEach(func() {
-			inputsPipeline, _, err := team.SavePipeline("inputs-pipeline", atc.Config{
+			inputsPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2624,7 +2829,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2662,13 +2867,76 @@ var _ = Describe("Job", func() {
 				},
 			}))
 		})
+
+		Context("when an input has passed constraints on a job in another pipeline", func() {
+			BeforeEach(func() {
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "upstream-job",
+							Plan: atc.PlanSequence{
+								{
+									Get: "some-resource",
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+
+				downstreamPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "downstream-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "downstream-job",
+							Plan: atc.PlanSequence{
+								{
+									Get:    "some-resource",
+									Passed: []string{"upstream-pipeline/upstream-job"},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+				}, db.ConfigVersion(0), false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+
+				var found bool
+				inputsJob, found, err = downstreamPipeline.Job("downstream-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("names the job along with its pipeline", func() {
+				inputs, err := inputsJob.Inputs()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(inputs).To(Equal([]atc.JobInput{
+					{
+						Name:     "some-resource",
+						Resource: "some-resource",
+						Passed:   []string{"upstream-pipeline/upstream-job"},
+					},
+				}))
+			})
+		})
 	})
 
 	Describe("Outputs", func() {
 		var outputsJob db.Job
 
 		BeforeEach(func() {
-			outputsPipeline, _, err := team.SavePipeline("outputs-pipeline", atc.Config{
+			outputsPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "outputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2717,7 +2985,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
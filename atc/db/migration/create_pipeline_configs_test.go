@@ -0,0 +1,96 @@
+package migration_test
+
+import (
+	"database/sql"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Create pipeline configs", func() {
+	const preMigrationVersion = 1593449362
+	const postMigrationVersion = 1593707411
+
+	var (
+		db *sql.DB
+	)
+
+	Context("Up", func() {
+		It("saves the current config of each pipeline as its first version", func() {
+			db = postgresRunner.OpenDBAtVersion(preMigrationVersion)
+
+			_, err := db.Exec(`
+			INSERT INTO teams(id, name) VALUES
+			(1, 'some-team')
+			`)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = db.Exec(`
+			INSERT INTO pipelines(id, team_id, name, version, groups, archived) VALUES
+			(1, 1, 'pipeline1', 5, '[{"name":"some-group","jobs":["job-1"]}]', false),
+			(2, 1, 'pipeline2', 7, NULL, true)
+			`)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = db.Exec(`
+			INSERT INTO resources(name, pipeline_id, config, active, type) VALUES
+			('resource-2', 1, '{"name":"resource-2","type":"some-type"}', true, 'some-type'),
+			('resource-1', 1, '{"name":"resource-1","type":"some-type"}', true, 'some-type'),
+			('resource-3', 1, '{"name":"resource-3","type":"some-type"}', false, 'some-type'),
+			('resource-1', 2, '{"name":"resource-1","type":"some-type"}', true, 'some-type')
+			`)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = db.Exec(`
+			INSERT INTO resource_types(name, pipeline_id, config, active, type) VALUES
+			('some-type', 1, '{"name":"some-type","type":"registry-image"}', true, 'registry-image')
+			`)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = db.Exec(`
+			INSERT INTO jobs(id, name, pipeline_id, config, active) VALUES
+			(1, 'job-1', 1, '{"name":"job-1"}', true),
+			(2, 'job-2', 1, '{"name":"job-2"}', false)
+			`)
+			Expect(err).NotTo(HaveOccurred())
+
+			db.Close()
+
+			db = postgresRunner.OpenDBAtVersion(postMigrationVersion)
+
+			rows, err := db.Query(`SELECT pipeline_id, version, config FROM pipeline_configs`)
+			Expect(err).NotTo(HaveOccurred())
+
+			type pipelineConfig struct {
+				pipelineID int
+				version    int
+				config     string
+			}
+
+			var pipelineConfigs []pipelineConfig
+			for rows.Next() {
+				var c pipelineConfig
+
+				err := rows.Scan(&c.pipelineID, &c.version, &c.config)
+				Expect(err).NotTo(HaveOccurred())
+
+				pipelineConfigs = append(pipelineConfigs, c)
+			}
+
+			_ = db.Close()
+
+			Expect(pipelineConfigs).To(HaveLen(1))
+			Expect(pipelineConfigs[0].pipelineID).To(Equal(1))
+			Expect(pipelineConfigs[0].version).To(Equal(5))
+			Expect(pipelineConfigs[0].config).To(MatchJSON(`{
+				"groups": [{"name":"some-group","jobs":["job-1"]}],
+				"resources": [
+					{"name":"resource-1","type":"some-type"},
+					{"name":"resource-2","type":"some-type"}
+				],
+				"resource_types": [{"name":"some-type","type":"registry-image"}],
+				"jobs": [{"name":"job-1"}]
+			}`))
+		})
+	})
+})
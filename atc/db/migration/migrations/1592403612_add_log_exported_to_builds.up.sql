@@ -0,0 +1,7 @@
+BEGIN;
+  -- builds which finished before log export existed are considered exported,
+  -- so that upgrading does not export the entire build history; builds which
+  -- finish while no build log sink is configured are marked as exported too
+  ALTER TABLE builds ADD COLUMN log_exported boolean NOT NULL DEFAULT true;
+  ALTER TABLE builds ALTER COLUMN log_exported SET DEFAULT false;
+COMMIT;
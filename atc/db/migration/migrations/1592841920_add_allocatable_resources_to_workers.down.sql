@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE workers
+    DROP COLUMN allocatable_cpu,
+    DROP COLUMN allocatable_memory,
+    DROP COLUMN allocated_cpu,
+    DROP COLUMN allocated_memory;
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN allocatable_cpu bigint NOT NULL DEFAULT 0,
+    ADD COLUMN allocatable_memory bigint NOT NULL DEFAULT 0,
+    ADD COLUMN allocated_cpu bigint NOT NULL DEFAULT 0,
+    ADD COLUMN allocated_memory bigint NOT NULL DEFAULT 0;
+COMMIT;
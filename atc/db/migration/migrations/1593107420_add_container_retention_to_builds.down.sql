@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE builds
+    DROP COLUMN keep_containers_on_failure,
+    DROP COLUMN containers_retained_until;
+
+  ALTER TABLE jobs DROP COLUMN keep_containers_on_failure;
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN keep_containers_on_failure interval,
+    ADD COLUMN containers_retained_until timestamp with time zone;
+
+  ALTER TABLE jobs ADD COLUMN keep_containers_on_failure interval;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  DROP TABLE build_notifications;
+
+  ALTER TABLE pipelines DROP COLUMN notifications, DROP COLUMN notifications_nonce;
+COMMIT;
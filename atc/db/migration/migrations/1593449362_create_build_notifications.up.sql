@@ -0,0 +1,25 @@
+BEGIN;
+  -- the notifications are encrypted separately from the var sources of the
+  -- pipeline, so they need a nonce of their own
+  ALTER TABLE pipelines ADD COLUMN notifications text, ADD COLUMN notifications_nonce text;
+
+  CREATE TABLE build_notifications (
+    "id" serial PRIMARY KEY,
+    "build_id" integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    "name" text NOT NULL,
+    "config" text NOT NULL,
+    "nonce" text,
+    "previous_status" build_status,
+    "status" text NOT NULL DEFAULT 'pending',
+    "attempts" integer NOT NULL DEFAULT 0,
+    "response_code" integer,
+    "last_error" text,
+    "next_attempt_at" timestamp with time zone NOT NULL DEFAULT now(),
+    "created_at" timestamp with time zone NOT NULL DEFAULT now(),
+    "delivered_at" timestamp with time zone
+  );
+
+  CREATE INDEX build_notifications_build_id_idx ON build_notifications (build_id);
+
+  CREATE INDEX build_notifications_pending_idx ON build_notifications (next_attempt_at) WHERE status = 'pending';
+COMMIT;
@@ -0,0 +1,172 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+)
+
+// V6PipelineConfig is the config a pipeline is saved with, made up of the
+// configs stored with its groups, var sources, resources, resource types and
+// jobs. They are kept as they were stored, so that the saved config is the
+// same as the one the pipeline returns.
+type V6PipelineConfig struct {
+	Groups        json.RawMessage   `json:"groups,omitempty"`
+	VarSources    json.RawMessage   `json:"var_sources,omitempty"`
+	Resources     []json.RawMessage `json:"resources,omitempty"`
+	ResourceTypes []json.RawMessage `json:"resource_types,omitempty"`
+	Jobs          []json.RawMessage `json:"jobs,omitempty"`
+}
+
+type v6PipelineVersion struct {
+	id         int
+	version    int
+	groups     sql.NullString
+	varSources sql.NullString
+	nonce      sql.NullString
+}
+
+func (self *migrations) Up_1593707411() error {
+	tx, err := self.DB.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback()
+
+	_, err = tx.Exec(`
+		CREATE TABLE pipeline_configs (
+			id serial PRIMARY KEY,
+			pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+			version bigint NOT NULL,
+			config text NOT NULL,
+			nonce text,
+			saved_by_user text,
+			saved_by_build_id integer,
+			saved_at timestamp with time zone NOT NULL DEFAULT now()
+		)`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE UNIQUE INDEX pipeline_configs_pipeline_id_version_key ON pipeline_configs (pipeline_id, version)")
+	if err != nil {
+		return err
+	}
+
+	// archived pipelines have no config until they are set again
+	rows, err := tx.Query("SELECT id, version, groups, var_sources, nonce FROM pipelines WHERE archived = false")
+	if err != nil {
+		return err
+	}
+
+	var pipelines []v6PipelineVersion
+	for rows.Next() {
+		var pipeline v6PipelineVersion
+		err = rows.Scan(&pipeline.id, &pipeline.version, &pipeline.groups, &pipeline.varSources, &pipeline.nonce)
+		if err != nil {
+			rows.Close()
+			return err
+		}
+
+		pipelines = append(pipelines, pipeline)
+	}
+
+	err = rows.Close()
+	if err != nil {
+		return err
+	}
+
+	// save each pipeline's current config as its first version, so that there
+	// is something to roll back to before it is set again
+	for _, pipeline := range pipelines {
+		var config V6PipelineConfig
+
+		if pipeline.groups.Valid {
+			config.Groups = json.RawMessage(pipeline.groups.String)
+		}
+
+		if pipeline.varSources.Valid {
+			decrypted, err := self.decrypt(pipeline.varSources.String, pipeline.nonce)
+			if err != nil {
+				return err
+			}
+
+			config.VarSources = decrypted
+		}
+
+		config.Resources, err = self.activeConfigs(tx, "SELECT config, nonce FROM resources WHERE pipeline_id = $1 AND active = true ORDER BY name", pipeline.id)
+		if err != nil {
+			return err
+		}
+
+		config.ResourceTypes, err = self.activeConfigs(tx, "SELECT config, nonce FROM resource_types WHERE pipeline_id = $1 AND active = true ORDER BY name", pipeline.id)
+		if err != nil {
+			return err
+		}
+
+		config.Jobs, err = self.activeConfigs(tx, "SELECT config, nonce FROM jobs WHERE pipeline_id = $1 AND active = true ORDER BY id", pipeline.id)
+		if err != nil {
+			return err
+		}
+
+		payload, err := json.Marshal(config)
+		if err != nil {
+			return err
+		}
+
+		encrypted, nonce, err := self.Strategy.Encrypt(payload)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec("INSERT INTO pipeline_configs (pipeline_id, version, config, nonce) VALUES ($1, $2, $3, $4)", pipeline.id, pipeline.version, encrypted, nonce)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (self *migrations) activeConfigs(tx *sql.Tx, query string, pipelineID int) ([]json.RawMessage, error) {
+	rows, err := tx.Query(query, pipelineID)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	var configs []json.RawMessage
+	for rows.Next() {
+		var config string
+		var nonce sql.NullString
+
+		err = rows.Scan(&config, &nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		decrypted, err := self.decrypt(config, nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		configs = append(configs, decrypted)
+	}
+
+	return configs, rows.Err()
+}
+
+func (self *migrations) decrypt(payload string, nonce sql.NullString) (json.RawMessage, error) {
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decrypted, err := self.Strategy.Decrypt(payload, noncense)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.RawMessage(decrypted), nil
+}
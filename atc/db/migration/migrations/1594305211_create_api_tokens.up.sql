@@ -0,0 +1,19 @@
+BEGIN;
+  CREATE TABLE api_tokens (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    token_hash text NOT NULL,
+    role text NOT NULL,
+    user_name text,
+    user_connector text,
+    user_sub text,
+    service_account text,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    expires_at timestamp with time zone NOT NULL,
+    last_used_at timestamp with time zone
+  );
+
+  CREATE UNIQUE INDEX api_tokens_team_id_name_key ON api_tokens (team_id, name);
+  CREATE UNIQUE INDEX api_tokens_token_hash_key ON api_tokens (token_hash);
+COMMIT;
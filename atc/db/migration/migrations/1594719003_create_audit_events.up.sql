@@ -0,0 +1,20 @@
+BEGIN;
+  -- audit events refer to teams, pipelines etc. by name rather than by id so
+  -- that they outlive whatever they refer to
+  CREATE TABLE audit_events (
+    id bigserial PRIMARY KEY,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    action text NOT NULL,
+    user_name text,
+    team_name text,
+    pipeline_name text,
+    job_name text,
+    resource_name text,
+    build_id integer,
+    source_ip text,
+    forwarded_for text
+  );
+
+  CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+  CREATE INDEX audit_events_team_name_id_idx ON audit_events (team_name, id);
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE jobs DROP COLUMN priority;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE jobs ADD COLUMN priority integer NOT NULL DEFAULT 0;
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  DROP TABLE cluster_quota;
+
+  ALTER TABLE teams
+    DROP COLUMN quota_builds,
+    DROP COLUMN quota_task_containers;
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+  ALTER TABLE teams
+    ADD COLUMN quota_builds integer NOT NULL DEFAULT 0,
+    ADD COLUMN quota_task_containers integer NOT NULL DEFAULT 0;
+
+  -- holds at most one row; no row means the cluster has no quota
+  CREATE TABLE cluster_quota (
+    builds integer NOT NULL DEFAULT 0,
+    task_containers integer NOT NULL DEFAULT 0
+  );
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE jobs
+    DROP COLUMN schedule,
+    DROP COLUMN schedule_triggered_at;
+COMMIT;
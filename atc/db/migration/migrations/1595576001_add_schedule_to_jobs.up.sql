@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE jobs
+    ADD COLUMN schedule jsonb,
+    ADD COLUMN schedule_triggered_at timestamp with time zone;
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE workers
+    DROP COLUMN usage;
+COMMIT;
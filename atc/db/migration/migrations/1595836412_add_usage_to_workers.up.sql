@@ -0,0 +1,4 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN usage jsonb;
+COMMIT;
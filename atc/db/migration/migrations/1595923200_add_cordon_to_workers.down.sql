@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE workers
+    DROP COLUMN cordon_start,
+    DROP COLUMN cordon_end;
+COMMIT;
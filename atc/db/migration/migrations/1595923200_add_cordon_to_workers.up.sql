@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE workers
+    ADD COLUMN cordon_start timestamp with time zone,
+    ADD COLUMN cordon_end timestamp with time zone;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE api_tokens
+    DROP COLUMN user_id,
+    DROP COLUMN user_groups;
+COMMIT;
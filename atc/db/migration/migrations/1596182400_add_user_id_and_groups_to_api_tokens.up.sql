@@ -0,0 +1,7 @@
+BEGIN;
+  -- a user's API token is capped by the roles the team's auth still grants
+  -- them, so keep what their roles are matched against
+  ALTER TABLE api_tokens
+    ADD COLUMN user_id text,
+    ADD COLUMN user_groups text[];
+COMMIT;
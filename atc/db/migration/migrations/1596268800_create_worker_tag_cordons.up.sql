@@ -0,0 +1,10 @@
+BEGIN;
+  -- cordons of every worker with all of the tags, including workers that
+  -- register after the cordon was set; the tags are kept sorted so that
+  -- cordoning the same tags again replaces the window
+  CREATE TABLE worker_tag_cordons (
+    tags text[] PRIMARY KEY,
+    cordon_start timestamp with time zone NOT NULL,
+    cordon_end timestamp with time zone
+  );
+COMMIT;
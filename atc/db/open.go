@@ -129,17 +129,24 @@ type encryptedColumn struct {
 	Table      string
 	Column     string
 	PrimaryKey string
+
+	// Nonce is the column holding the nonce the column is encrypted with. A
+	// table with more than one encrypted column needs a nonce per column.
+	Nonce string
 }
 
 var encryptedColumns = []encryptedColumn{
-	{"teams", "legacy_auth", "id"},
-	{"resources", "config", "id"},
-	{"jobs", "config", "id"},
-	{"resource_types", "config", "id"},
-	{"builds", "private_plan", "id"},
-	{"cert_cache", "cert", "domain"},
-	{"checks", "plan", "id"},
-	{"pipelines", "var_sources", "id"},
+	{"teams", "legacy_auth", "id", "nonce"},
+	{"resources", "config", "id", "nonce"},
+	{"jobs", "config", "id", "nonce"},
+	{"resource_types", "config", "id", "nonce"},
+	{"builds", "private_plan", "id", "nonce"},
+	{"cert_cache", "cert", "domain", "nonce"},
+	{"checks", "plan", "id", "nonce"},
+	{"pipelines", "var_sources", "id", "nonce"},
+	{"pipelines", "notifications", "id", "notifications_nonce"},
+	{"pipeline_configs", "config", "id", "nonce"},
+	{"build_notifications", "config", "id", "nonce"},
 }
 
 func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) error {
@@ -147,7 +154,7 @@ func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) e
 		rows, err := sqlDB.Query(`
 			SELECT ` + ec.PrimaryKey + `, ` + ec.Column + `
 			FROM ` + ec.Table + `
-			WHERE nonce IS NULL
+			WHERE ` + ec.Nonce + ` IS NULL
 			AND ` + ec.Column + ` IS NOT NULL
 		`)
 		if err != nil {
@@ -188,7 +195,7 @@ func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) e
 
 			_, err = sqlDB.Exec(`
 				UPDATE `+ec.Table+`
-				SET `+ec.Column+` = $1, nonce = $2
+				SET `+ec.Column+` = $1, `+ec.Nonce+` = $2
 				WHERE `+ec.PrimaryKey+` = $3
 			`, encrypted, nonce, primaryKey)
 			if err != nil {
@@ -212,9 +219,9 @@ func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) e
 func decryptToPlaintext(logger lager.Logger, sqlDB *sql.DB, oldKey *encryption.Key) error {
 	for _, ec := range encryptedColumns {
 		rows, err := sqlDB.Query(`
-			SELECT ` + ec.PrimaryKey + `, nonce, ` + ec.Column + `
+			SELECT ` + ec.PrimaryKey + `, ` + ec.Nonce + `, ` + ec.Column + `
 			FROM ` + ec.Table + `
-			WHERE nonce IS NOT NULL
+			WHERE ` + ec.Nonce + ` IS NOT NULL
 		`)
 		if err != nil {
 			return err
@@ -250,7 +257,7 @@ func decryptToPlaintext(logger lager.Logger, sqlDB *sql.DB, oldKey *encryption.K
 
 			_, err = sqlDB.Exec(`
 				UPDATE `+ec.Table+`
-				SET `+ec.Column+` = $1, nonce = NULL
+				SET `+ec.Column+` = $1, `+ec.Nonce+` = NULL
 				WHERE `+ec.PrimaryKey+` = $2
 			`, decrypted, primaryKey)
 			if err != nil {
@@ -276,9 +283,9 @@ var ErrEncryptedWithUnknownKey = errors.New("row encrypted with neither old nor
 func encryptWithNewKey(logger lager.Logger, sqlDB *sql.DB, newKey *encryption.Key, oldKey *encryption.Key) error {
 	for _, ec := range encryptedColumns {
 		rows, err := sqlDB.Query(`
-			SELECT ` + ec.PrimaryKey + `, nonce, ` + ec.Column + `
+			SELECT ` + ec.PrimaryKey + `, ` + ec.Nonce + `, ` + ec.Column + `
 			FROM ` + ec.Table + `
-			WHERE nonce IS NOT NULL
+			WHERE ` + ec.Nonce + ` IS NOT NULL
 		`)
 		if err != nil {
 			return err
@@ -326,7 +333,7 @@ func encryptWithNewKey(logger lager.Logger, sqlDB *sql.DB, newKey *encryption.Ke
 
 			_, err = sqlDB.Exec(`
 				UPDATE `+ec.Table+`
-				SET `+ec.Column+` = $1, nonce = $2
+				SET `+ec.Column+` = $1, `+ec.Nonce+` = $2
 				WHERE `+ec.PrimaryKey+` = $3
 			`, encrypted, newNonce, primaryKey)
 			if err != nil {
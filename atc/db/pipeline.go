@@ -38,12 +38,17 @@ type Cause struct {
 type Pipeline interface {
 	ID() int
 	Name() string
+	InstanceVars() atc.InstanceVars
+	Ref() atc.PipelineRef
 	TeamID() int
 	TeamName() string
 	Groups() atc.GroupConfigs
 	VarSources() atc.VarSourceConfigs
+	Notifications() atc.NotificationConfigs
 	ConfigVersion() ConfigVersion
 	Config() (atc.Config, error)
+	ConfigHistory() ([]PipelineConfigVersion, error)
+	ConfigAtVersion(ConfigVersion) (PipelineConfigVersion, bool, error)
 	Public() bool
 	Paused() bool
 	Archived() bool
@@ -97,10 +102,12 @@ type Pipeline interface {
 type pipeline struct {
 	id            int
 	name          string
+	instanceVars  atc.InstanceVars
 	teamID        int
 	teamName      string
 	groups        atc.GroupConfigs
 	varSources    atc.VarSourceConfigs
+	notifications atc.NotificationConfigs
 	configVersion ConfigVersion
 	paused        bool
 	public        bool
@@ -114,12 +121,38 @@ type pipeline struct {
 // ConfigVersion is a sequence identifier used for compare-and-swap.
 type ConfigVersion int
 
+// ConfigAuthor identifies who or what saved a pipeline config: a user through
+// the API, or the set_pipeline step of a build.
+type ConfigAuthor struct {
+	UserName string
+	BuildID  int
+}
+
+// PipelineConfigVersion is one of the configs a pipeline has been saved with.
+type PipelineConfigVersion struct {
+	Version ConfigVersion
+	Config  atc.Config
+	SavedBy ConfigAuthor
+	SavedAt time.Time
+}
+
+var pipelineConfigsQuery = psql.Select(
+	"pc.version",
+	"pc.saved_by_user",
+	"pc.saved_by_build_id",
+	"pc.saved_at",
+).
+	From("pipeline_configs pc")
+
 var pipelinesQuery = psql.Select(`
 		p.id,
 		p.name,
+		p.instance_vars,
 		p.groups,
 		p.var_sources,
+		p.notifications,
 		p.nonce,
+		p.notifications_nonce,
 		p.version,
 		p.team_id,
 		t.name,
@@ -144,6 +177,12 @@ func (p *pipeline) TeamID() int              { return p.teamID }
 func (p *pipeline) TeamName() string         { return p.teamName }
 func (p *pipeline) Groups() atc.GroupConfigs { return p.groups }
 
+func (p *pipeline) InstanceVars() atc.InstanceVars { return p.instanceVars }
+
+func (p *pipeline) Ref() atc.PipelineRef {
+	return atc.PipelineRef{Name: p.name, InstanceVars: p.instanceVars}
+}
+
 func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
 func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
 func (p *pipeline) Public() bool                     { return p.public }
@@ -151,6 +190,8 @@ func (p *pipeline) Paused() bool                     { return p.paused }
 func (p *pipeline) Archived() bool                   { return p.archived }
 func (p *pipeline) LastUpdated() time.Time           { return p.lastUpdated }
 
+func (p *pipeline) Notifications() atc.NotificationConfigs { return p.notifications }
+
 // IMPORTANT: This method is broken with the new resource config versions changes
 func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
 	rows, err := p.conn.Query(`
@@ -258,11 +299,103 @@ func (p *pipeline) Config() (atc.Config, error) {
 		Resources:     resources.Configs(),
 		ResourceTypes: resourceTypes.Configs(),
 		Jobs:          jobConfigs,
+		Notifications: p.Notifications(),
 	}
 
 	return config, nil
 }
 
+// ConfigHistory returns every config the pipeline has been saved with, latest
+// first. The configs themselves are left out; see ConfigAtVersion.
+func (p *pipeline) ConfigHistory() ([]PipelineConfigVersion, error) {
+	rows, err := pipelineConfigsQuery.
+		Where(sq.Eq{"pc.pipeline_id": p.id}).
+		OrderBy("pc.version DESC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	versions := []PipelineConfigVersion{}
+	for rows.Next() {
+		version, err := scanPipelineConfigVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
+func (p *pipeline) ConfigAtVersion(configVersion ConfigVersion) (PipelineConfigVersion, bool, error) {
+	var (
+		rawConfig string
+		nonce     sql.NullString
+	)
+
+	row := pipelineConfigsQuery.
+		Columns("pc.config", "pc.nonce").
+		Where(sq.Eq{
+			"pc.pipeline_id": p.id,
+			"pc.version":     configVersion,
+		}).
+		RunWith(p.conn).
+		QueryRow()
+
+	version, err := scanPipelineConfigVersion(row, &rawConfig, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PipelineConfigVersion{}, false, nil
+		}
+
+		return PipelineConfigVersion{}, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedConfig, err := p.conn.EncryptionStrategy().Decrypt(rawConfig, noncense)
+	if err != nil {
+		return PipelineConfigVersion{}, false, err
+	}
+
+	err = json.Unmarshal(decryptedConfig, &version.Config)
+	if err != nil {
+		return PipelineConfigVersion{}, false, err
+	}
+
+	return version, true, nil
+}
+
+func scanPipelineConfigVersion(row scannable, extra ...interface{}) (PipelineConfigVersion, error) {
+	var (
+		version  PipelineConfigVersion
+		userName sql.NullString
+		buildID  sql.NullInt64
+	)
+
+	dest := append([]interface{}{&version.Version, &userName, &buildID, &version.SavedAt}, extra...)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return PipelineConfigVersion{}, err
+	}
+
+	version.SavedBy = ConfigAuthor{
+		UserName: userName.String,
+		BuildID:  int(buildID.Int64),
+	}
+
+	return version, nil
+}
+
 func (p *pipeline) CreateJobBuild(jobName string) (Build, error) {
 	tx, err := p.conn.Begin()
 	if err != nil {
@@ -705,14 +838,29 @@ func (p *pipeline) Rename(name string) error {
 }
 
 func (p *pipeline) Destroy() error {
-	_, err := psql.Delete("pipelines").
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	err = checkPassedJobsInUse(tx, p.id, sq.And{})
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("pipelines").
 		Where(sq.Eq{
 			"id": p.id,
 		}).
-		RunWith(p.conn).
+		RunWith(tx).
 		Exec()
+	if err != nil {
+		return err
+	}
 
-	return err
+	return tx.Commit()
 }
 
 func (p *pipeline) LoadDebugVersionsDB() (*atc.DebugVersionsDB, error) {
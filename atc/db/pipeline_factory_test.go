@@ -28,27 +28,27 @@ var _ = Describe("Pipeline Factory", func() {
 			team, err := teamFactory.CreateTeam(atc.Team{Name: "some-team"})
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline1, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+			pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline1.Reload()).To(BeTrue())
 
-			pipeline2, _, err = defaultTeam.SavePipeline("fake-pipeline-two", atc.Config{
+			pipeline2, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline2.Reload()).To(BeTrue())
 
-			pipeline3, _, err = defaultTeam.SavePipeline("fake-pipeline-three", atc.Config{
+			pipeline3, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-three"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake-two"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline3.Expose()).To(Succeed())
 			Expect(pipeline3.Reload()).To(BeTrue())
@@ -98,28 +98,28 @@ var _ = Describe("Pipeline Factory", func() {
 			team, err := teamFactory.CreateTeam(atc.Team{Name: "some-team"})
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline2.Reload()).To(BeTrue())
 
-			pipeline3, _, err = team.SavePipeline("fake-pipeline-three", atc.Config{
+			pipeline3, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-three"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake-two"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline3.Expose()).To(Succeed())
 			Expect(pipeline3.Reload()).To(BeTrue())
 
-			pipeline1, _, err = defaultTeam.SavePipeline("fake-pipeline", atc.Config{
+			pipeline1, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline1.Expose()).To(Succeed())
 			Expect(pipeline1.Reload()).To(BeTrue())
@@ -2,8 +2,11 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
+
 	sq "github.com/Masterminds/squirrel"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/lock"
 )
 
@@ -13,23 +16,27 @@ import (
 type PipelineRef interface {
 	PipelineID() int
 	PipelineName() string
+	PipelineInstanceVars() atc.InstanceVars
+	PipelineRef() atc.PipelineRef
 	Pipeline() (Pipeline, bool, error)
 }
 
 type pipelineRef struct {
-	pipelineID   int
-	pipelineName string
+	pipelineID           int
+	pipelineName         string
+	pipelineInstanceVars atc.InstanceVars
 
 	conn        Conn
 	lockFactory lock.LockFactory
 }
 
-func NewPipelineRef(id int, name string, conn Conn, lockFactory lock.LockFactory) PipelineRef {
+func NewPipelineRef(id int, name string, instanceVars atc.InstanceVars, conn Conn, lockFactory lock.LockFactory) PipelineRef {
 	return pipelineRef{
-		pipelineID:   id,
-		pipelineName: name,
-		conn:         conn,
-		lockFactory:  lockFactory,
+		pipelineID:           id,
+		pipelineName:         name,
+		pipelineInstanceVars: instanceVars,
+		conn:                 conn,
+		lockFactory:          lockFactory,
 	}
 }
 
@@ -41,6 +48,17 @@ func (r pipelineRef) PipelineName() string {
 	return r.pipelineName
 }
 
+func (r pipelineRef) PipelineInstanceVars() atc.InstanceVars {
+	return r.pipelineInstanceVars
+}
+
+func (r pipelineRef) PipelineRef() atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         r.pipelineName,
+		InstanceVars: r.pipelineInstanceVars,
+	}
+}
+
 func (r pipelineRef) Pipeline() (Pipeline, bool, error) {
 	if r.PipelineID() == 0 {
 		return nil, false, nil
@@ -62,3 +80,47 @@ func (r pipelineRef) Pipeline() (Pipeline, bool, error) {
 
 	return pipeline, true, nil
 }
+
+func unmarshalInstanceVars(raw sql.NullString) (atc.InstanceVars, error) {
+	if !raw.Valid {
+		return nil, nil
+	}
+
+	var instanceVars atc.InstanceVars
+	err := json.Unmarshal([]byte(raw.String), &instanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return instanceVars, nil
+}
+
+func marshalInstanceVars(instanceVars atc.InstanceVars) (sql.NullString, error) {
+	if len(instanceVars) == 0 {
+		return sql.NullString{}, nil
+	}
+
+	payload, err := json.Marshal(instanceVars)
+	if err != nil {
+		return sql.NullString{}, err
+	}
+
+	return sql.NullString{String: string(payload), Valid: true}, nil
+}
+
+// pipelineRefCondition matches the pipeline identified by the given name and
+// (marshalled) instance vars. Pipelines without instance vars have a NULL
+// instance_vars column.
+func pipelineRefCondition(nameColumn, instanceVarsColumn string, name string, instanceVars sql.NullString) sq.Sqlizer {
+	if !instanceVars.Valid {
+		return sq.Eq{
+			nameColumn:         name,
+			instanceVarsColumn: nil,
+		}
+	}
+
+	return sq.And{
+		sq.Eq{nameColumn: name},
+		sq.Expr(instanceVarsColumn+" = ?::jsonb", instanceVars.String),
+	}
+}
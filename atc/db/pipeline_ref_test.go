@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 
 	. "github.com/onsi/ginkgo"
@@ -13,7 +14,7 @@ var _ = Describe("PipelineRef", func() {
 	)
 
 	BeforeEach(func(){
-		pr = db.NewPipelineRef(defaultPipeline.ID(), defaultPipeline.Name(), dbConn, lockFactory)
+		pr = db.NewPipelineRef(defaultPipeline.ID(), defaultPipeline.Name(), atc.InstanceVars{"branch": "master"}, dbConn, lockFactory)
 	})
 
 	It("id should be correct", func() {
@@ -24,6 +25,17 @@ var _ = Describe("PipelineRef", func() {
 		Expect(pr.PipelineName()).To(Equal(defaultPipeline.Name()))
 	})
 
+	It("instance vars should be correct", func() {
+		Expect(pr.PipelineInstanceVars()).To(Equal(atc.InstanceVars{"branch": "master"}))
+	})
+
+	It("ref should be correct", func() {
+		Expect(pr.PipelineRef()).To(Equal(atc.PipelineRef{
+			Name:         defaultPipeline.Name(),
+			InstanceVars: atc.InstanceVars{"branch": "master"},
+		}))
+	})
+
 	It("pipeline should be correct", func() {
 		p, found, err := pr.Pipeline()
 		Expect(err).ToNot(HaveOccurred())
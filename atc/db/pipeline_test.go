@@ -148,7 +148,7 @@ var _ = Describe("Pipeline", func() {
 			},
 		}
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, db.ConfigVersion(0), false)
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false, db.ConfigAuthor{})
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -197,6 +197,93 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("ConfigHistory", func() {
+		var (
+			firstVersion db.ConfigVersion
+			newConfig    atc.Config
+		)
+
+		BeforeEach(func() {
+			firstVersion = pipeline.ConfigVersion()
+
+			newConfig = pipelineConfig
+			newConfig.Groups = atc.GroupConfigs{{Name: "new-group", Jobs: []string{"job-name"}}}
+
+			var err error
+			pipeline, _, err = team.SavePipeline(
+				atc.PipelineRef{Name: "fake-pipeline"},
+				newConfig,
+				firstVersion,
+				false,
+				db.ConfigAuthor{UserName: "some-user"},
+			)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("lists every saved config, latest first", func() {
+			history, err := pipeline.ConfigHistory()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(2))
+
+			Expect(history[0].Version).To(Equal(pipeline.ConfigVersion()))
+			Expect(history[0].SavedBy).To(Equal(db.ConfigAuthor{UserName: "some-user"}))
+			Expect(history[0].SavedAt).To(BeTemporally("~", time.Now(), time.Minute))
+			Expect(history[0].Config).To(BeZero())
+
+			Expect(history[1].Version).To(Equal(firstVersion))
+			Expect(history[1].SavedBy).To(Equal(db.ConfigAuthor{}))
+		})
+
+		Context("when the config is saved by a build", func() {
+			var build db.Build
+
+			BeforeEach(func() {
+				var err error
+				build, err = team.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				pipeline, _, err = team.SavePipeline(
+					atc.PipelineRef{Name: "fake-pipeline"},
+					pipelineConfig,
+					pipeline.ConfigVersion(),
+					false,
+					db.ConfigAuthor{BuildID: build.ID()},
+				)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("records the build", func() {
+				history, err := pipeline.ConfigHistory()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(history).To(HaveLen(3))
+				Expect(history[0].SavedBy).To(Equal(db.ConfigAuthor{BuildID: build.ID()}))
+			})
+		})
+
+		Describe("ConfigAtVersion", func() {
+			It("returns the config saved at the version", func() {
+				version, found, err := pipeline.ConfigAtVersion(firstVersion)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(version.Version).To(Equal(firstVersion))
+				Expect(version.Config.Groups).To(Equal(pipelineConfig.Groups))
+				Expect(version.Config.Jobs).To(HaveLen(len(pipelineConfig.Jobs)))
+
+				version, found, err = pipeline.ConfigAtVersion(pipeline.ConfigVersion())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(version.Config.Groups).To(Equal(newConfig.Groups))
+				Expect(version.SavedBy).To(Equal(db.ConfigAuthor{UserName: "some-user"}))
+			})
+
+			It("does not find versions it was never saved with", func() {
+				_, found, err := pipeline.ConfigAtVersion(pipeline.ConfigVersion() + 1000)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Pause", func() {
 		JustBeforeEach(func() {
 			Expect(pipeline.Pause()).To(Succeed())
@@ -409,7 +496,7 @@ var _ = Describe("Pipeline", func() {
 		})
 
 		It("renames the pipeline", func() {
-			pipeline, found, err := team.Pipeline("oopsies")
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: "oopsies"})
 			Expect(pipeline.Name()).To(Equal("oopsies"))
 			Expect(found).To(BeTrue())
 			Expect(err).ToNot(HaveOccurred())
@@ -593,10 +680,10 @@ var _ = Describe("Pipeline", func() {
 			}
 
 			var err error
-			dbPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, false)
+			dbPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name"}, pipelineConfig, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			otherDBPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, false)
+			otherDBPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline-name"}, otherPipelineConfig, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, _, err = dbPipeline.Resource(resourceName)
@@ -1189,7 +1276,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeFalse())
 
-			_, found, err = team.Pipeline(pipeline.Name())
+			_, found, err = team.Pipeline(atc.PipelineRef{Name: pipeline.Name()})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
@@ -1984,7 +2071,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -2004,7 +2091,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(found).To(BeTrue())
 			}
 
-			otherPipeline, _, err := team.SavePipeline("another-pipeline", config, db.ConfigVersion(1), false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err := otherPipeline.Job("some-job")
@@ -2147,7 +2234,7 @@ var _ = Describe("Pipeline", func() {
 				})
 
 				var created bool
-				pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, pipeline.ConfigVersion(), false)
+				pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
 			})
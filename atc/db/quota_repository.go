@@ -0,0 +1,294 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+
+	sq "github.com/Masterminds/squirrel"
+
+	"github.com/concourse/concourse/atc"
+)
+
+//go:generate counterfeiter . QuotaRepository
+
+type QuotaRepository interface {
+	ClusterQuota() (atc.QuotaUsage, error)
+	SetClusterQuota(atc.Quota) error
+
+	TeamQuota(teamID int) (atc.QuotaUsage, error)
+	SetTeamQuota(teamID int, quota atc.Quota) error
+
+	// QuotasReached returns which quotas would be exceeded by starting
+	// another build of the team.
+	QuotasReached(teamID int) (QuotasReached, error)
+}
+
+// ErrTaskContainerQuotaReached is returned when creating a task container
+// would exceed the task container quota of its team or of the cluster.
+var ErrTaskContainerQuotaReached = errors.New("task container quota reached")
+
+type QuotasReached struct {
+	Team    bool
+	Cluster bool
+}
+
+func (reached QuotasReached) Any() bool {
+	return reached.Team || reached.Cluster
+}
+
+type quotaRepository struct {
+	conn Conn
+}
+
+func NewQuotaRepository(conn Conn) QuotaRepository {
+	return &quotaRepository{
+		conn: conn,
+	}
+}
+
+func (repository *quotaRepository) ClusterQuota() (atc.QuotaUsage, error) {
+	return clusterQuotaUsage(repository.conn)
+}
+
+func (repository *quotaRepository) SetClusterQuota(quota atc.Quota) error {
+	tx, err := repository.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete("cluster_quota").RunWith(tx).Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("cluster_quota").
+		Columns("builds", "task_containers").
+		Values(quota.Builds, quota.TaskContainers).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (repository *quotaRepository) TeamQuota(teamID int) (atc.QuotaUsage, error) {
+	return teamQuotaUsage(repository.conn, teamID)
+}
+
+func (repository *quotaRepository) SetTeamQuota(teamID int, quota atc.Quota) error {
+	result, err := psql.Update("teams").
+		Set("quota_builds", quota.Builds).
+		Set("quota_task_containers", quota.TaskContainers).
+		Where(sq.Eq{"id": teamID}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected != 1 {
+		return NonOneRowAffectedError{rowsAffected}
+	}
+
+	return nil
+}
+
+func (repository *quotaRepository) QuotasReached(teamID int) (QuotasReached, error) {
+	return quotasReached(repository.conn, teamID)
+}
+
+func quotasReached(conn Conn, teamID int) (QuotasReached, error) {
+	team, err := teamQuotaUsage(conn, teamID)
+	if err != nil {
+		return QuotasReached{}, err
+	}
+
+	cluster, err := clusterQuotaUsage(conn)
+	if err != nil {
+		return QuotasReached{}, err
+	}
+
+	return QuotasReached{
+		Team:    quotaReached(team),
+		Cluster: quotaReached(cluster),
+	}, nil
+}
+
+func quotaReached(usage atc.QuotaUsage) bool {
+	if usage.Quota.Builds != 0 && usage.Running.Builds >= usage.Quota.Builds {
+		return true
+	}
+
+	if usage.Quota.TaskContainers != 0 && usage.Running.TaskContainers >= usage.Quota.TaskContainers {
+		return true
+	}
+
+	return false
+}
+
+// reserveTaskContainer returns ErrTaskContainerQuotaReached if the team can't
+// create another task container. When a quota is set, other task container
+// creations wait for the transaction to end, so that two of them can't both
+// take the last free container.
+func reserveTaskContainer(tx Tx, teamID int) error {
+	team, err := teamQuota(tx, teamID)
+	if err != nil {
+		return err
+	}
+
+	cluster, err := clusterQuota(tx)
+	if err != nil {
+		return err
+	}
+
+	if team.TaskContainers == 0 && cluster.TaskContainers == 0 {
+		return nil
+	}
+
+	_, err = tx.Exec(`LOCK TABLE cluster_quota IN SHARE ROW EXCLUSIVE MODE`)
+	if err != nil {
+		return err
+	}
+
+	if team.TaskContainers != 0 {
+		running, err := runningTaskContainers(tx, sq.Eq{"b.team_id": teamID})
+		if err != nil {
+			return err
+		}
+
+		if running >= team.TaskContainers {
+			return ErrTaskContainerQuotaReached
+		}
+	}
+
+	if cluster.TaskContainers != 0 {
+		running, err := runningTaskContainers(tx, sq.And{})
+		if err != nil {
+			return err
+		}
+
+		if running >= cluster.TaskContainers {
+			return ErrTaskContainerQuotaReached
+		}
+	}
+
+	return nil
+}
+
+func clusterQuotaUsage(conn Conn) (atc.QuotaUsage, error) {
+	quota, err := clusterQuota(conn)
+	if err != nil {
+		return atc.QuotaUsage{}, err
+	}
+
+	running, err := runningUsage(conn, sq.And{})
+	if err != nil {
+		return atc.QuotaUsage{}, err
+	}
+
+	return atc.QuotaUsage{Quota: quota, Running: running}, nil
+}
+
+func teamQuotaUsage(conn Conn, teamID int) (atc.QuotaUsage, error) {
+	quota, err := teamQuota(conn, teamID)
+	if err != nil {
+		return atc.QuotaUsage{}, err
+	}
+
+	running, err := runningUsage(conn, sq.Eq{"b.team_id": teamID})
+	if err != nil {
+		return atc.QuotaUsage{}, err
+	}
+
+	return atc.QuotaUsage{Quota: quota, Running: running}, nil
+}
+
+func clusterQuota(runner sq.Runner) (atc.Quota, error) {
+	var quota atc.Quota
+	err := psql.Select("builds", "task_containers").
+		From("cluster_quota").
+		RunWith(runner).
+		QueryRow().
+		Scan(&quota.Builds, &quota.TaskContainers)
+	if err != nil && err != sql.ErrNoRows {
+		return atc.Quota{}, err
+	}
+
+	return quota, nil
+}
+
+func teamQuota(runner sq.Runner, teamID int) (atc.Quota, error) {
+	var quota atc.Quota
+	err := psql.Select("quota_builds", "quota_task_containers").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		RunWith(runner).
+		QueryRow().
+		Scan(&quota.Builds, &quota.TaskContainers)
+	if err != nil {
+		return atc.Quota{}, err
+	}
+
+	return quota, nil
+}
+
+// runningUsage counts the started builds and their running task containers.
+func runningUsage(runner sq.Runner, where sq.Sqlizer) (atc.Quota, error) {
+	var running atc.Quota
+	err := psql.Select("COUNT(*)").
+		From("builds b").
+		Where(sq.Eq{"b.status": string(BuildStatusStarted)}).
+		Where(where).
+		RunWith(runner).
+		QueryRow().
+		Scan(&running.Builds)
+	if err != nil {
+		return atc.Quota{}, err
+	}
+
+	running.TaskContainers, err = runningTaskContainers(runner, where)
+	if err != nil {
+		return atc.Quota{}, err
+	}
+
+	return running, nil
+}
+
+// runningTaskContainers counts the task containers which have not yet been
+// destroyed. Containers which are only kept around to be hijacked, either
+// because they are being hijacked or because their failed task is retained
+// for debugging, are not counted.
+func runningTaskContainers(runner sq.Runner, where sq.Sqlizer) (int, error) {
+	var running int
+	err := psql.Select("COUNT(*)").
+		From("containers c").
+		Join("builds b ON b.id = c.build_id").
+		Where(sq.Eq{
+			"c.meta_type":   string(ContainerTypeTask),
+			"c.state":       []string{atc.ContainerStateCreating, atc.ContainerStateCreated},
+			"c.last_hijack": nil,
+		}).
+		Where(sq.Or{
+			sq.Eq{"c.task_failed": false},
+			sq.Eq{"b.containers_retained_until": nil},
+			sq.Expr("b.containers_retained_until < now()"),
+		}).
+		Where(where).
+		RunWith(runner).
+		QueryRow().
+		Scan(&running)
+	if err != nil {
+		return 0, err
+	}
+
+	return running, nil
+}
@@ -0,0 +1,217 @@
+package db_test
+
+import (
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("QuotaRepository", func() {
+	var (
+		repository db.QuotaRepository
+		otherTeam  db.Team
+	)
+
+	BeforeEach(func() {
+		repository = db.NewQuotaRepository(dbConn)
+
+		var err error
+		otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "other-team"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	startBuild := func(team db.Team) {
+		build, err := team.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		started, err := build.Start(atc.Plan{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(started).To(BeTrue())
+	}
+
+	Describe("ClusterQuota", func() {
+		It("has no limit by default", func() {
+			usage, err := repository.ClusterQuota()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage.Quota).To(Equal(atc.Quota{}))
+		})
+
+		It("returns the quota that was set along with the running builds of every team", func() {
+			err := repository.SetClusterQuota(atc.Quota{Builds: 5, TaskContainers: 10})
+			Expect(err).ToNot(HaveOccurred())
+
+			startBuild(defaultTeam)
+			startBuild(otherTeam)
+
+			usage, err := repository.ClusterQuota()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage).To(Equal(atc.QuotaUsage{
+				Quota:   atc.Quota{Builds: 5, TaskContainers: 10},
+				Running: atc.Quota{Builds: 2},
+			}))
+		})
+	})
+
+	Describe("TeamQuota", func() {
+		It("returns the quota that was set along with the team's running builds", func() {
+			err := repository.SetTeamQuota(defaultTeam.ID(), atc.Quota{Builds: 3})
+			Expect(err).ToNot(HaveOccurred())
+
+			startBuild(defaultTeam)
+			startBuild(otherTeam)
+
+			usage, err := repository.TeamQuota(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage).To(Equal(atc.QuotaUsage{
+				Quota:   atc.Quota{Builds: 3},
+				Running: atc.Quota{Builds: 1},
+			}))
+
+			usage, err = repository.TeamQuota(otherTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage.Quota).To(Equal(atc.Quota{}))
+		})
+	})
+
+	Describe("QuotasReached", func() {
+		It("reports nothing when there are no quotas", func() {
+			startBuild(defaultTeam)
+
+			reached, err := repository.QuotasReached(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reached.Any()).To(BeFalse())
+		})
+
+		It("reports the team's quota once it has as many running builds", func() {
+			err := repository.SetTeamQuota(defaultTeam.ID(), atc.Quota{Builds: 1})
+			Expect(err).ToNot(HaveOccurred())
+
+			reached, err := repository.QuotasReached(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reached).To(Equal(db.QuotasReached{}))
+
+			startBuild(defaultTeam)
+
+			reached, err = repository.QuotasReached(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reached).To(Equal(db.QuotasReached{Team: true}))
+
+			reached, err = repository.QuotasReached(otherTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reached).To(Equal(db.QuotasReached{}))
+		})
+
+		It("reports the cluster's quota for every team", func() {
+			err := repository.SetClusterQuota(atc.Quota{Builds: 1})
+			Expect(err).ToNot(HaveOccurred())
+
+			startBuild(defaultTeam)
+
+			reached, err := repository.QuotasReached(otherTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reached).To(Equal(db.QuotasReached{Cluster: true}))
+		})
+	})
+
+	Describe("creating task containers", func() {
+		createContainer := func(team db.Team, meta db.ContainerMetadata) (db.CreatingContainer, error) {
+			build, err := team.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			return defaultWorker.CreateContainer(
+				db.NewBuildStepContainerOwner(build.ID(), atc.PlanID("some-plan"), team.ID()),
+				meta,
+			)
+		}
+
+		taskMeta := db.ContainerMetadata{Type: db.ContainerTypeTask}
+
+		It("is not limited when there are no quotas", func() {
+			for i := 0; i < 3; i++ {
+				_, err := createContainer(defaultTeam, taskMeta)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		Context("when the team has a task container quota", func() {
+			BeforeEach(func() {
+				err := repository.SetTeamQuota(defaultTeam.ID(), atc.Quota{TaskContainers: 1})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("fails once the team has as many task containers", func() {
+				_, err := createContainer(defaultTeam, taskMeta)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = createContainer(defaultTeam, taskMeta)
+				Expect(err).To(Equal(db.ErrTaskContainerQuotaReached))
+
+				_, err = createContainer(otherTeam, taskMeta)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not limit other containers", func() {
+				_, err := createContainer(defaultTeam, taskMeta)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = createContainer(defaultTeam, db.ContainerMetadata{Type: db.ContainerTypeGet})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not count hijacked containers", func() {
+				container, err := createContainer(defaultTeam, taskMeta)
+				Expect(err).ToNot(HaveOccurred())
+
+				created, err := container.Created()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created.UpdateLastHijack()).To(Succeed())
+
+				_, err = createContainer(defaultTeam, taskMeta)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not count the retained containers of failed tasks", func() {
+				container, err := createContainer(defaultTeam, taskMeta)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = psql.Update("containers").
+					Set("task_failed", true).
+					Where(sq.Eq{"id": container.ID()}).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = psql.Update("builds").
+					Set("containers_retained_until", sq.Expr("now() + interval '1 hour'")).
+					Where(sq.Expr("id = (SELECT build_id FROM containers WHERE id = ?)", container.ID())).
+					RunWith(dbConn).
+					Exec()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = createContainer(defaultTeam, taskMeta)
+				Expect(err).ToNot(HaveOccurred())
+
+				usage, err := repository.TeamQuota(defaultTeam.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(usage.Running.TaskContainers).To(Equal(1))
+			})
+		})
+
+		Context("when the cluster has a task container quota", func() {
+			BeforeEach(func() {
+				err := repository.SetClusterQuota(atc.Quota{TaskContainers: 1})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("fails once any team has as many task containers", func() {
+				_, err := createContainer(defaultTeam, taskMeta)
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = createContainer(otherTeam, taskMeta)
+				Expect(err).To(Equal(db.ErrTaskContainerQuotaReached))
+			})
+		})
+	})
+})
@@ -80,6 +80,7 @@ var resourcesQuery = psql.Select(
 	"r.resource_config_id",
 	"r.resource_config_scope_id",
 	"p.name",
+	"p.instance_vars",
 	"t.id",
 	"t.name",
 	"rs.check_error",
@@ -734,11 +735,17 @@ func scanResource(r *resource, row scannable) error {
 	var (
 		configBlob                                                               []byte
 		checkErr, rcsCheckErr, nonce, rcID, rcScopeID, pinnedVersion, pinComment sql.NullString
+		pipelineInstanceVars                                                     sql.NullString
 		lastCheckStartTime, lastCheckEndTime                                     pq.NullTime
 		pinnedThroughConfig                                                      sql.NullBool
 	)
 
-	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &r.teamID, &r.teamName, &rcsCheckErr, &pinnedVersion, &pinComment, &pinnedThroughConfig)
+	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &pipelineInstanceVars, &r.teamID, &r.teamName, &rcsCheckErr, &pinnedVersion, &pinComment, &pinnedThroughConfig)
+	if err != nil {
+		return err
+	}
+
+	r.pipelineInstanceVars, err = unmarshalInstanceVars(pipelineInstanceVars)
 	if err != nil {
 		return err
 	}
@@ -148,7 +148,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 						})
 						It("does not remove the resource caches from other jobs", func() {
 							By("creating a second pipeline")
-							secondPipeline, _, err := defaultTeam.SavePipeline("second-pipeline", atc.Config{
+							secondPipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "second-pipeline"}, atc.Config{
 								Jobs: atc.JobConfigs{
 									{
 										Name: "some-job",
@@ -172,7 +172,7 @@ var _ = Describe("ResourceCacheLifecycle", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(0), false)
+							}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 							Expect(err).NotTo(HaveOccurred())
 
 							By("creating an image resource cache tied to the job in the second pipeline")
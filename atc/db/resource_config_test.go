@@ -29,10 +29,11 @@ var _ = Describe("ResourceConfig", func() {
 			var created bool
 			var err error
 			pipeline, created, err = defaultTeam.SavePipeline(
-				"pipeline-one-resource",
+				atc.PipelineRef{Name: "pipeline-one-resource"},
 				config,
 				0,
 				false,
+				db.ConfigAuthor{},
 			)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
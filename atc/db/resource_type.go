@@ -134,6 +134,7 @@ var resourceTypesQuery = psql.Select(
 	"r.nonce",
 	"r.check_error",
 	"p.name",
+	"p.instance_vars",
 	"t.id",
 	"t.name",
 	"ro.id",
@@ -287,10 +288,16 @@ func scanResourceType(t *resourceType, row scannable) error {
 	var (
 		configJSON                                   []byte
 		checkErr, rcsCheckErr, rcsID, version, nonce sql.NullString
+		pipelineInstanceVars                         sql.NullString
 		lastCheckStartTime, lastCheckEndTime         pq.NullTime
 	)
 
-	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &checkErr, &t.pipelineName, &t.teamID, &t.teamName, &rcsID, &rcsCheckErr, &lastCheckStartTime, &lastCheckEndTime)
+	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &checkErr, &t.pipelineName, &pipelineInstanceVars, &t.teamID, &t.teamName, &rcsID, &rcsCheckErr, &lastCheckStartTime, &lastCheckEndTime)
+	if err != nil {
+		return err
+	}
+
+	t.pipelineInstanceVars, err = unmarshalInstanceVars(pipelineInstanceVars)
 	if err != nil {
 		return err
 	}
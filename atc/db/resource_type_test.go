@@ -19,7 +19,7 @@ var _ = Describe("ResourceType", func() {
 		)
 
 		pipeline, created, err = defaultTeam.SavePipeline(
-			"pipeline-with-types",
+			atc.PipelineRef{Name: "pipeline-with-types"},
 			atc.Config{
 				ResourceTypes: atc.ResourceTypes{
 					{
@@ -49,6 +49,7 @@ var _ = Describe("ResourceType", func() {
 			},
 			0,
 			false,
+			db.ConfigAuthor{},
 		)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
@@ -107,7 +108,7 @@ var _ = Describe("ResourceType", func() {
 				)
 
 				pipeline, created, err = defaultTeam.SavePipeline(
-					"pipeline-with-types",
+					atc.PipelineRef{Name: "pipeline-with-types"},
 					atc.Config{
 						ResourceTypes: atc.ResourceTypes{
 							{
@@ -119,6 +120,7 @@ var _ = Describe("ResourceType", func() {
 					},
 					pipeline.ConfigVersion(),
 					false,
+					db.ConfigAuthor{},
 				)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
@@ -138,7 +140,7 @@ var _ = Describe("ResourceType", func() {
 				)
 
 				pipeline, created, err = defaultTeam.SavePipeline(
-					"pipeline-with-types",
+					atc.PipelineRef{Name: "pipeline-with-types"},
 					atc.Config{
 						Resources: atc.ResourceConfigs{
 							{
@@ -158,6 +160,7 @@ var _ = Describe("ResourceType", func() {
 					},
 					pipeline.ConfigVersion(),
 					false,
+					db.ConfigAuthor{},
 				)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
@@ -184,7 +187,7 @@ var _ = Describe("ResourceType", func() {
 				)
 
 				otherPipeline, created, err := defaultTeam.SavePipeline(
-					"pipeline-with-duplicate-type-name",
+					atc.PipelineRef{Name: "pipeline-with-duplicate-type-name"},
 					atc.Config{
 						ResourceTypes: atc.ResourceTypes{
 							{
@@ -197,13 +200,14 @@ var _ = Describe("ResourceType", func() {
 					},
 					db.ConfigVersion(0),
 					false,
+					db.ConfigAuthor{},
 				)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeTrue())
 				Expect(otherPipeline).NotTo(BeNil())
 
 				pipeline, created, err = defaultTeam.SavePipeline(
-					"pipeline-with-types",
+					atc.PipelineRef{Name: "pipeline-with-types"},
 					atc.Config{
 						Resources: atc.ResourceConfigs{
 							{
@@ -252,6 +256,7 @@ var _ = Describe("ResourceType", func() {
 					},
 					pipeline.ConfigVersion(),
 					false,
+					db.ConfigAuthor{},
 				)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
@@ -2,6 +2,8 @@ package db
 
 import (
 	"context"
+	"sort"
+	"strings"
 
 	"github.com/concourse/concourse/tracing"
 )
@@ -26,3 +28,17 @@ func (sc SpanContext) Set(key, value string) {
 		sc[key] = value
 	}
 }
+
+// Env returns the span context as environment variables named after the
+// upper-cased keys, e.g. TRACEPARENT, so that processes in a container can
+// attach their spans to the span the container was created under.
+func (sc SpanContext) Env() []string {
+	env := []string{}
+	for key, value := range sc {
+		env = append(env, strings.ToUpper(key)+"="+value)
+	}
+
+	sort.Strings(env)
+
+	return env
+}
@@ -20,6 +20,24 @@ import (
 
 var ErrConfigComparisonFailed = errors.New("comparison with existing config failed during save")
 
+type PassedJobNotFoundError struct {
+	Job string
+}
+
+func (e PassedJobNotFoundError) Error() string {
+	return fmt.Sprintf("passed constraint references a job which does not exist in the team ('%s')", e.Job)
+}
+
+type PassedJobInUseError struct {
+	Job           string
+	Pipeline      string
+	DownstreamJob string
+}
+
+func (e PassedJobInUseError) Error() string {
+	return fmt.Sprintf("job '%s' is used in a passed constraint of '%s/%s'", e.Job, e.Pipeline, e.DownstreamJob)
+}
+
 //go:generate counterfeiter . Team
 
 type Team interface {
@@ -28,18 +46,20 @@ type Team interface {
 	Admin() bool
 
 	Auth() atc.TeamAuth
+	Roles() atc.TeamRoles
 
 	Delete() error
 	Rename(string) error
 
 	SavePipeline(
-		pipelineName string,
+		pipelineRef atc.PipelineRef,
 		config atc.Config,
 		from ConfigVersion,
 		initiallyPaused bool,
+		savedBy ConfigAuthor,
 	) (Pipeline, bool, error)
 
-	Pipeline(pipelineName string) (Pipeline, bool, error)
+	Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	OrderPipelines([]string) error
@@ -60,13 +80,17 @@ type Team interface {
 	IsContainerWithinTeam(string, bool) (bool, error)
 
 	FindContainerByHandle(string) (Container, bool, error)
-	FindCheckContainers(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
+	FindCheckContainers(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
 	FindContainersByMetadata(ContainerMetadata) ([]Container, error)
 	FindCreatedContainerByHandle(string) (CreatedContainer, bool, error)
 	FindWorkerForContainer(handle string) (Worker, bool, error)
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
-	UpdateProviderAuth(auth atc.TeamAuth) error
+	UpdateProviderAuth(auth atc.TeamAuth, roles atc.TeamRoles) error
+
+	CreateAPIToken(APITokenSpec) (APIToken, string, error)
+	APITokens() ([]APIToken, error)
+	APIToken(name string) (APIToken, bool, error)
 }
 
 type team struct {
@@ -77,14 +101,16 @@ type team struct {
 	name  string
 	admin bool
 
-	auth atc.TeamAuth
+	auth  atc.TeamAuth
+	roles atc.TeamRoles
 }
 
 func (t *team) ID() int      { return t.id }
 func (t *team) Name() string { return t.name }
 func (t *team) Admin() bool  { return t.admin }
 
-func (t *team) Auth() atc.TeamAuth { return t.auth }
+func (t *team) Auth() atc.TeamAuth   { return t.auth }
+func (t *team) Roles() atc.TeamRoles { return t.roles }
 
 func (t *team) Delete() error {
 	_, err := psql.Delete("teams").
@@ -340,11 +366,17 @@ func (t *team) FindCreatedContainerByHandle(
 }
 
 func (t *team) SavePipeline(
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
+	savedBy ConfigAuthor,
 ) (Pipeline, bool, error) {
+	instanceVarsPayload, err := marshalInstanceVars(pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	tx, err := t.conn.Begin()
 	if err != nil {
 		return nil, false, err
@@ -353,12 +385,15 @@ func (t *team) SavePipeline(
 	defer Rollback(tx)
 
 	var existingConfig bool
-	err = tx.QueryRow(`SELECT EXISTS (
-		SELECT 1
-		FROM pipelines
-		WHERE name = $1
-		AND team_id = $2
-	)`, pipelineName, t.id).Scan(&existingConfig)
+	err = psql.Select("1").
+		Prefix("SELECT EXISTS (").
+		From("pipelines").
+		Where(sq.Eq{"team_id": t.id}).
+		Where(pipelineRefCondition("name", "instance_vars", pipelineRef.Name, instanceVarsPayload)).
+		Suffix(")").
+		RunWith(tx).
+		QueryRow().
+		Scan(&existingConfig)
 	if err != nil {
 		return nil, false, err
 	}
@@ -373,21 +408,39 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	notificationsPayload, err := json.Marshal(config.Notifications)
+	if err != nil {
+		return nil, false, err
+	}
+
 	encryptedVarSourcesPayload, nonce, err := t.conn.EncryptionStrategy().Encrypt(varSourcesPayload)
 	if err != nil {
 		return nil, false, err
 	}
 
+	encryptedNotificationsPayload, notificationsNonce, err := t.conn.EncryptionStrategy().Encrypt(notificationsPayload)
+	if err != nil {
+		return nil, false, err
+	}
+
 	var pipelineID int
 	if !existingConfig {
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":         pipelineName,
-				"groups":       groupsPayload,
-				"var_sources":  encryptedVarSourcesPayload,
-				"nonce":        nonce,
-				"version":      sq.Expr("nextval('config_version_seq')"),
-				"ordering":     sq.Expr("currval('pipelines_id_seq')"),
+				"name":                pipelineRef.Name,
+				"instance_vars":       instanceVarsPayload,
+				"groups":              groupsPayload,
+				"var_sources":         encryptedVarSourcesPayload,
+				"notifications":       encryptedNotificationsPayload,
+				"nonce":               nonce,
+				"notifications_nonce": notificationsNonce,
+				"version":             sq.Expr("nextval('config_version_seq')"),
+				// instances of a pipeline share the ordering of their siblings so
+				// that they are listed together
+				"ordering": sq.Expr(
+					"COALESCE((SELECT MIN(ordering) FROM pipelines WHERE name = ? AND team_id = ?), currval('pipelines_id_seq'))",
+					pipelineRef.Name, t.id,
+				),
 				"paused":       initiallyPaused,
 				"last_updated": sq.Expr("now()"),
 				"team_id":      t.id,
@@ -403,14 +456,16 @@ func (t *team) SavePipeline(
 			Set("archived", false).
 			Set("groups", groupsPayload).
 			Set("var_sources", encryptedVarSourcesPayload).
+			Set("notifications", encryptedNotificationsPayload).
 			Set("nonce", nonce).
+			Set("notifications_nonce", notificationsNonce).
 			Set("version", sq.Expr("nextval('config_version_seq')")).
 			Set("last_updated", sq.Expr("now()")).
 			Where(sq.Eq{
-				"name":    pipelineName,
 				"version": from,
 				"team_id": t.id,
 			}).
+			Where(pipelineRefCondition("name", "instance_vars", pipelineRef.Name, instanceVarsPayload)).
 			Suffix("RETURNING id").
 			RunWith(tx).
 			QueryRow().
@@ -461,6 +516,11 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	err = checkPassedJobsInUse(tx, pipelineID, sq.Eq{"j.active": false})
+	if err != nil {
+		return nil, false, err
+	}
+
 	err = removeUnusedWorkerTaskCaches(tx, pipelineID, config.Jobs)
 	if err != nil {
 		return nil, false, err
@@ -483,6 +543,11 @@ func (t *team) SavePipeline(
 		return nil, false, err
 	}
 
+	err = t.savePipelineConfig(tx, pipelineID, pipeline.ConfigVersion(), config, savedBy)
+	if err != nil {
+		return nil, false, err
+	}
+
 	err = requestScheduleForJobsInPipeline(tx, pipelineID)
 	if err != nil {
 		return nil, false, err
@@ -496,16 +561,19 @@ func (t *team) SavePipeline(
 	return pipeline, !existingConfig, nil
 }
 
-func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
+func (t *team) Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error) {
+	instanceVarsPayload, err := marshalInstanceVars(pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
-	err := scanPipeline(
+	err = scanPipeline(
 		pipeline,
 		pipelinesQuery.
-			Where(sq.Eq{
-				"p.team_id": t.id,
-				"p.name":    pipelineName,
-			}).
+			Where(sq.Eq{"p.team_id": t.id}).
+			Where(pipelineRefCondition("p.name", "p.instance_vars", pipelineRef.Name, instanceVarsPayload)).
 			RunWith(t.conn).
 			QueryRow(),
 	)
@@ -524,7 +592,7 @@ func (t *team) Pipelines() ([]Pipeline, error) {
 		Where(sq.Eq{
 			"team_id": t.id,
 		}).
-		OrderBy("ordering").
+		OrderBy("ordering", "p.id").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -545,7 +613,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 			"team_id": t.id,
 			"public":  true,
 		}).
-		OrderBy("t.name ASC", "ordering ASC").
+		OrderBy("t.name ASC", "ordering ASC", "p.id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -712,7 +780,7 @@ func (t *team) SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, erro
 	return savedWorker, nil
 }
 
-func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
+func (t *team) UpdateProviderAuth(auth atc.TeamAuth, roles atc.TeamRoles) error {
 	tx, err := t.conn.Begin()
 	if err != nil {
 		return err
@@ -724,13 +792,18 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		return err
 	}
 
+	jsonEncodedRoles, err := json.Marshal(roles)
+	if err != nil {
+		return err
+	}
+
 	query := `
 		UPDATE teams
-		SET auth = $1, legacy_auth = NULL, nonce = NULL
-		WHERE id = $2
-		RETURNING id, name, admin, auth, nonce
+		SET auth = $1, roles = $2, legacy_auth = NULL, nonce = NULL
+		WHERE id = $3
+		RETURNING id, name, admin, auth, roles, nonce
 	`
-	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
+	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, jsonEncodedRoles, t.id)
 	if err != nil {
 		return err
 	}
@@ -738,8 +811,8 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
-func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
-	pipeline, found, err := t.Pipeline(pipelineName)
+func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
+	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -950,11 +1023,30 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 		return 0, err
 	}
 
+	var keepContainersOnFailure interface{}
+	if retention := job.ContainerRetention(); retention > 0 {
+		keepContainersOnFailure = sq.Expr(fmt.Sprintf("'%d seconds'::interval", int(retention.Seconds())))
+	}
+
+	// the schedule is counted from when it was first configured, so that
+	// setting a pipeline doesn't trigger builds for every time it would have
+	// fired in the past
+	var schedule, scheduleTriggeredAt interface{}
+	if job.Schedule != nil {
+		schedulePayload, err := json.Marshal(job.Schedule)
+		if err != nil {
+			return 0, err
+		}
+
+		schedule = string(schedulePayload)
+		scheduleTriggeredAt = sq.Expr("now()")
+	}
+
 	var jobID int
 	err = psql.Insert("jobs").
-		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "interruptible", "active", "nonce", "tags").
-		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.Interruptible, true, nonce, pq.Array(groups)).
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags").
+		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "priority", "interruptible", "active", "nonce", "tags", "keep_containers_on_failure", "schedule", "schedule_triggered_at").
+		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.Priority, job.Interruptible, true, nonce, pq.Array(groups), keepContainersOnFailure, schedule, scheduleTriggeredAt).
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, priority = EXCLUDED.priority, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags, keep_containers_on_failure = EXCLUDED.keep_containers_on_failure, schedule = EXCLUDED.schedule, schedule_triggered_at = CASE WHEN EXCLUDED.schedule IS NULL THEN NULL ELSE COALESCE(jobs.schedule_triggered_at, EXCLUDED.schedule_triggered_at) END").
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -966,6 +1058,30 @@ func (t *team) saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string
 	return jobID, nil
 }
 
+func (t *team) savePipelineConfig(tx Tx, pipelineID int, version ConfigVersion, config atc.Config, savedBy ConfigAuthor) error {
+	configPayload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := t.conn.EncryptionStrategy().Encrypt(configPayload)
+	if err != nil {
+		return err
+	}
+
+	userName := sql.NullString{
+		String: savedBy.UserName,
+		Valid:  savedBy.UserName != "",
+	}
+
+	_, err = psql.Insert("pipeline_configs").
+		Columns("pipeline_id", "version", "config", "nonce", "saved_by_user", "saved_by_build_id").
+		Values(pipelineID, version, encryptedPayload, nonce, userName, nullIfZero(savedBy.BuildID)).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
 func (t *team) registerSerialGroup(tx Tx, serialGroup string, jobID int) error {
 	_, err := psql.Insert("jobs_serial_groups").
 		Columns("serial_group", "job_id").
@@ -1109,19 +1225,27 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 
 func scanPipeline(p *pipeline, scan scannable) error {
 	var (
-		groups      sql.NullString
-		varSources  sql.NullString
-		nonce       sql.NullString
-		nonceStr    *string
-		lastUpdated pq.NullTime
+		instanceVars  sql.NullString
+		groups        sql.NullString
+		varSources    sql.NullString
+		notifications sql.NullString
+		nonce         sql.NullString
+		nonceStr      *string
+		notifNonce    sql.NullString
+		lastUpdated   pq.NullTime
 	)
-	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated)
+	err := scan.Scan(&p.id, &p.name, &instanceVars, &groups, &varSources, &notifications, &nonce, &notifNonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated)
 	if err != nil {
 		return err
 	}
 
 	p.lastUpdated = lastUpdated.Time
 
+	p.instanceVars, err = unmarshalInstanceVars(instanceVars)
+	if err != nil {
+		return err
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
@@ -1150,6 +1274,25 @@ func scanPipeline(p *pipeline, scan scannable) error {
 		p.varSources = pipelineVarSources
 	}
 
+	if notifications.Valid {
+		var notifNonceStr *string
+		if notifNonce.Valid {
+			notifNonceStr = &notifNonce.String
+		}
+
+		var pipelineNotifications atc.NotificationConfigs
+		decryptedNotifications, err := p.conn.EncryptionStrategy().Decrypt(notifications.String, notifNonceStr)
+		if err != nil {
+			return err
+		}
+		err = json.Unmarshal([]byte(decryptedNotifications), &pipelineNotifications)
+		if err != nil {
+			return err
+		}
+
+		p.notifications = pipelineNotifications
+	}
+
 	return nil
 }
 
@@ -1200,13 +1343,14 @@ func scanContainers(rows *sql.Rows, conn Conn, initContainers []Container) ([]Co
 }
 
 func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
-	var providerAuth, nonce sql.NullString
+	var providerAuth, roles, nonce sql.NullString
 
 	err := tx.QueryRow(query, params...).Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&roles,
 		&nonce,
 	)
 	if err != nil {
@@ -1222,6 +1366,14 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 		t.auth = auth
 	}
 
+	t.roles = nil
+	if roles.Valid {
+		err = json.Unmarshal([]byte(roles.String), &t.roles)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1349,7 +1501,7 @@ func (t *team) insertJobPipes(tx Tx, jobConfigs atc.JobConfigs, resourceNameToID
 	for _, jobConfig := range jobConfigs {
 		for _, plan := range jobConfig.Plans() {
 			if plan.Get != "" {
-				err = insertJobInput(tx, plan, jobConfig.Name, resourceNameToID, jobNameToID)
+				err = insertJobInput(tx, t.id, plan, jobConfig.Name, resourceNameToID, jobNameToID)
 				if err != nil {
 					return err
 				}
@@ -1365,9 +1517,14 @@ func (t *team) insertJobPipes(tx Tx, jobConfigs atc.JobConfigs, resourceNameToID
 	return nil
 }
 
-func insertJobInput(tx Tx, plan atc.PlanConfig, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int) error {
+func insertJobInput(tx Tx, teamID int, plan atc.PlanConfig, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int) error {
 	if len(plan.Passed) != 0 {
 		for _, passedJob := range plan.Passed {
+			passedJobID, err := findPassedJobID(tx, teamID, passedJob, jobNameToID)
+			if err != nil {
+				return err
+			}
+
 			var resourceID int
 			if plan.Resource != "" {
 				resourceID = resourceNameToID[plan.Resource]
@@ -1385,9 +1542,9 @@ func insertJobInput(tx Tx, plan atc.PlanConfig, jobName string, resourceNameToID
 				version = sql.NullString{Valid: true, String: string(versionJSON)}
 			}
 
-			_, err := psql.Insert("job_inputs").
+			_, err = psql.Insert("job_inputs").
 				Columns("name", "job_id", "resource_id", "passed_job_id", "trigger", "version").
-				Values(plan.Get, jobNameToID[jobName], resourceID, jobNameToID[passedJob], plan.Trigger, version).
+				Values(plan.Get, jobNameToID[jobName], resourceID, passedJobID, plan.Trigger, version).
 				RunWith(tx).
 				Exec()
 			if err != nil {
@@ -1425,6 +1582,77 @@ func insertJobInput(tx Tx, plan atc.PlanConfig, jobName string, resourceNameToID
 	return nil
 }
 
+// findPassedJobID looks up a job named in a passed constraint, which is either
+// a job of the pipeline being saved or, as pipeline/job, an active job in
+// another pipeline of the team.
+func findPassedJobID(tx Tx, teamID int, passedJob string, jobNameToID map[string]int) (int, error) {
+	jobID, found := jobNameToID[passedJob]
+	if found {
+		return jobID, nil
+	}
+
+	pipelineName, jobName, ok := atc.SplitPassedJob(passedJob)
+	if !ok {
+		return 0, PassedJobNotFoundError{Job: passedJob}
+	}
+
+	err := psql.Select("j.id").
+		From("jobs j").
+		Join("pipelines p ON p.id = j.pipeline_id").
+		Where(sq.Eq{
+			"p.team_id": teamID,
+			"j.name":    jobName,
+			"j.active":  true,
+		}).
+		Where(pipelineRefCondition("p.name", "p.instance_vars", pipelineName, sql.NullString{})).
+		RunWith(tx).
+		QueryRow().
+		Scan(&jobID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, PassedJobNotFoundError{Job: passedJob}
+		}
+
+		return 0, err
+	}
+
+	return jobID, nil
+}
+
+// checkPassedJobsInUse returns a PassedJobInUseError if any of the jobs of the
+// pipeline matching the condition are named in a passed constraint of an
+// active job in another pipeline. Those constraints would otherwise silently
+// go away or stay stuck on a job which no longer runs.
+func checkPassedJobsInUse(tx Tx, pipelineID int, where sq.Sqlizer) error {
+	var inUse PassedJobInUseError
+	err := psql.Select("j.name", "dp.name", "dj.name").
+		From("job_inputs ji").
+		Join("jobs j ON j.id = ji.passed_job_id").
+		Join("jobs dj ON dj.id = ji.job_id").
+		Join("pipelines dp ON dp.id = dj.pipeline_id").
+		Where(sq.Eq{
+			"j.pipeline_id": pipelineID,
+			"dj.active":     true,
+			"dp.archived":   false,
+		}).
+		Where(sq.NotEq{"dj.pipeline_id": pipelineID}).
+		Where(where).
+		OrderBy("ji.job_id").
+		Limit(1).
+		RunWith(tx).
+		QueryRow().
+		Scan(&inUse.Job, &inUse.Pipeline, &inUse.DownstreamJob)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+
+		return err
+	}
+
+	return inUse
+}
+
 func insertJobOutput(tx Tx, plan atc.PlanConfig, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int) error {
 	var resourceID int
 	if plan.Resource != "" {
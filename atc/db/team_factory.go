@@ -52,10 +52,15 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	roles, err := json.Marshal(t.Roles)
+	if err != nil {
+		return nil, err
+	}
+
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, roles, admin").
+		Values(t.Name, auth, roles, admin).
+		Suffix("RETURNING id, name, admin, auth, roles").
 		RunWith(tx).
 		QueryRow()
 
@@ -91,7 +96,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, roles").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -110,7 +115,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, roles").
 		From("teams").
 		OrderBy("name ASC").
 		RunWith(factory.conn).
@@ -176,13 +181,14 @@ func (factory *teamFactory) NotifyCacher() error {
 }
 
 func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
-	var providerAuth sql.NullString
+	var providerAuth, roles sql.NullString
 
 	err := rows.Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&roles,
 	)
 
 	if providerAuth.Valid {
@@ -192,5 +198,12 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 		}
 	}
 
+	if roles.Valid {
+		err = json.Unmarshal([]byte(roles.String), &t.roles)
+		if err != nil {
+			return err
+		}
+	}
+
 	return err
 }
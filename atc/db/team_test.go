@@ -44,11 +44,11 @@ var _ = Describe("Team", func() {
 		var otherTeamPipeline db.Pipeline
 
 		BeforeEach(func() {
-			otherTeamPipeline, _, err = otherTeam.SavePipeline("fake-pipeline", atc.Config{
+			otherTeamPipeline, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			err = otherTeam.Delete()
@@ -523,7 +523,7 @@ var _ = Describe("Team", func() {
 					otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "other-team"})
 					Expect(err).NotTo(HaveOccurred())
 
-					otherPipeline, _, err := otherTeam.SavePipeline("other-pipeline", atc.Config{
+					otherPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "some-job",
@@ -547,7 +547,7 @@ var _ = Describe("Team", func() {
 								},
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 					Expect(err).NotTo(HaveOccurred())
 
 					otherResource, found, err := otherPipeline.Resource("some-resource")
@@ -822,16 +822,38 @@ var _ = Describe("Team", func() {
 
 		Describe("UpdateProviderAuth", func() {
 			It("saves auth team info to the existing team", func() {
-				err := team.UpdateProviderAuth(authProvider)
+				err := team.UpdateProviderAuth(authProvider, nil)
 				Expect(err).ToNot(HaveOccurred())
 
 				Expect(team.Auth()).To(Equal(authProvider))
 			})
 
+			It("saves the team's custom roles", func() {
+				roles := atc.TeamRoles{
+					{
+						Name:      "releaser",
+						Actions:   []string{atc.CreateJobBuild, atc.PinResourceVersion},
+						Pipelines: []string{"release-*"},
+					},
+				}
+
+				err := team.UpdateProviderAuth(atc.TeamAuth{
+					"owner":    {"users": []string{"local:username"}},
+					"releaser": {"users": []string{"local:someone"}},
+				}, roles)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(team.Roles()).To(Equal(roles))
+
+				reloaded, _, err := teamFactory.FindTeam(team.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reloaded.Roles()).To(Equal(roles))
+			})
+
 			It("resets legacy_auth to NULL", func() {
 				oldLegacyAuth := `{"basicauth": {"username": "u", "password": "p"}}`
 				_, err := dbConn.Exec("UPDATE teams SET legacy_auth = $1 WHERE id = $2", oldLegacyAuth, team.ID())
-				team.UpdateProviderAuth(authProvider)
+				team.UpdateProviderAuth(authProvider, nil)
 
 				var newLegacyAuth sql.NullString
 				err = dbConn.QueryRow("SELECT legacy_auth FROM teams WHERE id = $1", team.ID()).Scan(&newLegacyAuth)
@@ -847,11 +869,11 @@ var _ = Describe("Team", func() {
 					team.UpdateProviderAuth(atc.TeamAuth{
 						"owner":  {"users": []string{"local:somebody"}},
 						"viewer": {"users": []string{"local:someone"}},
-					})
+					}, nil)
 				})
 
 				It("overrides the existing auth with the new config", func() {
-					err := team.UpdateProviderAuth(authProvider)
+					err := team.UpdateProviderAuth(authProvider, nil)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(team.Auth()).To(Equal(authProvider))
@@ -876,18 +898,18 @@ var _ = Describe("Team", func() {
 		Context("when the team has configured pipelines", func() {
 			BeforeEach(func() {
 				var err error
-				pipeline1, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
+				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -918,18 +940,18 @@ var _ = Describe("Team", func() {
 		Context("when the team has configured pipelines", func() {
 			BeforeEach(func() {
 				var err error
-				_, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline2, _, err = team.SavePipeline("fake-pipeline-two", atc.Config{
+				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				err = pipeline2.Expose()
@@ -959,14 +981,14 @@ var _ = Describe("Team", func() {
 
 		BeforeEach(func() {
 			var err error
-			pipeline1, _, err = team.SavePipeline("pipeline-name-a", atc.Config{}, 0, false)
+			pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name-a"}, atc.Config{}, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
-			pipeline2, _, err = team.SavePipeline("pipeline-name-b", atc.Config{}, 0, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name-b"}, atc.Config{}, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline1, _, err = otherTeam.SavePipeline("pipeline-name-a", atc.Config{}, 0, false)
+			otherPipeline1, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-name-a"}, atc.Config{}, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline2, _, err = otherTeam.SavePipeline("pipeline-name-b", atc.Config{}, 0, false)
+			otherPipeline2, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline-name-b"}, atc.Config{}, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1122,7 +1144,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job("some-job")
@@ -1273,7 +1295,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1379,7 +1401,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1637,26 +1659,26 @@ var _ = Describe("Team", func() {
 		})
 
 		It("returns true for created", func() {
-			_, created, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, created, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 		})
 
 		It("caches the team id", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 			Expect(pipeline.TeamID()).To(Equal(team.ID()))
 		})
 
 		It("can be saved as paused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, true)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, true, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -1664,21 +1686,76 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as unpaused", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
 			Expect(pipeline.Paused()).To(BeFalse())
 		})
 
+		Context("when saving pipeline instances", func() {
+			var instanceRef atc.PipelineRef
+
+			BeforeEach(func() {
+				instanceRef = atc.PipelineRef{
+					Name:         pipelineName,
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				}
+			})
+
+			It("saves each instance separately", func() {
+				pipeline, created, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				instance, created, err := team.SavePipeline(instanceRef, otherConfig, 0, false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				Expect(instance.ID()).ToNot(Equal(pipeline.ID()))
+				Expect(instance.Name()).To(Equal(pipeline.Name()))
+				Expect(instance.InstanceVars()).To(Equal(atc.InstanceVars{"branch": "feature"}))
+			})
+
+			It("looks up the instance by its instance vars", func() {
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+
+				instance, _, err := team.SavePipeline(instanceRef, otherConfig, 0, false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+
+				pipeline, found, err := team.Pipeline(instanceRef)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(pipeline.ID()).To(Equal(instance.ID()))
+				Expect(pipeline.Ref()).To(Equal(instanceRef))
+
+				_, found, err = team.Pipeline(atc.PipelineRef{
+					Name:         pipelineName,
+					InstanceVars: atc.InstanceVars{"branch": "other"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("updates an existing instance", func() {
+				instance, _, err := team.SavePipeline(instanceRef, config, 0, false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, created, err := team.SavePipeline(instanceRef, otherConfig, instance.ConfigVersion(), false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+			})
+		})
+
 		It("is not archived by default", func() {
-			_, _, err := team.SavePipeline(pipelineName, config, 0, true)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, true, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 
@@ -1686,10 +1763,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("requests schedule on the pipeline", func() {
-			requestedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			requestedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline("other-pipeline", otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, otherConfig, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			requestedJob, found, err := requestedPipeline.Job("some-job")
@@ -1707,7 +1784,7 @@ var _ = Describe("Team", func() {
 				"source-other-config": "some-other-value",
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, requestedPipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, requestedPipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			found, err = requestedJob.Reload()
@@ -1723,7 +1800,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resources from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1736,14 +1813,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1756,7 +1833,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("clears out api pinned version when resaving a pinned version on the pipeline config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1800,7 +1877,7 @@ var _ = Describe("Team", func() {
 				"version": "v2",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1815,7 +1892,7 @@ var _ = Describe("Team", func() {
 				"version": "v1",
 			}
 
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1826,7 +1903,7 @@ var _ = Describe("Team", func() {
 
 			config.Resources[0].Version = nil
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1837,7 +1914,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("does not clear the api pinned version when resaving pipeline config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -1877,7 +1954,7 @@ var _ = Describe("Team", func() {
 			Expect(reloaded).To(BeTrue())
 			Expect(resource.APIPinnedVersion()).To(Equal(atc.Version{"version": "v1"}))
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -1887,7 +1964,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources = []atc.ResourceConfig{}
@@ -1931,7 +2008,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Resource("some-other-resource")
@@ -1940,7 +2017,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resource types from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1953,14 +2030,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource type config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1973,12 +2050,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource type as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes = []atc.ResourceType{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -1987,7 +2064,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the jobs from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-job")
@@ -1997,12 +2074,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates job config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs[0].Public = false
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2012,12 +2089,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks job inactive when it is no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs = []atc.JobConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Job("some-job")
@@ -2066,7 +2143,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when there are multiple name changes", func() {
-				pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				job, _, _ := pipeline.Job("some-job")
@@ -2078,7 +2155,7 @@ var _ = Describe("Team", func() {
 				config.Jobs[3].Name = "new-other-job"
 				config.Jobs[3].OldName = "new-job"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedJob, _, _ := updatedPipeline.Job("new-job")
@@ -2089,7 +2166,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when old job has the same name as new job", func() {
-				pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				job, _, _ := pipeline.Job("some-job")
@@ -2097,7 +2174,7 @@ var _ = Describe("Team", func() {
 				config.Jobs[0].Name = "some-job"
 				config.Jobs[0].OldName = "some-job"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedJob, _, _ := updatedPipeline.Job("some-job")
@@ -2105,7 +2182,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should return an error when there is a swap with job name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+				pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				config.Jobs[0].Name = "new-job"
@@ -2114,31 +2191,31 @@ var _ = Describe("Team", func() {
 				config.Jobs[1].Name = "some-job"
 				config.Jobs[1].OldName = "new-job"
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).To(HaveOccurred())
 			})
 
 			Context("when new job name is in database but is inactive", func() {
 				It("should successfully update job name", func() {
-					pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+					pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs = config.Jobs[:len(config.Jobs)-1]
 
-					_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+					_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs[0].Name = "new-job"
 					config.Jobs[0].OldName = "some-job"
 
-					_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion()+1, false)
+					_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion()+1, false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
 		})
 
 		It("removes task caches for jobs that are no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2154,7 +2231,7 @@ var _ = Describe("Team", func() {
 
 			config.Jobs = []atc.JobConfig{}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2163,7 +2240,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes task caches for tasks that are no longer exist", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2189,7 +2266,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2198,10 +2275,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("should not remove task caches in other pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline("other-pipeline", config, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2238,7 +2315,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2251,7 +2328,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the serial groups from the jobs in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			serialGroups := []SerialGroup{}
@@ -2282,7 +2359,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("saves tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, otherConfig, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2293,7 +2370,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineName, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, otherConfig, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2313,7 +2390,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err = team.SavePipeline(pipelineName, otherConfig, savedPipeline.ConfigVersion(), false)
+			savedPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, otherConfig, savedPipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = savedPipeline.Job("some-other-job")
@@ -2324,10 +2401,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("it returns created as false when updated", func() {
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			_, created, err := team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, created, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeFalse())
 		})
@@ -2424,7 +2501,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, true)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, true, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			rows, err := psql.Select("name", "job_id", "resource_id", "passed_job_id").
@@ -2572,7 +2649,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			rows, err = psql.Select("name", "job_id", "resource_id", "passed_job_id").
@@ -2615,49 +2692,192 @@ var _ = Describe("Team", func() {
 			))
 		})
 
+		Context("when a passed constraint names a job in another pipeline", func() {
+			var downstreamConfig atc.Config
+
+			BeforeEach(func() {
+				downstreamConfig = atc.Config{
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+					Jobs: atc.JobConfigs{
+						{
+							Name: "downstream-job",
+							Plan: atc.PlanSequence{
+								{
+									Get:    "some-resource",
+									Passed: []string{"upstream-pipeline/upstream-job"},
+								},
+							},
+						},
+					},
+				}
+			})
+
+			upstreamConfig := atc.Config{
+				Resources: atc.ResourceConfigs{
+					{
+						Name: "some-resource",
+						Type: "some-type",
+					},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "upstream-job",
+						Plan: atc.PlanSequence{
+							{
+								Get: "some-resource",
+							},
+						},
+					},
+				},
+			}
+
+			It("saves the job as the input's passed job", func() {
+				upstreamPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, upstreamConfig, 0, false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+
+				upstreamJob, found, err := upstreamPipeline.Job("upstream-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				downstreamPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "downstream-pipeline"}, downstreamConfig, 0, false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+
+				downstreamJob, found, err := downstreamPipeline.Job("downstream-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				inputs, err := downstreamJob.AlgorithmInputs()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(inputs).To(HaveLen(1))
+				Expect(inputs[0].Passed).To(Equal(db.JobSet{upstreamJob.ID(): true}))
+			})
+
+			It("fails when the pipeline does not exist", func() {
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: "downstream-pipeline"}, downstreamConfig, 0, false, db.ConfigAuthor{})
+				Expect(err).To(Equal(db.PassedJobNotFoundError{Job: "upstream-pipeline/upstream-job"}))
+				Expect(err).To(MatchError("passed constraint references a job which does not exist in the team ('upstream-pipeline/upstream-job')"))
+			})
+
+			It("fails when the pipeline belongs to another team", func() {
+				_, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, upstreamConfig, 0, false, db.ConfigAuthor{})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "downstream-pipeline"}, downstreamConfig, 0, false, db.ConfigAuthor{})
+				Expect(err).To(Equal(db.PassedJobNotFoundError{Job: "upstream-pipeline/upstream-job"}))
+			})
+
+			Context("when the downstream pipeline has been saved", func() {
+				var upstreamPipeline db.Pipeline
+
+				BeforeEach(func() {
+					var err error
+					upstreamPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, upstreamConfig, 0, false, db.ConfigAuthor{})
+					Expect(err).ToNot(HaveOccurred())
+
+					_, _, err = team.SavePipeline(atc.PipelineRef{Name: "downstream-pipeline"}, downstreamConfig, 0, false, db.ConfigAuthor{})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				inUseErr := db.PassedJobInUseError{
+					Job:           "upstream-job",
+					Pipeline:      "downstream-pipeline",
+					DownstreamJob: "downstream-job",
+				}
+
+				It("fails to remove the upstream job", func() {
+					removedConfig := upstreamConfig
+					removedConfig.Jobs = atc.JobConfigs{}
+
+					_, _, err := team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, removedConfig, upstreamPipeline.ConfigVersion(), false, db.ConfigAuthor{})
+					Expect(err).To(Equal(inUseErr))
+				})
+
+				It("fails to rename the upstream job without its old name", func() {
+					renamedConfig := upstreamConfig
+					renamedConfig.Jobs = atc.JobConfigs{upstreamConfig.Jobs[0]}
+					renamedConfig.Jobs[0].Name = "renamed-job"
+
+					_, _, err := team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, renamedConfig, upstreamPipeline.ConfigVersion(), false, db.ConfigAuthor{})
+					Expect(err).To(Equal(inUseErr))
+				})
+
+				It("keeps the constraint when the upstream job is renamed with its old name", func() {
+					renamedConfig := upstreamConfig
+					renamedConfig.Jobs = atc.JobConfigs{upstreamConfig.Jobs[0]}
+					renamedConfig.Jobs[0].Name = "renamed-job"
+					renamedConfig.Jobs[0].OldName = "upstream-job"
+
+					_, _, err := team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, renamedConfig, upstreamPipeline.ConfigVersion(), false, db.ConfigAuthor{})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("fails to destroy the upstream pipeline", func() {
+					Expect(upstreamPipeline.Destroy()).To(Equal(inUseErr))
+
+					_, found, err := team.Pipeline(atc.PipelineRef{Name: "upstream-pipeline"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+
+				It("can destroy the upstream pipeline once the downstream pipeline is gone", func() {
+					downstreamPipeline, found, err := team.Pipeline(atc.PipelineRef{Name: "downstream-pipeline"})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					Expect(downstreamPipeline.Destroy()).To(Succeed())
+					Expect(upstreamPipeline.Destroy()).To(Succeed())
+				})
+			})
+		})
+
 		Context("updating an existing pipeline", func() {
 			It("maintains paused if the pipeline is paused", func() {
-				_, _, err := team.SavePipeline(pipelineName, config, 0, true)
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, true, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err := team.Pipeline(pipelineName)
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err = team.Pipeline(pipelineName)
+				pipeline, found, err = team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 			})
 
 			It("maintains unpaused if the pipeline is unpaused", func() {
-				_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+				_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err := team.Pipeline(pipelineName)
+				pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
 
-				_, _, err = team.SavePipeline(pipelineName, config, pipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, pipeline.ConfigVersion(), true, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
-				pipeline, found, err = team.Pipeline(pipelineName)
+				pipeline, found, err = team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
 			})
 
 			It("resets to unarchived", func() {
-				team.SavePipeline(pipelineName, config, 0, false)
-				pipeline, _, _ := team.Pipeline(pipelineName)
+				team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
+				pipeline, _, _ := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 				pipeline.Archive()
 
-				team.SavePipeline(pipelineName, config, db.ConfigVersion(0), true)
+				team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, db.ConfigVersion(0), true, db.ConfigAuthor{})
 				pipeline.Reload()
 				Expect(pipeline.Archived()).To(BeFalse(), "the pipeline remained archived")
 			})
@@ -2667,12 +2887,12 @@ var _ = Describe("Team", func() {
 			pipelineName := "a-pipeline-name"
 			otherPipelineName := "an-other-pipeline-name"
 
-			_, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			_, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
-			_, _, err = team.SavePipeline(otherPipelineName, otherConfig, 0, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, otherConfig, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline, found, err := team.Pipeline(pipelineName)
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: pipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 			Expect(pipeline.Name()).To(Equal(pipelineName))
@@ -2692,7 +2912,7 @@ var _ = Describe("Team", func() {
 				Jobs:          jobConfigs,
 			}, config)
 
-			otherPipeline, found, err := team.Pipeline(otherPipelineName)
+			otherPipeline, found, err := team.Pipeline(atc.PipelineRef{Name: otherPipelineName})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeTrue())
 			Expect(otherPipeline.Name()).To(Equal(otherPipelineName))
@@ -2719,10 +2939,10 @@ var _ = Describe("Team", func() {
 			otherPipelineName := "an-other-pipeline-name"
 
 			By("being able to save the config")
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(otherPipelineName, otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, otherConfig, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the saved config to later gets")
@@ -2796,22 +3016,22 @@ var _ = Describe("Team", func() {
 			})
 
 			By("not allowing non-sequential updates")
-			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, updatedConfig, pipeline.ConfigVersion()-1, false, db.ConfigAuthor{})
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, updatedConfig, pipeline.ConfigVersion()+10, false, db.ConfigAuthor{})
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, updatedConfig, otherPipeline.ConfigVersion()-1, false, db.ConfigAuthor{})
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, updatedConfig, otherPipeline.ConfigVersion()+10, false, db.ConfigAuthor{})
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
 			By("being able to update the config with a valid con")
-			pipeline, _, err = team.SavePipeline(pipelineName, updatedConfig, pipeline.ConfigVersion(), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: pipelineName}, updatedConfig, pipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline, _, err = team.SavePipeline(otherPipelineName, updatedConfig, otherPipeline.ConfigVersion(), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: otherPipelineName}, updatedConfig, otherPipeline.ConfigVersion(), false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the updated config")
@@ -2872,7 +3092,7 @@ var _ = Describe("Team", func() {
 
 			pipelineName := "a-pipeline-name"
 
-			pipeline, _, err := team.SavePipeline(pipelineName, config, 0, false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: pipelineName}, config, 0, false, db.ConfigAuthor{})
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceTypes, err := pipeline.ResourceTypes()
@@ -2890,27 +3110,27 @@ var _ = Describe("Team", func() {
 
 		Context("when there are multiple teams", func() {
 			It("can allow pipelines with the same name across teams", func() {
-				teamPipeline, _, err := team.SavePipeline("steve", config, 0, true)
+				teamPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "steve"}, config, 0, true, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(teamPipeline.Paused()).To(BeTrue())
 
 				By("allowing you to save a pipeline with the same name in another team")
-				otherTeamPipeline, _, err := otherTeam.SavePipeline("steve", otherConfig, 0, true)
+				otherTeamPipeline, _, err := otherTeam.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, 0, true, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 				Expect(otherTeamPipeline.Paused()).To(BeTrue())
 
 				By("updating the pipeline config for the correct team's pipeline")
-				teamPipeline, _, err = team.SavePipeline("steve", otherConfig, teamPipeline.ConfigVersion(), false)
+				teamPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, teamPipeline.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = otherTeam.SavePipeline("steve", config, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "steve"}, config, otherTeamPipeline.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).ToNot(HaveOccurred())
 
 				By("cannot cross update configs")
-				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, otherTeamPipeline.ConfigVersion(), false, db.ConfigAuthor{})
 				Expect(err).To(HaveOccurred())
 
-				_, _, err = team.SavePipeline("steve", otherConfig, otherTeamPipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(atc.PipelineRef{Name: "steve"}, otherConfig, otherTeamPipeline.ConfigVersion(), true, db.ConfigAuthor{})
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -2962,7 +3182,7 @@ var _ = Describe("Team", func() {
 					})
 
 					It("returns check container for resource", func() {
-						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, "default-pipeline", "some-resource", fakeSecretManager, fakeVarSourcePool)
+						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "default-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(containers).To(HaveLen(1))
 						Expect(containers[0].ID()).To(Equal(resourceContainer.ID()))
@@ -2980,7 +3200,7 @@ var _ = Describe("Team", func() {
 						)
 
 						BeforeEach(func() {
-							otherPipeline, _, err = defaultTeam.SavePipeline("other-pipeline", atc.Config{
+							otherPipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -2990,7 +3210,7 @@ var _ = Describe("Team", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(0), false)
+							}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 							Expect(err).NotTo(HaveOccurred())
 
 							otherResource, found, err = otherPipeline.Resource("some-resource")
@@ -3015,7 +3235,7 @@ var _ = Describe("Team", func() {
 						})
 
 						It("returns the same check container", func() {
-							containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, "other-pipeline", "some-resource", fakeSecretManager, fakeVarSourcePool)
+							containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "other-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 							Expect(err).ToNot(HaveOccurred())
 							Expect(containers).To(HaveLen(1))
 							Expect(containers[0].ID()).To(Equal(otherResourceContainer.ID()))
@@ -3028,7 +3248,7 @@ var _ = Describe("Team", func() {
 
 				Context("when check container does not exist", func() {
 					It("returns empty list", func() {
-						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, "default-pipeline", "some-resource", fakeSecretManager, fakeVarSourcePool)
+						containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "default-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 						Expect(err).ToNot(HaveOccurred())
 						Expect(containers).To(BeEmpty())
 						Expect(checkContainersExpiresAt).To(BeEmpty())
@@ -3038,7 +3258,7 @@ var _ = Describe("Team", func() {
 
 			Context("when resource does not exist", func() {
 				It("returns empty list", func() {
-					containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, "default-pipeline", "non-existent-resource", fakeSecretManager, fakeVarSourcePool)
+					containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "default-pipeline"}, "non-existent-resource", fakeSecretManager, fakeVarSourcePool)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(containers).To(BeEmpty())
 					Expect(checkContainersExpiresAt).To(BeEmpty())
@@ -3048,7 +3268,7 @@ var _ = Describe("Team", func() {
 
 		Context("when pipeline does not exist", func() {
 			It("returns empty list", func() {
-				containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, "non-existent-pipeline", "some-resource", fakeSecretManager, fakeVarSourcePool)
+				containers, checkContainersExpiresAt, err := defaultTeam.FindCheckContainers(logger, atc.PipelineRef{Name: "non-existent-pipeline"}, "some-resource", fakeSecretManager, fakeVarSourcePool)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(containers).To(BeEmpty())
 				Expect(checkContainersExpiresAt).To(BeEmpty())
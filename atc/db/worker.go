@@ -46,6 +46,23 @@ func AllWorkerStates() []WorkerState {
 	}
 }
 
+// WorkerCordon is a window of time during which a worker is not given any new
+// containers. A zero EndTime means the worker stays cordoned until it is
+// uncordoned.
+type WorkerCordon struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// ActiveAt returns whether the worker is cordoned at the given time.
+func (cordon WorkerCordon) ActiveAt(t time.Time) bool {
+	if t.Before(cordon.StartTime) {
+		return false
+	}
+
+	return cordon.EndTime.IsZero() || t.Before(cordon.EndTime)
+}
+
 //go:generate counterfeiter . Worker
 
 type Worker interface {
@@ -69,6 +86,7 @@ type Worker interface {
 	StartTime() time.Time
 	ExpiresAt() time.Time
 	Ephemeral() bool
+	CordonWindow() (WorkerCordon, bool)
 
 	Reload() (bool, error)
 
@@ -77,10 +95,20 @@ type Worker interface {
 	Prune() error
 	Delete() error
 
+	Cordon(WorkerCordon) error
+	Uncordon() error
+
 	ActiveTasks() (int, error)
 	IncreaseActiveTasks() error
 	DecreaseActiveTasks() error
 
+	AllocatableCPU() uint64
+	AllocatableMemory() uint64
+	Usage() *atc.WorkerUsage
+	AllocatedResources() (cpu uint64, memory uint64, err error)
+	IncreaseAllocatedResources(atc.ContainerLimits) error
+	DecreaseAllocatedResources(atc.ContainerLimits) error
+
 	FindContainer(owner ContainerOwner) (CreatingContainer, CreatedContainer, error)
 	CreateContainer(owner ContainerOwner, meta ContainerMetadata) (CreatingContainer, error)
 }
@@ -88,26 +116,30 @@ type Worker interface {
 type worker struct {
 	conn Conn
 
-	name             string
-	version          *string
-	state            WorkerState
-	gardenAddr       *string
-	baggageclaimURL  *string
-	httpProxyURL     string
-	httpsProxyURL    string
-	noProxy          string
-	activeContainers int
-	activeVolumes    int
-	activeTasks      int
-	resourceTypes    []atc.WorkerResourceType
-	platform         string
-	tags             []string
-	teamID           int
-	teamName         string
-	startTime        time.Time
-	expiresAt        time.Time
-	certsPath        *string
-	ephemeral        bool
+	name              string
+	version           *string
+	state             WorkerState
+	gardenAddr        *string
+	baggageclaimURL   *string
+	httpProxyURL      string
+	httpsProxyURL     string
+	noProxy           string
+	activeContainers  int
+	activeVolumes     int
+	activeTasks       int
+	allocatableCPU    uint64
+	allocatableMemory uint64
+	usage             *atc.WorkerUsage
+	resourceTypes     []atc.WorkerResourceType
+	platform          string
+	tags              []string
+	teamID            int
+	teamName          string
+	startTime         time.Time
+	expiresAt         time.Time
+	certsPath         *string
+	ephemeral         bool
+	cordon            *WorkerCordon
 }
 
 func (worker *worker) Name() string             { return worker.name }
@@ -122,6 +154,9 @@ func (worker *worker) HTTPSProxyURL() string                   { return worker.h
 func (worker *worker) NoProxy() string                         { return worker.noProxy }
 func (worker *worker) ActiveContainers() int                   { return worker.activeContainers }
 func (worker *worker) ActiveVolumes() int                      { return worker.activeVolumes }
+func (worker *worker) AllocatableCPU() uint64                  { return worker.allocatableCPU }
+func (worker *worker) AllocatableMemory() uint64               { return worker.allocatableMemory }
+func (worker *worker) Usage() *atc.WorkerUsage                 { return worker.usage }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
@@ -132,6 +167,14 @@ func (worker *worker) Ephemeral() bool                         { return worker.e
 func (worker *worker) StartTime() time.Time { return worker.startTime }
 func (worker *worker) ExpiresAt() time.Time { return worker.expiresAt }
 
+func (worker *worker) CordonWindow() (WorkerCordon, bool) {
+	if worker.cordon == nil {
+		return WorkerCordon{}, false
+	}
+
+	return *worker.cordon, true
+}
+
 func (worker *worker) Reload() (bool, error) {
 	row := workersQuery.Where(sq.Eq{"w.name": worker.name}).
 		RunWith(worker.conn).
@@ -203,6 +246,66 @@ func (worker *worker) Retire() error {
 	return nil
 }
 
+// Cordon stops the worker from being given new containers during the
+// cordon's window, replacing any previous window. A zero StartTime starts it
+// right away.
+func (worker *worker) Cordon(cordon WorkerCordon) error {
+	var start, end interface{} = cordon.StartTime, nil
+	if cordon.StartTime.IsZero() {
+		start = sq.Expr("now()")
+	}
+
+	if !cordon.EndTime.IsZero() {
+		end = cordon.EndTime
+	}
+
+	result, err := psql.Update("workers").
+		Set("cordon_start", start).
+		Set("cordon_end", end).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	return nil
+}
+
+func (worker *worker) Uncordon() error {
+	result, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"cordon_start": nil,
+			"cordon_end":   nil,
+		}).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	return nil
+}
+
 func (worker *worker) Prune() error {
 	tx, err := worker.conn.Begin()
 	if err != nil {
@@ -324,6 +427,13 @@ func (worker *worker) CreateContainer(owner ContainerOwner, meta ContainerMetada
 		insMap[k] = v
 	}
 
+	if teamID, ok := ownerCols["team_id"].(int); ok && meta.Type == ContainerTypeTask {
+		err = reserveTaskContainer(tx, teamID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = psql.Insert("containers").
 		SetMap(insMap).
 		Suffix("RETURNING id, " + strings.Join(containerMetadataColumns, ", ")).
@@ -428,3 +538,67 @@ func (worker *worker) DecreaseActiveTasks() error {
 
 	return nil
 }
+
+func (worker *worker) AllocatedResources() (uint64, uint64, error) {
+	var cpu, memory uint64
+	err := psql.Select("allocated_cpu", "allocated_memory").From("workers").Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		QueryRow().
+		Scan(&cpu, &memory)
+	if err != nil {
+		return 0, 0, err
+	}
+	return cpu, memory, nil
+}
+
+func (worker *worker) IncreaseAllocatedResources(limits atc.ContainerLimits) error {
+	cpu, memory := limitValues(limits)
+
+	return worker.updateAllocatedResources(
+		sq.Expr("allocated_cpu+?", cpu),
+		sq.Expr("allocated_memory+?", memory),
+	)
+}
+
+func (worker *worker) DecreaseAllocatedResources(limits atc.ContainerLimits) error {
+	cpu, memory := limitValues(limits)
+
+	return worker.updateAllocatedResources(
+		sq.Expr("GREATEST(allocated_cpu-?, 0)", cpu),
+		sq.Expr("GREATEST(allocated_memory-?, 0)", memory),
+	)
+}
+
+func (worker *worker) updateAllocatedResources(cpu sq.Sqlizer, memory sq.Sqlizer) error {
+	result, err := psql.Update("workers").
+		Set("allocated_cpu", cpu).
+		Set("allocated_memory", memory).
+		Where(sq.Eq{"name": worker.name}).
+		RunWith(worker.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if count == 0 {
+		return ErrWorkerNotPresent
+	}
+
+	return nil
+}
+
+func limitValues(limits atc.ContainerLimits) (uint64, uint64) {
+	var cpu, memory uint64
+	if limits.CPU != nil {
+		cpu = *limits.CPU
+	}
+	if limits.Memory != nil {
+		memory = *limits.Memory
+	}
+	return cpu, memory
+}
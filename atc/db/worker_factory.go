@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -23,6 +24,9 @@ type WorkerFactory interface {
 
 	FindWorkersForContainerByOwner(ContainerOwner) ([]Worker, error)
 	BuildContainersCountPerWorker() (map[string]int, error)
+
+	CordonTaggedWorkers(tags []string, cordon WorkerCordon) error
+	UncordonTaggedWorkers(tags []string) (bool, error)
 }
 
 type workerFactory struct {
@@ -47,6 +51,9 @@ var workersQuery = psql.Select(`
 		w.no_proxy,
 		w.active_containers,
 		w.active_volumes,
+		w.allocatable_cpu,
+		w.allocatable_memory,
+		w.usage,
 		w.resource_types,
 		w.platform,
 		w.tags,
@@ -54,10 +61,28 @@ var workersQuery = psql.Select(`
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		cordon.cordon_start,
+		cordon.cordon_end
 	`).
 	From("workers w").
-	LeftJoin("teams t ON w.team_id = t.id")
+	LeftJoin("teams t ON w.team_id = t.id").
+	// the worker's cordon is its own or that of any of its tags, preferring
+	// one that's in effect over one that's yet to start
+	LeftJoin(`LATERAL (
+		SELECT c.cordon_start, c.cordon_end
+		FROM (
+			SELECT w.cordon_start, w.cordon_end
+			WHERE w.cordon_start IS NOT NULL
+			UNION ALL
+			SELECT tc.cordon_start, tc.cordon_end
+			FROM worker_tag_cordons tc
+			WHERE COALESCE(NULLIF(w.tags, ''), 'null')::jsonb @> to_jsonb(tc.tags)
+		) c
+		WHERE c.cordon_end IS NULL OR c.cordon_end > now()
+		ORDER BY (c.cordon_start <= now()) DESC, c.cordon_start
+		LIMIT 1
+	) cordon ON true`)
 
 func (f *workerFactory) GetWorker(name string) (Worker, bool, error) {
 	return getWorker(f.conn, workersQuery.Where(sq.Eq{"w.name": name}))
@@ -140,6 +165,9 @@ func scanWorker(worker *worker, row scannable) error {
 		startTime     pq.NullTime
 		expiresAt     pq.NullTime
 		ephemeral     sql.NullBool
+		usage         []byte
+		cordonStart   pq.NullTime
+		cordonEnd     pq.NullTime
 	)
 
 	err := row.Scan(
@@ -154,6 +182,9 @@ func scanWorker(worker *worker, row scannable) error {
 		&noProxy,
 		&worker.activeContainers,
 		&worker.activeVolumes,
+		&worker.allocatableCPU,
+		&worker.allocatableMemory,
+		&usage,
 		&resourceTypes,
 		&platform,
 		&tags,
@@ -162,6 +193,8 @@ func scanWorker(worker *worker, row scannable) error {
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&cordonStart,
+		&cordonEnd,
 	)
 	if err != nil {
 		return err
@@ -215,6 +248,21 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.ephemeral = ephemeral.Bool
 	}
 
+	worker.cordon = nil
+	if cordonStart.Valid {
+		worker.cordon = &WorkerCordon{
+			StartTime: cordonStart.Time,
+			EndTime:   cordonEnd.Time,
+		}
+	}
+
+	if usage != nil {
+		err = json.Unmarshal(usage, &worker.usage)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = json.Unmarshal(resourceTypes, &worker.resourceTypes)
 	if err != nil {
 		return err
@@ -223,6 +271,68 @@ func scanWorker(worker *worker, row scannable) error {
 	return json.Unmarshal(tags, &worker.tags)
 }
 
+// CordonTaggedWorkers cordons every worker that has all of the tags, including
+// workers that register later on, replacing any previous cordon of the same
+// tags. A zero StartTime starts it right away.
+func (f *workerFactory) CordonTaggedWorkers(tags []string, cordon WorkerCordon) error {
+	var start, end interface{} = cordon.StartTime, nil
+	if cordon.StartTime.IsZero() {
+		start = sq.Expr("now()")
+	}
+
+	if !cordon.EndTime.IsZero() {
+		end = cordon.EndTime
+	}
+
+	_, err := psql.Insert("worker_tag_cordons").
+		Columns("tags", "cordon_start", "cordon_end").
+		Values(pq.Array(normalizeTags(tags)), start, end).
+		Suffix(`
+			ON CONFLICT (tags) DO UPDATE SET
+				cordon_start = EXCLUDED.cordon_start,
+				cordon_end = EXCLUDED.cordon_end
+		`).
+		RunWith(f.conn).
+		Exec()
+	return err
+}
+
+// UncordonTaggedWorkers removes the cordon of the tags, returning whether
+// there was one. Workers that are cordoned on their own, or through other
+// tags, stay cordoned.
+func (f *workerFactory) UncordonTaggedWorkers(tags []string) (bool, error) {
+	result, err := psql.Delete("worker_tag_cordons").
+		Where(sq.Eq{"tags": pq.Array(normalizeTags(tags))}).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	count, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// normalizeTags sorts the tags and drops duplicates, so that the same tags
+// given in a different order are the same cordon.
+func normalizeTags(tags []string) []string {
+	sorted := append([]string{}, tags...)
+	sort.Strings(sorted)
+
+	normalized := []string{}
+	for _, tag := range sorted {
+		if len(normalized) == 0 || normalized[len(normalized)-1] != tag {
+			normalized = append(normalized, tag)
+		}
+	}
+
+	return normalized
+}
+
 func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error) {
 	// In order to be able to calculate the ttl that we return to the caller
 	// we must compare time.Now() to the worker.expires column
@@ -252,10 +362,18 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		return nil, err
 	}
 
+	usage, err := marshalWorkerUsage(atcWorker.Usage)
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = psql.Update("workers").
 		Set("expires", sq.Expr(expires)).
 		Set("active_containers", atcWorker.ActiveContainers).
 		Set("active_volumes", atcWorker.ActiveVolumes).
+		Set("allocatable_cpu", atcWorker.AllocatableCPU).
+		Set("allocatable_memory", atcWorker.AllocatableMemory).
+		Set("usage", usage).
 		Set("state", sq.Expr("("+cSQL+")")).
 		Where(sq.Eq{"name": atcWorker.Name}).
 		RunWith(tx).
@@ -375,6 +493,11 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	usage, err := marshalWorkerUsage(atcWorker.Usage)
+	if err != nil {
+		return nil, err
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -398,6 +521,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.GardenAddr,
 		atcWorker.ActiveContainers,
 		atcWorker.ActiveVolumes,
+		atcWorker.AllocatableCPU,
+		atcWorker.AllocatableMemory,
+		usage,
 		resourceTypes,
 		tags,
 		atcWorker.Platform,
@@ -429,6 +555,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"addr",
 			"active_containers",
 			"active_volumes",
+			"allocatable_cpu",
+			"allocatable_memory",
+			"usage",
 			"resource_types",
 			"tags",
 			"platform",
@@ -454,6 +583,9 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				addr = ?,
 				active_containers = ?,
 				active_volumes = ?,
+				allocatable_cpu = ?,
+				allocatable_memory = ?,
+				usage = ?,
 				resource_types = ?,
 				tags = ?,
 				platform = ?,
@@ -491,25 +623,28 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 	}
 
 	savedWorker := &worker{
-		name:             atcWorker.Name,
-		version:          workerVersion,
-		state:            workerState,
-		gardenAddr:       &atcWorker.GardenAddr,
-		baggageclaimURL:  &atcWorker.BaggageclaimURL,
-		certsPath:        atcWorker.CertsPath,
-		httpProxyURL:     atcWorker.HTTPProxyURL,
-		httpsProxyURL:    atcWorker.HTTPSProxyURL,
-		noProxy:          atcWorker.NoProxy,
-		activeContainers: atcWorker.ActiveContainers,
-		activeVolumes:    atcWorker.ActiveVolumes,
-		resourceTypes:    atcWorker.ResourceTypes,
-		platform:         atcWorker.Platform,
-		tags:             atcWorker.Tags,
-		teamName:         atcWorker.Team,
-		teamID:           workerTeamID,
-		startTime:        time.Unix(atcWorker.StartTime, 0),
-		ephemeral:        atcWorker.Ephemeral,
-		conn:             conn,
+		name:              atcWorker.Name,
+		version:           workerVersion,
+		state:             workerState,
+		gardenAddr:        &atcWorker.GardenAddr,
+		baggageclaimURL:   &atcWorker.BaggageclaimURL,
+		certsPath:         atcWorker.CertsPath,
+		httpProxyURL:      atcWorker.HTTPProxyURL,
+		httpsProxyURL:     atcWorker.HTTPSProxyURL,
+		noProxy:           atcWorker.NoProxy,
+		activeContainers:  atcWorker.ActiveContainers,
+		activeVolumes:     atcWorker.ActiveVolumes,
+		allocatableCPU:    atcWorker.AllocatableCPU,
+		allocatableMemory: atcWorker.AllocatableMemory,
+		usage:             atcWorker.Usage,
+		resourceTypes:     atcWorker.ResourceTypes,
+		platform:          atcWorker.Platform,
+		tags:              atcWorker.Tags,
+		teamName:          atcWorker.Team,
+		teamID:            workerTeamID,
+		startTime:         time.Unix(atcWorker.StartTime, 0),
+		ephemeral:         atcWorker.Ephemeral,
+		conn:              conn,
 	}
 
 	workerBaseResourceTypeIDs := []int{}
@@ -557,3 +692,18 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 
 	return savedWorker, nil
 }
+
+// marshalWorkerUsage returns the usage as JSON, or nil for storing NULL when
+// the worker didn't report any.
+func marshalWorkerUsage(usage *atc.WorkerUsage) (interface{}, error) {
+	if usage == nil {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(usage)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(payload), nil
+}
@@ -28,6 +28,17 @@ var _ = Describe("WorkerFactory", func() {
 			Ephemeral:        true,
 			ActiveContainers: 140,
 			ActiveVolumes:    550,
+
+			AllocatableCPU:    4096,
+			AllocatableMemory: 8589934592,
+			Usage: &atc.WorkerUsage{
+				CPU:            1500,
+				Memory:         1024,
+				MemoryCapacity: 8589934592,
+				Containers: []atc.ContainerUsage{
+					{Handle: "some-handle", CPU: 1500, Memory: 1024},
+				},
+			},
 			ResourceTypes: []atc.WorkerResourceType{
 				{
 					Type:       "some-resource-type",
@@ -227,6 +238,16 @@ var _ = Describe("WorkerFactory", func() {
 				Expect(foundWorker.Ephemeral()).To(Equal(true))
 				Expect(foundWorker.ActiveContainers()).To(Equal(140))
 				Expect(foundWorker.ActiveVolumes()).To(Equal(550))
+				Expect(foundWorker.AllocatableCPU()).To(Equal(uint64(4096)))
+				Expect(foundWorker.AllocatableMemory()).To(Equal(uint64(8589934592)))
+				Expect(foundWorker.Usage()).To(Equal(&atc.WorkerUsage{
+					CPU:            1500,
+					Memory:         1024,
+					MemoryCapacity: 8589934592,
+					Containers: []atc.ContainerUsage{
+						{Handle: "some-handle", CPU: 1500, Memory: 1024},
+					},
+				}))
 				Expect(foundWorker.ResourceTypes()).To(Equal([]atc.WorkerResourceType{
 					{
 						Type:       "some-resource-type",
@@ -388,6 +409,144 @@ var _ = Describe("WorkerFactory", func() {
 		})
 	})
 
+	Describe("CordonTaggedWorkers", func() {
+		var otherWorker db.Worker
+
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherAtcWorker := atcWorker
+			otherAtcWorker.Name = "some-other-worker"
+			otherAtcWorker.Tags = atc.Tags{"some"}
+			otherWorker, err = workerFactory.SaveWorker(otherAtcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		cordoned := func(worker db.Worker) bool {
+			found, err := worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			cordon, cordoned := worker.CordonWindow()
+			return cordoned && cordon.ActiveAt(time.Now())
+		}
+
+		It("cordons the workers with all of the tags right away by default", func() {
+			err := workerFactory.CordonTaggedWorkers([]string{"tags", "some"}, db.WorkerCordon{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cordoned(worker)).To(BeTrue())
+			Expect(cordoned(otherWorker)).To(BeFalse())
+
+			cordon, _ := worker.CordonWindow()
+			Expect(cordon.StartTime).To(BeTemporally("~", time.Now(), time.Minute))
+			Expect(cordon.EndTime).To(BeZero())
+		})
+
+		It("cordons workers that register with the tags later on", func() {
+			err := workerFactory.CordonTaggedWorkers([]string{"tags"}, db.WorkerCordon{})
+			Expect(err).NotTo(HaveOccurred())
+
+			newAtcWorker := atcWorker
+			newAtcWorker.Name = "some-new-worker"
+			newWorker, err := workerFactory.SaveWorker(newAtcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, cordoned := newWorker.CordonWindow()
+			Expect(cordoned).To(BeTrue())
+		})
+
+		It("stops applying to a worker that re-registers without the tags", func() {
+			err := workerFactory.CordonTaggedWorkers([]string{"tags"}, db.WorkerCordon{})
+			Expect(err).NotTo(HaveOccurred())
+
+			untaggedAtcWorker := atcWorker
+			untaggedAtcWorker.Tags = nil
+			worker, err = workerFactory.SaveWorker(untaggedAtcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, cordoned := worker.CordonWindow()
+			Expect(cordoned).To(BeFalse())
+		})
+
+		It("replaces the window when the same tags are cordoned again", func() {
+			start := time.Now().Add(time.Hour).Truncate(time.Second)
+			end := start.Add(time.Hour)
+
+			err := workerFactory.CordonTaggedWorkers([]string{"some"}, db.WorkerCordon{})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = workerFactory.CordonTaggedWorkers([]string{"some", "some"}, db.WorkerCordon{StartTime: start, EndTime: end})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cordoned(otherWorker)).To(BeFalse())
+
+			cordon, found := otherWorker.CordonWindow()
+			Expect(found).To(BeTrue())
+			Expect(cordon.StartTime).To(BeTemporally("==", start))
+			Expect(cordon.EndTime).To(BeTemporally("==", end))
+		})
+
+		It("prefers a cordon that's in effect over one that's yet to start", func() {
+			err := worker.Cordon(db.WorkerCordon{StartTime: time.Now().Add(time.Hour)})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = workerFactory.CordonTaggedWorkers([]string{"some"}, db.WorkerCordon{})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(cordoned(worker)).To(BeTrue())
+		})
+
+		It("ignores cordons that have ended", func() {
+			err := workerFactory.CordonTaggedWorkers([]string{"some"}, db.WorkerCordon{
+				StartTime: time.Now().Add(-2 * time.Hour),
+				EndTime:   time.Now().Add(-time.Hour),
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = worker.Reload()
+			Expect(err).NotTo(HaveOccurred())
+
+			_, cordoned := worker.CordonWindow()
+			Expect(cordoned).To(BeFalse())
+		})
+
+		Describe("UncordonTaggedWorkers", func() {
+			BeforeEach(func() {
+				err := workerFactory.CordonTaggedWorkers([]string{"some", "tags"}, db.WorkerCordon{})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("removes the cordon of the tags", func() {
+				found, err := workerFactory.UncordonTaggedWorkers([]string{"tags", "some"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				Expect(cordoned(worker)).To(BeFalse())
+			})
+
+			It("leaves workers cordoned on their own alone", func() {
+				err := worker.Cordon(db.WorkerCordon{})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = workerFactory.UncordonTaggedWorkers([]string{"some", "tags"})
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(cordoned(worker)).To(BeTrue())
+			})
+
+			It("returns false when the tags aren't cordoned", func() {
+				found, err := workerFactory.UncordonTaggedWorkers([]string{"some"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+
+				Expect(cordoned(worker)).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("HeartbeatWorker", func() {
 		var (
 			ttl              time.Duration
@@ -415,6 +574,7 @@ var _ = Describe("WorkerFactory", func() {
 			It("updates the expires field, and the number of active containers and volumes", func() {
 				atcWorker.ActiveContainers = 1
 				atcWorker.ActiveVolumes = 3
+				atcWorker.AllocatableMemory = 1024
 
 				now := time.Now()
 				By("current time")
@@ -431,10 +591,25 @@ var _ = Describe("WorkerFactory", func() {
 				Expect(foundWorker.ExpiresAt()).To(BeTemporally("~", later, epsilon))
 				Expect(foundWorker.ActiveContainers()).To(And(Not(Equal(activeContainers)), Equal(1)))
 				Expect(foundWorker.ActiveVolumes()).To(And(Not(Equal(activeVolumes)), Equal(3)))
+				Expect(foundWorker.AllocatableMemory()).To(Equal(uint64(1024)))
 				Expect(*foundWorker.GardenAddr()).To(Equal("some-garden-addr"))
 				Expect(*foundWorker.BaggageclaimURL()).To(Equal("some-bc-url"))
 			})
 
+			It("replaces the usage", func() {
+				atcWorker.Usage = &atc.WorkerUsage{CPU: 250, Memory: 2048}
+
+				foundWorker, err := workerFactory.HeartbeatWorker(atcWorker, ttl)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(foundWorker.Usage()).To(Equal(&atc.WorkerUsage{CPU: 250, Memory: 2048}))
+
+				atcWorker.Usage = nil
+
+				foundWorker, err = workerFactory.HeartbeatWorker(atcWorker, ttl)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(foundWorker.Usage()).To(BeNil())
+			})
+
 			Context("when the current state is landing", func() {
 				BeforeEach(func() {
 					atcWorker.State = string(db.WorkerStateLanding)
@@ -570,7 +745,7 @@ var _ = Describe("WorkerFactory", func() {
 					}
 
 					var err error
-					otherPipeline, _, err := defaultTeam.SavePipeline("other-pipeline", atc.Config{
+					otherPipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 						Resources: atc.ResourceConfigs{
 							{
 								Name: "some-resource",
@@ -580,7 +755,7 @@ var _ = Describe("WorkerFactory", func() {
 								},
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 					Expect(err).NotTo(HaveOccurred())
 
 					taggedWorkerSpec := atc.Worker{
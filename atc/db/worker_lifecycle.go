@@ -13,6 +13,7 @@ type WorkerLifecycle interface {
 	StallUnresponsiveWorkers() ([]string, error)
 	LandFinishedLandingWorkers() ([]string, error)
 	DeleteFinishedRetiringWorkers() ([]string, error)
+	UncordonExpiredWorkers() ([]string, error)
 	GetWorkerStateByName() (map[string]WorkerState, error)
 }
 
@@ -167,6 +168,38 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 	return workersAffected(rows)
 }
 
+// UncordonExpiredWorkers lifts the cordons whose window has ended, returning
+// the names of the workers that were cordoned on their own. Cordons of tags
+// that have ended are removed too.
+func (lifecycle *workerLifecycle) UncordonExpiredWorkers() ([]string, error) {
+	_, err := psql.Delete("worker_tag_cordons").
+		Where(sq.Expr("cordon_end < NOW()")).
+		RunWith(lifecycle.conn).
+		Exec()
+	if err != nil {
+		return nil, err
+	}
+
+	query, args, err := psql.Update("workers").
+		SetMap(map[string]interface{}{
+			"cordon_start": nil,
+			"cordon_end":   nil,
+		}).
+		Where(sq.Expr("cordon_end < NOW()")).
+		Suffix("RETURNING name").
+		ToSql()
+	if err != nil {
+		return []string{}, err
+	}
+
+	rows, err := lifecycle.conn.Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return workersAffected(rows)
+}
+
 func (lifecycle *workerLifecycle) GetWorkerStateByName() (map[string]WorkerState, error) {
 	rows, err := psql.Select(`
 		name,
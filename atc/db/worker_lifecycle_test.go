@@ -224,14 +224,14 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with uninterruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
 								Interruptible: false,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -256,14 +256,14 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with interruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
 								Interruptible: true,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -471,14 +471,14 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with uninterruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
 								Interruptible: false,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -503,14 +503,14 @@ var _ = Describe("Worker Lifecycle", func() {
 
 			Context("when worker has build with interruptible job", func() {
 				BeforeEach(func() {
-					pipeline, created, err := defaultTeam.SavePipeline("some-pipeline", atc.Config{
+					pipeline, created, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name:          "some-job",
 								Interruptible: true,
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, db.ConfigAuthor{})
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -553,6 +553,86 @@ var _ = Describe("Worker Lifecycle", func() {
 		})
 	})
 
+	Describe("UncordonExpiredWorkers", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("when the worker's cordon has not ended", func() {
+			BeforeEach(func() {
+				err := worker.Cordon(db.WorkerCordon{EndTime: time.Now().Add(time.Hour)})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("leaves the worker alone", func() {
+				uncordonedWorkers, err := workerLifecycle.UncordonExpiredWorkers()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(uncordonedWorkers).To(BeEmpty())
+			})
+		})
+
+		Context("when the worker is cordoned indefinitely", func() {
+			BeforeEach(func() {
+				err := worker.Cordon(db.WorkerCordon{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("leaves the worker alone", func() {
+				uncordonedWorkers, err := workerLifecycle.UncordonExpiredWorkers()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(uncordonedWorkers).To(BeEmpty())
+			})
+		})
+
+		Context("when the worker's cordon has ended", func() {
+			BeforeEach(func() {
+				err := worker.Cordon(db.WorkerCordon{
+					StartTime: time.Now().Add(-2 * time.Hour),
+					EndTime:   time.Now().Add(-time.Hour),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("uncordons the worker", func() {
+				uncordonedWorkers, err := workerLifecycle.UncordonExpiredWorkers()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(uncordonedWorkers).To(Equal([]string{"some-name"}))
+
+				_, err = worker.Reload()
+				Expect(err).ToNot(HaveOccurred())
+
+				_, cordoned := worker.CordonWindow()
+				Expect(cordoned).To(BeFalse())
+			})
+		})
+
+		Context("when the cordon of the worker's tags has ended", func() {
+			BeforeEach(func() {
+				err := workerFactory.CordonTaggedWorkers(atcWorker.Tags, db.WorkerCordon{
+					StartTime: time.Now().Add(-2 * time.Hour),
+					EndTime:   time.Now().Add(-time.Hour),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("removes the cordon", func() {
+				_, err := workerLifecycle.UncordonExpiredWorkers()
+				Expect(err).ToNot(HaveOccurred())
+
+				var count int
+				err = psql.Select("COUNT(*)").
+					From("worker_tag_cordons").
+					RunWith(dbConn).
+					QueryRow().
+					Scan(&count)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(BeZero())
+			})
+		})
+	})
+
 	Describe("GetWorkersState", func() {
 
 		JustBeforeEach(func() {
@@ -131,6 +131,86 @@ var _ = Describe("Worker", func() {
 		})
 	})
 
+	Describe("Cordon", func() {
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the worker is present", func() {
+			It("cordons the worker right away by default", func() {
+				err := worker.Cordon(WorkerCordon{})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+
+				cordon, cordoned := worker.CordonWindow()
+				Expect(cordoned).To(BeTrue())
+				Expect(cordon.StartTime).To(BeTemporally("~", time.Now(), time.Minute))
+				Expect(cordon.EndTime).To(BeZero())
+				Expect(cordon.ActiveAt(time.Now())).To(BeTrue())
+			})
+
+			It("saves the cordon's window", func() {
+				start := time.Now().Add(time.Hour).Truncate(time.Second)
+				end := start.Add(time.Hour)
+
+				err := worker.Cordon(WorkerCordon{StartTime: start, EndTime: end})
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+
+				cordon, cordoned := worker.CordonWindow()
+				Expect(cordoned).To(BeTrue())
+				Expect(cordon.StartTime).To(BeTemporally("==", start))
+				Expect(cordon.EndTime).To(BeTemporally("==", end))
+				Expect(cordon.ActiveAt(time.Now())).To(BeFalse())
+				Expect(cordon.ActiveAt(start.Add(time.Minute))).To(BeTrue())
+				Expect(cordon.ActiveAt(end)).To(BeFalse())
+			})
+
+			It("is kept when the worker heartbeats", func() {
+				err := worker.Cordon(WorkerCordon{})
+				Expect(err).NotTo(HaveOccurred())
+
+				worker, err = workerFactory.HeartbeatWorker(atcWorker, 5*time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, cordoned := worker.CordonWindow()
+				Expect(cordoned).To(BeTrue())
+			})
+
+			It("can be uncordoned", func() {
+				err := worker.Cordon(WorkerCordon{})
+				Expect(err).NotTo(HaveOccurred())
+
+				err = worker.Uncordon()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = worker.Reload()
+				Expect(err).NotTo(HaveOccurred())
+
+				_, cordoned := worker.CordonWindow()
+				Expect(cordoned).To(BeFalse())
+			})
+		})
+
+		Context("when the worker is not present", func() {
+			BeforeEach(func() {
+				err := worker.Delete()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("returns an error", func() {
+				Expect(worker.Cordon(WorkerCordon{})).To(Equal(ErrWorkerNotPresent))
+				Expect(worker.Uncordon()).To(Equal(ErrWorkerNotPresent))
+			})
+		})
+	})
+
 	Describe("Delete", func() {
 		BeforeEach(func() {
 			var err error
@@ -436,4 +516,70 @@ var _ = Describe("Worker", func() {
 			})
 		})
 	})
+
+	Describe("Allocated resources", func() {
+		var limits atc.ContainerLimits
+
+		BeforeEach(func() {
+			var err error
+			worker, err = workerFactory.SaveWorker(atcWorker, 5*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			cpu := uint64(512)
+			memory := uint64(1024)
+			limits = atc.ContainerLimits{CPU: &cpu, Memory: &memory}
+		})
+
+		Context("when the worker registers", func() {
+			It("has no allocated resources", func() {
+				cpu, memory, err := worker.AllocatedResources()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cpu).To(BeZero())
+				Expect(memory).To(BeZero())
+			})
+		})
+
+		Context("when resources are allocated", func() {
+			BeforeEach(func() {
+				err := worker.IncreaseAllocatedResources(limits)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = worker.IncreaseAllocatedResources(atc.ContainerLimits{Memory: limits.Memory})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("adds up the limits", func() {
+				cpu, memory, err := worker.AllocatedResources()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cpu).To(Equal(uint64(512)))
+				Expect(memory).To(Equal(uint64(2048)))
+			})
+
+			Context("when resources are released", func() {
+				BeforeEach(func() {
+					err := worker.DecreaseAllocatedResources(limits)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("subtracts the limits", func() {
+					cpu, memory, err := worker.AllocatedResources()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(cpu).To(BeZero())
+					Expect(memory).To(Equal(uint64(1024)))
+				})
+			})
+		})
+
+		Context("when more resources are released than allocated", func() {
+			It("does not go below zero", func() {
+				err := worker.DecreaseAllocatedResources(limits)
+				Expect(err).ToNot(HaveOccurred())
+
+				cpu, memory, err := worker.AllocatedResources()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(cpu).To(BeZero())
+				Expect(memory).To(BeZero())
+			})
+		})
+	})
 })
@@ -195,6 +195,10 @@ func (builder *stepBuilder) buildStep(build db.Build, plan atc.Plan, credVarsTra
 		return builder.buildRetryStep(build, plan, credVarsTracker)
 	}
 
+	if plan.Across != nil {
+		return builder.buildAcrossStep(build, plan, credVarsTracker)
+	}
+
 	if plan.ArtifactInput != nil {
 		return builder.buildArtifactInputStep(build, plan, credVarsTracker)
 	}
@@ -313,6 +317,44 @@ func (builder *stepBuilder) buildRetryStep(build db.Build, plan atc.Plan, credVa
 	return exec.Retry(steps...)
 }
 
+func (builder *stepBuilder) buildAcrossStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
+	buildScopedStep := func(step atc.Plan, values []interface{}) exec.Step {
+		scopedTracker := credVarsTracker.NewLocalScope()
+		for i, v := range plan.Across.Vars {
+			// across var values are not secret, so they are not redacted
+			scopedTracker.AddLocalVar(v.Var, values[i], false)
+		}
+
+		step.Attempts = plan.Attempts
+		return builder.buildStep(build, step, scopedTracker)
+	}
+
+	if plan.Across.SubStepTemplate != nil {
+		return exec.AcrossFromFactory(
+			plan.Across.Vars,
+			func(index int, values []interface{}) exec.Step {
+				// every combination is built from the same template, so its
+				// plan IDs are made unique to keep e.g. the containers and
+				// build events of the combinations apart
+				subStep := plan.Across.SubStepTemplate.WithIDSuffix(fmt.Sprintf("/%d", index))
+				return buildScopedStep(subStep, values)
+			},
+			credVarsTracker,
+			plan.Across.FailFast,
+		)
+	}
+
+	steps := make([]exec.ScopedStep, len(plan.Across.Steps))
+	for i, scopedPlan := range plan.Across.Steps {
+		steps[i] = exec.ScopedStep{
+			Step:   buildScopedStep(scopedPlan.Step, scopedPlan.Values),
+			Values: scopedPlan.Values,
+		}
+	}
+
+	return exec.Across(plan.Across.Vars, steps, plan.Across.FailFast)
+}
+
 func (builder *stepBuilder) buildGetStep(build db.Build, plan atc.Plan, credVarsTracker vars.CredVarsTracker) exec.Step {
 
 	containerMetadata := builder.containerMetadata(
@@ -1,6 +1,9 @@
 package builder_test
 
 import (
+	"context"
+	"fmt"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
@@ -13,6 +16,8 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/engine/builder/builderfakes"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
 )
 
 type StepBuilder interface {
@@ -75,6 +80,8 @@ var _ = Describe("Builder", func() {
 
 				expectedPlan     atc.Plan
 				expectedMetadata exec.StepMetadata
+
+				builtStep exec.Step
 			)
 
 			BeforeEach(func() {
@@ -109,7 +116,7 @@ var _ = Describe("Builder", func() {
 			JustBeforeEach(func() {
 				fakeBuild.PrivatePlanReturns(expectedPlan)
 
-				_, err = stepBuilder.BuildStep(logger, fakeBuild)
+				builtStep, err = stepBuilder.BuildStep(logger, fakeBuild)
 			})
 
 			Context("when the build has the wrong schema", func() {
@@ -567,6 +574,99 @@ var _ = Describe("Builder", func() {
 						})
 					})
 
+					Context("that contains an across step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.AcrossPlan{
+								Vars: []atc.AcrossVar{
+									{
+										Var:    "v",
+										Values: []interface{}{"a", "b"},
+									},
+								},
+								Steps: []atc.VarScopedPlan{
+									{
+										Step:   planFactory.NewPlan(atc.TaskPlan{Name: "some-task"}),
+										Values: []interface{}{"a"},
+									},
+									{
+										Step:   planFactory.NewPlan(atc.TaskPlan{Name: "some-task"}),
+										Values: []interface{}{"b"},
+									},
+								},
+							})
+						})
+
+						It("constructs each sub-step with its own var scope", func() {
+							Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(2))
+							Expect(fakeDelegateFactory.TaskDelegateCallCount()).To(Equal(2))
+
+							for i, expectedValue := range []string{"a", "b"} {
+								plan, _, _, _ := fakeStepFactory.TaskStepArgsForCall(i)
+								Expect(plan).To(Equal(expectedPlan.Across.Steps[i].Step))
+
+								_, _, tracker := fakeDelegateFactory.TaskDelegateArgsForCall(i)
+								val, found, err := tracker.Get(vars.VariableDefinition{Name: ".:v"})
+								Expect(err).ToNot(HaveOccurred())
+								Expect(found).To(BeTrue())
+								Expect(val).To(Equal(expectedValue))
+							}
+						})
+					})
+
+					Context("that contains an across step with loaded values", func() {
+						var taskTemplate atc.Plan
+
+						BeforeEach(func() {
+							taskTemplate = planFactory.NewPlan(atc.TaskPlan{Name: "some-task"})
+
+							expectedPlan = planFactory.NewPlan(atc.DoPlan{
+								planFactory.NewPlan(atc.LoadVarPlan{
+									Name: "loaded",
+									File: "some-input/data.yml",
+								}),
+								planFactory.NewPlan(atc.AcrossPlan{
+									Vars: []atc.AcrossVar{
+										{
+											Var:         "v",
+											LoadVar:     "loaded",
+											MaxInFlight: 1,
+										},
+									},
+									SubStepTemplate: &taskTemplate,
+								}),
+							})
+
+							fakeStepFactory.LoadVarStepStub = func(atc.Plan, exec.StepMetadata, exec.BuildStepDelegate) exec.Step {
+								_, _, tracker := fakeDelegateFactory.BuildStepDelegateArgsForCall(0)
+
+								loadVarStep := new(execfakes.FakeStep)
+								loadVarStep.RunStub = func(context.Context, exec.RunState) error {
+									tracker.AddLocalVar("loaded", []interface{}{"a", "b"}, false)
+									return nil
+								}
+								loadVarStep.SucceededReturns(true)
+								return loadVarStep
+							}
+
+							taskStep := new(execfakes.FakeStep)
+							taskStep.SucceededReturns(true)
+							fakeStepFactory.TaskStepReturns(taskStep)
+						})
+
+						It("gives the sub-step of each combination its own plan IDs", func() {
+							Expect(builtStep.Run(context.Background(), exec.NewRunState())).To(Succeed())
+
+							Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(2))
+
+							for i := 0; i < 2; i++ {
+								plan, _, containerMetadata, _ := fakeStepFactory.TaskStepArgsForCall(i)
+								Expect(plan.ID).To(Equal(taskTemplate.ID + atc.PlanID(fmt.Sprintf("/%d", i))))
+								Expect(plan.Task).To(Equal(taskTemplate.Task))
+								Expect(containerMetadata.StepName).To(Equal("some-task"))
+							}
+						})
+					})
+
 					Context("that contains outputs", func() {
 						var (
 							putPlan          atc.Plan
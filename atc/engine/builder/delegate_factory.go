@@ -236,6 +236,7 @@ func NewTaskDelegate(build db.Build, planID atc.PlanID, credVarsTracker vars.Cre
 	return &taskDelegate{
 		BuildStepDelegate: NewBuildStepDelegate(build, planID, credVarsTracker, clock),
 
+		planID:      planID,
 		eventOrigin: event.Origin{ID: event.OriginID(planID)},
 		build:       build,
 	}
@@ -245,6 +246,7 @@ type taskDelegate struct {
 	exec.BuildStepDelegate
 	config      atc.TaskConfig
 	build       db.Build
+	planID      atc.PlanID
 	eventOrigin event.Origin
 }
 
@@ -295,6 +297,14 @@ func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus)
 		return
 	}
 
+	if exitStatus != 0 {
+		err = d.build.MarkTaskFailed(d.planID)
+		if err != nil {
+			logger.Error("failed-to-mark-task-failed", err)
+			return
+		}
+	}
+
 	logger.Info("finished", lager.Data{"exit-status": exitStatus})
 }
 
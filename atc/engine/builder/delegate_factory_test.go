@@ -287,6 +287,27 @@ var _ = Describe("DelegateFactory", func() {
 				event := fakeBuild.SaveEventArgsForCall(0)
 				Expect(event.EventType()).To(Equal(atc.EventType("finish-task")))
 			})
+
+			Context("when the task succeeds", func() {
+				BeforeEach(func() {
+					exitStatus = 0
+				})
+
+				It("does not mark the task as failed", func() {
+					Expect(fakeBuild.MarkTaskFailedCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the task fails", func() {
+				BeforeEach(func() {
+					exitStatus = 1
+				})
+
+				It("marks the task as failed so that its container can be retained", func() {
+					Expect(fakeBuild.MarkTaskFailedCallCount()).To(Equal(1))
+					Expect(fakeBuild.MarkTaskFailedArgsForCall(0)).To(Equal(atc.PlanID("some-plan-id")))
+				})
+			})
 		})
 	})
 
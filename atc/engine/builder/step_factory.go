@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/worker"
 )
@@ -24,6 +25,7 @@ type stepFactory struct {
 	strategy                        worker.ContainerPlacementStrategy
 	lockFactory                     lock.LockFactory
 	enableRerunWhenWorkerDisappears bool
+	policyChecker                   policy.Checker
 }
 
 func NewStepFactory(
@@ -37,6 +39,7 @@ func NewStepFactory(
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
 	enableRerunWhenWorkerDisappears bool,
+	policyChecker policy.Checker,
 ) *stepFactory {
 	return &stepFactory{
 		pool:                            pool,
@@ -49,6 +52,7 @@ func NewStepFactory(
 		strategy:                        strategy,
 		lockFactory:                     lockFactory,
 		enableRerunWhenWorkerDisappears: enableRerunWhenWorkerDisappears,
+		policyChecker:                   policyChecker,
 	}
 }
 
@@ -148,6 +152,7 @@ func (factory *stepFactory) TaskStep(
 		factory.client,
 		delegate,
 		factory.lockFactory,
+		factory.policyChecker,
 	)
 
 	taskStep = exec.LogError(taskStep, delegate)
@@ -0,0 +1,160 @@
+package exec
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/vars"
+)
+
+// ScopedStep is a step which runs with the given values set for each of the
+// vars of an AcrossStep.
+type ScopedStep struct {
+	Step
+	Values []interface{}
+}
+
+// ScopedStepFactory constructs a step with the given values set for each of
+// the vars of an AcrossStep. The index is the position of the combination of
+// values among all of the combinations.
+type ScopedStepFactory func(index int, values []interface{}) Step
+
+// InvalidAcrossValuesError is returned when the values of an across var loaded
+// from a local var are not a list.
+type InvalidAcrossValuesError struct {
+	Var     string
+	LoadVar string
+	Value   interface{}
+}
+
+// Error returns a human-friendly error message.
+func (err InvalidAcrossValuesError) Error() string {
+	return fmt.Sprintf("values for across var '%s' must be a list, but local var '%s' is a %T", err.Var, err.LoadVar, err.Value)
+}
+
+// AcrossStep is a step which runs a sub-step for every combination of the
+// values of its vars.
+type AcrossStep struct {
+	vars     []atc.AcrossVar
+	steps    []ScopedStep
+	failFast bool
+
+	factory   ScopedStepFactory
+	variables vars.Variables
+
+	values [][]interface{}
+}
+
+// Across constructs an AcrossStep from sub-steps which were built ahead of
+// time, one for each combination of the vars' values.
+func Across(acrossVars []atc.AcrossVar, steps []ScopedStep, failFast bool) *AcrossStep {
+	values := make([][]interface{}, len(acrossVars))
+	for i, v := range acrossVars {
+		values[i] = v.Values
+	}
+
+	return &AcrossStep{
+		vars:     acrossVars,
+		steps:    steps,
+		failFast: failFast,
+		values:   values,
+	}
+}
+
+// AcrossFromFactory constructs an AcrossStep whose var values are resolved
+// when it runs, e.g. because they are loaded by a load_var step. The sub-steps
+// are then constructed by the given factory.
+func AcrossFromFactory(acrossVars []atc.AcrossVar, factory ScopedStepFactory, variables vars.Variables, failFast bool) *AcrossStep {
+	return &AcrossStep{
+		vars:      acrossVars,
+		failFast:  failFast,
+		factory:   factory,
+		variables: variables,
+	}
+}
+
+// Run runs the sub-steps for the first var's values in parallel, limited by
+// that var's max in flight. Each of them in turn does the same for the next
+// var, until all of the vars are set and the sub-step itself runs.
+//
+// Fail fast causes any outstanding sub-steps to be skipped once one of them
+// fails or errors.
+func (step *AcrossStep) Run(ctx context.Context, state RunState) error {
+	if step.factory != nil {
+		err := step.buildSteps()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(step.steps) == 0 {
+		return nil
+	}
+
+	return step.stepForVar(0, step.steps).Run(ctx, state)
+}
+
+// Succeeded is true if all of the sub-steps' Succeeded is true
+func (step *AcrossStep) Succeeded() bool {
+	for _, s := range step.steps {
+		if !s.Succeeded() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (step *AcrossStep) stepForVar(varIndex int, steps []ScopedStep) Step {
+	if varIndex == len(step.vars) {
+		return steps[0].Step
+	}
+
+	values := step.values[varIndex]
+	chunkSize := len(steps) / len(values)
+
+	subSteps := make([]Step, len(values))
+	for i := range values {
+		subSteps[i] = step.stepForVar(varIndex+1, steps[i*chunkSize:(i+1)*chunkSize])
+	}
+
+	return InParallel(subSteps, step.vars[varIndex].MaxInFlight, step.failFast)
+}
+
+func (step *AcrossStep) buildSteps() error {
+	step.values = make([][]interface{}, len(step.vars))
+
+	for i, v := range step.vars {
+		if v.LoadVar == "" {
+			step.values[i] = v.Values
+			continue
+		}
+
+		val, found, err := step.variables.Get(vars.VariableDefinition{Name: ".:" + v.LoadVar})
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return vars.UndefinedVarsError{Vars: []string{".:" + v.LoadVar}}
+		}
+
+		values, ok := val.([]interface{})
+		if !ok {
+			return InvalidAcrossValuesError{Var: v.Var, LoadVar: v.LoadVar, Value: val}
+		}
+
+		step.values[i] = values
+	}
+
+	step.steps = nil
+	for i, combination := range atc.AcrossCombinations(step.values) {
+		step.steps = append(step.steps, ScopedStep{
+			Step:   step.factory(i, combination),
+			Values: combination,
+		})
+	}
+
+	return nil
+}
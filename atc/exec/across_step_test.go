@@ -0,0 +1,220 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Across", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		acrossVars []atc.AcrossVar
+		fakeSteps  []*execfakes.FakeStep
+		scoped     []ScopedStep
+		failFast   bool
+
+		repo  *build.Repository
+		state *execfakes.FakeRunState
+
+		step    Step
+		stepErr error
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		acrossVars = []atc.AcrossVar{
+			{
+				Var:         "a",
+				Values:      []interface{}{"a1", "a2"},
+				MaxInFlight: 1,
+			},
+			{
+				Var:         "b",
+				Values:      []interface{}{"b1", "b2"},
+				MaxInFlight: 0,
+			},
+		}
+
+		fakeSteps = nil
+		scoped = nil
+		for _, values := range atc.AcrossCombinations([][]interface{}{{"a1", "a2"}, {"b1", "b2"}}) {
+			fakeStep := new(execfakes.FakeStep)
+			fakeStep.SucceededReturns(true)
+
+			fakeSteps = append(fakeSteps, fakeStep)
+			scoped = append(scoped, ScopedStep{Step: fakeStep, Values: values})
+		}
+
+		failFast = false
+
+		repo = build.NewRepository()
+		state = new(execfakes.FakeRunState)
+		state.ArtifactRepositoryReturns(repo)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step = Across(acrossVars, scoped, failFast)
+		stepErr = step.Run(ctx, state)
+	})
+
+	It("succeeds", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(step.Succeeded()).To(BeTrue())
+	})
+
+	It("runs every sub-step with the run state", func() {
+		for _, fakeStep := range fakeSteps {
+			Expect(fakeStep.RunCallCount()).To(Equal(1))
+			_, runState := fakeStep.RunArgsForCall(0)
+			Expect(runState).To(Equal(state))
+		}
+	})
+
+	Context("when the inner var runs all values in parallel", func() {
+		BeforeEach(func() {
+			wg := new(sync.WaitGroup)
+			wg.Add(2)
+
+			for _, fakeStep := range fakeSteps {
+				fakeStep.RunStub = func(context.Context, RunState) error {
+					wg.Done()
+					wg.Wait()
+					return nil
+				}
+			}
+
+			// only the first value of the outer var needs to wait for its peer;
+			// the next one will have nothing to wait on
+			fakeSteps[2].RunStub = nil
+			fakeSteps[3].RunStub = nil
+		})
+
+		It("runs the sub-steps for each value of the inner var concurrently", func() {
+			for _, fakeStep := range fakeSteps {
+				Expect(fakeStep.RunCallCount()).To(Equal(1))
+			}
+		})
+	})
+
+	Context("when a sub-step fails", func() {
+		BeforeEach(func() {
+			fakeSteps[0].SucceededReturns(false)
+		})
+
+		It("does not succeed", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(step.Succeeded()).To(BeFalse())
+		})
+
+		It("runs the remaining sub-steps", func() {
+			for _, fakeStep := range fakeSteps {
+				Expect(fakeStep.RunCallCount()).To(Equal(1))
+			}
+		})
+
+		Context("with fail fast", func() {
+			BeforeEach(func() {
+				failFast = true
+			})
+
+			It("does not run the sub-steps for the next value of the outer var", func() {
+				Expect(fakeSteps[2].RunCallCount()).To(Equal(0))
+				Expect(fakeSteps[3].RunCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when a sub-step errors", func() {
+		BeforeEach(func() {
+			fakeSteps[1].RunReturns(errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(HaveOccurred())
+			Expect(stepErr.Error()).To(ContainSubstring("nope"))
+		})
+	})
+
+	Context("when the values are loaded at runtime", func() {
+		var (
+			variables    vars.CredVarsTracker
+			builtIndexes []int
+			builtValues  [][]interface{}
+		)
+
+		BeforeEach(func() {
+			acrossVars = []atc.AcrossVar{
+				{
+					Var:         "v",
+					LoadVar:     "loaded",
+					MaxInFlight: 1,
+				},
+			}
+
+			builtIndexes = nil
+			builtValues = nil
+			variables = vars.NewCredVarsTracker(vars.StaticVariables{}, false)
+			variables.AddLocalVar("loaded", []interface{}{"x", "y"}, false)
+		})
+
+		JustBeforeEach(func() {
+			step = AcrossFromFactory(acrossVars, func(index int, values []interface{}) Step {
+				builtIndexes = append(builtIndexes, index)
+				builtValues = append(builtValues, values)
+
+				fakeStep := new(execfakes.FakeStep)
+				fakeStep.SucceededReturns(true)
+				return fakeStep
+			}, variables, false)
+
+			stepErr = step.Run(ctx, state)
+		})
+
+		It("builds a sub-step for each loaded value", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(builtValues).To(Equal([][]interface{}{{"x"}, {"y"}}))
+			Expect(builtIndexes).To(Equal([]int{0, 1}))
+			Expect(step.Succeeded()).To(BeTrue())
+		})
+
+		Context("when the local var is not a list", func() {
+			BeforeEach(func() {
+				variables.AddLocalVar("loaded", "nope", false)
+			})
+
+			It("errors", func() {
+				Expect(stepErr).To(Equal(InvalidAcrossValuesError{
+					Var:     "v",
+					LoadVar: "loaded",
+					Value:   "nope",
+				}))
+			})
+		})
+
+		Context("when the local var is not set", func() {
+			BeforeEach(func() {
+				variables = vars.NewCredVarsTracker(vars.StaticVariables{}, false)
+			})
+
+			It("errors", func() {
+				Expect(stepErr).To(Equal(vars.UndefinedVarsError{Vars: []string{".:loaded"}}))
+			})
+		})
+	})
+})
@@ -3,6 +3,8 @@ package exec_test
 import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/api/trace/testtrace"
 
 	"testing"
 )
@@ -15,3 +17,9 @@ func TestExec(t *testing.T) {
 type testMetadata []string
 
 func (m testMetadata) Env() []string { return m }
+
+type testTraceProvider struct{}
+
+func (testTraceProvider) Tracer(name string) trace.Tracer {
+	return testtrace.NewTracer()
+}
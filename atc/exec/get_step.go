@@ -213,6 +213,10 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 			step.delegate.UpdateVersion(logger, step.plan, getResult.VersionResult)
 		}
 
+		if step.plan.Prefetch {
+			step.workerClient.PrefetchResourceCache(logger, workerSpec, resourceCache, getResult.GetArtifact)
+		}
+
 		step.succeeded = true
 	}
 
@@ -321,6 +321,31 @@ var _ = Describe("GetStep", func() {
 			})
 		})
 
+		Context("when the plan prefetches the resource", func() {
+			BeforeEach(func() {
+				getPlan.Prefetch = true
+			})
+
+			It("prefetches the resource cache to other workers", func() {
+				Expect(fakeClient.PrefetchResourceCacheCallCount()).To(Equal(1))
+				_, actualWorkerSpec, actualResourceCache, actualArtifact := fakeClient.PrefetchResourceCacheArgsForCall(0)
+				Expect(actualWorkerSpec).To(Equal(worker.WorkerSpec{
+					ResourceType:  "some-resource-type",
+					Tags:          atc.Tags{"some", "tags"},
+					TeamID:        stepMetadata.TeamID,
+					ResourceTypes: interpolatedResourceTypes,
+				}))
+				Expect(actualResourceCache).To(Equal(fakeResourceCache))
+				Expect(actualArtifact).To(Equal(runtime.GetArtifact{VolumeHandle: "some-volume-handle"}))
+			})
+		})
+
+		Context("when the plan does not prefetch the resource", func() {
+			It("does not prefetch the resource cache", func() {
+				Expect(fakeClient.PrefetchResourceCacheCallCount()).To(Equal(0))
+			})
+		})
+
 		It("does not return an err", func() {
 			Expect(getStepErr).ToNot(HaveOccurred())
 		})
@@ -339,6 +364,16 @@ var _ = Describe("GetStep", func() {
 			Expect(getStep.Succeeded()).To(BeFalse())
 		})
 
+		Context("when the plan prefetches the resource", func() {
+			BeforeEach(func() {
+				getPlan.Prefetch = true
+			})
+
+			It("does not prefetch the resource cache", func() {
+				Expect(fakeClient.PrefetchResourceCacheCallCount()).To(Equal(0))
+			})
+		})
+
 		It("finishes the step via the delegate", func() {
 			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
 			_, actualExitStatus, actualVersionResult := fakeDelegate.FinishedArgsForCall(0)
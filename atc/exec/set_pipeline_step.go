@@ -19,6 +19,7 @@ import (
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -53,6 +54,21 @@ func NewSetPipelineStep(
 }
 
 func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
+	ctx, span := tracing.StartSpan(ctx, "set_pipeline", tracing.Attrs{
+		"team":     step.metadata.TeamName,
+		"pipeline": step.metadata.PipelineName,
+		"job":      step.metadata.JobName,
+		"build":    step.metadata.BuildName,
+		"name":     step.plan.Name,
+	})
+
+	err := step.run(ctx, state)
+	tracing.End(span, err)
+
+	return err
+}
+
+func (step *SetPipelineStep) run(ctx context.Context, state RunState) error {
 	logger := lagerctx.FromContext(ctx)
 	logger = logger.Session("set-pipeline-step", lager.Data{
 		"step-name": step.plan.Name,
@@ -107,8 +123,13 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 
 	team := step.teamFactory.GetByID(step.metadata.TeamID)
 
+	pipelineRef := atc.PipelineRef{
+		Name:         step.plan.Name,
+		InstanceVars: step.plan.InstanceVars,
+	}
+
 	fromVersion := db.ConfigVersion(0)
-	pipeline, found, err := team.Pipeline(step.plan.Name)
+	pipeline, found, err := team.Pipeline(pipelineRef)
 	if err != nil {
 		return err
 	}
@@ -134,8 +155,10 @@ func (step *SetPipelineStep) Run(ctx context.Context, state RunState) error {
 		return nil
 	}
 
-	fmt.Fprintf(stdout, "setting pipeline: %s\n", step.plan.Name)
-	pipeline, _, err = team.SavePipeline(step.plan.Name, atcConfig, fromVersion, false)
+	fmt.Fprintf(stdout, "setting pipeline: %s\n", pipelineRef.String())
+	pipeline, _, err = team.SavePipeline(pipelineRef, atcConfig, fromVersion, false, db.ConfigAuthor{
+		BuildID: step.metadata.BuildID,
+	})
 	if err != nil {
 		return err
 	}
@@ -177,6 +200,9 @@ func (s setPipelineSource) FetchPipelineConfig() (atc.Config, error) {
 	}
 
 	staticVars := []vars.Variables{}
+	if len(s.step.plan.InstanceVars) > 0 {
+		staticVars = append(staticVars, vars.StaticVariables(s.step.plan.InstanceVars))
+	}
 	if len(s.step.plan.Vars) > 0 {
 		staticVars = append(staticVars, vars.StaticVariables(s.step.plan.Vars))
 	}
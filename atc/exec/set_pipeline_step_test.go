@@ -16,6 +16,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
@@ -238,9 +239,10 @@ jobs:
 
 				It("should save the pipeline un-paused", func() {
 					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
-					name, _, _, paused := fakeTeam.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					name, _, _, paused, savedBy := fakeTeam.SavePipelineArgsForCall(0)
+					Expect(name).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(paused).To(BeFalse())
+					Expect(savedBy).To(Equal(db.ConfigAuthor{BuildID: 42}))
 				})
 
 				It("should stdout have message", func() {
@@ -248,6 +250,52 @@ jobs:
 				})
 			})
 
+			Context("when instance vars are specified", func() {
+				BeforeEach(func() {
+					spPlan.InstanceVars = atc.InstanceVars{"branch": "feature"}
+					fakeWorkerClient.StreamFileFromArtifactReturns(&fakeReadCloser{str: `
+---
+jobs:
+- name: some-job
+  plan:
+  - task: some-task
+    config:
+      platform: linux
+      image_resource:
+        type: registry-image
+        source: {repository: busybox}
+      run:
+        path: echo
+        args:
+         - ((branch))
+`}, nil)
+					fakeTeam.PipelineReturns(nil, false, nil)
+					fakeTeam.SavePipelineReturns(fakePipeline, true, nil)
+				})
+
+				It("should look up the pipeline instance", func() {
+					Expect(fakeTeam.PipelineCallCount()).To(Equal(1))
+					Expect(fakeTeam.PipelineArgsForCall(0)).To(Equal(atc.PipelineRef{
+						Name:         "some-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "feature"},
+					}))
+				})
+
+				It("should save the pipeline instance with the instance vars interpolated", func() {
+					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
+					ref, config, _, _, _ := fakeTeam.SavePipelineArgsForCall(0)
+					Expect(ref).To(Equal(atc.PipelineRef{
+						Name:         "some-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "feature"},
+					}))
+					Expect(config.Jobs[0].Plan[0].TaskConfig.Run.Args).To(Equal([]string{"feature"}))
+				})
+
+				It("should stdout have message", func() {
+					Expect(stdout).To(gbytes.Say(`setting pipeline: some-pipeline/branch:"feature"`))
+				})
+			})
+
 			Context("when specified pipeline exists already", func() {
 				BeforeEach(func() {
 					fakeTeam.PipelineReturns(fakePipeline, true, nil)
@@ -288,9 +336,10 @@ jobs:
 
 				It("should save the pipeline un-paused", func() {
 					Expect(fakeTeam.SavePipelineCallCount()).To(Equal(1))
-					name, _, _, paused := fakeTeam.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					name, _, _, paused, savedBy := fakeTeam.SavePipelineArgsForCall(0)
+					Expect(name).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(paused).To(BeFalse())
+					Expect(savedBy).To(Equal(db.ConfigAuthor{BuildID: 42}))
 				})
 
 				It("should stdout have message", func() {
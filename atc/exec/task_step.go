@@ -15,6 +15,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
@@ -80,6 +81,7 @@ type TaskStep struct {
 	workerClient      worker.Client
 	delegate          TaskDelegate
 	lockFactory       lock.LockFactory
+	policyChecker     policy.Checker
 	succeeded         bool
 }
 
@@ -93,6 +95,7 @@ func NewTaskStep(
 	workerClient worker.Client,
 	delegate TaskDelegate,
 	lockFactory lock.LockFactory,
+	policyChecker policy.Checker,
 ) Step {
 	return &TaskStep{
 		planID:            planID,
@@ -104,6 +107,7 @@ func NewTaskStep(
 		workerClient:      workerClient,
 		delegate:          delegate,
 		lockFactory:       lockFactory,
+		policyChecker:     policyChecker,
 	}
 }
 
@@ -216,6 +220,14 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	// lets tools in the task attach their spans to the task's
+	containerSpec.Env = append(containerSpec.Env, db.NewSpanContext(ctx).Env()...)
+
+	err = step.checkPolicy(logger, config)
+	if err != nil {
+		return err
+	}
+
 	processSpec := runtime.ProcessSpec{
 		Path:         config.Run.Path,
 		Args:         config.Run.Args,
@@ -272,6 +284,47 @@ func (step *TaskStep) Succeeded() bool {
 	return step.succeeded
 }
 
+// checkPolicy runs the task spec through the policy check, if configured,
+// before its container is created.
+func (step *TaskStep) checkPolicy(logger lager.Logger, config atc.TaskConfig) error {
+	if step.policyChecker == nil || !step.policyChecker.ShouldCheck(policy.ActionRunTask, "") {
+		return nil
+	}
+
+	// params have been interpolated with credentials by now, so don't leak
+	// them to the policy agent
+	config.Params = nil
+
+	data := map[string]interface{}{
+		"privileged": bool(step.plan.Privileged),
+		"config":     config,
+	}
+	if step.plan.ImageArtifactName != "" {
+		data["image_artifact"] = step.plan.ImageArtifactName
+	}
+
+	output, err := step.policyChecker.Check(policy.PolicyCheckInput{
+		Action:   policy.ActionRunTask,
+		Team:     step.metadata.TeamName,
+		Pipeline: step.metadata.PipelineName,
+		Data:     data,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !output.Allowed {
+		logger.Info("policy-check-not-passed", lager.Data{"reasons": output.Reasons})
+		return policy.PolicyCheckNotPassedError{Output: output}
+	}
+
+	if output.Warning {
+		fmt.Fprintln(step.delegate.Stderr(), "[WARNING]", output.Message())
+	}
+
+	return nil
+}
+
 func (step *TaskStep) imageSpec(logger lager.Logger, repository *build.Repository, config atc.TaskConfig) (worker.ImageSpec, error) {
 	imageSpec := worker.ImageSpec{
 		Privileged: bool(step.plan.Privileged),
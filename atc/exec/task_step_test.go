@@ -11,10 +11,13 @@ import (
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
 	"github.com/onsi/gomega/gbytes"
 
@@ -43,6 +46,8 @@ var _ = Describe("TaskStep", func() {
 		repo  *build.Repository
 		state *execfakes.FakeRunState
 
+		fakePolicyChecker *policyfakes.FakeChecker
+
 		taskStep exec.Step
 		stepErr  error
 
@@ -74,6 +79,8 @@ var _ = Describe("TaskStep", func() {
 
 		fakeLockFactory = new(lockfakes.FakeLockFactory)
 
+		fakePolicyChecker = new(policyfakes.FakeChecker)
+
 		credVars := vars.StaticVariables{"source-param": "super-secret-source"}
 		credVarsTracker = vars.NewCredVarsTracker(credVars, true)
 
@@ -135,6 +142,7 @@ var _ = Describe("TaskStep", func() {
 			fakeClient,
 			fakeDelegate,
 			fakeLockFactory,
+			fakePolicyChecker,
 		)
 
 		stepErr = taskStep.Run(ctx, state)
@@ -168,6 +176,69 @@ var _ = Describe("TaskStep", func() {
 			}
 		})
 
+		Context("when tasks are subject to policy checks", func() {
+			BeforeEach(func() {
+				taskPlan.Privileged = true
+				stepMetadata.TeamName = "some-team"
+				stepMetadata.PipelineName = "some-pipeline"
+
+				fakePolicyChecker.ShouldCheckReturns(true)
+			})
+
+			It("checks the task before running its container", func() {
+				action, _ := fakePolicyChecker.ShouldCheckArgsForCall(0)
+				Expect(action).To(Equal(policy.ActionRunTask))
+
+				Expect(fakePolicyChecker.CheckCallCount()).To(Equal(1))
+				input := fakePolicyChecker.CheckArgsForCall(0)
+				Expect(input.Action).To(Equal(policy.ActionRunTask))
+				Expect(input.Team).To(Equal("some-team"))
+				Expect(input.Pipeline).To(Equal("some-pipeline"))
+				Expect(input.Data).To(HaveKeyWithValue("privileged", true))
+			})
+
+			It("does not send the task params", func() {
+				input := fakePolicyChecker.CheckArgsForCall(0)
+				config := input.Data.(map[string]interface{})["config"].(atc.TaskConfig)
+				Expect(config.Params).To(BeNil())
+				Expect(config.ImageResource.Type).To(Equal("docker"))
+			})
+
+			Context("when the policy check passes", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{Allowed: true}, nil)
+				})
+
+				It("runs the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the policy check only warns", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{Allowed: true, Warning: true, Reasons: []string{"careful"}}, nil)
+				})
+
+				It("runs the task with a warning", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+					Expect(stderrBuf).To(gbytes.Say(`\[WARNING\] policy check failed: careful`))
+				})
+			})
+
+			Context("when the policy check does not pass", func() {
+				BeforeEach(func() {
+					fakePolicyChecker.CheckReturns(policy.PolicyCheckOutput{Allowed: false, Reasons: []string{"no privileged tasks"}}, nil)
+				})
+
+				It("errors without running the task", func() {
+					Expect(stepErr).To(MatchError("policy check failed: no privileged tasks"))
+					Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+				})
+			})
+		})
+
 		Context("before running the task container", func() {
 			BeforeEach(func() {
 				fakeDelegate.InitializingStub = func(lager.Logger) {
@@ -249,6 +320,22 @@ var _ = Describe("TaskStep", func() {
 			Expect(actualTaskConfig).To(Equal(*taskPlan.Config))
 		})
 
+		Context("when tracing is configured", func() {
+			BeforeEach(func() {
+				tracing.ConfigureTraceProvider(testTraceProvider{})
+			})
+
+			AfterEach(func() {
+				tracing.Configured = false
+			})
+
+			It("propagates the trace context to the container", func() {
+				Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+				_, _, _, containerSpec, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Env).To(ContainElement(MatchRegexp(`^TRACEPARENT=00-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2}$`)))
+			})
+		})
+
 		Context("when privileged", func() {
 			BeforeEach(func() {
 				taskPlan.Privileged = true
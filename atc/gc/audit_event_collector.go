@@ -0,0 +1,48 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type auditEventCollector struct {
+	auditEventRepository db.AuditEventRepository
+	retention            time.Duration
+}
+
+// NewAuditEventCollector returns a collector which removes audit events once
+// they are older than the retention period. A retention period of 0 keeps
+// them forever.
+func NewAuditEventCollector(auditEventRepository db.AuditEventRepository, retention time.Duration) *auditEventCollector {
+	return &auditEventCollector{
+		auditEventRepository: auditEventRepository,
+		retention:            retention,
+	}
+}
+
+func (c *auditEventCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("audit-event-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	if c.retention == 0 {
+		return nil
+	}
+
+	deleted, err := c.auditEventRepository.RemoveExpiredAuditEvents(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-expired-audit-events", err)
+		return err
+	}
+
+	if deleted > 0 {
+		logger.Debug("removed-expired-audit-events", lager.Data{"count": deleted})
+	}
+
+	return nil
+}
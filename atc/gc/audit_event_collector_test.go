@@ -0,0 +1,63 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEventCollector", func() {
+	var (
+		collector                GcCollector
+		fakeAuditEventRepository *dbfakes.FakeAuditEventRepository
+		retention                time.Duration
+	)
+
+	BeforeEach(func() {
+		fakeAuditEventRepository = new(dbfakes.FakeAuditEventRepository)
+		retention = 90 * 24 * time.Hour
+	})
+
+	JustBeforeEach(func() {
+		collector = gc.NewAuditEventCollector(fakeAuditEventRepository, retention)
+	})
+
+	Describe("Run", func() {
+		It("removes the audit events older than the retention period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeAuditEventRepository.RemoveExpiredAuditEventsCallCount()).To(Equal(1))
+			Expect(fakeAuditEventRepository.RemoveExpiredAuditEventsArgsForCall(0)).To(Equal(90 * 24 * time.Hour))
+		})
+
+		Context("when removing the events fails", func() {
+			BeforeEach(func() {
+				fakeAuditEventRepository.RemoveExpiredAuditEventsReturns(0, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+
+		Context("when the retention period is 0", func() {
+			BeforeEach(func() {
+				retention = 0
+			})
+
+			It("keeps every event", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(fakeAuditEventRepository.RemoveExpiredAuditEventsCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -15,6 +15,7 @@ type buildLogCollector struct {
 	pipelineFactory             db.PipelineFactory
 	batchSize                   int
 	drainerConfigured           bool
+	logExportConfigured         bool
 	buildLogRetentionCalculator BuildLogRetentionCalculator
 }
 
@@ -23,11 +24,13 @@ func NewBuildLogCollector(
 	batchSize int,
 	buildLogRetentionCalculator BuildLogRetentionCalculator,
 	drainerConfigured bool,
+	logExportConfigured bool,
 ) *buildLogCollector {
 	return &buildLogCollector{
 		pipelineFactory:             pipelineFactory,
 		batchSize:                   batchSize,
 		drainerConfigured:           drainerConfigured,
+		logExportConfigured:         logExportConfigured,
 		buildLogRetentionCalculator: buildLogRetentionCalculator,
 	}
 }
@@ -156,6 +159,14 @@ func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
 			}
 		}
 
+		// Likewise, a build should not be reaped before its logs are exported.
+		if br.logExportConfigured {
+			if !build.IsLogExported() {
+				firstLoggedBuildID = build.ID()
+				continue
+			}
+		}
+
 		// If Builds is 0, then all builds are retained, so we don't need to
 		// check MinSuccessBuilds at all.
 		if logRetention.Builds > 0 {
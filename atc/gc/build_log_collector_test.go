@@ -35,6 +35,7 @@ var _ = Describe("BuildLogCollector", func() {
 			batchSize,
 			buildLogRetainCalc,
 			false,
+			false,
 		)
 	})
 
@@ -83,6 +84,7 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						true,
+						false,
 					)
 				})
 				BeforeEach(func() {
@@ -128,6 +130,48 @@ var _ = Describe("BuildLogCollector", func() {
 				})
 			})
 
+			Context("log export handling", func() {
+				JustBeforeEach(func() {
+					buildLogCollector = NewBuildLogCollector(
+						fakePipelineFactory,
+						batchSize,
+						buildLogRetainCalc,
+						false,
+						true,
+					)
+				})
+
+				BeforeEach(func() {
+					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+						if page == (db.Page{Until: 4, Limit: 5}) {
+							return []db.Build{sbLogExported(10, true), sbLogExported(9, false), sbLogExported(8, false), sbLogExported(7, true), sbLogExported(6, false)}, db.Pagination{}, nil
+						} else if page == (db.Page{Until: 10, Limit: 5}) {
+							return []db.Build{sbLogExported(11, true)}, db.Pagination{}, nil
+						}
+						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
+						return []db.Build{}, db.Pagination{}, nil
+					}
+
+					fakePipeline.DeleteBuildEventsByBuildIDsReturns(nil)
+					fakeJob.UpdateFirstLoggedBuildIDReturns(nil)
+				})
+
+				JustBeforeEach(func() {
+					err := buildLogCollector.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should only reap builds whose logs have been exported", func() {
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(7))
+				})
+
+				It("should update first logged build id to the earliest non-exported build", func() {
+					Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(Equal(1))
+					Expect(fakeJob.UpdateFirstLoggedBuildIDArgsForCall(0)).To(Equal(6))
+				})
+			})
+
 			Context("when drain has not been configured", func() {
 				BeforeEach(func() {
 					buildLogCollector = NewBuildLogCollector(
@@ -135,6 +179,7 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						false,
+						false,
 					)
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Until: 4, Limit: 5}) {
@@ -686,6 +731,14 @@ func sbDrained(id int, drained bool) db.Build {
 	return build
 }
 
+func sbLogExported(id int, exported bool) db.Build {
+	build := new(dbfakes.FakeBuild)
+	build.IsLogExportedReturns(exported)
+	build.IDReturns(id)
+	build.IsRunningReturns(false)
+	return build
+}
+
 func runningBuild(id int) db.Build {
 	build := new(dbfakes.FakeBuild)
 	build.IDReturns(id)
@@ -73,6 +73,16 @@ func (wc *workerCollector) Run(ctx context.Context) error {
 		logger.Info("marked-workers-as-landed", lager.Data{"count": len(affected), "workers": affected})
 	}
 
+	affected, err = wc.workerLifecycle.UncordonExpiredWorkers()
+	if err != nil {
+		logger.Error("failed-to-uncordon-expired-workers", err)
+		return err
+	}
+
+	if len(affected) > 0 {
+		logger.Info("uncordoned-workers", lager.Data{"count": len(affected), "workers": affected})
+	}
+
 	workerStateByName, err := wc.workerLifecycle.GetWorkerStateByName()
 
 	if err != nil {
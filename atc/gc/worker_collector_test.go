@@ -27,6 +27,7 @@ var _ = Describe("WorkerCollector", func() {
 		fakeWorkerLifecycle.StallUnresponsiveWorkersReturns(nil, nil)
 		fakeWorkerLifecycle.DeleteFinishedRetiringWorkersReturns(nil, nil)
 		fakeWorkerLifecycle.LandFinishedLandingWorkersReturns(nil, nil)
+		fakeWorkerLifecycle.UncordonExpiredWorkersReturns(nil, nil)
 	})
 
 	Describe("Run", func() {
@@ -58,6 +59,13 @@ var _ = Describe("WorkerCollector", func() {
 			Expect(fakeWorkerLifecycle.LandFinishedLandingWorkersCallCount()).To(Equal(1))
 		})
 
+		It("tells the worker factory to uncordon workers whose cordon has ended", func() {
+			err := workerCollector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeWorkerLifecycle.UncordonExpiredWorkersCallCount()).To(Equal(1))
+		})
+
 		It("returns an error if stalling unresponsive workers fails", func() {
 			returnedErr := errors.New("some-error")
 			fakeWorkerLifecycle.StallUnresponsiveWorkersReturns(nil, returnedErr)
@@ -82,5 +90,13 @@ var _ = Describe("WorkerCollector", func() {
 			Expect(err).To(MatchError(returnedErr))
 		})
 
+		It("returns an error if uncordoning expired workers fails", func() {
+			returnedErr := errors.New("some-error")
+			fakeWorkerLifecycle.UncordonExpiredWorkersReturns(nil, returnedErr)
+
+			err := workerCollector.Run(context.TODO())
+			Expect(err).To(MatchError(returnedErr))
+		})
+
 	})
 })
@@ -140,6 +140,6 @@ func setupTeam(atcURL string, team atc.Team) {
 
 func setupPipeline(atcURL, teamName string, config []byte) {
 	ccClient := login(atcURL, "test", "test")
-	_, _, _, err := ccClient.Team(teamName).CreateOrUpdatePipelineConfig("pipeline-name", "0", config, false)
+	_, _, _, err := ccClient.Team(teamName).CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: "pipeline-name"}, "0", config, false)
 	Expect(err).ToNot(HaveOccurred())
 }
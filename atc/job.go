@@ -3,16 +3,19 @@ package atc
 type Job struct {
 	ID int `json:"id"`
 
-	Name                 string `json:"name"`
-	PipelineName         string `json:"pipeline_name"`
-	TeamName             string `json:"team_name"`
-	Paused               bool   `json:"paused,omitempty"`
-	FirstLoggedBuildID   int    `json:"first_logged_build_id,omitempty"`
-	DisableManualTrigger bool   `json:"disable_manual_trigger,omitempty"`
-	NextBuild            *Build `json:"next_build"`
-	FinishedBuild        *Build `json:"finished_build"`
-	TransitionBuild      *Build `json:"transition_build,omitempty"`
-	HasNewInputs         bool   `json:"has_new_inputs,omitempty"`
+	Name                 string       `json:"name"`
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	TeamName             string       `json:"team_name"`
+	Paused               bool         `json:"paused,omitempty"`
+	FirstLoggedBuildID   int          `json:"first_logged_build_id,omitempty"`
+	DisableManualTrigger bool         `json:"disable_manual_trigger,omitempty"`
+	NextBuild            *Build       `json:"next_build"`
+	FinishedBuild        *Build       `json:"finished_build"`
+	TransitionBuild      *Build       `json:"transition_build,omitempty"`
+	HasNewInputs         bool         `json:"has_new_inputs,omitempty"`
+
+	Schedule *ScheduleConfig `json:"schedule,omitempty"`
 
 	Inputs  []JobInput  `json:"inputs,omitempty"`
 	Outputs []JobOutput `json:"outputs,omitempty"`
@@ -1,5 +1,7 @@
 package atc
 
+import "time"
+
 type JobConfig struct {
 	Name    string `json:"name"`
 	OldName string `json:"old_name,omitempty"`
@@ -11,9 +13,14 @@ type JobConfig struct {
 	SerialGroups         []string `json:"serial_groups,omitempty"`
 	RawMaxInFlight       int      `json:"max_in_flight,omitempty"`
 	BuildLogsToRetain    int      `json:"build_logs_to_retain,omitempty"`
+	Priority             int      `json:"priority,omitempty"`
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	Schedule *ScheduleConfig `json:"schedule,omitempty"`
+
+	KeepContainersOnFailure string `json:"keep_containers_on_failure,omitempty"`
+
 	Abort   *PlanConfig `json:"on_abort,omitempty"`
 	Error   *PlanConfig `json:"on_error,omitempty"`
 	Failure *PlanConfig `json:"on_failure,omitempty"`
@@ -51,6 +58,17 @@ func (config JobConfig) MaxInFlight() int {
 	return 0
 }
 
+// ContainerRetention returns how long the containers of a failed build are
+// kept around for intercepting, or 0 if they are collected as usual.
+func (config JobConfig) ContainerRetention() time.Duration {
+	retention, err := time.ParseDuration(config.KeepContainersOnFailure)
+	if err != nil || retention < 0 {
+		return 0
+	}
+
+	return retention
+}
+
 func (config JobConfig) Plans() []PlanConfig {
 	plan := collectPlans(PlanConfig{
 		Do:      &config.Plan,
@@ -136,6 +154,17 @@ func (config JobConfig) OutputPlans() []PlanConfig {
 	return outputs
 }
 
+// TaskStep looks up a task step by name, wherever it is nested in the plan.
+func (config JobConfig) TaskStep(name string) (PlanConfig, bool) {
+	for _, plan := range config.Plans() {
+		if plan.Task != "" && plan.Name() == name {
+			return plan, true
+		}
+	}
+
+	return PlanConfig{}, false
+}
+
 func (config JobConfig) Inputs() []JobInputParams {
 	var inputs []JobInputParams
 
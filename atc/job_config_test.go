@@ -1,6 +1,8 @@
 package atc_test
 
 import (
+	"time"
+
 	"github.com/concourse/concourse/atc"
 
 	. "github.com/onsi/ginkgo"
@@ -1053,4 +1055,61 @@ var _ = Describe("JobConfig", func() {
 			})
 		})
 	})
+
+	Describe("TaskStep", func() {
+		var jobConfig atc.JobConfig
+
+		BeforeEach(func() {
+			jobConfig = atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{Get: "some-input"},
+					{
+						InParallel: &atc.InParallelConfig{
+							Steps: atc.PlanSequence{
+								{Task: "unit", File: "some-input/unit.yml"},
+								{Task: "lint", File: "some-input/lint.yml"},
+							},
+						},
+					},
+				},
+				Failure: &atc.PlanConfig{
+					Task:       "notify",
+					TaskConfig: &atc.TaskConfig{Platform: "linux"},
+				},
+			}
+		})
+
+		It("finds a nested task step", func() {
+			step, found := jobConfig.TaskStep("lint")
+			Expect(found).To(BeTrue())
+			Expect(step.File).To(Equal("some-input/lint.yml"))
+		})
+
+		It("finds a task step in a hook", func() {
+			step, found := jobConfig.TaskStep("notify")
+			Expect(found).To(BeTrue())
+			Expect(step.TaskConfig).To(Equal(&atc.TaskConfig{Platform: "linux"}))
+		})
+
+		It("does not find steps which are not tasks", func() {
+			_, found := jobConfig.TaskStep("some-input")
+			Expect(found).To(BeFalse())
+		})
+
+		It("does not find missing steps", func() {
+			_, found := jobConfig.TaskStep("bogus")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("ContainerRetention", func() {
+		It("parses keep_containers_on_failure", func() {
+			jobConfig := atc.JobConfig{KeepContainersOnFailure: "90m"}
+			Expect(jobConfig.ContainerRetention()).To(Equal(90 * time.Minute))
+		})
+
+		It("returns 0 if containers are not kept", func() {
+			Expect(atc.JobConfig{}.ContainerRetention()).To(BeZero())
+		})
+	})
 })
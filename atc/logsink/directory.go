@@ -0,0 +1,92 @@
+package logsink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+)
+
+type directorySink struct {
+	dir string
+}
+
+// NewDirectorySink writes each batch of records to its own newline-delimited
+// JSON file, under <dir>/<team>/<build id>/. The completion marker is written
+// to complete.json in the same directory.
+func NewDirectorySink(dir string) BuildLogSink {
+	return directorySink{dir: dir}
+}
+
+func (sink directorySink) Write(ctx context.Context, build Build, records []Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	payload, err := marshalRecords(records)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%010d.ndjson", records[0].Sequence)
+
+	return sink.writeFile(build, name, payload)
+}
+
+func (sink directorySink) Complete(ctx context.Context, completion Completion) error {
+	payload, err := json.Marshal(completion)
+	if err != nil {
+		return err
+	}
+
+	return sink.writeFile(completion.Build, "complete.json", append(payload, '\n'))
+}
+
+// writeFile writes to a temporary file first so that a file is never seen
+// partially written.
+func (sink directorySink) writeFile(build Build, name string, payload []byte) error {
+	buildDir := filepath.Join(sink.dir, build.Team, strconv.Itoa(build.ID))
+
+	err := os.MkdirAll(buildDir, 0755)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := ioutil.TempFile(buildDir, "."+name)
+	if err != nil {
+		return err
+	}
+
+	_, err = tmp.Write(payload)
+	if err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filepath.Join(buildDir, name))
+}
+
+func marshalRecords(records []Record) ([]byte, error) {
+	var payload []byte
+	for _, record := range records {
+		line, err := json.Marshal(record)
+		if err != nil {
+			return nil, err
+		}
+
+		payload = append(payload, line...)
+		payload = append(payload, '\n')
+	}
+
+	return payload, nil
+}
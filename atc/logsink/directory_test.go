@@ -0,0 +1,79 @@
+package logsink_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logsink"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DirectorySink", func() {
+	var (
+		dir   string
+		sink  logsink.BuildLogSink
+		build logsink.Build
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "build-logs")
+		Expect(err).NotTo(HaveOccurred())
+
+		sink = logsink.NewDirectorySink(dir)
+		build = logsink.Build{ID: 42, Name: "1", Team: "some-team", Status: "failed"}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("writes each batch as newline-delimited JSON", func() {
+		data := json.RawMessage(`{"payload":"hello"}`)
+
+		err := sink.Write(context.TODO(), build, []logsink.Record{
+			{Build: build, Sequence: 2, Event: event.EventTypeLog, Version: "5.1", Data: &data},
+			{Build: build, Sequence: 3, Event: event.EventTypeLog, Version: "5.1", Data: &data},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		contents, err := ioutil.ReadFile(filepath.Join(dir, "some-team", "42", "0000000002.ndjson"))
+		Expect(err).NotTo(HaveOccurred())
+
+		lines := bytes.Split(bytes.TrimSpace(contents), []byte("\n"))
+		Expect(lines).To(HaveLen(2))
+
+		var record logsink.Record
+		Expect(json.Unmarshal(lines[1], &record)).To(Succeed())
+		Expect(record.Sequence).To(Equal(3))
+		Expect(record.Build).To(Equal(build))
+		Expect(string(*record.Data)).To(MatchJSON(`{"payload":"hello"}`))
+	})
+
+	It("writes the completion marker", func() {
+		err := sink.Complete(context.TODO(), logsink.Completion{Build: build, Events: 4, ExportedAt: 100})
+		Expect(err).NotTo(HaveOccurred())
+
+		contents, err := ioutil.ReadFile(filepath.Join(dir, "some-team", "42", "complete.json"))
+		Expect(err).NotTo(HaveOccurred())
+
+		var completion logsink.Completion
+		Expect(json.Unmarshal(contents, &completion)).To(Succeed())
+		Expect(completion).To(Equal(logsink.Completion{Build: build, Events: 4, ExportedAt: 100}))
+	})
+
+	It("does not leave temporary files behind", func() {
+		err := sink.Complete(context.TODO(), logsink.Completion{Build: build})
+		Expect(err).NotTo(HaveOccurred())
+
+		files, err := ioutil.ReadDir(filepath.Join(dir, "some-team", "42"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,137 @@
+package logsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Elasticsearch struct {
+	URL      string        `long:"elasticsearch-url"      description:"Elasticsearch-compatible endpoint to export build events to with the bulk API."`
+	Index    string        `long:"elasticsearch-index"    default:"concourse-build-events" description:"Index to write build events to."`
+	Username string        `long:"elasticsearch-username" description:"Username for basic auth with the Elasticsearch endpoint."`
+	Password string        `long:"elasticsearch-password" description:"Password for basic auth with the Elasticsearch endpoint."`
+	Timeout  time.Duration `long:"elasticsearch-timeout"  default:"30s" description:"Timeout for requests to the Elasticsearch endpoint."`
+}
+
+func (es Elasticsearch) IsConfigured() bool {
+	return es.URL != ""
+}
+
+func (es Elasticsearch) NewSink() BuildLogSink {
+	return elasticsearchSink{
+		url:      strings.TrimSuffix(es.URL, "/") + "/_bulk",
+		index:    es.Index,
+		username: es.Username,
+		password: es.Password,
+		client:   &http.Client{Timeout: es.Timeout},
+	}
+}
+
+type elasticsearchSink struct {
+	url      string
+	index    string
+	username string
+	password string
+	client   *http.Client
+}
+
+type bulkAction struct {
+	Index bulkIndex `json:"index"`
+}
+
+type bulkIndex struct {
+	Index string `json:"_index"`
+	ID    string `json:"_id"`
+}
+
+type bulkResponse struct {
+	Errors bool `json:"errors"`
+}
+
+// Write indexes every record as its own document. Documents are identified by
+// build and sequence, so writing a batch again replaces rather than
+// duplicates them.
+func (sink elasticsearchSink) Write(ctx context.Context, build Build, records []Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	var body bytes.Buffer
+	for _, record := range records {
+		id := strconv.Itoa(build.ID) + "-" + strconv.Itoa(record.Sequence)
+
+		err := sink.appendDocument(&body, id, record)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sink.bulk(ctx, &body)
+}
+
+func (sink elasticsearchSink) Complete(ctx context.Context, completion Completion) error {
+	var body bytes.Buffer
+
+	err := sink.appendDocument(&body, strconv.Itoa(completion.Build.ID)+"-complete", completion)
+	if err != nil {
+		return err
+	}
+
+	return sink.bulk(ctx, &body)
+}
+
+func (sink elasticsearchSink) appendDocument(body *bytes.Buffer, id string, doc interface{}) error {
+	encoder := json.NewEncoder(body)
+
+	err := encoder.Encode(bulkAction{Index: bulkIndex{Index: sink.index, ID: id}})
+	if err != nil {
+		return err
+	}
+
+	return encoder.Encode(doc)
+}
+
+func (sink elasticsearchSink) bulk(ctx context.Context, body *bytes.Buffer) error {
+	req, err := http.NewRequest("POST", sink.url, body)
+	if err != nil {
+		return err
+	}
+
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-ndjson")
+
+	if sink.username != "" {
+		req.SetBasicAuth(sink.username, sink.password)
+	}
+
+	resp, err := sink.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("bulk request failed with status %d: %s", resp.StatusCode, msg)
+	}
+
+	var result bulkResponse
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return err
+	}
+
+	if result.Errors {
+		return fmt.Errorf("bulk request failed to index some documents")
+	}
+
+	return nil
+}
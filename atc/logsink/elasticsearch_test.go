@@ -0,0 +1,132 @@
+package logsink_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logsink"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Elasticsearch", func() {
+	var (
+		server *ghttp.Server
+		sink   logsink.BuildLogSink
+		build  logsink.Build
+		body   []byte
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+		body = nil
+
+		sink = logsink.Elasticsearch{
+			URL:      server.URL() + "/",
+			Index:    "some-index",
+			Username: "some-user",
+			Password: "some-password",
+			Timeout:  time.Second,
+		}.NewSink()
+
+		build = logsink.Build{ID: 42, Name: "1", Team: "some-team", Status: "succeeded"}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	recordBody := func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		body, err = ioutil.ReadAll(r.Body)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Describe("Write", func() {
+		var writeErr error
+
+		JustBeforeEach(func() {
+			data := json.RawMessage(`{"payload":"hello"}`)
+
+			writeErr = sink.Write(context.TODO(), build, []logsink.Record{
+				{Build: build, Sequence: 0, Event: event.EventTypeLog, Data: &data},
+				{Build: build, Sequence: 1, Event: event.EventTypeStatus, Data: &data},
+			})
+		})
+
+		Context("when the bulk request succeeds", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/_bulk"),
+					ghttp.VerifyBasicAuth("some-user", "some-password"),
+					ghttp.VerifyHeaderKV("Content-Type", "application/x-ndjson"),
+					recordBody,
+					ghttp.RespondWith(http.StatusOK, `{"errors":false}`),
+				))
+			})
+
+			It("indexes each record by build and sequence", func() {
+				Expect(writeErr).NotTo(HaveOccurred())
+
+				lines := bytes.Split(bytes.TrimSpace(body), []byte("\n"))
+				Expect(lines).To(HaveLen(4))
+				Expect(lines[0]).To(MatchJSON(`{"index":{"_index":"some-index","_id":"42-0"}}`))
+				Expect(lines[2]).To(MatchJSON(`{"index":{"_index":"some-index","_id":"42-1"}}`))
+
+				var record logsink.Record
+				Expect(json.Unmarshal(lines[3], &record)).To(Succeed())
+				Expect(record.Event).To(Equal(event.EventTypeStatus))
+				Expect(record.Build).To(Equal(build))
+			})
+		})
+
+		Context("when some documents fail to index", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{"errors":true}`))
+			})
+
+			It("errors", func() {
+				Expect(writeErr).To(HaveOccurred())
+			})
+		})
+
+		Context("when the bulk request fails", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(ghttp.RespondWith(http.StatusServiceUnavailable, `busy`))
+			})
+
+			It("errors", func() {
+				Expect(writeErr).To(MatchError("bulk request failed with status 503: busy"))
+			})
+		})
+	})
+
+	Describe("Complete", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest("POST", "/_bulk"),
+				recordBody,
+				ghttp.RespondWith(http.StatusOK, `{"errors":false}`),
+			))
+		})
+
+		It("indexes the completion marker", func() {
+			err := sink.Complete(context.TODO(), logsink.Completion{Build: build, Events: 2, ExportedAt: 100})
+			Expect(err).NotTo(HaveOccurred())
+
+			lines := bytes.Split(bytes.TrimSpace(body), []byte("\n"))
+			Expect(lines).To(HaveLen(2))
+			Expect(lines[0]).To(MatchJSON(`{"index":{"_index":"some-index","_id":"42-complete"}}`))
+
+			var completion logsink.Completion
+			Expect(json.Unmarshal(lines[1], &completion)).To(Succeed())
+			Expect(completion.Events).To(Equal(2))
+		})
+	})
+})
@@ -0,0 +1,200 @@
+package logsink
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/cenkalti/backoff"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// exportableBuildsPageSize is how many builds are loaded at a time to be
+// exported.
+const exportableBuildsPageSize = 100
+
+//go:generate counterfeiter . Exporter
+
+type Exporter interface {
+	Run(context.Context) error
+}
+
+type exporter struct {
+	sink          BuildLogSink
+	buildFactory  db.BuildFactory
+	batchSize     int
+	retries       int
+	retryInterval time.Duration
+}
+
+// NewExporter returns a component which exports the events of every build
+// that finished while a sink was configured. A build is marked as exported
+// once the sink has received its completion marker; until then its events are
+// not reaped.
+func NewExporter(
+	sink BuildLogSink,
+	buildFactory db.BuildFactory,
+	batchSize int,
+	retries int,
+	retryInterval time.Duration,
+) Exporter {
+	return &exporter{
+		sink:          sink,
+		buildFactory:  buildFactory,
+		batchSize:     batchSize,
+		retries:       retries,
+		retryInterval: retryInterval,
+	}
+}
+
+func (e *exporter) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("build-log-exporter")
+
+	afterID := 0
+	for {
+		builds, err := e.buildFactory.GetLogExportableBuilds(afterID, exportableBuildsPageSize)
+		if err != nil {
+			logger.Error("failed-to-get-exportable-builds", err)
+			return err
+		}
+
+		for _, build := range builds {
+			err := e.exportBuild(ctx, logger, build)
+			if err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				// the build will be exported again on the next run; don't let
+				// it hold up the others
+				continue
+			}
+		}
+
+		if len(builds) < exportableBuildsPageSize {
+			return nil
+		}
+
+		afterID = builds[len(builds)-1].ID()
+	}
+}
+
+func (e *exporter) exportBuild(ctx context.Context, logger lager.Logger, build db.Build) error {
+	logger = logger.Session("export-build", lager.Data{
+		"build": build.ID(),
+	})
+
+	info := Build{
+		ID:                   build.ID(),
+		Name:                 build.Name(),
+		Team:                 build.TeamName(),
+		Pipeline:             build.PipelineName(),
+		PipelineInstanceVars: build.PipelineInstanceVars(),
+		Job:                  build.JobName(),
+		Status:               string(build.Status()),
+	}
+
+	if !build.StartTime().IsZero() {
+		info.StartTime = build.StartTime().Unix()
+	}
+
+	if !build.EndTime().IsZero() {
+		info.EndTime = build.EndTime().Unix()
+	}
+
+	events, err := build.Events(0)
+	if err != nil {
+		logger.Error("failed-to-get-events", err)
+		return err
+	}
+
+	// ignore any errors coming from events.Close()
+	defer db.Close(events)
+
+	sequence := 0
+	batch := make([]Record, 0, e.batchSize)
+
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			logger.Error("failed-to-get-next-event", err)
+			return err
+		}
+
+		batch = append(batch, Record{
+			Build:    info,
+			Sequence: sequence,
+			Event:    ev.Event,
+			Version:  ev.Version,
+			Data:     ev.Data,
+		})
+
+		sequence++
+
+		if len(batch) >= e.batchSize {
+			err = e.write(ctx, logger, info, batch)
+			if err != nil {
+				return err
+			}
+
+			batch = make([]Record, 0, e.batchSize)
+		}
+	}
+
+	if len(batch) > 0 {
+		err = e.write(ctx, logger, info, batch)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = e.retry(ctx, logger, func() error {
+		return e.sink.Complete(ctx, Completion{
+			Build:      info,
+			Events:     sequence,
+			ExportedAt: time.Now().Unix(),
+		})
+	})
+	if err != nil {
+		logger.Error("failed-to-complete", err)
+		return err
+	}
+
+	err = build.SetLogExported(true)
+	if err != nil {
+		logger.Error("failed-to-update-status", err)
+		return err
+	}
+
+	return nil
+}
+
+func (e *exporter) write(ctx context.Context, logger lager.Logger, build Build, batch []Record) error {
+	err := e.retry(ctx, logger, func() error {
+		return e.sink.Write(ctx, build, batch)
+	})
+	if err != nil {
+		logger.Error("failed-to-write-batch", err, lager.Data{"sequence": batch[0].Sequence})
+		return err
+	}
+
+	return nil
+}
+
+func (e *exporter) retry(ctx context.Context, logger lager.Logger, op func() error) error {
+	b := backoff.NewExponentialBackOff()
+	b.InitialInterval = e.retryInterval
+
+	return backoff.RetryNotify(
+		op,
+		backoff.WithContext(backoff.WithMaxRetries(b, uint64(e.retries)), ctx),
+		func(err error, wait time.Duration) {
+			logger.Info("retrying", lager.Data{"error": err.Error(), "wait": wait.String()})
+		},
+	)
+}
@@ -0,0 +1,209 @@
+package logsink_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logsink"
+	"github.com/concourse/concourse/atc/logsink/logsinkfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func newFakeBuild(id int, eventCount int) *dbfakes.FakeBuild {
+	fakeEventSource := new(dbfakes.FakeEventSource)
+
+	for i := 0; i < eventCount; i++ {
+		msg := json.RawMessage(`{"time":1533744538,"payload":"build ` + strconv.Itoa(id) + ` log ` + strconv.Itoa(i) + `"}`)
+
+		fakeEventSource.NextReturnsOnCall(i, event.Envelope{
+			Data:    &msg,
+			Event:   event.EventTypeLog,
+			Version: "5.1",
+		}, nil)
+	}
+
+	fakeEventSource.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+	fakeBuild := new(dbfakes.FakeBuild)
+	fakeBuild.EventsReturns(fakeEventSource, nil)
+	fakeBuild.IDReturns(id)
+	fakeBuild.NameReturns("1")
+	fakeBuild.TeamNameReturns("some-team")
+	fakeBuild.PipelineNameReturns("some-pipeline")
+	fakeBuild.JobNameReturns("some-job")
+	fakeBuild.StatusReturns(db.BuildStatusSucceeded)
+	fakeBuild.EndTimeReturns(time.Unix(1533744538, 0))
+
+	return fakeBuild
+}
+
+var _ = Describe("Exporter", func() {
+	var (
+		fakeSink         *logsinkfakes.FakeBuildLogSink
+		fakeBuildFactory *dbfakes.FakeBuildFactory
+
+		build1 *dbfakes.FakeBuild
+		build2 *dbfakes.FakeBuild
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeSink = new(logsinkfakes.FakeBuildLogSink)
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+
+		build1 = newFakeBuild(123, 5)
+		build2 = newFakeBuild(345, 1)
+		fakeBuildFactory.GetLogExportableBuildsReturns([]db.Build{build1, build2}, nil)
+	})
+
+	JustBeforeEach(func() {
+		exporter := logsink.NewExporter(fakeSink, fakeBuildFactory, 2, 2, time.Millisecond)
+		runErr = exporter.Run(context.TODO())
+	})
+
+	It("writes the events of each build in batches", func() {
+		Expect(runErr).NotTo(HaveOccurred())
+		Expect(fakeSink.WriteCallCount()).To(Equal(4))
+
+		_, build, records := fakeSink.WriteArgsForCall(0)
+		Expect(build).To(Equal(logsink.Build{
+			ID:       123,
+			Name:     "1",
+			Team:     "some-team",
+			Pipeline: "some-pipeline",
+			Job:      "some-job",
+			Status:   "succeeded",
+			EndTime:  1533744538,
+		}))
+		Expect(records).To(HaveLen(2))
+		Expect(records[0].Build).To(Equal(build))
+		Expect(records[0].Sequence).To(Equal(0))
+		Expect(records[0].Event).To(Equal(event.EventTypeLog))
+		Expect(records[0].Version).To(Equal(atc.EventVersion("5.1")))
+		Expect(string(*records[0].Data)).To(ContainSubstring("build 123 log 0"))
+		Expect(records[1].Sequence).To(Equal(1))
+
+		_, _, records = fakeSink.WriteArgsForCall(2)
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Sequence).To(Equal(4))
+
+		_, build, records = fakeSink.WriteArgsForCall(3)
+		Expect(build.ID).To(Equal(345))
+		Expect(records).To(HaveLen(1))
+	})
+
+	It("completes each build and marks it as exported", func() {
+		Expect(fakeSink.CompleteCallCount()).To(Equal(2))
+
+		_, completion := fakeSink.CompleteArgsForCall(0)
+		Expect(completion.Build.ID).To(Equal(123))
+		Expect(completion.Events).To(Equal(5))
+		Expect(completion.ExportedAt).NotTo(BeZero())
+
+		Expect(build1.SetLogExportedCallCount()).To(Equal(1))
+		Expect(build1.SetLogExportedArgsForCall(0)).To(BeTrue())
+		Expect(build2.SetLogExportedCallCount()).To(Equal(1))
+	})
+
+	Context("when writing a batch fails intermittently", func() {
+		BeforeEach(func() {
+			fakeSink.WriteReturnsOnCall(0, errors.New("nope"))
+		})
+
+		It("retries the batch", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(fakeSink.WriteCallCount()).To(Equal(5))
+
+			_, _, first := fakeSink.WriteArgsForCall(0)
+			_, _, retried := fakeSink.WriteArgsForCall(1)
+			Expect(retried).To(Equal(first))
+
+			Expect(build1.SetLogExportedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when writing a batch keeps failing", func() {
+		BeforeEach(func() {
+			fakeSink.WriteStub = func(_ context.Context, build logsink.Build, _ []logsink.Record) error {
+				if build.ID == 123 {
+					return errors.New("nope")
+				}
+
+				return nil
+			}
+		})
+
+		It("gives up on the build without marking it as exported", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(build1.SetLogExportedCallCount()).To(BeZero())
+		})
+
+		It("continues exporting other builds", func() {
+			Expect(fakeSink.CompleteCallCount()).To(Equal(1))
+			Expect(build2.SetLogExportedCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when completing a build fails", func() {
+		BeforeEach(func() {
+			fakeSink.CompleteReturns(errors.New("nope"))
+		})
+
+		It("does not mark it as exported", func() {
+			Expect(fakeSink.CompleteCallCount()).To(Equal(6))
+			Expect(build1.SetLogExportedCallCount()).To(BeZero())
+			Expect(build2.SetLogExportedCallCount()).To(BeZero())
+		})
+	})
+
+	It("loads the builds a page at a time", func() {
+		Expect(fakeBuildFactory.GetLogExportableBuildsCallCount()).To(Equal(1))
+		afterID, limit := fakeBuildFactory.GetLogExportableBuildsArgsForCall(0)
+		Expect(afterID).To(BeZero())
+		Expect(limit).To(Equal(100))
+	})
+
+	Context("when a page is full", func() {
+		var pageBuilds []db.Build
+
+		BeforeEach(func() {
+			pageBuilds = nil
+			for i := 1; i <= 100; i++ {
+				pageBuilds = append(pageBuilds, newFakeBuild(i, 0))
+			}
+
+			fakeBuildFactory.GetLogExportableBuildsReturnsOnCall(0, pageBuilds, nil)
+			fakeBuildFactory.GetLogExportableBuildsReturnsOnCall(1, []db.Build{build1}, nil)
+		})
+
+		It("loads the next page after the last build", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(fakeBuildFactory.GetLogExportableBuildsCallCount()).To(Equal(2))
+
+			afterID, limit := fakeBuildFactory.GetLogExportableBuildsArgsForCall(1)
+			Expect(afterID).To(Equal(100))
+			Expect(limit).To(Equal(100))
+
+			Expect(fakeSink.CompleteCallCount()).To(Equal(101))
+		})
+	})
+
+	Context("when getting the builds fails", func() {
+		BeforeEach(func() {
+			fakeBuildFactory.GetLogExportableBuildsReturns(nil, errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+})
@@ -0,0 +1,106 @@
+package logsink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+)
+
+type S3 struct {
+	Bucket          string `long:"s3-bucket"            description:"S3 bucket to export build events to."`
+	Prefix          string `long:"s3-prefix"            description:"Prefix for the keys of exported objects."`
+	Region          string `long:"s3-region"            description:"AWS region of the S3 bucket."`
+	Endpoint        string `long:"s3-endpoint"          description:"Endpoint of an S3-compatible blob store, if not using AWS."`
+	UsePathStyle    bool   `long:"s3-use-path-style"    description:"Address the bucket as part of the path rather than the host, as some S3-compatible stores require."`
+	AccessKeyID     string `long:"s3-access-key-id"     description:"Access key ID for the S3 bucket. Defaults to the AWS credential chain."`
+	SecretAccessKey string `long:"s3-secret-access-key" description:"Secret access key for the S3 bucket."`
+	SessionToken    string `long:"s3-session-token"     description:"Session token for the S3 bucket."`
+}
+
+func (config S3) IsConfigured() bool {
+	return config.Bucket != ""
+}
+
+func (config S3) NewSink() (BuildLogSink, error) {
+	awsConfig := &aws.Config{
+		Region:           aws.String(config.Region),
+		S3ForcePathStyle: aws.Bool(config.UsePathStyle),
+	}
+
+	if config.Endpoint != "" {
+		awsConfig.Endpoint = aws.String(config.Endpoint)
+	}
+
+	if config.AccessKeyID != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, config.SessionToken)
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create aws session: %w", err)
+	}
+
+	return NewS3Sink(s3.New(sess), config.Bucket, config.Prefix), nil
+}
+
+type s3Sink struct {
+	client s3iface.S3API
+	bucket string
+	prefix string
+}
+
+// NewS3Sink writes each batch of records to its own newline-delimited JSON
+// object, keyed like the files written by the directory sink.
+func NewS3Sink(client s3iface.S3API, bucket string, prefix string) BuildLogSink {
+	return s3Sink{
+		client: client,
+		bucket: bucket,
+		prefix: prefix,
+	}
+}
+
+func (sink s3Sink) Write(ctx context.Context, build Build, records []Record) error {
+	if len(records) == 0 {
+		return nil
+	}
+
+	payload, err := marshalRecords(records)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%010d.ndjson", records[0].Sequence)
+
+	return sink.put(ctx, build, name, "application/x-ndjson", payload)
+}
+
+func (sink s3Sink) Complete(ctx context.Context, completion Completion) error {
+	payload, err := json.Marshal(completion)
+	if err != nil {
+		return err
+	}
+
+	return sink.put(ctx, completion.Build, "complete.json", "application/json", payload)
+}
+
+func (sink s3Sink) put(ctx context.Context, build Build, name string, contentType string, payload []byte) error {
+	key := path.Join(sink.prefix, build.Team, strconv.Itoa(build.ID), name)
+
+	_, err := sink.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(sink.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(payload),
+		ContentType: aws.String(contentType),
+	})
+
+	return err
+}
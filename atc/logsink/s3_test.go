@@ -0,0 +1,81 @@
+package logsink_test
+
+import (
+	"context"
+	"io/ioutil"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logsink"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type putObject struct {
+	bucket      string
+	key         string
+	contentType string
+	body        string
+}
+
+type MockS3Service struct {
+	s3iface.S3API
+
+	puts []putObject
+}
+
+func (mock *MockS3Service) PutObjectWithContext(ctx aws.Context, input *s3.PutObjectInput, opts ...request.Option) (*s3.PutObjectOutput, error) {
+	body, err := ioutil.ReadAll(input.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	mock.puts = append(mock.puts, putObject{
+		bucket:      *input.Bucket,
+		key:         *input.Key,
+		contentType: *input.ContentType,
+		body:        string(body),
+	})
+
+	return &s3.PutObjectOutput{}, nil
+}
+
+var _ = Describe("S3Sink", func() {
+	var (
+		mockService *MockS3Service
+		sink        logsink.BuildLogSink
+		build       logsink.Build
+	)
+
+	BeforeEach(func() {
+		mockService = &MockS3Service{}
+		sink = logsink.NewS3Sink(mockService, "some-bucket", "some/prefix")
+		build = logsink.Build{ID: 42, Name: "1", Team: "some-team", Status: "succeeded"}
+	})
+
+	It("puts each batch as an object keyed by build and sequence", func() {
+		err := sink.Write(context.TODO(), build, []logsink.Record{
+			{Build: build, Sequence: 500, Event: event.EventTypeLog},
+			{Build: build, Sequence: 501, Event: event.EventTypeLog},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockService.puts).To(HaveLen(1))
+		Expect(mockService.puts[0].bucket).To(Equal("some-bucket"))
+		Expect(mockService.puts[0].key).To(Equal("some/prefix/some-team/42/0000000500.ndjson"))
+		Expect(mockService.puts[0].contentType).To(Equal("application/x-ndjson"))
+		Expect(mockService.puts[0].body).To(ContainSubstring(`"sequence":501`))
+	})
+
+	It("puts the completion marker", func() {
+		err := sink.Complete(context.TODO(), logsink.Completion{Build: build, Events: 502})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(mockService.puts).To(HaveLen(1))
+		Expect(mockService.puts[0].key).To(Equal("some/prefix/some-team/42/complete.json"))
+		Expect(mockService.puts[0].body).To(ContainSubstring(`"events":502`))
+	})
+})
@@ -0,0 +1,107 @@
+package logsink
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+)
+
+// Build describes the build that exported events belong to.
+type Build struct {
+	ID                   int              `json:"id"`
+	Name                 string           `json:"name"`
+	Team                 string           `json:"team"`
+	Pipeline             string           `json:"pipeline,omitempty"`
+	PipelineInstanceVars atc.InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	Job                  string           `json:"job,omitempty"`
+	Status               string           `json:"status"`
+	StartTime            int64            `json:"start_time,omitempty"`
+	EndTime              int64            `json:"end_time,omitempty"`
+}
+
+// Record is a single build event, as saved by db.Build.SaveEvent, along with
+// the build it belongs to. Sequence is the position of the event in the
+// build's event stream, which sinks may use to write records idempotently.
+type Record struct {
+	Build    Build            `json:"build"`
+	Sequence int              `json:"sequence"`
+	Event    atc.EventType    `json:"event"`
+	Version  atc.EventVersion `json:"version"`
+	Data     *json.RawMessage `json:"data"`
+}
+
+// Completion marks that all of a build's events have been exported.
+type Completion struct {
+	Build      Build `json:"build"`
+	Events     int   `json:"events"`
+	ExportedAt int64 `json:"exported_at"`
+}
+
+//go:generate counterfeiter . BuildLogSink
+
+// BuildLogSink ships the events of finished builds to an external target.
+//
+// Records are written in batches, in order. Once every batch of a build has
+// been written, Complete is called with the completion marker. Both may be
+// retried, so writing the same records twice should not duplicate them.
+type BuildLogSink interface {
+	Write(ctx context.Context, build Build, records []Record) error
+	Complete(ctx context.Context, completion Completion) error
+}
+
+var ErrMultipleSinksConfigured = errors.New("only one build log sink may be configured")
+
+type Config struct {
+	Directory string `long:"directory" description:"Local directory to export build events to as newline-delimited JSON."`
+
+	S3 S3
+
+	Elasticsearch Elasticsearch
+
+	Interval      time.Duration `long:"interval"       default:"30s" description:"Interval on which to export the events of finished builds."`
+	BatchSize     int           `long:"batch-size"     default:"500" description:"Maximum number of events to write to the sink at once."`
+	Retries       int           `long:"retries"        default:"5"   description:"Number of times to retry writing a batch of events before giving up until the next interval."`
+	RetryInterval time.Duration `long:"retry-interval" default:"1s"  description:"Initial interval between retries, which backs off exponentially."`
+}
+
+func (config Config) IsConfigured() bool {
+	return config.Directory != "" ||
+		config.S3.IsConfigured() ||
+		config.Elasticsearch.IsConfigured()
+}
+
+// NewSink returns the configured sink, or nil if none is configured.
+func (config Config) NewSink(logger lager.Logger) (BuildLogSink, error) {
+	var sinks []BuildLogSink
+
+	if config.Directory != "" {
+		sinks = append(sinks, NewDirectorySink(config.Directory))
+	}
+
+	if config.S3.IsConfigured() {
+		sink, err := config.S3.NewSink()
+		if err != nil {
+			return nil, err
+		}
+
+		sinks = append(sinks, sink)
+	}
+
+	if config.Elasticsearch.IsConfigured() {
+		sinks = append(sinks, config.Elasticsearch.NewSink())
+	}
+
+	switch len(sinks) {
+	case 0:
+		return nil, nil
+	case 1:
+		logger.Info("build-log-sink-configured")
+		return sinks[0], nil
+	default:
+		return nil, ErrMultipleSinksConfigured
+	}
+}
@@ -0,0 +1,44 @@
+package logsink_test
+
+import (
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/logsink"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var logger *lagertest.TestLogger
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("test")
+	})
+
+	It("has no sink if nothing is configured", func() {
+		config := logsink.Config{}
+		Expect(config.IsConfigured()).To(BeFalse())
+
+		sink, err := config.NewSink(logger)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sink).To(BeNil())
+	})
+
+	It("returns the configured sink", func() {
+		config := logsink.Config{Directory: "/some/dir"}
+		Expect(config.IsConfigured()).To(BeTrue())
+
+		sink, err := config.NewSink(logger)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sink).To(Equal(logsink.NewDirectorySink("/some/dir")))
+	})
+
+	It("errors if more than one sink is configured", func() {
+		config := logsink.Config{
+			Directory:     "/some/dir",
+			Elasticsearch: logsink.Elasticsearch{URL: "http://example.com"},
+		}
+
+		_, err := config.NewSink(logger)
+		Expect(err).To(Equal(logsink.ErrMultipleSinksConfigured))
+	})
+})
@@ -2,7 +2,6 @@ package metric
 
 import (
 	"fmt"
-	"strings"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -50,13 +49,23 @@ type eventEmission struct {
 	logger lager.Logger
 }
 
+// emitterQueue buffers the events for one emitter, so that an emitter which
+// falls behind doesn't hold up the others.
+type emitterQueue struct {
+	description string
+	emitter     Emitter
+	emissions   chan eventEmission
+}
+
 var (
-	emitter         Emitter
+	emitters        []*emitterQueue
 	eventHost       string
 	eventAttributes map[string]string
-	emissions       chan eventEmission
 )
 
+// Initialize creates an emitter for each configured emitter factory. Every
+// event is emitted to all of them, so that metrics can be sent to a new
+// backend alongside the old one while moving between them.
 func Initialize(logger lager.Logger, host string, attributes map[string]string, bufferSize uint32) error {
 	logger.Debug("metric-initialize", lager.Data{
 		"host":        host,
@@ -64,49 +73,51 @@ func Initialize(logger lager.Logger, host string, attributes map[string]string,
 		"buffer-size": bufferSize,
 	})
 
-	var (
-		emitterDescriptions []string
-		err                 error
-	)
+	var queues []*emitterQueue
 
 	for _, factory := range emitterFactories {
-		if factory.IsConfigured() {
-			emitterDescriptions = append(emitterDescriptions, factory.Description())
+		if !factory.IsConfigured() {
+			continue
 		}
-	}
-	if len(emitterDescriptions) > 1 {
-		return fmt.Errorf("Multiple emitters configured: %s", strings.Join(emitterDescriptions, ", "))
-	}
 
-	for _, factory := range emitterFactories {
-		if factory.IsConfigured() {
-			emitter, err = factory.NewEmitter()
-			if err != nil {
-				return err
-			}
+		emitter, err := factory.NewEmitter()
+		if err != nil {
+			return err
 		}
+
+		queues = append(queues, &emitterQueue{
+			description: factory.Description(),
+			emitter:     emitter,
+			emissions:   make(chan eventEmission, int(bufferSize)),
+		})
 	}
 
-	if emitter == nil {
+	if len(queues) == 0 {
 		return nil
 	}
 
 	eventHost = host
 	eventAttributes = attributes
-	emissions = make(chan eventEmission, int(bufferSize))
+	emitters = queues
 
-	go emitLoop()
+	for _, queue := range queues {
+		go queue.emitLoop()
+	}
 
 	return nil
 }
 
 func Deinitialize(logger lager.Logger) {
-	close(emissions)
+	for _, queue := range emitters {
+		close(queue.emissions)
+	}
+
+	emitters = nil
 	emitterFactories = nil
 }
 
 func emit(logger lager.Logger, event Event) {
-	if emitter == nil {
+	if len(emitters) == 0 {
 		return
 	}
 
@@ -126,15 +137,17 @@ func emit(logger lager.Logger, event Event) {
 
 	event.Attributes = mergedAttributes
 
-	select {
-	case emissions <- eventEmission{logger: logger, event: event}:
-	default:
-		logger.Error("queue-full", nil)
+	for _, queue := range emitters {
+		select {
+		case queue.emissions <- eventEmission{logger: logger, event: event}:
+		default:
+			logger.Error("queue-full", nil, lager.Data{"emitter": queue.description})
+		}
 	}
 }
 
-func emitLoop() {
-	for emission := range emissions {
-		emitter.Emit(emission.logger.Session("emit"), emission.event)
+func (queue *emitterQueue) emitLoop() {
+	for emission := range queue.emissions {
+		queue.emitter.Emit(emission.logger.Session("emit"), emission.event)
 	}
 }
@@ -0,0 +1,88 @@
+package metric_test
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/metricfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Emitting", func() {
+	var (
+		influxFactory *metricfakes.FakeEmitterFactory
+		otlpFactory   *metricfakes.FakeEmitterFactory
+
+		influxEmitter *metricfakes.FakeEmitter
+		otlpEmitter   *metricfakes.FakeEmitter
+
+		initErr error
+	)
+
+	BeforeEach(func() {
+		influxEmitter = new(metricfakes.FakeEmitter)
+		influxFactory = new(metricfakes.FakeEmitterFactory)
+		influxFactory.DescriptionReturns("InfluxDB")
+		influxFactory.IsConfiguredReturns(true)
+		influxFactory.NewEmitterReturns(influxEmitter, nil)
+
+		otlpEmitter = new(metricfakes.FakeEmitter)
+		otlpFactory = new(metricfakes.FakeEmitterFactory)
+		otlpFactory.DescriptionReturns("OTLP")
+		otlpFactory.IsConfiguredReturns(true)
+		otlpFactory.NewEmitterReturns(otlpEmitter, nil)
+
+		metric.RegisterEmitter(influxFactory)
+		metric.RegisterEmitter(otlpFactory)
+	})
+
+	JustBeforeEach(func() {
+		initErr = metric.Initialize(testLogger, "test", map[string]string{"some": "attribute"}, 1000)
+	})
+
+	AfterEach(func() {
+		metric.Deinitialize(nil)
+	})
+
+	Context("when more than one emitter is configured", func() {
+		It("emits every event to all of them", func() {
+			Expect(initErr).NotTo(HaveOccurred())
+
+			metric.VolumesToBeGarbageCollected{Volumes: 3}.Emit(testLogger)
+
+			Eventually(influxEmitter.EmitCallCount).Should(Equal(1))
+			Eventually(otlpEmitter.EmitCallCount).Should(Equal(1))
+
+			_, influxEvent := influxEmitter.EmitArgsForCall(0)
+			_, otlpEvent := otlpEmitter.EmitArgsForCall(0)
+			Expect(influxEvent).To(Equal(otlpEvent))
+			Expect(influxEvent.Attributes).To(HaveKeyWithValue("some", "attribute"))
+		})
+
+		Context("when one of them is not configured", func() {
+			BeforeEach(func() {
+				otlpFactory.IsConfiguredReturns(false)
+			})
+
+			It("only emits to the configured one", func() {
+				metric.VolumesToBeGarbageCollected{Volumes: 3}.Emit(testLogger)
+
+				Eventually(influxEmitter.EmitCallCount).Should(Equal(1))
+				Consistently(otlpEmitter.EmitCallCount).Should(BeZero())
+				Expect(otlpFactory.NewEmitterCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when one of them fails to be created", func() {
+			BeforeEach(func() {
+				otlpFactory.NewEmitterReturns(nil, errors.New("nope"))
+			})
+
+			It("returns the error", func() {
+				Expect(initErr).To(MatchError("nope"))
+			})
+		})
+	})
+})
@@ -9,6 +9,16 @@ import (
 )
 
 type FakePrometheusGarbageCollectable struct {
+	WorkerCPUUsageStub        func() *prometheus.GaugeVec
+	workerCPUUsageMutex       sync.RWMutex
+	workerCPUUsageArgsForCall []struct {
+	}
+	workerCPUUsageReturns struct {
+		result1 *prometheus.GaugeVec
+	}
+	workerCPUUsageReturnsOnCall map[int]struct {
+		result1 *prometheus.GaugeVec
+	}
 	WorkerContainersStub        func() *prometheus.GaugeVec
 	workerContainersMutex       sync.RWMutex
 	workerContainersArgsForCall []struct {
@@ -29,6 +39,26 @@ type FakePrometheusGarbageCollectable struct {
 	workerContainersLabelsReturnsOnCall map[int]struct {
 		result1 map[string]map[string]prometheus.Labels
 	}
+	WorkerDiskUsageStub        func() *prometheus.GaugeVec
+	workerDiskUsageMutex       sync.RWMutex
+	workerDiskUsageArgsForCall []struct {
+	}
+	workerDiskUsageReturns struct {
+		result1 *prometheus.GaugeVec
+	}
+	workerDiskUsageReturnsOnCall map[int]struct {
+		result1 *prometheus.GaugeVec
+	}
+	WorkerMemoryUsageStub        func() *prometheus.GaugeVec
+	workerMemoryUsageMutex       sync.RWMutex
+	workerMemoryUsageArgsForCall []struct {
+	}
+	workerMemoryUsageReturns struct {
+		result1 *prometheus.GaugeVec
+	}
+	workerMemoryUsageReturnsOnCall map[int]struct {
+		result1 *prometheus.GaugeVec
+	}
 	WorkerTasksStub        func() *prometheus.GaugeVec
 	workerTasksMutex       sync.RWMutex
 	workerTasksArgsForCall []struct {
@@ -49,6 +79,16 @@ type FakePrometheusGarbageCollectable struct {
 	workerTasksLabelsReturnsOnCall map[int]struct {
 		result1 map[string]map[string]prometheus.Labels
 	}
+	WorkerUsageLabelsStub        func() map[string]map[string]prometheus.Labels
+	workerUsageLabelsMutex       sync.RWMutex
+	workerUsageLabelsArgsForCall []struct {
+	}
+	workerUsageLabelsReturns struct {
+		result1 map[string]map[string]prometheus.Labels
+	}
+	workerUsageLabelsReturnsOnCall map[int]struct {
+		result1 map[string]map[string]prometheus.Labels
+	}
 	WorkerVolumesStub        func() *prometheus.GaugeVec
 	workerVolumesMutex       sync.RWMutex
 	workerVolumesArgsForCall []struct {
@@ -73,6 +113,58 @@ type FakePrometheusGarbageCollectable struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakePrometheusGarbageCollectable) WorkerCPUUsage() *prometheus.GaugeVec {
+	fake.workerCPUUsageMutex.Lock()
+	ret, specificReturn := fake.workerCPUUsageReturnsOnCall[len(fake.workerCPUUsageArgsForCall)]
+	fake.workerCPUUsageArgsForCall = append(fake.workerCPUUsageArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerCPUUsage", []interface{}{})
+	fake.workerCPUUsageMutex.Unlock()
+	if fake.WorkerCPUUsageStub != nil {
+		return fake.WorkerCPUUsageStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.workerCPUUsageReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerCPUUsageCallCount() int {
+	fake.workerCPUUsageMutex.RLock()
+	defer fake.workerCPUUsageMutex.RUnlock()
+	return len(fake.workerCPUUsageArgsForCall)
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerCPUUsageCalls(stub func() *prometheus.GaugeVec) {
+	fake.workerCPUUsageMutex.Lock()
+	defer fake.workerCPUUsageMutex.Unlock()
+	fake.WorkerCPUUsageStub = stub
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerCPUUsageReturns(result1 *prometheus.GaugeVec) {
+	fake.workerCPUUsageMutex.Lock()
+	defer fake.workerCPUUsageMutex.Unlock()
+	fake.WorkerCPUUsageStub = nil
+	fake.workerCPUUsageReturns = struct {
+		result1 *prometheus.GaugeVec
+	}{result1}
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerCPUUsageReturnsOnCall(i int, result1 *prometheus.GaugeVec) {
+	fake.workerCPUUsageMutex.Lock()
+	defer fake.workerCPUUsageMutex.Unlock()
+	fake.WorkerCPUUsageStub = nil
+	if fake.workerCPUUsageReturnsOnCall == nil {
+		fake.workerCPUUsageReturnsOnCall = make(map[int]struct {
+			result1 *prometheus.GaugeVec
+		})
+	}
+	fake.workerCPUUsageReturnsOnCall[i] = struct {
+		result1 *prometheus.GaugeVec
+	}{result1}
+}
+
 func (fake *FakePrometheusGarbageCollectable) WorkerContainers() *prometheus.GaugeVec {
 	fake.workerContainersMutex.Lock()
 	ret, specificReturn := fake.workerContainersReturnsOnCall[len(fake.workerContainersArgsForCall)]
@@ -177,6 +269,110 @@ func (fake *FakePrometheusGarbageCollectable) WorkerContainersLabelsReturnsOnCal
 	}{result1}
 }
 
+func (fake *FakePrometheusGarbageCollectable) WorkerDiskUsage() *prometheus.GaugeVec {
+	fake.workerDiskUsageMutex.Lock()
+	ret, specificReturn := fake.workerDiskUsageReturnsOnCall[len(fake.workerDiskUsageArgsForCall)]
+	fake.workerDiskUsageArgsForCall = append(fake.workerDiskUsageArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerDiskUsage", []interface{}{})
+	fake.workerDiskUsageMutex.Unlock()
+	if fake.WorkerDiskUsageStub != nil {
+		return fake.WorkerDiskUsageStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.workerDiskUsageReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerDiskUsageCallCount() int {
+	fake.workerDiskUsageMutex.RLock()
+	defer fake.workerDiskUsageMutex.RUnlock()
+	return len(fake.workerDiskUsageArgsForCall)
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerDiskUsageCalls(stub func() *prometheus.GaugeVec) {
+	fake.workerDiskUsageMutex.Lock()
+	defer fake.workerDiskUsageMutex.Unlock()
+	fake.WorkerDiskUsageStub = stub
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerDiskUsageReturns(result1 *prometheus.GaugeVec) {
+	fake.workerDiskUsageMutex.Lock()
+	defer fake.workerDiskUsageMutex.Unlock()
+	fake.WorkerDiskUsageStub = nil
+	fake.workerDiskUsageReturns = struct {
+		result1 *prometheus.GaugeVec
+	}{result1}
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerDiskUsageReturnsOnCall(i int, result1 *prometheus.GaugeVec) {
+	fake.workerDiskUsageMutex.Lock()
+	defer fake.workerDiskUsageMutex.Unlock()
+	fake.WorkerDiskUsageStub = nil
+	if fake.workerDiskUsageReturnsOnCall == nil {
+		fake.workerDiskUsageReturnsOnCall = make(map[int]struct {
+			result1 *prometheus.GaugeVec
+		})
+	}
+	fake.workerDiskUsageReturnsOnCall[i] = struct {
+		result1 *prometheus.GaugeVec
+	}{result1}
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerMemoryUsage() *prometheus.GaugeVec {
+	fake.workerMemoryUsageMutex.Lock()
+	ret, specificReturn := fake.workerMemoryUsageReturnsOnCall[len(fake.workerMemoryUsageArgsForCall)]
+	fake.workerMemoryUsageArgsForCall = append(fake.workerMemoryUsageArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerMemoryUsage", []interface{}{})
+	fake.workerMemoryUsageMutex.Unlock()
+	if fake.WorkerMemoryUsageStub != nil {
+		return fake.WorkerMemoryUsageStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.workerMemoryUsageReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerMemoryUsageCallCount() int {
+	fake.workerMemoryUsageMutex.RLock()
+	defer fake.workerMemoryUsageMutex.RUnlock()
+	return len(fake.workerMemoryUsageArgsForCall)
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerMemoryUsageCalls(stub func() *prometheus.GaugeVec) {
+	fake.workerMemoryUsageMutex.Lock()
+	defer fake.workerMemoryUsageMutex.Unlock()
+	fake.WorkerMemoryUsageStub = stub
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerMemoryUsageReturns(result1 *prometheus.GaugeVec) {
+	fake.workerMemoryUsageMutex.Lock()
+	defer fake.workerMemoryUsageMutex.Unlock()
+	fake.WorkerMemoryUsageStub = nil
+	fake.workerMemoryUsageReturns = struct {
+		result1 *prometheus.GaugeVec
+	}{result1}
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerMemoryUsageReturnsOnCall(i int, result1 *prometheus.GaugeVec) {
+	fake.workerMemoryUsageMutex.Lock()
+	defer fake.workerMemoryUsageMutex.Unlock()
+	fake.WorkerMemoryUsageStub = nil
+	if fake.workerMemoryUsageReturnsOnCall == nil {
+		fake.workerMemoryUsageReturnsOnCall = make(map[int]struct {
+			result1 *prometheus.GaugeVec
+		})
+	}
+	fake.workerMemoryUsageReturnsOnCall[i] = struct {
+		result1 *prometheus.GaugeVec
+	}{result1}
+}
+
 func (fake *FakePrometheusGarbageCollectable) WorkerTasks() *prometheus.GaugeVec {
 	fake.workerTasksMutex.Lock()
 	ret, specificReturn := fake.workerTasksReturnsOnCall[len(fake.workerTasksArgsForCall)]
@@ -281,6 +477,58 @@ func (fake *FakePrometheusGarbageCollectable) WorkerTasksLabelsReturnsOnCall(i i
 	}{result1}
 }
 
+func (fake *FakePrometheusGarbageCollectable) WorkerUsageLabels() map[string]map[string]prometheus.Labels {
+	fake.workerUsageLabelsMutex.Lock()
+	ret, specificReturn := fake.workerUsageLabelsReturnsOnCall[len(fake.workerUsageLabelsArgsForCall)]
+	fake.workerUsageLabelsArgsForCall = append(fake.workerUsageLabelsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("WorkerUsageLabels", []interface{}{})
+	fake.workerUsageLabelsMutex.Unlock()
+	if fake.WorkerUsageLabelsStub != nil {
+		return fake.WorkerUsageLabelsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.workerUsageLabelsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerUsageLabelsCallCount() int {
+	fake.workerUsageLabelsMutex.RLock()
+	defer fake.workerUsageLabelsMutex.RUnlock()
+	return len(fake.workerUsageLabelsArgsForCall)
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerUsageLabelsCalls(stub func() map[string]map[string]prometheus.Labels) {
+	fake.workerUsageLabelsMutex.Lock()
+	defer fake.workerUsageLabelsMutex.Unlock()
+	fake.WorkerUsageLabelsStub = stub
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerUsageLabelsReturns(result1 map[string]map[string]prometheus.Labels) {
+	fake.workerUsageLabelsMutex.Lock()
+	defer fake.workerUsageLabelsMutex.Unlock()
+	fake.WorkerUsageLabelsStub = nil
+	fake.workerUsageLabelsReturns = struct {
+		result1 map[string]map[string]prometheus.Labels
+	}{result1}
+}
+
+func (fake *FakePrometheusGarbageCollectable) WorkerUsageLabelsReturnsOnCall(i int, result1 map[string]map[string]prometheus.Labels) {
+	fake.workerUsageLabelsMutex.Lock()
+	defer fake.workerUsageLabelsMutex.Unlock()
+	fake.WorkerUsageLabelsStub = nil
+	if fake.workerUsageLabelsReturnsOnCall == nil {
+		fake.workerUsageLabelsReturnsOnCall = make(map[int]struct {
+			result1 map[string]map[string]prometheus.Labels
+		})
+	}
+	fake.workerUsageLabelsReturnsOnCall[i] = struct {
+		result1 map[string]map[string]prometheus.Labels
+	}{result1}
+}
+
 func (fake *FakePrometheusGarbageCollectable) WorkerVolumes() *prometheus.GaugeVec {
 	fake.workerVolumesMutex.Lock()
 	ret, specificReturn := fake.workerVolumesReturnsOnCall[len(fake.workerVolumesArgsForCall)]
@@ -388,14 +636,22 @@ func (fake *FakePrometheusGarbageCollectable) WorkerVolumesLabelsReturnsOnCall(i
 func (fake *FakePrometheusGarbageCollectable) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.workerCPUUsageMutex.RLock()
+	defer fake.workerCPUUsageMutex.RUnlock()
 	fake.workerContainersMutex.RLock()
 	defer fake.workerContainersMutex.RUnlock()
 	fake.workerContainersLabelsMutex.RLock()
 	defer fake.workerContainersLabelsMutex.RUnlock()
+	fake.workerDiskUsageMutex.RLock()
+	defer fake.workerDiskUsageMutex.RUnlock()
+	fake.workerMemoryUsageMutex.RLock()
+	defer fake.workerMemoryUsageMutex.RUnlock()
 	fake.workerTasksMutex.RLock()
 	defer fake.workerTasksMutex.RUnlock()
 	fake.workerTasksLabelsMutex.RLock()
 	defer fake.workerTasksLabelsMutex.RUnlock()
+	fake.workerUsageLabelsMutex.RLock()
+	defer fake.workerUsageLabelsMutex.RUnlock()
 	fake.workerVolumesMutex.RLock()
 	defer fake.workerVolumesMutex.RUnlock()
 	fake.workerVolumesLabelsMutex.RLock()
@@ -0,0 +1,278 @@
+package emitter
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/tracing"
+	"github.com/pkg/errors"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+)
+
+type (
+	OTLPEmitter struct {
+		send tracing.OTLPSender
+
+		prefix        string
+		batchSize     int
+		batchDuration time.Duration
+
+		host         string
+		batch        []*metricspb.Metric
+		lastEmitTime time.Time
+	}
+
+	OTLPConfig struct {
+		Address       string            `long:"otlp-address" description:"OTLP collector address: host:port for grpc, or a url for http"`
+		Protocol      string            `long:"otlp-protocol" default:"grpc" choice:"grpc" choice:"http" description:"Protocol to send metrics to the OTLP collector with"`
+		Headers       map[string]string `long:"otlp-header" description:"Headers to attach to every request to the OTLP collector, e.g. for authentication"`
+		UseTLS        bool              `long:"otlp-use-tls" description:"Use TLS when sending metrics over grpc"`
+		Prefix        string            `long:"otlp-prefix" default:"concourse." description:"Prefix for the names of emitted metrics"`
+		BatchSize     uint64            `long:"otlp-batch-size" default:"1000" description:"Number of metrics to batch together before emitting"`
+		BatchDuration time.Duration     `long:"otlp-batch-duration" default:"15s" description:"Length of time to wait between emitting until all currently batched metrics are emitted"`
+	}
+)
+
+// otlpCounters are the events which count things that happened since the
+// last event, and so are sent as delta sums rather than as gauges.
+var otlpCounters = map[string]bool{
+	"build started":                      true,
+	"builds started":                     true,
+	"checks started":                     true,
+	"checks finished":                    true,
+	"checks enqueued":                    true,
+	"checks deleted":                     true,
+	"containers created":                 true,
+	"containers deleted":                 true,
+	"failed containers":                  true,
+	"volumes created":                    true,
+	"volumes deleted":                    true,
+	"failed volumes":                     true,
+	"jobs scheduled":                     true,
+	"database queries":                   true,
+	"error log":                          true,
+	"concurrent requests limit hit":      true,
+	"GC container collector job dropped": true,
+}
+
+// otlpOccurrences are counters whose events each stand for one occurrence;
+// their value is something else, like the build's ID.
+var otlpOccurrences = map[string]bool{
+	"build started": true,
+}
+
+// otlpHistogramBounds are the bucket boundaries of histograms of durations,
+// in milliseconds.
+var otlpHistogramBounds = []float64{
+	5, 10, 25, 50, 100, 250, 500,
+	1000, 2500, 5000, 10000, 30000, 60000,
+	300000, 600000, 1800000, 3600000,
+}
+
+func init() {
+	metric.RegisterEmitter(&OTLPConfig{})
+}
+
+func (config *OTLPConfig) Description() string { return "OTLP" }
+func (config *OTLPConfig) IsConfigured() bool  { return config.Address != "" }
+
+func (config *OTLPConfig) NewEmitter() (metric.Emitter, error) {
+	send, err := tracing.OTLPCollector{
+		Address:  config.Address,
+		Protocol: config.Protocol,
+		Headers:  config.Headers,
+		UseTLS:   config.UseTLS,
+	}.Sender(tracing.OTLPMetrics)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OTLPEmitter{
+		send:          send,
+		prefix:        config.Prefix,
+		batchSize:     int(config.BatchSize),
+		batchDuration: config.BatchDuration,
+		lastEmitTime:  time.Now(),
+	}, nil
+}
+
+func (emitter *OTLPEmitter) Emit(logger lager.Logger, event metric.Event) {
+	logger = logger.Session("otlp")
+
+	emitter.host = event.Host
+	emitter.batch = append(emitter.batch, emitter.transformToOTLPMetric(event))
+
+	duration := time.Since(emitter.lastEmitTime)
+	if len(emitter.batch) >= emitter.batchSize || duration >= emitter.batchDuration {
+		logger.Debug("pre-emit-batch", lager.Data{
+			"batch-size":         emitter.batchSize,
+			"current-batch-size": len(emitter.batch),
+			"batch-duration":     emitter.batchDuration,
+			"current-duration":   duration,
+		})
+
+		emitter.submitBatch(logger)
+	}
+}
+
+func (emitter *OTLPEmitter) transformToOTLPMetric(event metric.Event) *metricspb.Metric {
+	timestamp := uint64(event.Time.UnixNano())
+	attributes := otlpAttributes(event.Attributes)
+
+	otlpMetric := &metricspb.Metric{
+		Name: emitter.prefix + otlpMetricName(event.Name),
+	}
+
+	switch {
+	case otlpCounters[event.Name]:
+		value := event.Value
+		if otlpOccurrences[event.Name] {
+			value = 1
+		}
+
+		otlpMetric.Data = &metricspb.Metric_Sum{
+			Sum: &metricspb.Sum{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				IsMonotonic:            true,
+				DataPoints: []*metricspb.NumberDataPoint{
+					{
+						Attributes:   attributes,
+						TimeUnixNano: timestamp,
+						Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: value},
+					},
+				},
+			},
+		}
+
+	case isDuration(event.Name):
+		otlpMetric.Unit = "ms"
+
+		value := event.Value
+		otlpMetric.Data = &metricspb.Metric_Histogram{
+			Histogram: &metricspb.Histogram{
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				DataPoints: []*metricspb.HistogramDataPoint{
+					{
+						Attributes:     attributes,
+						TimeUnixNano:   timestamp,
+						Count:          1,
+						Sum:            &value,
+						Min:            &value,
+						Max:            &value,
+						ExplicitBounds: otlpHistogramBounds,
+						BucketCounts:   otlpBucketCounts(value),
+					},
+				},
+			},
+		}
+
+	default:
+		otlpMetric.Data = &metricspb.Metric_Gauge{
+			Gauge: &metricspb.Gauge{
+				DataPoints: []*metricspb.NumberDataPoint{
+					{
+						Attributes:   attributes,
+						TimeUnixNano: timestamp,
+						Value:        &metricspb.NumberDataPoint_AsDouble{AsDouble: event.Value},
+					},
+				},
+			},
+		}
+	}
+
+	return otlpMetric
+}
+
+func (emitter *OTLPEmitter) submitBatch(logger lager.Logger) {
+	request := &colmetricspb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource: &resourcepb.Resource{
+					Attributes: []*commonpb.KeyValue{
+						otlpKeyValue("service.name", "concourse"),
+						otlpKeyValue("host.name", emitter.host),
+					},
+				},
+				ScopeMetrics: []*metricspb.ScopeMetrics{
+					{
+						Scope:   &commonpb.InstrumentationScope{Name: "concourse"},
+						Metrics: emitter.batch,
+					},
+				},
+			},
+		},
+	}
+
+	emitter.batch = nil
+	emitter.lastEmitTime = time.Now()
+
+	go emitter.emitBatch(logger, request)
+}
+
+func (emitter *OTLPEmitter) emitBatch(logger lager.Logger, request *colmetricspb.ExportMetricsServiceRequest) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	err := emitter.send(ctx, request)
+	if err != nil {
+		logger.Error("failed-to-send-request",
+			errors.Wrap(metric.ErrFailedToEmit, err.Error()))
+	}
+}
+
+// otlpMetricName turns an event name like "gc: build collector duration (ms)"
+// into a metric name like "gc_build_collector_duration_ms".
+func otlpMetricName(eventName string) string {
+	words := strings.FieldsFunc(strings.ToLower(eventName), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	return strings.Join(words, "_")
+}
+
+// isDuration returns whether the event is the duration of something, in
+// milliseconds, and so is sent as a histogram.
+func isDuration(eventName string) bool {
+	return strings.HasSuffix(eventName, "(ms)") ||
+		eventName == "http response time" ||
+		eventName == "build finished"
+}
+
+func otlpBucketCounts(value float64) []uint64 {
+	counts := make([]uint64, len(otlpHistogramBounds)+1)
+	counts[sort.SearchFloat64s(otlpHistogramBounds, value)]++
+	return counts
+}
+
+func otlpAttributes(attributes map[string]string) []*commonpb.KeyValue {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	converted := make([]*commonpb.KeyValue, len(keys))
+	for i, key := range keys {
+		converted[i] = otlpKeyValue(key, attributes[key])
+	}
+
+	return converted
+}
+
+func otlpKeyValue(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key: key,
+		Value: &commonpb.AnyValue{
+			Value: &commonpb.AnyValue_StringValue{StringValue: value},
+		},
+	}
+}
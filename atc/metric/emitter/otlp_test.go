@@ -0,0 +1,218 @@
+package emitter_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/emitter"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+// otlpReceiver is a stand-in for an OTLP collector's metrics service.
+type otlpReceiver struct {
+	colmetricspb.UnimplementedMetricsServiceServer
+
+	requests chan *colmetricspb.ExportMetricsServiceRequest
+	metadata chan metadata.MD
+}
+
+func (r *otlpReceiver) Export(ctx context.Context, req *colmetricspb.ExportMetricsServiceRequest) (*colmetricspb.ExportMetricsServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	r.metadata <- md
+	r.requests <- req
+	return &colmetricspb.ExportMetricsServiceResponse{}, nil
+}
+
+var _ = Describe("OTLPEmitter", func() {
+	var (
+		config     emitter.OTLPConfig
+		testLogger *lagertest.TestLogger
+
+		server   *grpc.Server
+		receiver *otlpReceiver
+	)
+
+	BeforeEach(func() {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).NotTo(HaveOccurred())
+
+		receiver = &otlpReceiver{
+			requests: make(chan *colmetricspb.ExportMetricsServiceRequest, 10),
+			metadata: make(chan metadata.MD, 10),
+		}
+
+		server = grpc.NewServer()
+		colmetricspb.RegisterMetricsServiceServer(server, receiver)
+		go server.Serve(listener)
+
+		config = emitter.OTLPConfig{
+			Address:       listener.Addr().String(),
+			Protocol:      "grpc",
+			Headers:       map[string]string{"x-api-key": "some-key"},
+			Prefix:        "concourse.",
+			BatchSize:     3,
+			BatchDuration: time.Hour,
+		}
+
+		testLogger = lagertest.NewTestLogger("otlp")
+	})
+
+	AfterEach(func() {
+		server.Stop()
+	})
+
+	emitEvents := func() {
+		otlpEmitter, err := config.NewEmitter()
+		Expect(err).NotTo(HaveOccurred())
+
+		otlpEmitter.Emit(testLogger, metric.Event{
+			Name:  "build started",
+			Value: 123,
+			Host:  "some-host",
+			Time:  time.Unix(100, 0),
+			Attributes: map[string]string{
+				"team_name": "some-team",
+				"pipeline":  "some-pipeline",
+			},
+		})
+
+		otlpEmitter.Emit(testLogger, metric.Event{
+			Name:  "checks queue size",
+			Value: 7,
+			Host:  "some-host",
+			Time:  time.Unix(100, 0),
+		})
+
+		otlpEmitter.Emit(testLogger, metric.Event{
+			Name:  "http response time",
+			Value: 42,
+			Host:  "some-host",
+			Time:  time.Unix(100, 0),
+			Attributes: map[string]string{
+				"route": "GetPipeline",
+			},
+		})
+	}
+
+	It("does not send anything until the batch is full", func() {
+		otlpEmitter, err := config.NewEmitter()
+		Expect(err).NotTo(HaveOccurred())
+
+		otlpEmitter.Emit(testLogger, metric.Event{Name: "checks queue size", Value: 7})
+		Consistently(receiver.requests).ShouldNot(Receive())
+	})
+
+	It("sends a batch of metrics to the collector", func() {
+		emitEvents()
+
+		var request *colmetricspb.ExportMetricsServiceRequest
+		Eventually(receiver.requests).Should(Receive(&request))
+
+		var md metadata.MD
+		Eventually(receiver.metadata).Should(Receive(&md))
+		Expect(md.Get("x-api-key")).To(Equal([]string{"some-key"}))
+
+		Expect(request.ResourceMetrics).To(HaveLen(1))
+
+		resource := request.ResourceMetrics[0].Resource
+		Expect(resource.Attributes[1].Key).To(Equal("host.name"))
+		Expect(resource.Attributes[1].Value.GetStringValue()).To(Equal("some-host"))
+
+		metrics := request.ResourceMetrics[0].ScopeMetrics[0].Metrics
+		Expect(metrics).To(HaveLen(3))
+
+		By("counting builds started", func() {
+			Expect(metrics[0].Name).To(Equal("concourse.build_started"))
+
+			sum := metrics[0].GetSum()
+			Expect(sum).NotTo(BeNil())
+			Expect(sum.IsMonotonic).To(BeTrue())
+			Expect(sum.AggregationTemporality).To(Equal(metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA))
+			Expect(sum.DataPoints).To(HaveLen(1))
+			Expect(sum.DataPoints[0].GetAsDouble()).To(Equal(1.0))
+			Expect(sum.DataPoints[0].TimeUnixNano).To(Equal(uint64(100 * time.Second)))
+
+			attributes := sum.DataPoints[0].Attributes
+			Expect(attributes).To(HaveLen(2))
+			Expect(attributes[0].Key).To(Equal("pipeline"))
+			Expect(attributes[0].Value.GetStringValue()).To(Equal("some-pipeline"))
+			Expect(attributes[1].Key).To(Equal("team_name"))
+			Expect(attributes[1].Value.GetStringValue()).To(Equal("some-team"))
+		})
+
+		By("reporting the size of the check queue", func() {
+			Expect(metrics[1].Name).To(Equal("concourse.checks_queue_size"))
+
+			gauge := metrics[1].GetGauge()
+			Expect(gauge).NotTo(BeNil())
+			Expect(gauge.DataPoints[0].GetAsDouble()).To(Equal(7.0))
+		})
+
+		By("recording response times in a histogram", func() {
+			Expect(metrics[2].Name).To(Equal("concourse.http_response_time"))
+			Expect(metrics[2].Unit).To(Equal("ms"))
+
+			histogram := metrics[2].GetHistogram()
+			Expect(histogram).NotTo(BeNil())
+
+			point := histogram.DataPoints[0]
+			Expect(point.Count).To(Equal(uint64(1)))
+			Expect(point.GetSum()).To(Equal(42.0))
+			Expect(point.BucketCounts).To(HaveLen(len(point.ExplicitBounds) + 1))
+
+			// 42ms falls between the 25ms and 50ms bounds
+			Expect(point.ExplicitBounds[2:4]).To(Equal([]float64{25, 50}))
+			Expect(point.BucketCounts[3]).To(Equal(uint64(1)))
+		})
+	})
+
+	Context("over http", func() {
+		var httpServer *ghttp.Server
+
+		BeforeEach(func() {
+			httpServer = ghttp.NewServer()
+
+			config.Protocol = "http"
+			config.Address = httpServer.URL()
+		})
+
+		AfterEach(func() {
+			httpServer.Close()
+		})
+
+		It("posts the batch to the collector's metrics endpoint", func() {
+			httpServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/metrics"),
+					ghttp.VerifyHeaderKV("Content-Type", "application/x-protobuf"),
+					ghttp.VerifyHeaderKV("X-Api-Key", "some-key"),
+					func(w http.ResponseWriter, r *http.Request) {
+						body, err := ioutil.ReadAll(r.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						request := &colmetricspb.ExportMetricsServiceRequest{}
+						Expect(proto.Unmarshal(body, request)).To(Succeed())
+						Expect(request.ResourceMetrics[0].ScopeMetrics[0].Metrics).To(HaveLen(3))
+					},
+				),
+			)
+
+			emitEvents()
+
+			Eventually(httpServer.ReceivedRequests).Should(HaveLen(1))
+		})
+	})
+})
@@ -51,16 +51,22 @@ type PrometheusEmitter struct {
 	checksStarted   prometheus.Counter
 	checksEnqueued  prometheus.Counter
 
+	resourceCacheBytesPrefetched prometheus.Counter
+
 	workerContainers        *prometheus.GaugeVec
 	workerUnknownContainers *prometheus.GaugeVec
 	workerVolumes           *prometheus.GaugeVec
 	workerUnknownVolumes    *prometheus.GaugeVec
 	workerTasks             *prometheus.GaugeVec
+	workerCPUUsage          *prometheus.GaugeVec
+	workerMemoryUsage       *prometheus.GaugeVec
+	workerDiskUsage         *prometheus.GaugeVec
 	workersRegistered       *prometheus.GaugeVec
 
 	workerContainersLabels map[string]map[string]prometheus.Labels
 	workerVolumesLabels    map[string]map[string]prometheus.Labels
 	workerTasksLabels      map[string]map[string]prometheus.Labels
+	workerUsageLabels      map[string]map[string]prometheus.Labels
 	workerLastSeen         map[string]time.Time
 	mu                     sync.Mutex
 }
@@ -298,6 +304,39 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(workerTasks)
 
+	workerCPUUsage := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "cpu_usage_millicores",
+			Help:      "CPU used by the containers of each worker, in thousandths of a core",
+		},
+		[]string{"worker", "platform"},
+	)
+	prometheus.MustRegister(workerCPUUsage)
+
+	workerMemoryUsage := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "memory_usage_bytes",
+			Help:      "Memory used by the containers of each worker",
+		},
+		[]string{"worker", "platform"},
+	)
+	prometheus.MustRegister(workerMemoryUsage)
+
+	workerDiskUsage := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "workers",
+			Name:      "disk_usage_bytes",
+			Help:      "Disk used by the containers of each worker",
+		},
+		[]string{"worker", "platform"},
+	)
+	prometheus.MustRegister(workerDiskUsage)
+
 	workersRegistered := prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Namespace: "concourse",
@@ -381,6 +420,16 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(checksEnqueued)
 
+	resourceCacheBytesPrefetched := prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "resource_cache_prefetched_bytes_total",
+			Help:      "Total number of bytes streamed to prefetch resource caches onto other workers",
+		},
+	)
+	prometheus.MustRegister(resourceCacheBytesPrefetched)
+
 	listener, err := net.Listen("tcp", config.bind())
 	if err != nil {
 		return nil, err
@@ -422,14 +471,20 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		checksStarted:   checksStarted,
 		checksEnqueued:  checksEnqueued,
 
+		resourceCacheBytesPrefetched: resourceCacheBytesPrefetched,
+
 		workerContainers:        workerContainers,
 		workersRegistered:       workersRegistered,
 		workerContainersLabels:  map[string]map[string]prometheus.Labels{},
 		workerVolumesLabels:     map[string]map[string]prometheus.Labels{},
 		workerTasksLabels:       map[string]map[string]prometheus.Labels{},
+		workerUsageLabels:       map[string]map[string]prometheus.Labels{},
 		workerLastSeen:          map[string]time.Time{},
 		workerVolumes:           workerVolumes,
 		workerTasks:             workerTasks,
+		workerCPUUsage:          workerCPUUsage,
+		workerMemoryUsage:       workerMemoryUsage,
+		workerDiskUsage:         workerDiskUsage,
 		workerUnknownContainers: workerUnknownContainers,
 		workerUnknownVolumes:    workerUnknownVolumes,
 	}
@@ -478,6 +533,12 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.workerUnknownVolumesMetric(logger, event)
 	case "worker tasks":
 		emitter.workerTasksMetric(logger, event)
+	case "worker cpu usage":
+		emitter.workerUsageMetric(logger, event, emitter.workerCPUUsage)
+	case "worker memory usage":
+		emitter.workerUsageMetric(logger, event, emitter.workerMemoryUsage)
+	case "worker disk usage":
+		emitter.workerUsageMetric(logger, event, emitter.workerDiskUsage)
 	case "worker state":
 		emitter.workersRegisteredMetric(logger, event)
 	case "http response time":
@@ -494,6 +555,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.checksEnqueued.Add(event.Value)
 	case "checks queue size":
 		emitter.checksQueueSize.Set(event.Value)
+	case "resource cache bytes prefetched":
+		emitter.resourceCacheBytesPrefetched.Add(event.Value)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
@@ -711,6 +774,30 @@ func (emitter *PrometheusEmitter) workerTasksMetric(logger lager.Logger, event m
 	emitter.workerTasks.With(emitter.workerTasksLabels[worker][key]).Set(event.Value)
 }
 
+func (emitter *PrometheusEmitter) workerUsageMetric(logger lager.Logger, event metric.Event, gauge *prometheus.GaugeVec) {
+	worker, exists := event.Attributes["worker"]
+	if !exists {
+		logger.Error("failed-to-find-worker-in-event", fmt.Errorf("expected worker to exist in event.Attributes"))
+		return
+	}
+	platform, exists := event.Attributes["platform"]
+	if !exists || platform == "" {
+		logger.Error("failed-to-find-platform-in-event", fmt.Errorf("expected platform to exist in event.Attributes"))
+		return
+	}
+
+	labels := prometheus.Labels{
+		"worker":   worker,
+		"platform": platform,
+	}
+	key := serializeLabels(&labels)
+	if emitter.workerUsageLabels[worker] == nil {
+		emitter.workerUsageLabels[worker] = make(map[string]prometheus.Labels)
+	}
+	emitter.workerUsageLabels[worker][key] = labels
+	gauge.With(emitter.workerUsageLabels[worker][key]).Set(event.Value)
+}
+
 func (emitter *PrometheusEmitter) httpResponseTimeMetrics(logger lager.Logger, event metric.Event) {
 	route, exists := event.Attributes["route"]
 	if !exists {
@@ -788,9 +875,16 @@ func DoGarbageCollection(emitter PrometheusGarbageCollectable, worker string) {
 		emitter.WorkerTasks().Delete(labels)
 	}
 
+	for _, labels := range emitter.WorkerUsageLabels()[worker] {
+		emitter.WorkerCPUUsage().Delete(labels)
+		emitter.WorkerMemoryUsage().Delete(labels)
+		emitter.WorkerDiskUsage().Delete(labels)
+	}
+
 	delete(emitter.WorkerContainersLabels(), worker)
 	delete(emitter.WorkerVolumesLabels(), worker)
 	delete(emitter.WorkerTasksLabels(), worker)
+	delete(emitter.WorkerUsageLabels(), worker)
 }
 
 //go:generate counterfeiter . PrometheusGarbageCollectable
@@ -798,10 +892,14 @@ type PrometheusGarbageCollectable interface {
 	WorkerContainers() *prometheus.GaugeVec
 	WorkerVolumes() *prometheus.GaugeVec
 	WorkerTasks() *prometheus.GaugeVec
+	WorkerCPUUsage() *prometheus.GaugeVec
+	WorkerMemoryUsage() *prometheus.GaugeVec
+	WorkerDiskUsage() *prometheus.GaugeVec
 
 	WorkerContainersLabels() map[string]map[string]prometheus.Labels
 	WorkerVolumesLabels() map[string]map[string]prometheus.Labels
 	WorkerTasksLabels() map[string]map[string]prometheus.Labels
+	WorkerUsageLabels() map[string]map[string]prometheus.Labels
 }
 
 func (emitter *PrometheusEmitter) WorkerContainers() *prometheus.GaugeVec {
@@ -816,6 +914,18 @@ func (emitter *PrometheusEmitter) WorkerTasks() *prometheus.GaugeVec {
 	return emitter.workerTasks
 }
 
+func (emitter *PrometheusEmitter) WorkerCPUUsage() *prometheus.GaugeVec {
+	return emitter.workerCPUUsage
+}
+
+func (emitter *PrometheusEmitter) WorkerMemoryUsage() *prometheus.GaugeVec {
+	return emitter.workerMemoryUsage
+}
+
+func (emitter *PrometheusEmitter) WorkerDiskUsage() *prometheus.GaugeVec {
+	return emitter.workerDiskUsage
+}
+
 func (emitter *PrometheusEmitter) WorkerContainersLabels() map[string]map[string]prometheus.Labels {
 	return emitter.workerContainersLabels
 }
@@ -827,3 +937,7 @@ func (emitter *PrometheusEmitter) WorkerVolumesLabels() map[string]map[string]pr
 func (emitter *PrometheusEmitter) WorkerTasksLabels() map[string]map[string]prometheus.Labels {
 	return emitter.workerTasksLabels
 }
+
+func (emitter *PrometheusEmitter) WorkerUsageLabels() map[string]map[string]prometheus.Labels {
+	return emitter.workerUsageLabels
+}
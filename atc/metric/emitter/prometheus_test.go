@@ -26,10 +26,14 @@ var _ = Describe("PrometheusEmitter garbage collector", func() {
 		workerContainers *prometheus.GaugeVec
 		workerVolumes    *prometheus.GaugeVec
 		workerTasks      *prometheus.GaugeVec
+		workerCPUUsage   *prometheus.GaugeVec
+		workerMemUsage   *prometheus.GaugeVec
+		workerDiskUsage  *prometheus.GaugeVec
 
 		workerContainersLabels map[string]map[string]prometheus.Labels
 		workerVolumesLabels    map[string]map[string]prometheus.Labels
 		workerTasksLabels      map[string]map[string]prometheus.Labels
+		workerUsageLabels      map[string]map[string]prometheus.Labels
 	)
 
 	BeforeEach(func() {
@@ -66,9 +70,43 @@ var _ = Describe("PrometheusEmitter garbage collector", func() {
 		)
 		prometheus.Register(workerTasks)
 
+		workerCPUUsage = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "concourse",
+				Subsystem: "workers",
+				Name:      "cpu_usage_millicores",
+				Help:      "CPU used by the containers of each worker, in thousandths of a core",
+			},
+			[]string{"worker", "platform"},
+		)
+		prometheus.Register(workerCPUUsage)
+
+		workerMemUsage = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "concourse",
+				Subsystem: "workers",
+				Name:      "memory_usage_bytes",
+				Help:      "Memory used by the containers of each worker",
+			},
+			[]string{"worker", "platform"},
+		)
+		prometheus.Register(workerMemUsage)
+
+		workerDiskUsage = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: "concourse",
+				Subsystem: "workers",
+				Name:      "disk_usage_bytes",
+				Help:      "Disk used by the containers of each worker",
+			},
+			[]string{"worker", "platform"},
+		)
+		prometheus.Register(workerDiskUsage)
+
 		workerContainersLabels = map[string]map[string]prometheus.Labels{}
 		workerVolumesLabels = map[string]map[string]prometheus.Labels{}
 		workerTasksLabels = map[string]map[string]prometheus.Labels{}
+		workerUsageLabels = map[string]map[string]prometheus.Labels{}
 
 		labelsLong = prometheus.Labels{
 			"worker":   "foo",
@@ -84,9 +122,12 @@ var _ = Describe("PrometheusEmitter garbage collector", func() {
 	})
 	JustBeforeEach(func() {
 		fake = emitterfakes.FakePrometheusGarbageCollectable{
-			WorkerContainersStub: func() *prometheus.GaugeVec { return workerContainers },
-			WorkerVolumesStub:    func() *prometheus.GaugeVec { return workerVolumes },
-			WorkerTasksStub:      func() *prometheus.GaugeVec { return workerTasks },
+			WorkerContainersStub:  func() *prometheus.GaugeVec { return workerContainers },
+			WorkerVolumesStub:     func() *prometheus.GaugeVec { return workerVolumes },
+			WorkerTasksStub:       func() *prometheus.GaugeVec { return workerTasks },
+			WorkerCPUUsageStub:    func() *prometheus.GaugeVec { return workerCPUUsage },
+			WorkerMemoryUsageStub: func() *prometheus.GaugeVec { return workerMemUsage },
+			WorkerDiskUsageStub:   func() *prometheus.GaugeVec { return workerDiskUsage },
 
 			WorkerContainersLabelsStub: func() map[string]map[string]prometheus.Labels {
 				return workerContainersLabels
@@ -97,6 +138,9 @@ var _ = Describe("PrometheusEmitter garbage collector", func() {
 			WorkerTasksLabelsStub: func() map[string]map[string]prometheus.Labels {
 				return workerTasksLabels
 			},
+			WorkerUsageLabelsStub: func() map[string]map[string]prometheus.Labels {
+				return workerUsageLabels
+			},
 		}
 
 		// Deep copy the labels so we can use them to verify the test results later
@@ -119,23 +163,34 @@ var _ = Describe("PrometheusEmitter garbage collector", func() {
 		fake.WorkerTasks().With(labels).Set(42.0)
 		fake.WorkerTasksLabels()["foo"] = make(map[string]prometheus.Labels)
 		fake.WorkerTasksLabels()["foo"]["foo_linux"] = labels
+
+		fake.WorkerCPUUsage().With(labels).Set(42.0)
+		fake.WorkerMemoryUsage().With(labels).Set(42.0)
+		fake.WorkerDiskUsage().With(labels).Set(42.0)
+		fake.WorkerUsageLabels()["foo"] = make(map[string]prometheus.Labels)
+		fake.WorkerUsageLabels()["foo"]["foo_linux"] = labels
 	})
 
 	It("should remove all metrics from the emitter", func() {
 		Expect(fake.WorkerContainersLabels()).To(HaveLen(1))
 		Expect(fake.WorkerVolumesLabels()).To(HaveLen(1))
 		Expect(fake.WorkerTasksLabels()).To(HaveLen(1))
+		Expect(fake.WorkerUsageLabels()).To(HaveLen(1))
 
 		emitter.DoGarbageCollection(&fake, "foo")
 
 		Expect(fake.WorkerContainersLabels()).To(HaveLen(0))
 		Expect(fake.WorkerVolumesLabels()).To(HaveLen(0))
 		Expect(fake.WorkerTasksLabels()).To(HaveLen(0))
+		Expect(fake.WorkerUsageLabels()).To(HaveLen(0))
 
 		// Delete should return false if the metrics no longer exist
 		Expect(fake.WorkerContainers().Delete(labelsLong)).To(Equal(false))
 		Expect(fake.WorkerVolumes().Delete(labelsLong)).To(Equal(false))
 		Expect(fake.WorkerTasks().Delete(labelsShort)).To(Equal(false))
+		Expect(fake.WorkerCPUUsage().Delete(labelsShort)).To(Equal(false))
+		Expect(fake.WorkerMemoryUsage().Delete(labelsShort)).To(Equal(false))
+		Expect(fake.WorkerDiskUsage().Delete(labelsShort)).To(Equal(false))
 	})
 
 	// There is no easy way to detect whether metrics are REALLY garbage collected due to the
@@ -146,6 +201,9 @@ var _ = Describe("PrometheusEmitter garbage collector", func() {
 		Expect(fake.WorkerContainers().Delete(labelsLong)).To(Equal(true))
 		Expect(fake.WorkerVolumes().Delete(labelsLong)).To(Equal(true))
 		Expect(fake.WorkerTasks().Delete(labelsShort)).To(Equal(true))
+		Expect(fake.WorkerCPUUsage().Delete(labelsShort)).To(Equal(true))
+		Expect(fake.WorkerMemoryUsage().Delete(labelsShort)).To(Equal(true))
+		Expect(fake.WorkerDiskUsage().Delete(labelsShort)).To(Equal(true))
 
 		emitter.DoGarbageCollection(&fake, "foo")
 
@@ -153,6 +211,9 @@ var _ = Describe("PrometheusEmitter garbage collector", func() {
 		Expect(fake.WorkerContainers().Delete(labelsLong)).To(Equal(false))
 		Expect(fake.WorkerVolumes().Delete(labelsLong)).To(Equal(false))
 		Expect(fake.WorkerTasks().Delete(labelsShort)).To(Equal(false))
+		Expect(fake.WorkerCPUUsage().Delete(labelsShort)).To(Equal(false))
+		Expect(fake.WorkerMemoryUsage().Delete(labelsShort)).To(Equal(false))
+		Expect(fake.WorkerDiskUsage().Delete(labelsShort)).To(Equal(false))
 
 	})
 
@@ -160,14 +221,21 @@ var _ = Describe("PrometheusEmitter garbage collector", func() {
 		workerContainers.Reset()
 		workerVolumes.Reset()
 		workerTasks.Reset()
+		workerCPUUsage.Reset()
+		workerMemUsage.Reset()
+		workerDiskUsage.Reset()
 
 		workerContainersLabels = map[string]map[string]prometheus.Labels{}
 		workerVolumesLabels = map[string]map[string]prometheus.Labels{}
 		workerTasksLabels = map[string]map[string]prometheus.Labels{}
+		workerUsageLabels = map[string]map[string]prometheus.Labels{}
 
 		prometheus.Unregister(workerContainers)
 		prometheus.Unregister(workerVolumes)
 		prometheus.Unregister(workerTasks)
+		prometheus.Unregister(workerCPUUsage)
+		prometheus.Unregister(workerMemUsage)
+		prometheus.Unregister(workerDiskUsage)
 	})
 })
 
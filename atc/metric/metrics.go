@@ -8,6 +8,7 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -38,6 +39,8 @@ var ChecksQueueSize = &Gauge{}
 var ChecksStarted = &Counter{}
 var ChecksEnqueued = &Counter{}
 
+var ResourceCacheBytesPrefetched = &Counter{}
+
 var ConcurrentRequests = map[string]*Gauge{}
 var ConcurrentRequestsLimitHit = map[string]*Counter{}
 
@@ -293,6 +296,46 @@ func (event WorkerTasks) Emit(logger lager.Logger) {
 	)
 }
 
+type WorkerUsage struct {
+	WorkerName string
+	Platform   string
+	Usage      atc.WorkerUsage
+}
+
+func (event WorkerUsage) Emit(logger lager.Logger) {
+	attributes := map[string]string{
+		"worker":   event.WorkerName,
+		"platform": event.Platform,
+	}
+
+	emit(
+		logger.Session("worker-cpu-usage"),
+		Event{
+			Name:       "worker cpu usage",
+			Value:      float64(event.Usage.CPU),
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger.Session("worker-memory-usage"),
+		Event{
+			Name:       "worker memory usage",
+			Value:      float64(event.Usage.Memory),
+			Attributes: attributes,
+		},
+	)
+
+	emit(
+		logger.Session("worker-disk-usage"),
+		Event{
+			Name:       "worker disk usage",
+			Value:      float64(event.Usage.Disk),
+			Attributes: attributes,
+		},
+	)
+}
+
 type VolumesToBeGarbageCollected struct {
 	Volumes int
 }
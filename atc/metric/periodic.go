@@ -221,6 +221,14 @@ func tick(logger lager.Logger) {
 		},
 	)
 
+	emit(
+		logger.Session("resource-cache-bytes-prefetched"),
+		Event{
+			Name:  "resource cache bytes prefetched",
+			Value: ResourceCacheBytesPrefetched.Delta(),
+		},
+	)
+
 	var memStats runtime.MemStats
 	runtime.ReadMemStats(&memStats)
 
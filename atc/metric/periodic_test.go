@@ -161,4 +161,24 @@ var _ = Describe("Periodic emission of metrics", func() {
 			)
 		})
 	})
+
+	Context("resource cache prefetching metrics", func() {
+		BeforeEach(func() {
+			metric.ResourceCacheBytesPrefetched.IncDelta(1024)
+		})
+
+		It("emits", func() {
+			Eventually(emitter.EmitCallCount).Should(BeNumerically(">=", 1))
+			Expect(emitter.Invocations()["Emit"]).To(
+				ContainElement(
+					ContainElement(
+						MatchFields(IgnoreExtras, Fields{
+							"Name":  Equal("resource cache bytes prefetched"),
+							"Value": Equal(float64(1024)),
+						}),
+					),
+				),
+			)
+		})
+	})
 })
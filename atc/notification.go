@@ -0,0 +1,126 @@
+package atc
+
+import (
+	"encoding/json"
+	"text/template"
+)
+
+// NotificationConfig is sent to a webhook whenever a build of one of the
+// pipeline's jobs finishes and matches its filters.
+type NotificationConfig struct {
+	Name string `json:"name"`
+
+	// Teams limits the notification to builds of pipelines belonging to the
+	// given teams, e.g. for a pipeline config that is set on many teams.
+	// Builds of any team match if it is empty.
+	Teams []string `json:"teams,omitempty"`
+
+	// Jobs limits the notification to builds of the given jobs. Builds of any
+	// job match if it is empty.
+	Jobs []string `json:"jobs,omitempty"`
+
+	// Statuses limits the notification to builds finishing with one of the
+	// given statuses. Builds with any status match if it is empty.
+	Statuses []BuildStatus `json:"statuses,omitempty"`
+
+	// PreviousStatuses limits the notification to builds whose job's previous
+	// build finished with one of the given statuses, e.g. to only notify when
+	// a job goes from succeeded to failed. A job's first build never matches
+	// if it is set.
+	PreviousStatuses []BuildStatus `json:"previous_statuses,omitempty"`
+
+	Webhook NotificationWebhookConfig `json:"webhook"`
+}
+
+type NotificationWebhookConfig struct {
+	URL     string            `json:"url"`
+	Method  string            `json:"method,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body is a text/template rendered with the finished build. The build is
+	// sent as JSON if it is empty.
+	Body string `json:"body,omitempty"`
+}
+
+// BodyTemplate parses the body of the webhook. Besides the builtin functions,
+// the template can use json to encode a value as JSON, so that it can be put in
+// a JSON body safely.
+func (config NotificationWebhookConfig) BodyTemplate() (*template.Template, error) {
+	return template.New("body").Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			payload, err := json.Marshal(v)
+			return string(payload), err
+		},
+	}).Parse(config.Body)
+}
+
+// BuildNotification is the delivery log of one of a pipeline's notifications
+// about a finished build. The webhook's config is left out, as it may hold
+// credentials.
+type BuildNotification struct {
+	ID             int    `json:"id"`
+	BuildID        int    `json:"build_id"`
+	Name           string `json:"name"`
+	PreviousStatus string `json:"previous_status,omitempty"`
+	Status         string `json:"status"`
+	Attempts       int    `json:"attempts"`
+	ResponseCode   int    `json:"response_code,omitempty"`
+	LastError      string `json:"last_error,omitempty"`
+	CreatedAt      int64  `json:"created_at"`
+	DeliveredAt    int64  `json:"delivered_at,omitempty"`
+}
+
+type NotificationConfigs []NotificationConfig
+
+func (configs NotificationConfigs) Lookup(name string) (NotificationConfig, bool) {
+	for _, config := range configs {
+		if config.Name == name {
+			return config, true
+		}
+	}
+
+	return NotificationConfig{}, false
+}
+
+// Matches returns whether a build of the team's job finishing with the status
+// should be notified about. The previous status is empty if it is the job's
+// first build.
+func (config NotificationConfig) Matches(teamName string, jobName string, status BuildStatus, previousStatus BuildStatus) bool {
+	if len(config.Teams) > 0 && !containsString(config.Teams, teamName) {
+		return false
+	}
+
+	if len(config.Jobs) > 0 && !containsString(config.Jobs, jobName) {
+		return false
+	}
+
+	if len(config.Statuses) > 0 && !containsStatus(config.Statuses, status) {
+		return false
+	}
+
+	if len(config.PreviousStatuses) > 0 && !containsStatus(config.PreviousStatuses, previousStatus) {
+		return false
+	}
+
+	return true
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsStatus(list []BuildStatus, status BuildStatus) bool {
+	for _, item := range list {
+		if item == status {
+			return true
+		}
+	}
+
+	return false
+}
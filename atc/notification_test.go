@@ -0,0 +1,42 @@
+package atc_test
+
+import (
+	"bytes"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NotificationConfig", func() {
+	DescribeTable("Matches",
+		func(config atc.NotificationConfig, status atc.BuildStatus, previousStatus atc.BuildStatus, matches bool) {
+			Expect(config.Matches("some-team", "some-job", status, previousStatus)).To(Equal(matches))
+		},
+		Entry("without filters", atc.NotificationConfig{}, atc.StatusSucceeded, atc.BuildStatus(""), true),
+		Entry("with the team", atc.NotificationConfig{Teams: []string{"other-team", "some-team"}}, atc.StatusSucceeded, atc.StatusSucceeded, true),
+		Entry("with other teams", atc.NotificationConfig{Teams: []string{"other-team"}}, atc.StatusSucceeded, atc.StatusSucceeded, false),
+		Entry("with the job", atc.NotificationConfig{Jobs: []string{"other-job", "some-job"}}, atc.StatusSucceeded, atc.StatusSucceeded, true),
+		Entry("with other jobs", atc.NotificationConfig{Jobs: []string{"other-job"}}, atc.StatusSucceeded, atc.StatusSucceeded, false),
+		Entry("with the status", atc.NotificationConfig{Statuses: []atc.BuildStatus{atc.StatusFailed, atc.StatusErrored}}, atc.StatusFailed, atc.StatusFailed, true),
+		Entry("with other statuses", atc.NotificationConfig{Statuses: []atc.BuildStatus{atc.StatusFailed}}, atc.StatusSucceeded, atc.StatusFailed, false),
+		Entry("with the transition", atc.NotificationConfig{Statuses: []atc.BuildStatus{atc.StatusFailed}, PreviousStatuses: []atc.BuildStatus{atc.StatusSucceeded}}, atc.StatusFailed, atc.StatusSucceeded, true),
+		Entry("with another transition", atc.NotificationConfig{Statuses: []atc.BuildStatus{atc.StatusFailed}, PreviousStatuses: []atc.BuildStatus{atc.StatusSucceeded}}, atc.StatusFailed, atc.StatusFailed, false),
+		Entry("with previous statuses for the first build", atc.NotificationConfig{PreviousStatuses: []atc.BuildStatus{atc.StatusSucceeded}}, atc.StatusFailed, atc.BuildStatus(""), false),
+	)
+
+	Describe("BodyTemplate", func() {
+		It("can encode values as JSON", func() {
+			webhook := atc.NotificationWebhookConfig{Body: `{"text": {{json .}}}`}
+
+			tmpl, err := webhook.BodyTemplate()
+			Expect(err).NotTo(HaveOccurred())
+
+			buf := new(bytes.Buffer)
+			err = tmpl.Execute(buf, `some "quoted" job`)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buf.String()).To(MatchJSON(`{"text": "some \"quoted\" job"}`))
+		})
+	})
+})
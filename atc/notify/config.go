@@ -0,0 +1,13 @@
+package notify
+
+import (
+	"time"
+)
+
+type Config struct {
+	Interval      time.Duration `long:"interval"       default:"10s" description:"Interval on which to deliver the notifications of finished builds."`
+	BatchSize     int           `long:"batch-size"     default:"100" description:"Maximum number of notifications to deliver on each interval."`
+	Timeout       time.Duration `long:"timeout"        default:"30s" description:"Time limit on each webhook request."`
+	Attempts      int           `long:"attempts"       default:"5"   description:"Number of times to try delivering a notification before giving up."`
+	RetryInterval time.Duration `long:"retry-interval" default:"30s" description:"Initial interval between attempts, which backs off exponentially."`
+}
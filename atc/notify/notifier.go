@@ -0,0 +1,269 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// Build describes the finished build a notification is about. It is sent as
+// the body of a webhook without a body template, and is the data a body
+// template is rendered with.
+type Build struct {
+	ID                   int              `json:"id"`
+	Name                 string           `json:"name"`
+	Team                 string           `json:"team"`
+	Pipeline             string           `json:"pipeline"`
+	PipelineInstanceVars atc.InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	Job                  string           `json:"job"`
+	Status               string           `json:"status"`
+	PreviousStatus       string           `json:"previous_status,omitempty"`
+	StartTime            int64            `json:"start_time,omitempty"`
+	EndTime              int64            `json:"end_time,omitempty"`
+	URL                  string           `json:"url"`
+	Notification         string           `json:"notification"`
+}
+
+//go:generate counterfeiter . Notifier
+
+type Notifier interface {
+	Run(context.Context) error
+}
+
+type notifier struct {
+	notificationFactory db.BuildNotificationFactory
+	buildFactory        db.BuildFactory
+	secrets             creds.Secrets
+	varSourcePool       creds.VarSourcePool
+	httpClient          *http.Client
+	clock               clock.Clock
+	externalURL         string
+	config              Config
+}
+
+// NewNotifier returns a component which delivers the notifications queued by
+// db.Build.Finish. Each delivery is recorded on the notification, which is
+// retried with an exponential backoff until it succeeds or runs out of
+// attempts.
+func NewNotifier(
+	notificationFactory db.BuildNotificationFactory,
+	buildFactory db.BuildFactory,
+	secrets creds.Secrets,
+	varSourcePool creds.VarSourcePool,
+	httpClient *http.Client,
+	clock clock.Clock,
+	externalURL string,
+	config Config,
+) Notifier {
+	return &notifier{
+		notificationFactory: notificationFactory,
+		buildFactory:        buildFactory,
+		secrets:             secrets,
+		varSourcePool:       varSourcePool,
+		httpClient:          httpClient,
+		clock:               clock,
+		externalURL:         externalURL,
+		config:              config,
+	}
+}
+
+func (n *notifier) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("build-notifier")
+
+	notifications, err := n.notificationFactory.PendingNotifications(n.config.BatchSize)
+	if err != nil {
+		logger.Error("failed-to-get-pending-notifications", err)
+		return err
+	}
+
+	for _, notification := range notifications {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		n.notify(ctx, logger, notification)
+	}
+
+	return nil
+}
+
+func (n *notifier) notify(ctx context.Context, logger lager.Logger, notification db.BuildNotification) {
+	logger = logger.Session("notify", lager.Data{
+		"build":        notification.BuildID(),
+		"notification": notification.Name(),
+	})
+
+	responseCode, err := n.deliver(ctx, logger, notification)
+	if err == nil {
+		err = notification.Delivered(responseCode)
+		if err != nil {
+			logger.Error("failed-to-mark-delivered", err)
+		}
+
+		return
+	}
+
+	logger.Info("failed-to-deliver", lager.Data{"error": err.Error(), "attempts": notification.Attempts() + 1})
+
+	if notification.Attempts()+1 >= n.config.Attempts {
+		err = notification.Fail(responseCode, err.Error())
+		if err != nil {
+			logger.Error("failed-to-mark-failed", err)
+		}
+
+		return
+	}
+
+	backoff := n.config.RetryInterval << uint(notification.Attempts())
+
+	err = notification.Retry(responseCode, err.Error(), n.clock.Now().Add(backoff))
+	if err != nil {
+		logger.Error("failed-to-mark-for-retry", err)
+	}
+}
+
+// deliver sends the notification's webhook, returning the response code if it
+// got a response.
+func (n *notifier) deliver(ctx context.Context, logger lager.Logger, notification db.BuildNotification) (int, error) {
+	build, found, err := n.buildFactory.Build(notification.BuildID())
+	if err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, fmt.Errorf("build %d not found", notification.BuildID())
+	}
+
+	pipeline, found, err := build.Pipeline()
+	if err != nil {
+		return 0, err
+	}
+
+	if !found {
+		return 0, db.ErrBuildHasNoPipeline
+	}
+
+	variables, err := pipeline.Variables(logger, n.secrets, n.varSourcePool)
+	if err != nil {
+		return 0, err
+	}
+
+	webhook, err := creds.NewNotificationWebhook(variables, notification.Config().Webhook).Evaluate()
+	if err != nil {
+		return 0, err
+	}
+
+	body, err := renderBody(webhook, n.buildData(build, notification))
+	if err != nil {
+		return 0, err
+	}
+
+	method := webhook.Method
+	if method == "" {
+		method = http.MethodPost
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, n.config.Timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, webhook.URL, body)
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range webhook.Headers {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		// the error is recorded on the notification, which any viewer of the
+		// build can read, so leave out the url as it may contain credentials
+		if urlErr, ok := err.(*url.Error); ok {
+			return 0, fmt.Errorf("%s: %w", urlErr.Op, urlErr.Err)
+		}
+
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	// read a bit of the response so that it can be logged and the connection
+	// can be reused
+	response, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected response %d: %s", resp.StatusCode, response)
+	}
+
+	return resp.StatusCode, nil
+}
+
+func (n *notifier) buildData(build db.Build, notification db.BuildNotification) Build {
+	data := Build{
+		ID:                   build.ID(),
+		Name:                 build.Name(),
+		Team:                 build.TeamName(),
+		Pipeline:             build.PipelineName(),
+		PipelineInstanceVars: build.PipelineInstanceVars(),
+		Job:                  build.JobName(),
+		Status:               string(build.Status()),
+		PreviousStatus:       string(notification.PreviousStatus()),
+		URL: fmt.Sprintf(
+			"%s/teams/%s/pipelines/%s/jobs/%s/builds/%s",
+			n.externalURL,
+			url.PathEscape(build.TeamName()),
+			url.PathEscape(build.PipelineName()),
+			url.PathEscape(build.JobName()),
+			url.PathEscape(build.Name()),
+		),
+		Notification: notification.Name(),
+	}
+
+	if !build.StartTime().IsZero() {
+		data.StartTime = build.StartTime().Unix()
+	}
+
+	if !build.EndTime().IsZero() {
+		data.EndTime = build.EndTime().Unix()
+	}
+
+	return data
+}
+
+func renderBody(webhook atc.NotificationWebhookConfig, data Build) (io.Reader, error) {
+	if webhook.Body == "" {
+		payload, err := json.Marshal(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.NewReader(payload), nil
+	}
+
+	tmpl, err := webhook.BodyTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	buf := new(bytes.Buffer)
+	err = tmpl.Execute(buf, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf, nil
+}
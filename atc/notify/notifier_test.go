@@ -0,0 +1,258 @@
+package notify_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds/credsfakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/notify"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Notifier", func() {
+	var (
+		server *ghttp.Server
+
+		fakeNotificationFactory *dbfakes.FakeBuildNotificationFactory
+		fakeBuildFactory        *dbfakes.FakeBuildFactory
+		fakeSecrets             *credsfakes.FakeSecrets
+		fakeVarSourcePool       *credsfakes.FakeVarSourcePool
+		fakeClock               *fakeclock.FakeClock
+
+		fakeBuild        *dbfakes.FakeBuild
+		fakePipeline     *dbfakes.FakePipeline
+		fakeNotification *dbfakes.FakeBuildNotification
+
+		webhook atc.NotificationWebhookConfig
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		fakeNotificationFactory = new(dbfakes.FakeBuildNotificationFactory)
+		fakeBuildFactory = new(dbfakes.FakeBuildFactory)
+		fakeSecrets = new(credsfakes.FakeSecrets)
+		fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1593449362, 0))
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.VariablesReturns(vars.StaticVariables{"hook-token": "some-token"}, nil)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.IDReturns(123)
+		fakeBuild.NameReturns("42")
+		fakeBuild.TeamNameReturns("some-team")
+		fakeBuild.PipelineNameReturns("some-pipeline")
+		fakeBuild.JobNameReturns("some-job")
+		fakeBuild.StatusReturns(db.BuildStatusFailed)
+		fakeBuild.StartTimeReturns(time.Unix(1593449000, 0))
+		fakeBuild.EndTimeReturns(time.Unix(1593449300, 0))
+		fakeBuild.PipelineReturns(fakePipeline, true, nil)
+		fakeBuildFactory.BuildReturns(fakeBuild, true, nil)
+
+		webhook = atc.NotificationWebhookConfig{
+			URL: server.URL() + "/hook",
+		}
+
+		fakeNotification = new(dbfakes.FakeBuildNotification)
+		fakeNotification.BuildIDReturns(123)
+		fakeNotification.NameReturns("some-notification")
+		fakeNotification.PreviousStatusReturns(db.BuildStatusSucceeded)
+
+		fakeNotificationFactory.PendingNotificationsReturns([]db.BuildNotification{fakeNotification}, nil)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		fakeNotification.ConfigReturns(atc.NotificationConfig{
+			Name:    "some-notification",
+			Webhook: webhook,
+		})
+
+		notifier := notify.NewNotifier(
+			fakeNotificationFactory,
+			fakeBuildFactory,
+			fakeSecrets,
+			fakeVarSourcePool,
+			&http.Client{},
+			fakeClock,
+			"https://concourse.example.com",
+			notify.Config{
+				BatchSize:     10,
+				Timeout:       time.Second,
+				Attempts:      3,
+				RetryInterval: time.Minute,
+			},
+		)
+
+		runErr = notifier.Run(context.TODO())
+	})
+
+	Context("when the webhook succeeds", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/hook"),
+					ghttp.VerifyContentType("application/json"),
+					ghttp.VerifyJSONRepresenting(notify.Build{
+						ID:             123,
+						Name:           "42",
+						Team:           "some-team",
+						Pipeline:       "some-pipeline",
+						Job:            "some-job",
+						Status:         "failed",
+						PreviousStatus: "succeeded",
+						StartTime:      1593449000,
+						EndTime:        1593449300,
+						URL:            "https://concourse.example.com/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds/42",
+						Notification:   "some-notification",
+					}),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("sends the build as JSON and marks the notification as delivered", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+			Expect(fakeNotificationFactory.PendingNotificationsArgsForCall(0)).To(Equal(10))
+			Expect(fakeBuildFactory.BuildArgsForCall(0)).To(Equal(123))
+
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+			Expect(fakeNotification.DeliveredCallCount()).To(Equal(1))
+			Expect(fakeNotification.DeliveredArgsForCall(0)).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Context("when the webhook has a method, headers and a body template", func() {
+		BeforeEach(func() {
+			webhook.Method = "PUT"
+			webhook.Headers = map[string]string{"Authorization": "Bearer ((hook-token))"}
+			webhook.Body = `{"text": {{json (printf "%s/%s #%s %s" .Pipeline .Job .Name .Status)}}}`
+
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/hook"),
+					ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+					ghttp.VerifyJSON(`{"text": "some-pipeline/some-job #42 failed"}`),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+		})
+
+		It("interpolates vars and renders the body", func() {
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+			Expect(fakeNotification.DeliveredCallCount()).To(Equal(1))
+
+			_, secrets, varSourcePool := fakePipeline.VariablesArgsForCall(0)
+			Expect(secrets).To(Equal(fakeSecrets))
+			Expect(varSourcePool).To(Equal(fakeVarSourcePool))
+		})
+	})
+
+	Context("when the webhook fails", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, "oh no"))
+		})
+
+		It("retries with a backoff", func() {
+			Expect(fakeNotification.DeliveredCallCount()).To(BeZero())
+			Expect(fakeNotification.RetryCallCount()).To(Equal(1))
+
+			code, reason, at := fakeNotification.RetryArgsForCall(0)
+			Expect(code).To(Equal(http.StatusInternalServerError))
+			Expect(reason).To(Equal("unexpected response 500: oh no"))
+			Expect(at).To(Equal(fakeClock.Now().Add(time.Minute)))
+		})
+
+		Context("when it has been attempted before", func() {
+			BeforeEach(func() {
+				fakeNotification.AttemptsReturns(1)
+			})
+
+			It("backs off exponentially", func() {
+				_, _, at := fakeNotification.RetryArgsForCall(0)
+				Expect(at).To(Equal(fakeClock.Now().Add(2 * time.Minute)))
+			})
+		})
+
+		Context("when it is out of attempts", func() {
+			BeforeEach(func() {
+				fakeNotification.AttemptsReturns(2)
+			})
+
+			It("gives up", func() {
+				Expect(fakeNotification.RetryCallCount()).To(BeZero())
+				Expect(fakeNotification.FailCallCount()).To(Equal(1))
+
+				code, reason := fakeNotification.FailArgsForCall(0)
+				Expect(code).To(Equal(http.StatusInternalServerError))
+				Expect(reason).To(Equal("unexpected response 500: oh no"))
+			})
+		})
+	})
+
+	Context("when the webhook can't be reached", func() {
+		BeforeEach(func() {
+			webhook.URL = "((hook-url))"
+			fakePipeline.VariablesReturns(vars.StaticVariables{"hook-url": "http://127.0.0.1:1/hook?token=some-token"}, nil)
+		})
+
+		It("retries without recording the url", func() {
+			Expect(fakeNotification.RetryCallCount()).To(Equal(1))
+
+			code, reason, _ := fakeNotification.RetryArgsForCall(0)
+			Expect(code).To(BeZero())
+			Expect(reason).To(HavePrefix("Post: "))
+			Expect(reason).NotTo(ContainSubstring("some-token"))
+		})
+	})
+
+	Context("when the vars can't be interpolated", func() {
+		BeforeEach(func() {
+			webhook.URL = "((missing-var))"
+		})
+
+		It("retries without sending anything", func() {
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+			Expect(fakeNotification.RetryCallCount()).To(Equal(1))
+
+			code, _, _ := fakeNotification.RetryArgsForCall(0)
+			Expect(code).To(BeZero())
+		})
+	})
+
+	Context("when the build is gone", func() {
+		BeforeEach(func() {
+			fakeBuildFactory.BuildReturns(nil, false, nil)
+		})
+
+		It("does not send anything", func() {
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+			Expect(fakeNotification.RetryCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when getting the pending notifications fails", func() {
+		BeforeEach(func() {
+			fakeNotificationFactory.PendingNotificationsReturns(nil, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("nope"))
+		})
+	})
+})
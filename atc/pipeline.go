@@ -1,16 +1,108 @@
 package atc
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
 type Pipeline struct {
-	ID          int          `json:"id"`
-	Name        string       `json:"name"`
-	Paused      bool         `json:"paused"`
-	Public      bool         `json:"public"`
-	Archived    bool         `json:"archived"`
-	Groups      GroupConfigs `json:"groups,omitempty"`
-	TeamName    string       `json:"team_name"`
-	LastUpdated int64        `json:"last_updated,omitempty"`
+	ID           int          `json:"id"`
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+	Paused       bool         `json:"paused"`
+	Public       bool         `json:"public"`
+	Archived     bool         `json:"archived"`
+	Groups       GroupConfigs `json:"groups,omitempty"`
+	TeamName     string       `json:"team_name"`
+	LastUpdated  int64        `json:"last_updated,omitempty"`
+}
+
+func (p Pipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         p.Name,
+		InstanceVars: p.InstanceVars,
+	}
 }
 
 type RenameRequest struct {
 	NewName string `json:"name"`
 }
+
+// PipelineConfigVersion is one of the configs a pipeline has been saved with.
+// It was saved either by a user through the API or by the set_pipeline step
+// of a build. Config is left out when listing the versions.
+type PipelineConfigVersion struct {
+	Version      int     `json:"version"`
+	SavedAt      int64   `json:"saved_at"`
+	SavedBy      string  `json:"saved_by,omitempty"`
+	SavedByBuild int     `json:"saved_by_build,omitempty"`
+	Config       *Config `json:"config,omitempty"`
+}
+
+// PipelineConfigDiff holds two versions of a pipeline's config to compare.
+type PipelineConfigDiff struct {
+	From PipelineConfigVersion `json:"from"`
+	To   PipelineConfigVersion `json:"to"`
+}
+
+// InstanceVars are the vars which, together with the pipeline name, identify
+// an instance of a pipeline. Pipelines without instance vars are identified
+// by their name alone.
+type InstanceVars map[string]interface{}
+
+// PipelineRef identifies a pipeline (or a pipeline instance) within a team.
+type PipelineRef struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+func (ref PipelineRef) String() string {
+	if len(ref.InstanceVars) == 0 {
+		return ref.Name
+	}
+
+	keys := make([]string, 0, len(ref.InstanceVars))
+	for k := range ref.InstanceVars {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		payload, _ := json.Marshal(ref.InstanceVars[k])
+		pairs[i] = fmt.Sprintf("%s:%s", k, payload)
+	}
+
+	return ref.Name + "/" + strings.Join(pairs, ",")
+}
+
+// QueryParams returns the query params used to address the pipeline instance
+// in API routes. It is empty for pipelines without instance vars.
+func (ref PipelineRef) QueryParams() url.Values {
+	if len(ref.InstanceVars) == 0 {
+		return nil
+	}
+
+	payload, _ := json.Marshal(ref.InstanceVars)
+	return url.Values{"instance_vars": []string{string(payload)}}
+}
+
+// InstanceVarsFromQueryParams parses the instance vars encoded by
+// PipelineRef.QueryParams.
+func InstanceVarsFromQueryParams(params url.Values) (InstanceVars, error) {
+	raw := params.Get("instance_vars")
+	if raw == "" {
+		return nil, nil
+	}
+
+	var instanceVars InstanceVars
+	err := json.Unmarshal([]byte(raw), &instanceVars)
+	if err != nil {
+		return nil, fmt.Errorf("invalid instance_vars: %w", err)
+	}
+
+	return instanceVars, nil
+}
@@ -0,0 +1,58 @@
+package atc_test
+
+import (
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("PipelineRef", func() {
+	Describe("String", func() {
+		It("is just the name if there are no instance vars", func() {
+			ref := atc.PipelineRef{Name: "some-pipeline"}
+			Expect(ref.String()).To(Equal("some-pipeline"))
+		})
+
+		It("includes the instance vars sorted by name", func() {
+			ref := atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"version": 1, "branch": "master"},
+			}
+			Expect(ref.String()).To(Equal(`some-pipeline/branch:"master",version:1`))
+		})
+	})
+
+	Describe("QueryParams", func() {
+		It("is empty if there are no instance vars", func() {
+			ref := atc.PipelineRef{Name: "some-pipeline"}
+			Expect(ref.QueryParams()).To(BeEmpty())
+		})
+
+		It("round trips the instance vars", func() {
+			ref := atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "master"},
+			}
+
+			instanceVars, err := atc.InstanceVarsFromQueryParams(ref.QueryParams())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instanceVars).To(Equal(ref.InstanceVars))
+		})
+	})
+
+	Describe("InstanceVarsFromQueryParams", func() {
+		It("returns nil if there are no instance vars", func() {
+			instanceVars, err := atc.InstanceVarsFromQueryParams(url.Values{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(instanceVars).To(BeNil())
+		})
+
+		It("errors if the instance vars are malformed", func() {
+			_, err := atc.InstanceVarsFromQueryParams(url.Values{"instance_vars": []string{"bogus"}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
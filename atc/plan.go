@@ -21,6 +21,7 @@ type Plan struct {
 	Try         *TryPlan         `json:"try,omitempty"`
 	Timeout     *TimeoutPlan     `json:"timeout,omitempty"`
 	Retry       *RetryPlan       `json:"retry,omitempty"`
+	Across      *AcrossPlan      `json:"across,omitempty"`
 
 	// used for 'fly execute'
 	ArtifactInput  *ArtifactInputPlan  `json:"artifact_input,omitempty"`
@@ -85,6 +86,159 @@ type InParallelPlan struct {
 
 type DoPlan []Plan
 
+type AcrossPlan struct {
+	Vars     []AcrossVar     `json:"vars"`
+	Steps    []VarScopedPlan `json:"steps,omitempty"`
+	FailFast bool            `json:"fail_fast,omitempty"`
+
+	// SubStepTemplate is used in place of Steps when the values of any var
+	// are only known at runtime, i.e. they are loaded by a load_var step.
+	SubStepTemplate *Plan `json:"substep_template,omitempty"`
+}
+
+type AcrossVar struct {
+	Var         string        `json:"name"`
+	Values      []interface{} `json:"values,omitempty"`
+	LoadVar     string        `json:"load_var,omitempty"`
+	MaxInFlight int           `json:"max_in_flight,omitempty"`
+}
+
+type VarScopedPlan struct {
+	Step   Plan          `json:"step"`
+	Values []interface{} `json:"values"`
+}
+
+// AcrossCombinations returns every combination of the given lists of values,
+// in order, with the values of the last list varying fastest.
+func AcrossCombinations(values [][]interface{}) [][]interface{} {
+	combinations := [][]interface{}{{}}
+
+	for _, vals := range values {
+		next := make([][]interface{}, 0, len(combinations)*len(vals))
+
+		for _, combination := range combinations {
+			for _, val := range vals {
+				c := make([]interface{}, len(combination), len(combination)+1)
+				copy(c, combination)
+				next = append(next, append(c, val))
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
+}
+
+// WithIDSuffix returns a copy of the plan with the given suffix appended to
+// its ID and to the IDs of all of its sub-plans. It is used to give each of
+// the sub-steps an across step builds from the same template IDs of their own.
+func (plan Plan) WithIDSuffix(suffix string) Plan {
+	plan.ID += PlanID(suffix)
+
+	suffixed := func(plans []Plan) []Plan {
+		copied := make([]Plan, len(plans))
+		for i, p := range plans {
+			copied[i] = p.WithIDSuffix(suffix)
+		}
+		return copied
+	}
+
+	if plan.Aggregate != nil {
+		aggregate := AggregatePlan(suffixed(*plan.Aggregate))
+		plan.Aggregate = &aggregate
+	}
+
+	if plan.InParallel != nil {
+		inParallel := *plan.InParallel
+		inParallel.Steps = suffixed(inParallel.Steps)
+		plan.InParallel = &inParallel
+	}
+
+	if plan.Do != nil {
+		do := DoPlan(suffixed(*plan.Do))
+		plan.Do = &do
+	}
+
+	if plan.Get != nil && plan.Get.VersionFrom != nil {
+		get := *plan.Get
+		versionFrom := *get.VersionFrom + PlanID(suffix)
+		get.VersionFrom = &versionFrom
+		plan.Get = &get
+	}
+
+	if plan.OnAbort != nil {
+		plan.OnAbort = &OnAbortPlan{
+			Step: plan.OnAbort.Step.WithIDSuffix(suffix),
+			Next: plan.OnAbort.Next.WithIDSuffix(suffix),
+		}
+	}
+
+	if plan.OnError != nil {
+		plan.OnError = &OnErrorPlan{
+			Step: plan.OnError.Step.WithIDSuffix(suffix),
+			Next: plan.OnError.Next.WithIDSuffix(suffix),
+		}
+	}
+
+	if plan.Ensure != nil {
+		plan.Ensure = &EnsurePlan{
+			Step: plan.Ensure.Step.WithIDSuffix(suffix),
+			Next: plan.Ensure.Next.WithIDSuffix(suffix),
+		}
+	}
+
+	if plan.OnSuccess != nil {
+		plan.OnSuccess = &OnSuccessPlan{
+			Step: plan.OnSuccess.Step.WithIDSuffix(suffix),
+			Next: plan.OnSuccess.Next.WithIDSuffix(suffix),
+		}
+	}
+
+	if plan.OnFailure != nil {
+		plan.OnFailure = &OnFailurePlan{
+			Step: plan.OnFailure.Step.WithIDSuffix(suffix),
+			Next: plan.OnFailure.Next.WithIDSuffix(suffix),
+		}
+	}
+
+	if plan.Try != nil {
+		plan.Try = &TryPlan{Step: plan.Try.Step.WithIDSuffix(suffix)}
+	}
+
+	if plan.Timeout != nil {
+		timeout := *plan.Timeout
+		timeout.Step = timeout.Step.WithIDSuffix(suffix)
+		plan.Timeout = &timeout
+	}
+
+	if plan.Retry != nil {
+		retry := RetryPlan(suffixed(*plan.Retry))
+		plan.Retry = &retry
+	}
+
+	if plan.Across != nil {
+		across := *plan.Across
+
+		across.Steps = nil
+		for _, step := range plan.Across.Steps {
+			across.Steps = append(across.Steps, VarScopedPlan{
+				Step:   step.Step.WithIDSuffix(suffix),
+				Values: step.Values,
+			})
+		}
+
+		if across.SubStepTemplate != nil {
+			template := across.SubStepTemplate.WithIDSuffix(suffix)
+			across.SubStepTemplate = &template
+		}
+
+		plan.Across = &across
+	}
+
+	return plan
+}
+
 type GetPlan struct {
 	Type        string   `json:"type"`
 	Name        string   `json:"name,omitempty"`
@@ -94,6 +248,7 @@ type GetPlan struct {
 	Version     *Version `json:"version,omitempty"`
 	VersionFrom *PlanID  `json:"version_from,omitempty"`
 	Tags        Tags     `json:"tags,omitempty"`
+	Prefetch    bool     `json:"prefetch,omitempty"`
 
 	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
@@ -140,10 +295,11 @@ type TaskPlan struct {
 }
 
 type SetPipelinePlan struct {
-	Name     string                 `json:"name"`
-	File     string                 `json:"file"`
-	Vars     map[string]interface{} `json:"vars,omitempty"`
-	VarFiles []string               `json:"var_files,omitempty"`
+	Name         string                 `json:"name"`
+	File         string                 `json:"file"`
+	Vars         map[string]interface{} `json:"vars,omitempty"`
+	VarFiles     []string               `json:"var_files,omitempty"`
+	InstanceVars InstanceVars           `json:"instance_vars,omitempty"`
 }
 
 type LoadVarPlan struct {
@@ -59,6 +59,8 @@ func (factory PlanFactory) NewPlan(step Step) Plan {
 		plan.Timeout = &t
 	case RetryPlan:
 		plan.Retry = &t
+	case AcrossPlan:
+		plan.Across = &t
 	case ArtifactInputPlan:
 		plan.ArtifactInput = &t
 	case ArtifactOutputPlan:
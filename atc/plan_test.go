@@ -0,0 +1,53 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	Describe("WithIDSuffix", func() {
+		var (
+			putID atc.PlanID
+			plan  atc.Plan
+		)
+
+		BeforeEach(func() {
+			putID = "2"
+
+			plan = atc.Plan{
+				ID: "1",
+				OnSuccess: &atc.OnSuccessPlan{
+					Step: atc.Plan{
+						ID:  putID,
+						Put: &atc.PutPlan{Name: "some-put"},
+					},
+					Next: atc.Plan{
+						ID: "3",
+						Get: &atc.GetPlan{
+							Name:        "some-put",
+							VersionFrom: &putID,
+						},
+					},
+				},
+			}
+		})
+
+		It("suffixes the IDs of the plan and its sub-plans", func() {
+			suffixed := plan.WithIDSuffix("/1")
+
+			Expect(suffixed.ID).To(Equal(atc.PlanID("1/1")))
+			Expect(suffixed.OnSuccess.Step.ID).To(Equal(atc.PlanID("2/1")))
+			Expect(suffixed.OnSuccess.Next.ID).To(Equal(atc.PlanID("3/1")))
+			Expect(*suffixed.OnSuccess.Next.Get.VersionFrom).To(Equal(atc.PlanID("2/1")))
+		})
+
+		It("does not modify the original plan", func() {
+			plan.WithIDSuffix("/1")
+
+			Expect(plan.OnSuccess.Step.ID).To(Equal(atc.PlanID("2")))
+			Expect(*plan.OnSuccess.Next.Get.VersionFrom).To(Equal(atc.PlanID("2")))
+		})
+	})
+})
@@ -0,0 +1,104 @@
+package policy
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// OPA configures an agent which queries the data API of an Open Policy Agent
+// compatible endpoint.
+type OPA struct {
+	URL     string        `long:"opa-url"     description:"OPA policy check endpoint, e.g. http://localhost:8181/v1/data/concourse/decision"`
+	Timeout time.Duration `long:"opa-timeout" default:"5s" description:"Timeout for requests to the OPA endpoint."`
+}
+
+var ErrNoAgentURL = errors.New("policy agent url is not configured")
+
+func (o OPA) IsConfigured() bool {
+	return o.URL != ""
+}
+
+func (o OPA) NewAgent(logger lager.Logger) (Agent, error) {
+	if o.URL == "" {
+		return nil, ErrNoAgentURL
+	}
+
+	return opaAgent{
+		logger: logger.Session("opa"),
+		url:    o.URL,
+		client: &http.Client{Timeout: o.Timeout},
+	}, nil
+}
+
+type opaAgent struct {
+	logger lager.Logger
+	url    string
+	client *http.Client
+}
+
+type opaInput struct {
+	Input PolicyCheckInput `json:"input"`
+}
+
+type opaResult struct {
+	Result *json.RawMessage `json:"result"`
+}
+
+type opaDecision struct {
+	Allowed bool     `json:"allowed"`
+	Reasons []string `json:"reasons"`
+}
+
+// Check queries the OPA data API with the input document. The decision may
+// either be a plain boolean or an object with `allowed` and `reasons`. An
+// undefined decision allows the action.
+func (a opaAgent) Check(input PolicyCheckInput) (PolicyCheckOutput, error) {
+	payload, err := json.Marshal(opaInput{Input: input})
+	if err != nil {
+		return PolicyCheckOutput{}, err
+	}
+
+	resp, err := a.client.Post(a.url, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return PolicyCheckOutput{}, fmt.Errorf("opa request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return PolicyCheckOutput{}, fmt.Errorf("opa returned status: %d", resp.StatusCode)
+	}
+
+	var result opaResult
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return PolicyCheckOutput{}, fmt.Errorf("failed to decode opa response: %w", err)
+	}
+
+	if result.Result == nil {
+		a.logger.Debug("undefined-decision", lager.Data{"action": input.Action})
+		return PolicyCheckOutput{Allowed: true}, nil
+	}
+
+	var allowed bool
+	err = json.Unmarshal(*result.Result, &allowed)
+	if err == nil {
+		return PolicyCheckOutput{Allowed: allowed}, nil
+	}
+
+	var decision opaDecision
+	err = json.Unmarshal(*result.Result, &decision)
+	if err != nil {
+		return PolicyCheckOutput{}, fmt.Errorf("failed to decode opa decision: %w", err)
+	}
+
+	return PolicyCheckOutput{
+		Allowed: decision.Allowed,
+		Reasons: decision.Reasons,
+	}, nil
+}
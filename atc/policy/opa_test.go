@@ -0,0 +1,103 @@
+package policy_test
+
+import (
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc/policy"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OPA", func() {
+	var (
+		server *ghttp.Server
+		agent  policy.Agent
+
+		output   policy.PolicyCheckOutput
+		checkErr error
+	)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		var err error
+		agent, err = policy.OPA{URL: server.URL() + "/v1/data/concourse/decision", Timeout: time.Second}.NewAgent(lagertest.NewTestLogger("test"))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		output, checkErr = agent.Check(policy.PolicyCheckInput{
+			Service: "concourse",
+			Action:  "SaveConfig",
+			Team:    "some-team",
+			Data:    map[string]interface{}{"some": "config"},
+		})
+	})
+
+	Context("when the decision is an object", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/data/concourse/decision"),
+					ghttp.VerifyJSON(`{
+						"input": {
+							"service": "concourse",
+							"cluster_name": "",
+							"cluster_version": "",
+							"action": "SaveConfig",
+							"team": "some-team",
+							"data": {"some": "config"}
+						}
+					}`),
+					ghttp.RespondWith(http.StatusOK, `{"result": {"allowed": false, "reasons": ["no privileged tasks"]}}`),
+				),
+			)
+		})
+
+		It("returns the decision", func() {
+			Expect(checkErr).ToNot(HaveOccurred())
+			Expect(output).To(Equal(policy.PolicyCheckOutput{Allowed: false, Reasons: []string{"no privileged tasks"}}))
+		})
+	})
+
+	Context("when the decision is a boolean", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{"result": true}`))
+		})
+
+		It("returns the decision", func() {
+			Expect(checkErr).ToNot(HaveOccurred())
+			Expect(output).To(Equal(policy.PolicyCheckOutput{Allowed: true}))
+		})
+	})
+
+	Context("when the decision is undefined", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusOK, `{}`))
+		})
+
+		It("allows the action", func() {
+			Expect(checkErr).ToNot(HaveOccurred())
+			Expect(output.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("when the endpoint fails", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, ``))
+		})
+
+		It("returns an error", func() {
+			Expect(checkErr).To(MatchError("opa returned status: 500"))
+		})
+	})
+})
@@ -0,0 +1,158 @@
+package policy
+
+import (
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// ActionRunTask is the action checked just before a task step creates its
+// container. Unlike API actions it is not a route name.
+const ActionRunTask = "RunTask"
+
+type Config struct {
+	OPA OPA
+
+	Filter Filter
+
+	WarnOnly bool `long:"warn-only" description:"Only log a warning when a policy check fails, rather than rejecting the action."`
+}
+
+// Filter decides which actions go through a policy check. An action is
+// checked if either its HTTP method or its name is listed, and it is not
+// explicitly skipped.
+type Filter struct {
+	HttpMethods   []string `long:"filter-http-method" default:"PUT" default:"POST" default:"DELETE" default:"PATCH" description:"API HTTP methods to go through policy check."`
+	Actions       []string `long:"filter-action" description:"Actions to go through policy check, e.g. RunTask or an API action name like SaveConfig."`
+	ActionsToSkip []string `long:"filter-action-skip" description:"Actions to skip policy check, even if their HTTP method is filtered."`
+}
+
+// PolicyCheckInput is the document describing an action that is sent to the
+// policy agent.
+type PolicyCheckInput struct {
+	Service        string      `json:"service"`
+	ClusterName    string      `json:"cluster_name"`
+	ClusterVersion string      `json:"cluster_version"`
+	HttpMethod     string      `json:"http_method,omitempty"`
+	Action         string      `json:"action"`
+	User           string      `json:"user,omitempty"`
+	Team           string      `json:"team,omitempty"`
+	Pipeline       string      `json:"pipeline,omitempty"`
+	Data           interface{} `json:"data,omitempty"`
+}
+
+type PolicyCheckOutput struct {
+	Allowed bool
+	Reasons []string
+
+	// Warning is set when the action did not pass the policy check, but is
+	// allowed anyway because policy checks only warn.
+	Warning bool
+}
+
+func (output PolicyCheckOutput) Message() string {
+	if len(output.Reasons) == 0 {
+		return "policy check failed"
+	}
+
+	return fmt.Sprintf("policy check failed: %s", strings.Join(output.Reasons, ", "))
+}
+
+// PolicyCheckNotPassedError is returned by steps when an action is rejected by
+// the policy check.
+type PolicyCheckNotPassedError struct {
+	Output PolicyCheckOutput
+}
+
+func (err PolicyCheckNotPassedError) Error() string {
+	return err.Output.Message()
+}
+
+//go:generate counterfeiter . Agent
+
+// Agent evaluates a policy check against an external policy engine.
+type Agent interface {
+	Check(PolicyCheckInput) (PolicyCheckOutput, error)
+}
+
+//go:generate counterfeiter . Checker
+
+type Checker interface {
+	ShouldCheck(action string, httpMethod string) bool
+	Check(PolicyCheckInput) (PolicyCheckOutput, error)
+}
+
+// Initialize returns a Checker for the configured policy agent, or nil if no
+// agent is configured.
+func Initialize(logger lager.Logger, clusterName string, clusterVersion string, config Config) (Checker, error) {
+	if !config.OPA.IsConfigured() {
+		return nil, nil
+	}
+
+	agent, err := config.OPA.NewAgent(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("policy-checker-initialized", lager.Data{"agent": "opa"})
+
+	return NewChecker(agent, config.Filter, config.WarnOnly, clusterName, clusterVersion), nil
+}
+
+type checker struct {
+	agent          Agent
+	filter         Filter
+	warnOnly       bool
+	clusterName    string
+	clusterVersion string
+}
+
+func NewChecker(agent Agent, filter Filter, warnOnly bool, clusterName string, clusterVersion string) Checker {
+	return &checker{
+		agent:          agent,
+		filter:         filter,
+		warnOnly:       warnOnly,
+		clusterName:    clusterName,
+		clusterVersion: clusterVersion,
+	}
+}
+
+func (c *checker) ShouldCheck(action string, httpMethod string) bool {
+	if contains(c.filter.ActionsToSkip, action) {
+		return false
+	}
+
+	if contains(c.filter.Actions, action) {
+		return true
+	}
+
+	return httpMethod != "" && contains(c.filter.HttpMethods, httpMethod)
+}
+
+func (c *checker) Check(input PolicyCheckInput) (PolicyCheckOutput, error) {
+	input.Service = "concourse"
+	input.ClusterName = c.clusterName
+	input.ClusterVersion = c.clusterVersion
+
+	output, err := c.agent.Check(input)
+	if err != nil {
+		return PolicyCheckOutput{}, err
+	}
+
+	if !output.Allowed && c.warnOnly {
+		output.Allowed = true
+		output.Warning = true
+	}
+
+	return output, nil
+}
+
+func contains(list []string, item string) bool {
+	for _, i := range list {
+		if strings.EqualFold(i, item) {
+			return true
+		}
+	}
+	return false
+}
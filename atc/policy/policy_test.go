@@ -0,0 +1,120 @@
+package policy_test
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Checker", func() {
+	var (
+		fakeAgent *policyfakes.FakeAgent
+		filter    policy.Filter
+		warnOnly  bool
+
+		checker policy.Checker
+	)
+
+	BeforeEach(func() {
+		fakeAgent = new(policyfakes.FakeAgent)
+		filter = policy.Filter{
+			HttpMethods:   []string{"PUT", "POST"},
+			Actions:       []string{policy.ActionRunTask, "GetPipeline"},
+			ActionsToSkip: []string{"SetTeam"},
+		}
+		warnOnly = false
+	})
+
+	JustBeforeEach(func() {
+		checker = policy.NewChecker(fakeAgent, filter, warnOnly, "some-cluster", "some-version")
+	})
+
+	Describe("ShouldCheck", func() {
+		It("checks actions with a filtered http method", func() {
+			Expect(checker.ShouldCheck("SaveConfig", "PUT")).To(BeTrue())
+			Expect(checker.ShouldCheck("ListPipelines", "GET")).To(BeFalse())
+		})
+
+		It("checks filtered actions regardless of the http method", func() {
+			Expect(checker.ShouldCheck("GetPipeline", "GET")).To(BeTrue())
+			Expect(checker.ShouldCheck(policy.ActionRunTask, "")).To(BeTrue())
+		})
+
+		It("skips actions which are skipped", func() {
+			Expect(checker.ShouldCheck("SetTeam", "PUT")).To(BeFalse())
+		})
+	})
+
+	Describe("Check", func() {
+		var (
+			output   policy.PolicyCheckOutput
+			checkErr error
+		)
+
+		JustBeforeEach(func() {
+			output, checkErr = checker.Check(policy.PolicyCheckInput{
+				Action: "SaveConfig",
+				Team:   "some-team",
+			})
+		})
+
+		It("fills in the cluster details", func() {
+			Expect(fakeAgent.CheckCallCount()).To(Equal(1))
+			Expect(fakeAgent.CheckArgsForCall(0)).To(Equal(policy.PolicyCheckInput{
+				Service:        "concourse",
+				ClusterName:    "some-cluster",
+				ClusterVersion: "some-version",
+				Action:         "SaveConfig",
+				Team:           "some-team",
+			}))
+		})
+
+		Context("when the agent allows the action", func() {
+			BeforeEach(func() {
+				fakeAgent.CheckReturns(policy.PolicyCheckOutput{Allowed: true}, nil)
+			})
+
+			It("allows the action", func() {
+				Expect(checkErr).ToNot(HaveOccurred())
+				Expect(output).To(Equal(policy.PolicyCheckOutput{Allowed: true}))
+			})
+		})
+
+		Context("when the agent rejects the action", func() {
+			BeforeEach(func() {
+				fakeAgent.CheckReturns(policy.PolicyCheckOutput{Allowed: false, Reasons: []string{"nope"}}, nil)
+			})
+
+			It("rejects the action", func() {
+				Expect(checkErr).ToNot(HaveOccurred())
+				Expect(output).To(Equal(policy.PolicyCheckOutput{Allowed: false, Reasons: []string{"nope"}}))
+				Expect(output.Message()).To(Equal("policy check failed: nope"))
+			})
+
+			Context("when policy checks only warn", func() {
+				BeforeEach(func() {
+					warnOnly = true
+				})
+
+				It("allows the action with a warning", func() {
+					Expect(checkErr).ToNot(HaveOccurred())
+					Expect(output).To(Equal(policy.PolicyCheckOutput{Allowed: true, Reasons: []string{"nope"}, Warning: true}))
+				})
+			})
+		})
+
+		Context("when the agent fails", func() {
+			BeforeEach(func() {
+				fakeAgent.CheckReturns(policy.PolicyCheckOutput{}, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				Expect(checkErr).To(MatchError("disaster"))
+			})
+		})
+	})
+})
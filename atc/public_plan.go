@@ -24,6 +24,7 @@ func (plan Plan) Public() *json.RawMessage {
 		DependentGet   *json.RawMessage `json:"dependent_get,omitempty"`
 		Timeout        *json.RawMessage `json:"timeout,omitempty"`
 		Retry          *json.RawMessage `json:"retry,omitempty"`
+		Across         *json.RawMessage `json:"across,omitempty"`
 		ArtifactInput  *json.RawMessage `json:"artifact_input,omitempty"`
 		ArtifactOutput *json.RawMessage `json:"artifact_output,omitempty"`
 	}
@@ -98,6 +99,10 @@ func (plan Plan) Public() *json.RawMessage {
 		public.Retry = plan.Retry.Public()
 	}
 
+	if plan.Across != nil {
+		public.Across = plan.Across.Public()
+	}
+
 	if plan.ArtifactInput != nil {
 		public.ArtifactInput = plan.ArtifactInput.Public()
 	}
@@ -141,6 +146,39 @@ func (plan InParallelPlan) Public() *json.RawMessage {
 	})
 }
 
+func (plan AcrossPlan) Public() *json.RawMessage {
+	type scopedStep struct {
+		Step   *json.RawMessage `json:"step"`
+		Values []interface{}    `json:"values"`
+	}
+
+	steps := make([]scopedStep, len(plan.Steps))
+
+	for i := 0; i < len(plan.Steps); i++ {
+		steps[i] = scopedStep{
+			Step:   plan.Steps[i].Step.Public(),
+			Values: plan.Steps[i].Values,
+		}
+	}
+
+	var template *json.RawMessage
+	if plan.SubStepTemplate != nil {
+		template = plan.SubStepTemplate.Public()
+	}
+
+	return enc(struct {
+		Vars            []AcrossVar      `json:"vars"`
+		Steps           []scopedStep     `json:"steps,omitempty"`
+		SubStepTemplate *json.RawMessage `json:"substep_template,omitempty"`
+		FailFast        bool             `json:"fail_fast,omitempty"`
+	}{
+		Vars:            plan.Vars,
+		Steps:           steps,
+		SubStepTemplate: template,
+		FailFast:        plan.FailFast,
+	})
+}
+
 func (plan DoPlan) Public() *json.RawMessage {
 	public := make([]*json.RawMessage, len(plan))
 
@@ -261,9 +299,11 @@ func (plan TaskPlan) Public() *json.RawMessage {
 
 func (plan SetPipelinePlan) Public() *json.RawMessage {
 	return enc(struct {
-		Name string `json:"name"`
+		Name         string       `json:"name"`
+		InstanceVars InstanceVars `json:"instance_vars,omitempty"`
 	}{
-		Name: plan.Name,
+		Name:         plan.Name,
+		InstanceVars: plan.InstanceVars,
 	})
 }
 
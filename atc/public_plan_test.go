@@ -359,6 +359,47 @@ var _ = Describe("Plan", func() {
 							Vars:     map[string]interface{}{"k1": "v1"},
 						},
 					},
+					atc.Plan{
+						ID: "38",
+						Across: &atc.AcrossPlan{
+							Vars: []atc.AcrossVar{
+								{
+									Var:         "v1",
+									Values:      []interface{}{"a", "b"},
+									MaxInFlight: 1,
+								},
+							},
+							Steps: []atc.VarScopedPlan{
+								{
+									Step: atc.Plan{
+										ID: "39",
+										Task: &atc.TaskPlan{
+											Name:       "name",
+											ConfigPath: "some/config/path.yml",
+											Config: &atc.TaskConfig{
+												Params: atc.TaskEnv{"some": "secret"},
+											},
+										},
+									},
+									Values: []interface{}{"a"},
+								},
+								{
+									Step: atc.Plan{
+										ID: "40",
+										Task: &atc.TaskPlan{
+											Name:       "name",
+											ConfigPath: "some/config/path.yml",
+											Config: &atc.TaskConfig{
+												Params: atc.TaskEnv{"some": "secret"},
+											},
+										},
+									},
+									Values: []interface{}{"b"},
+								},
+							},
+							FailFast: true,
+						},
+					},
 				},
 			}
 
@@ -623,6 +664,41 @@ var _ = Describe("Plan", func() {
 	  "set_pipeline": {
 		"name": "some-pipeline"
 	  }
+	},
+	{
+	  "id": "38",
+	  "across": {
+		"vars": [
+		  {
+			"name": "v1",
+			"values": ["a", "b"],
+			"max_in_flight": 1
+		  }
+		],
+		"steps": [
+		  {
+			"step": {
+			  "id": "39",
+			  "task": {
+				"name": "name",
+				"privileged": false
+			  }
+			},
+			"values": ["a"]
+		  },
+		  {
+			"step": {
+			  "id": "40",
+			  "task": {
+				"name": "name",
+				"privileged": false
+			  }
+			},
+			"values": ["b"]
+		  }
+		],
+		"fail_fast": true
+	  }
 	}
   ]
 }
@@ -0,0 +1,24 @@
+package atc
+
+import "errors"
+
+// A Quota limits how many builds and task containers can be running at once,
+// either across the whole cluster or within a team. Zero means no limit.
+type Quota struct {
+	Builds         int `json:"builds,omitempty"`
+	TaskContainers int `json:"task_containers,omitempty"`
+}
+
+func (quota Quota) Validate() error {
+	if quota.Builds < 0 || quota.TaskContainers < 0 {
+		return errors.New("quota limits must not be negative")
+	}
+
+	return nil
+}
+
+// QuotaUsage is a quota along with how much of it is currently in use.
+type QuotaUsage struct {
+	Quota   Quota `json:"quota"`
+	Running Quota `json:"running"`
+}
@@ -1,12 +1,13 @@
 package atc
 
 type Resource struct {
-	Name         string `json:"name"`
-	PipelineName string `json:"pipeline_name"`
-	TeamName     string `json:"team_name"`
-	Type         string `json:"type"`
-	LastChecked  int64  `json:"last_checked,omitempty"`
-	Icon         string `json:"icon,omitempty"`
+	Name                 string       `json:"name"`
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	TeamName             string       `json:"team_name"`
+	Type                 string       `json:"type"`
+	LastChecked          int64        `json:"last_checked,omitempty"`
+	Icon                 string       `json:"icon,omitempty"`
 
 	FailingToCheck  bool   `json:"failing_to_check,omitempty"`
 	CheckSetupError string `json:"check_setup_error,omitempty"`
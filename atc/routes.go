@@ -3,8 +3,11 @@ package atc
 import "github.com/tedsuo/rata"
 
 const (
-	SaveConfig = "SaveConfig"
-	GetConfig  = "GetConfig"
+	SaveConfig         = "SaveConfig"
+	GetConfig          = "GetConfig"
+	ListConfigVersions = "ListConfigVersions"
+	GetConfigVersion   = "GetConfigVersion"
+	DiffConfigVersions = "DiffConfigVersions"
 
 	GetBuild            = "GetBuild"
 	GetBuildPlan        = "GetBuildPlan"
@@ -15,6 +18,8 @@ const (
 	AbortBuild          = "AbortBuild"
 	GetBuildPreparation = "GetBuildPreparation"
 
+	ListBuildNotifications = "ListBuildNotifications"
+
 	GetCheck = "GetCheck"
 
 	GetJob         = "GetJob"
@@ -33,6 +38,7 @@ const (
 	MainJobBadge   = "MainJobBadge"
 
 	ClearTaskCache = "ClearTaskCache"
+	GetJobTaskPlan = "GetJobTaskPlan"
 
 	ListAllResources     = "ListAllResources"
 	ListResources        = "ListResources"
@@ -77,6 +83,11 @@ const (
 	HeartbeatWorker = "HeartbeatWorker"
 	ListWorkers     = "ListWorkers"
 	DeleteWorker    = "DeleteWorker"
+	CordonWorker    = "CordonWorker"
+	UncordonWorker  = "UncordonWorker"
+
+	CordonTaggedWorkers   = "CordonTaggedWorkers"
+	UncordonTaggedWorkers = "UncordonTaggedWorkers"
 
 	SetLogLevel = "SetLogLevel"
 	GetLogLevel = "GetLogLevel"
@@ -90,6 +101,8 @@ const (
 	HijackContainer          = "HijackContainer"
 	ListDestroyingContainers = "ListDestroyingContainers"
 	ReportWorkerContainers   = "ReportWorkerContainers"
+	RetainBuildContainers    = "RetainBuildContainers"
+	ReleaseBuildContainers   = "ReleaseBuildContainers"
 
 	ListVolumes           = "ListVolumes"
 	ListDestroyingVolumes = "ListDestroyingVolumes"
@@ -102,6 +115,10 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	ListAPITokens  = "ListAPITokens"
+	CreateAPIToken = "CreateAPIToken"
+	RevokeAPIToken = "RevokeAPIToken"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
@@ -112,16 +129,28 @@ const (
 	SetWall   = "SetWall"
 	GetWall   = "GetWall"
 	ClearWall = "ClearWall"
+
+	ListAuditEvents = "ListAuditEvents"
+
+	GetClusterQuota = "GetClusterQuota"
+	SetClusterQuota = "SetClusterQuota"
+	GetTeamQuota    = "GetTeamQuota"
+	SetTeamQuota    = "SetTeamQuota"
 )
 
 const (
 	ClearTaskCacheQueryPath = "cache_path"
 	SaveConfigCheckCreds    = "check_creds"
+	DiffConfigVersionsFrom  = "from"
+	DiffConfigVersionsTo    = "to"
 )
 
 var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "PUT", Name: SaveConfig},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config", Method: "GET", Name: GetConfig},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions", Method: "GET", Name: ListConfigVersions},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/versions/:config_version", Method: "GET", Name: GetConfigVersion},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/diff", Method: "GET", Name: DiffConfigVersions},
 
 	{Path: "/api/v1/teams/:team_name/builds", Method: "POST", Name: CreateBuild},
 
@@ -132,6 +161,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
+	{Path: "/api/v1/builds/:build_id/notifications", Method: "GET", Name: ListBuildNotifications},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
 
 	{Path: "/api/v1/checks/:check_id", Method: "GET", Name: GetCheck},
@@ -151,6 +181,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/pipelines/:pipeline_name/jobs/:job_name/badge", Method: "GET", Name: MainJobBadge},
 
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/tasks/:step_name/cache", Method: "DELETE", Name: ClearTaskCache},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/tasks/:step_name/plan", Method: "GET", Name: GetJobTaskPlan},
 
 	{Path: "/api/v1/pipelines", Method: "GET", Name: ListAllPipelines},
 	{Path: "/api/v1/teams/:team_name/pipelines", Method: "GET", Name: ListPipelines},
@@ -193,10 +224,15 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/workers", Method: "POST", Name: RegisterWorker},
 	{Path: "/api/v1/workers/:worker_name/land", Method: "PUT", Name: LandWorker},
 	{Path: "/api/v1/workers/:worker_name/retire", Method: "PUT", Name: RetireWorker},
+	{Path: "/api/v1/workers/:worker_name/cordon", Method: "PUT", Name: CordonWorker},
+	{Path: "/api/v1/workers/:worker_name/cordon", Method: "DELETE", Name: UncordonWorker},
 	{Path: "/api/v1/workers/:worker_name/prune", Method: "PUT", Name: PruneWorker},
 	{Path: "/api/v1/workers/:worker_name/heartbeat", Method: "PUT", Name: HeartbeatWorker},
 	{Path: "/api/v1/workers/:worker_name", Method: "DELETE", Name: DeleteWorker},
 
+	{Path: "/api/v1/worker-cordons", Method: "PUT", Name: CordonTaggedWorkers},
+	{Path: "/api/v1/worker-cordons", Method: "DELETE", Name: UncordonTaggedWorkers},
+
 	{Path: "/api/v1/log-level", Method: "GET", Name: GetLogLevel},
 	{Path: "/api/v1/log-level", Method: "PUT", Name: SetLogLevel},
 
@@ -212,6 +248,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
 	{Path: "/api/v1/teams/:team_name/containers/:id", Method: "GET", Name: GetContainer},
 	{Path: "/api/v1/teams/:team_name/containers/:id/hijack", Method: "GET", Name: HijackContainer},
+	{Path: "/api/v1/builds/:build_id/containers/retain", Method: "PUT", Name: RetainBuildContainers},
+	{Path: "/api/v1/builds/:build_id/containers/release", Method: "PUT", Name: ReleaseBuildContainers},
 
 	{Path: "/api/v1/teams/:team_name/volumes", Method: "GET", Name: ListVolumes},
 	{Path: "/api/v1/volumes/destroying", Method: "GET", Name: ListDestroyingVolumes},
@@ -224,10 +262,21 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
 
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "GET", Name: ListAPITokens},
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "POST", Name: CreateAPIToken},
+	{Path: "/api/v1/teams/:team_name/tokens/:token_name", Method: "DELETE", Name: RevokeAPIToken},
+
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
 
 	{Path: "/api/v1/wall", Method: "GET", Name: GetWall},
 	{Path: "/api/v1/wall", Method: "PUT", Name: SetWall},
 	{Path: "/api/v1/wall", Method: "DELETE", Name: ClearWall},
+
+	{Path: "/api/v1/audit-events", Method: "GET", Name: ListAuditEvents},
+
+	{Path: "/api/v1/quota", Method: "GET", Name: GetClusterQuota},
+	{Path: "/api/v1/quota", Method: "PUT", Name: SetClusterQuota},
+	{Path: "/api/v1/teams/:team_name/quota", Method: "GET", Name: GetTeamQuota},
+	{Path: "/api/v1/teams/:team_name/quota", Method: "PUT", Name: SetTeamQuota},
 })
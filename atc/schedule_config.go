@@ -0,0 +1,88 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// ScheduleConfig triggers builds of a job on a cron schedule, without having
+// to configure a time resource.
+type ScheduleConfig struct {
+	// Cron is a standard five field cron expression, e.g. "0 2 * * 1-5", or a
+	// descriptor such as "@daily" or "@every 6h".
+	Cron string `json:"cron"`
+
+	// Location is the time zone the expression is evaluated in, e.g.
+	// "America/Toronto". Defaults to UTC.
+	Location string `json:"location,omitempty"`
+
+	// Jitter delays each build by up to this long, so that the jobs sharing a
+	// schedule don't all start at once.
+	Jitter string `json:"jitter,omitempty"`
+}
+
+func (config ScheduleConfig) Validate() error {
+	if config.Cron == "" {
+		return errors.New("cron must be specified")
+	}
+
+	_, err := cron.ParseStandard(config.Cron)
+	if err != nil {
+		return fmt.Errorf("invalid cron '%s': %w", config.Cron, err)
+	}
+
+	_, err = time.LoadLocation(config.Location)
+	if err != nil {
+		return fmt.Errorf("invalid location '%s': %w", config.Location, err)
+	}
+
+	if config.Jitter != "" {
+		jitter, err := time.ParseDuration(config.Jitter)
+		if err != nil {
+			return fmt.Errorf("invalid jitter '%s': %w", config.Jitter, err)
+		}
+
+		if jitter < 0 {
+			return fmt.Errorf("invalid jitter '%s': must not be negative", config.Jitter)
+		}
+	}
+
+	return nil
+}
+
+// Next returns the first time the schedule fires after the given time.
+func (config ScheduleConfig) Next(after time.Time) (time.Time, error) {
+	schedule, err := cron.ParseStandard(config.Cron)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	location, err := time.LoadLocation(config.Location)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return schedule.Next(after.In(location)), nil
+}
+
+// MaxJitter returns how long each build may be delayed by, or 0 if builds
+// are started as soon as the schedule fires.
+func (config ScheduleConfig) MaxJitter() time.Duration {
+	jitter, err := time.ParseDuration(config.Jitter)
+	if err != nil || jitter < 0 {
+		return 0
+	}
+
+	return jitter
+}
+
+func (config ScheduleConfig) String() string {
+	if config.Location == "" {
+		return config.Cron
+	}
+
+	return config.Cron + " " + config.Location
+}
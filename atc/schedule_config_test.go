@@ -0,0 +1,59 @@
+package atc_test
+
+import (
+	"time"
+
+	. "github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduleConfig", func() {
+	Describe("Validate", func() {
+		It("requires a cron expression", func() {
+			Expect(ScheduleConfig{}.Validate()).To(MatchError("cron must be specified"))
+		})
+
+		It("accepts descriptors", func() {
+			Expect(ScheduleConfig{Cron: "@every 6h"}.Validate()).To(Succeed())
+		})
+
+		It("rejects an invalid jitter", func() {
+			Expect(ScheduleConfig{Cron: "@daily", Jitter: "soon"}.Validate()).To(MatchError(ContainSubstring("invalid jitter 'soon'")))
+		})
+
+		It("rejects a negative jitter", func() {
+			Expect(ScheduleConfig{Cron: "@daily", Jitter: "-5m"}.Validate()).To(MatchError("invalid jitter '-5m': must not be negative"))
+		})
+	})
+
+	Describe("Next", func() {
+		var after time.Time
+
+		BeforeEach(func() {
+			after = time.Date(2020, 7, 24, 12, 0, 0, 0, time.UTC)
+		})
+
+		It("evaluates the expression in UTC by default", func() {
+			next, err := ScheduleConfig{Cron: "0 2 * * *"}.Next(after)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeTemporally("==", time.Date(2020, 7, 25, 2, 0, 0, 0, time.UTC)))
+		})
+
+		It("evaluates the expression in the configured location", func() {
+			next, err := ScheduleConfig{Cron: "0 2 * * *", Location: "America/Toronto"}.Next(after)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(next).To(BeTemporally("==", time.Date(2020, 7, 25, 6, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	Describe("MaxJitter", func() {
+		It("is zero when unset", func() {
+			Expect(ScheduleConfig{Cron: "@daily"}.MaxJitter()).To(BeZero())
+		})
+
+		It("parses the jitter", func() {
+			Expect(ScheduleConfig{Cron: "@daily", Jitter: "10m"}.MaxJitter()).To(Equal(10 * time.Minute))
+		})
+	})
+})
@@ -403,7 +403,9 @@ func (r *groupResolver) constrainingCandidates(passedJobID int) map[string][]str
 	constrainingCandidates := map[string][]string{}
 	for passedIndex, passedInput := range r.inputConfigs {
 		if passedInput.Passed[passedJobID] && r.candidates[passedIndex] != nil {
-			resID := strconv.Itoa(passedInput.ResourceID)
+			// the passed job's builds record versions under its own resource,
+			// which differs from the input's when it is in another pipeline
+			resID := strconv.Itoa(passedInput.PassedResourceID(passedJobID))
 			constrainingCandidates[resID] = append(constrainingCandidates[resID], string(r.candidates[passedIndex].Version))
 		}
 	}
@@ -415,13 +417,13 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 	inputConfig := r.inputConfigs[candidateIdx]
 	candidate := r.candidates[candidateIdx]
 
-	if inputConfig.ResourceID != output.ResourceID {
-		// unrelated; different resource
+	if !inputConfig.Passed[passedJobID] {
+		// unrelated; this input is unaffected by the current job
 		return false, false, nil
 	}
 
-	if !inputConfig.Passed[passedJobID] {
-		// unrelated; this input is unaffected by the current job
+	if inputConfig.PassedResourceID(passedJobID) != output.ResourceID {
+		// unrelated; different resource
 		return false, false, nil
 	}
 
@@ -431,7 +433,7 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 		return false, true, nil
 	}
 
-	disabled, err := r.vdb.VersionIsDisabled(ctx, output.ResourceID, output.Version)
+	disabled, err := r.vdb.VersionIsDisabled(ctx, inputConfig.ResourceID, output.Version)
 	if err != nil {
 		return false, false, err
 	}
@@ -36,16 +36,22 @@ type Build interface {
 func NewBuildStarter(
 	factory BuildFactory,
 	algorithm Algorithm,
+	queue db.BuildQueue,
+	quotas db.QuotaRepository,
 ) BuildStarter {
 	return &buildStarter{
 		factory:   factory,
 		algorithm: algorithm,
+		queue:     queue,
+		quotas:    quotas,
 	}
 }
 
 type buildStarter struct {
 	factory   BuildFactory
 	algorithm Algorithm
+	queue     db.BuildQueue
+	quotas    db.QuotaRepository
 }
 
 func (s *buildStarter) TryStartPendingBuildsForJob(
@@ -73,9 +79,10 @@ func (s *buildStarter) TryStartPendingBuildsForJob(
 			continue
 		}
 
-		if !results.scheduled || !results.readyToDetermineInputs {
-			// If max in flight is reached or a manually triggered build has not
-			// checked all resources, stop scheduling and retry later
+		if !results.scheduled || !results.admitted || !results.readyToDetermineInputs {
+			// If max in flight is reached, the workers are saturated, a quota is
+			// reached or a manually triggered build has not checked all resources,
+			// stop scheduling and retry later
 			needsRetry = true
 			break
 		}
@@ -125,6 +132,7 @@ func (s *buildStarter) constructBuilds(job db.Job, jobInputs db.InputConfigs, bu
 type startResults struct {
 	finished               bool
 	scheduled              bool
+	admitted               bool
 	readyToDetermineInputs bool
 	inputsDetermined       bool
 }
@@ -164,6 +172,40 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		}, nil
 	}
 
+	config, err := job.Config()
+	if err != nil {
+		return startResults{}, fmt.Errorf("config: %w", err)
+	}
+
+	queued, err := s.hasFreeSlot(nextPendingBuild, config)
+	if err != nil {
+		return startResults{}, fmt.Errorf("check build queue: %w", err)
+	}
+
+	if !queued {
+		logger.Debug("waiting-for-free-slot")
+		return startResults{
+			scheduled: scheduled,
+		}, nil
+	}
+
+	quotasReached, err := s.quotas.QuotasReached(nextPendingBuild.TeamID())
+	if err != nil {
+		return startResults{}, fmt.Errorf("check quotas: %w", err)
+	}
+
+	admitted := !quotasReached.Any()
+	if !admitted {
+		logger.Debug("quota-reached", lager.Data{
+			"team":    quotasReached.Team,
+			"cluster": quotasReached.Cluster,
+		})
+		return startResults{
+			scheduled: scheduled,
+			admitted:  admitted,
+		}, nil
+	}
+
 	readyToDetermineInputs, err := nextPendingBuild.IsReadyToDetermineInputs(logger)
 	if err != nil {
 		return startResults{}, fmt.Errorf("ready to determine inputs: %w", err)
@@ -172,6 +214,7 @@ func (s *buildStarter) tryStartNextPendingBuild(
 	if !readyToDetermineInputs {
 		return startResults{
 			scheduled:              scheduled,
+			admitted:               admitted,
 			readyToDetermineInputs: readyToDetermineInputs,
 		}, nil
 	}
@@ -188,16 +231,12 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		// inputs being unsatisfiable
 		return startResults{
 			scheduled:              scheduled,
+			admitted:               admitted,
 			readyToDetermineInputs: readyToDetermineInputs,
 			inputsDetermined:       inputsDetermined,
 		}, nil
 	}
 
-	config, err := job.Config()
-	if err != nil {
-		return startResults{}, fmt.Errorf("config: %w", err)
-	}
-
 	plan, err := s.factory.Create(config, job.Resources, job.ResourceTypes, buildInputs)
 	if err != nil {
 		logger.Error("failed-to-create-build-plan", err)
@@ -236,3 +275,29 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		finished: true,
 	}, nil
 }
+
+// hasFreeSlot returns whether the build can be started without going ahead of
+// the builds queued before it for the same workers. When the workers its
+// tasks could be placed on are saturated, only builds within the first free
+// slots of their queue may start.
+func (s *buildStarter) hasFreeSlot(build Build, config atc.JobConfig) (bool, error) {
+	freeSlots, limited, err := s.queue.FreeSlots(build.TeamID(), db.TaskPlacements(config))
+	if err != nil {
+		return false, err
+	}
+
+	if !limited {
+		return true, nil
+	}
+
+	position, found, err := s.queue.Position(build.ID())
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return freeSlots > 0, nil
+	}
+
+	return position <= freeSlots, nil
+}
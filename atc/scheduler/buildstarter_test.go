@@ -21,6 +21,8 @@ var _ = Describe("BuildStarter", func() {
 		fakeFactory   *schedulerfakes.FakeBuildFactory
 		pendingBuilds []db.Build
 		fakeAlgorithm *schedulerfakes.FakeAlgorithm
+		fakeQueue     *dbfakes.FakeBuildQueue
+		fakeQuotas    *dbfakes.FakeQuotaRepository
 
 		buildStarter scheduler.BuildStarter
 
@@ -33,8 +35,10 @@ var _ = Describe("BuildStarter", func() {
 		fakePipeline = new(dbfakes.FakePipeline)
 		fakeFactory = new(schedulerfakes.FakeBuildFactory)
 		fakeAlgorithm = new(schedulerfakes.FakeAlgorithm)
+		fakeQueue = new(dbfakes.FakeBuildQueue)
+		fakeQuotas = new(dbfakes.FakeQuotaRepository)
 
-		buildStarter = scheduler.NewBuildStarter(fakeFactory, fakeAlgorithm)
+		buildStarter = scheduler.NewBuildStarter(fakeFactory, fakeAlgorithm, fakeQueue, fakeQuotas)
 
 		disaster = errors.New("bad thing")
 	})
@@ -555,6 +559,137 @@ var _ = Describe("BuildStarter", func() {
 							})
 						})
 
+						Context("when the workers are saturated", func() {
+							BeforeEach(func() {
+								fakeQueue.FreeSlotsReturns(1, true, nil)
+								fakeQueue.PositionReturns(2, true, nil)
+							})
+
+							It("doesn't return an error", func() {
+								Expect(tryStartErr).NotTo(HaveOccurred())
+							})
+
+							It("looks up the position of the first pending build", func() {
+								Expect(fakeQueue.PositionCallCount()).To(Equal(1))
+								Expect(fakeQueue.PositionArgsForCall(0)).To(Equal(pendingBuild1.ID()))
+							})
+
+							Context("when the job runs tasks", func() {
+								BeforeEach(func() {
+									pendingBuild1.TeamIDReturns(7)
+									job.ConfigReturns(atc.JobConfig{
+										Name: "some-job",
+										Plan: atc.PlanSequence{
+											{Get: "some-input"},
+											{Task: "unit", Tags: atc.Tags{"gpu"}},
+											{Task: "windows", TaskConfig: &atc.TaskConfig{Platform: "windows"}},
+										},
+									}, nil)
+								})
+
+								It("counts the free slots of the workers its tasks could be placed on", func() {
+									Expect(fakeQueue.FreeSlotsCallCount()).To(Equal(1))
+
+									teamID, tasks := fakeQueue.FreeSlotsArgsForCall(0)
+									Expect(teamID).To(Equal(7))
+									Expect(tasks).To(ConsistOf(
+										db.TaskPlacement{Tags: []string{"gpu"}},
+										db.TaskPlacement{Platform: "windows"},
+									))
+								})
+							})
+
+							It("doesn't start any build and retries to schedule", func() {
+								Expect(pendingBuild1.AdoptInputsAndPipesCallCount()).To(BeZero())
+								Expect(pendingBuild1.StartCallCount()).To(BeZero())
+								Expect(pendingBuild2.StartCallCount()).To(BeZero())
+								Expect(rerunBuild.StartCallCount()).To(BeZero())
+								Expect(needsReschedule).To(BeTrue())
+							})
+
+							Context("when the build is within the free slots", func() {
+								BeforeEach(func() {
+									fakeQueue.PositionReturns(1, true, nil)
+									fakeFactory.CreateReturns(atc.Plan{}, nil)
+									pendingBuild1.StartReturns(true, nil)
+									pendingBuild2.StartReturns(true, nil)
+									rerunBuild.StartReturns(true, nil)
+								})
+
+								It("starts it", func() {
+									Expect(pendingBuild1.StartCallCount()).To(Equal(1))
+									Expect(rerunBuild.StartCallCount()).To(Equal(1))
+									Expect(pendingBuild2.StartCallCount()).To(Equal(1))
+									Expect(needsReschedule).To(BeFalse())
+								})
+							})
+
+							Context("when the build is not in the queue", func() {
+								BeforeEach(func() {
+									fakeQueue.PositionReturns(0, false, nil)
+								})
+
+								It("starts it as long as there is a free slot", func() {
+									Expect(pendingBuild1.AdoptInputsAndPipesCallCount()).To(Equal(1))
+								})
+							})
+
+							Context("when looking up the position fails", func() {
+								BeforeEach(func() {
+									fakeQueue.PositionReturns(0, false, disaster)
+								})
+
+								It("returns the error", func() {
+									Expect(tryStartErr).To(Equal(fmt.Errorf("check build queue: %w", disaster)))
+								})
+							})
+						})
+
+						Context("when counting the free slots fails", func() {
+							BeforeEach(func() {
+								fakeQueue.FreeSlotsReturns(0, false, disaster)
+							})
+
+							It("returns the error", func() {
+								Expect(tryStartErr).To(Equal(fmt.Errorf("check build queue: %w", disaster)))
+							})
+
+							It("doesn't start any build", func() {
+								Expect(pendingBuild1.StartCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when a quota is reached", func() {
+							BeforeEach(func() {
+								pendingBuild1.TeamIDReturns(7)
+								fakeQuotas.QuotasReachedReturns(db.QuotasReached{Team: true}, nil)
+							})
+
+							It("checks the quotas of the build's team", func() {
+								Expect(fakeQuotas.QuotasReachedCallCount()).To(Equal(1))
+								Expect(fakeQuotas.QuotasReachedArgsForCall(0)).To(Equal(7))
+							})
+
+							It("doesn't start any build and retries to schedule", func() {
+								Expect(tryStartErr).NotTo(HaveOccurred())
+								Expect(pendingBuild1.AdoptInputsAndPipesCallCount()).To(BeZero())
+								Expect(pendingBuild1.StartCallCount()).To(BeZero())
+								Expect(rerunBuild.StartCallCount()).To(BeZero())
+								Expect(pendingBuild2.StartCallCount()).To(BeZero())
+								Expect(needsReschedule).To(BeTrue())
+							})
+						})
+
+						Context("when checking the quotas fails", func() {
+							BeforeEach(func() {
+								fakeQuotas.QuotasReachedReturns(db.QuotasReached{}, disaster)
+							})
+
+							It("returns the error", func() {
+								Expect(tryStartErr).To(Equal(fmt.Errorf("check quotas: %w", disaster)))
+							})
+						})
+
 						Context("when the build was scheduled successfully", func() {
 							Context("when the resource types are successfully fetched", func() {
 								Context("when creating the build plan fails for the rerun build and the scheduler builds", func() {
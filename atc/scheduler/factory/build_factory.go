@@ -110,6 +110,10 @@ func (factory *buildFactory) constructPlanFromConfig(
 	resourceTypes atc.VersionedResourceTypes,
 	inputs []db.BuildInput,
 ) (atc.Plan, error) {
+	if len(planConfig.Across) > 0 {
+		return factory.across(job, planConfig, resources, resourceTypes, inputs)
+	}
+
 	var plan atc.Plan
 	var err error
 
@@ -142,6 +146,75 @@ func (factory *buildFactory) constructPlanFromConfig(
 	})
 }
 
+func (factory *buildFactory) across(
+	job atc.JobConfig,
+	planConfig atc.PlanConfig,
+	resources db.SchedulerResources,
+	resourceTypes atc.VersionedResourceTypes,
+	inputs []db.BuildInput,
+) (atc.Plan, error) {
+	// hooks apply to the across step as a whole, while everything else (e.g.
+	// attempts and timeout) applies to each sub-step
+	subStepConfig := planConfig
+	subStepConfig.Across = nil
+	subStepConfig.FailFast = false
+	subStepConfig.Abort = nil
+	subStepConfig.Error = nil
+	subStepConfig.Failure = nil
+	subStepConfig.Ensure = nil
+	subStepConfig.Success = nil
+
+	acrossPlan := atc.AcrossPlan{
+		FailFast: planConfig.FailFast,
+	}
+
+	dynamic := false
+	values := [][]interface{}{}
+	for _, acrossVar := range planConfig.Across {
+		acrossPlan.Vars = append(acrossPlan.Vars, atc.AcrossVar{
+			Var:         acrossVar.Var,
+			Values:      acrossVar.Values.Static,
+			LoadVar:     acrossVar.Values.LoadVar,
+			MaxInFlight: acrossVar.MaxInFlight.EffectiveLimit(),
+		})
+
+		if acrossVar.Values.LoadVar != "" {
+			dynamic = true
+		}
+
+		values = append(values, acrossVar.Values.Static)
+	}
+
+	if dynamic {
+		template, err := factory.constructPlanFromConfig(job, subStepConfig, resources, resourceTypes, inputs)
+		if err != nil {
+			return atc.Plan{}, err
+		}
+
+		acrossPlan.SubStepTemplate = &template
+	} else {
+		for _, combination := range atc.AcrossCombinations(values) {
+			step, err := factory.constructPlanFromConfig(job, subStepConfig, resources, resourceTypes, inputs)
+			if err != nil {
+				return atc.Plan{}, err
+			}
+
+			acrossPlan.Steps = append(acrossPlan.Steps, atc.VarScopedPlan{
+				Step:   step,
+				Values: combination,
+			})
+		}
+	}
+
+	return factory.applyHooks(job, constructionParams{
+		plan:          factory.planFactory.NewPlan(acrossPlan),
+		hooks:         planConfig.Hooks(),
+		resources:     resources,
+		resourceTypes: resourceTypes,
+		inputs:        inputs,
+	})
+}
+
 func (factory *buildFactory) constructUnhookedPlan(
 	job atc.JobConfig,
 	planConfig atc.PlanConfig,
@@ -198,9 +271,10 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Resource:    resourceName,
 			VersionFrom: &putPlan.ID,
 
-			Params: planConfig.GetParams,
-			Tags:   planConfig.Tags,
-			Source: resource.Source,
+			Params:   planConfig.GetParams,
+			Tags:     planConfig.Tags,
+			Source:   resource.Source,
+			Prefetch: resource.Prefetch,
 
 			VersionedResourceTypes: resourceTypes,
 		})
@@ -242,33 +316,22 @@ func (factory *buildFactory) constructUnhookedPlan(
 			Params:   planConfig.Params,
 			Version:  &version,
 			Tags:     planConfig.Tags,
+			Prefetch: resource.Prefetch,
 
 			VersionedResourceTypes: resourceTypes,
 		})
 
 	case planConfig.Task != "":
-		plan = factory.planFactory.NewPlan(atc.TaskPlan{
-			Name:              planConfig.Task,
-			Privileged:        planConfig.Privileged,
-			Config:            planConfig.TaskConfig,
-			ConfigPath:        planConfig.File,
-			Vars:              planConfig.Vars,
-			Tags:              planConfig.Tags,
-			Params:            planConfig.Params,
-			InputMapping:      planConfig.InputMapping,
-			OutputMapping:     planConfig.OutputMapping,
-			ImageArtifactName: planConfig.ImageArtifactName,
-
-			VersionedResourceTypes: resourceTypes,
-		})
+		plan = factory.planFactory.NewPlan(planConfig.TaskPlan(resourceTypes))
 
 	case planConfig.SetPipeline != "":
 		name := planConfig.SetPipeline
 		plan = factory.planFactory.NewPlan(atc.SetPipelinePlan{
-			Name:     name,
-			File:     planConfig.File,
-			Vars:     planConfig.Vars,
-			VarFiles: planConfig.VarFiles,
+			Name:         name,
+			File:         planConfig.File,
+			Vars:         planConfig.Vars,
+			VarFiles:     planConfig.VarFiles,
+			InstanceVars: planConfig.InstanceVars,
 		})
 
 	case planConfig.LoadVar != "":
@@ -0,0 +1,195 @@
+package factory_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/scheduler/factory"
+	"github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Factory Across Step", func() {
+	var (
+		resourceTypes atc.VersionedResourceTypes
+
+		buildFactory        factory.BuildFactory
+		actualPlanFactory   atc.PlanFactory
+		expectedPlanFactory atc.PlanFactory
+	)
+
+	BeforeEach(func() {
+		actualPlanFactory = atc.NewPlanFactory(123)
+		expectedPlanFactory = atc.NewPlanFactory(123)
+		buildFactory = factory.NewBuildFactory(actualPlanFactory)
+
+		resourceTypes = atc.VersionedResourceTypes{
+			{
+				ResourceType: atc.ResourceType{
+					Name:   "some-custom-resource",
+					Type:   "registry-image",
+					Source: atc.Source{"some": "custom-source"},
+				},
+				Version: atc.Version{"some": "version"},
+			},
+		}
+	})
+
+	Context("when a task is annotated with 'across' static values", func() {
+		It("builds a sub-step for every combination", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "go_version",
+								Values: atc.AcrossValuesConfig{Static: []interface{}{"1.13", "1.14"}},
+							},
+							{
+								Var:         "os",
+								Values:      atc.AcrossValuesConfig{Static: []interface{}{"linux", "windows"}},
+								MaxInFlight: &atc.MaxInFlightConfig{All: true},
+							},
+						},
+						FailFast: true,
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			var steps []atc.VarScopedPlan
+			for _, values := range [][]interface{}{
+				{"1.13", "linux"},
+				{"1.13", "windows"},
+				{"1.14", "linux"},
+				{"1.14", "windows"},
+			} {
+				steps = append(steps, atc.VarScopedPlan{
+					Step: expectedPlanFactory.NewPlan(atc.TaskPlan{
+						Name:                   "some-task",
+						VersionedResourceTypes: resourceTypes,
+					}),
+					Values: values,
+				})
+			}
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:         "go_version",
+						Values:      []interface{}{"1.13", "1.14"},
+						MaxInFlight: 1,
+					},
+					{
+						Var:         "os",
+						Values:      []interface{}{"linux", "windows"},
+						MaxInFlight: 0,
+					},
+				},
+				Steps:    steps,
+				FailFast: true,
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when a task is annotated with 'across' and 'attempts' and 'on_failure'", func() {
+		It("retries each sub-step and applies the hook to the whole step", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:    "v",
+								Values: atc.AcrossValuesConfig{Static: []interface{}{"a"}},
+							},
+						},
+						Attempts: 2,
+						Failure: &atc.PlanConfig{
+							Task: "alert",
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			retry := expectedPlanFactory.NewPlan(atc.RetryPlan{
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					VersionedResourceTypes: resourceTypes,
+				}),
+				expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "some-task",
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+
+			across := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:         "v",
+						Values:      []interface{}{"a"},
+						MaxInFlight: 1,
+					},
+				},
+				Steps: []atc.VarScopedPlan{
+					{
+						Step:   retry,
+						Values: []interface{}{"a"},
+					},
+				},
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.OnFailurePlan{
+				Step: across,
+				Next: expectedPlanFactory.NewPlan(atc.TaskPlan{
+					Name:                   "alert",
+					VersionedResourceTypes: resourceTypes,
+				}),
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
+	Context("when a task is annotated with 'across' values from a load_var step", func() {
+		It("builds a sub-step template", func() {
+			actual, err := buildFactory.Create(atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Task: "some-task",
+						Across: []atc.AcrossVarConfig{
+							{
+								Var:         "version",
+								Values:      atc.AcrossValuesConfig{LoadVar: "versions"},
+								MaxInFlight: &atc.MaxInFlightConfig{Limit: 3},
+							},
+						},
+					},
+				},
+			}, nil, resourceTypes, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			template := expectedPlanFactory.NewPlan(atc.TaskPlan{
+				Name:                   "some-task",
+				VersionedResourceTypes: resourceTypes,
+			})
+
+			expected := expectedPlanFactory.NewPlan(atc.AcrossPlan{
+				Vars: []atc.AcrossVar{
+					{
+						Var:         "version",
+						LoadVar:     "versions",
+						MaxInFlight: 3,
+					},
+				},
+				SubStepTemplate: &template,
+			})
+
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+})
@@ -82,6 +82,46 @@ var _ = Describe("Factory Get", func() {
 		})
 	})
 
+	Context("with a get of a resource that is prefetched", func() {
+		BeforeEach(func() {
+			resources[0].Prefetch = true
+
+			input = atc.JobConfig{
+				Plan: atc.PlanSequence{
+					{
+						Get:      "some-get",
+						Resource: "some-resource",
+					},
+				},
+			}
+		})
+
+		It("prefetches the fetched version", func() {
+			buildInputs := []db.BuildInput{
+				{
+					Name:    "some-get",
+					Version: atc.Version{"ref": "v1"},
+				},
+			}
+
+			actual, err := buildFactory.Create(input, resources, resourceTypes, buildInputs)
+			Expect(err).NotTo(HaveOccurred())
+
+			expected := expectedPlanFactory.NewPlan(atc.GetPlan{
+				Type:     "git",
+				Name:     "some-get",
+				Resource: "some-resource",
+				Source: atc.Source{
+					"uri": "git://some-resource",
+				},
+				Version:                &atc.Version{"ref": "v1"},
+				Prefetch:               true,
+				VersionedResourceTypes: resourceTypes,
+			})
+			Expect(actual).To(testhelpers.MatchPlan(expected))
+		})
+	})
+
 	Context("with a get for a non-existent resource", func() {
 		BeforeEach(func() {
 			input = atc.JobConfig{
@@ -260,7 +260,7 @@ func (example Example) Run() {
 	fakeAlgorithm := new(schedulerfakes.FakeAlgorithm)
 	fakeAlgorithm.ComputeReturns(nil, true, false, nil)
 
-	buildStarter := scheduler.NewBuildStarter(fakeFactory, fakeAlgorithm)
+	buildStarter := scheduler.NewBuildStarter(fakeFactory, fakeAlgorithm, new(dbfakes.FakeBuildQueue), new(dbfakes.FakeQuotaRepository))
 
 	fakeJob := new(dbfakes.FakeJob)
 	fakeJob.ConfigReturns(atc.JobConfig{}, nil)
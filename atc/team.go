@@ -2,6 +2,8 @@ package atc
 
 import (
 	"errors"
+	"fmt"
+	"path"
 )
 
 var (
@@ -9,14 +11,83 @@ var (
 	ErrAuthConfigInvalid = errors.New("auth config for the team does not have users and groups configured")
 )
 
+// The built-in roles, from most to least privileged. A custom role may list
+// one of them as an action to grant everything the built-in role can do.
+const (
+	OwnerRole    = "owner"
+	MemberRole   = "member"
+	OperatorRole = "pipeline-operator"
+	ViewerRole   = "viewer"
+)
+
+var BuiltinRoles = []string{OwnerRole, MemberRole, OperatorRole, ViewerRole}
+
+func IsBuiltinRole(name string) bool {
+	for _, role := range BuiltinRoles {
+		if role == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RolesCover returns whether the given roles cover a role, either by
+// including it or by including a built-in role ranked above it. Owners cover
+// any of the team's roles.
+func RolesCover(held []string, role string) bool {
+	rank := func(role string) int {
+		for i, builtin := range BuiltinRoles {
+			if builtin == role {
+				return i
+			}
+		}
+
+		return -1
+	}
+
+	for _, candidate := range held {
+		if candidate == role || candidate == OwnerRole {
+			return true
+		}
+
+		if rank(candidate) >= 0 && rank(role) >= 0 && rank(candidate) <= rank(role) {
+			return true
+		}
+	}
+
+	return false
+}
+
 type Team struct {
-	ID   int      `json:"id,omitempty"`
-	Name string   `json:"name,omitempty"`
-	Auth TeamAuth `json:"auth,omitempty"`
+	ID    int       `json:"id,omitempty"`
+	Name  string    `json:"name,omitempty"`
+	Auth  TeamAuth  `json:"auth,omitempty"`
+	Roles TeamRoles `json:"roles,omitempty"`
 }
 
 func (team Team) Validate() error {
-	return team.Auth.Validate()
+	err := team.Auth.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = team.Roles.Validate()
+	if err != nil {
+		return err
+	}
+
+	for role := range team.Auth {
+		if IsBuiltinRole(role) {
+			continue
+		}
+
+		if _, found := team.Roles.Lookup(role); !found {
+			return fmt.Errorf("auth config refers to undefined role '%s'", role)
+		}
+	}
+
+	return nil
 }
 
 type TeamAuth map[string]map[string][]string
@@ -37,3 +108,88 @@ func (auth TeamAuth) Validate() error {
 
 	return nil
 }
+
+// TeamRole is a custom role defined by a team. It permits the listed actions,
+// which are either API route names or built-in roles, on the pipelines whose
+// names match one of its patterns, or on the whole team if it has none.
+type TeamRole struct {
+	Name      string   `json:"name"`
+	Actions   []string `json:"actions"`
+	Pipelines []string `json:"pipelines,omitempty"`
+}
+
+// AppliesTo returns whether the role's permissions apply to an action on the
+// given pipeline. Actions which are not on a pipeline are only covered by
+// roles which are not limited to some pipelines.
+func (role TeamRole) AppliesTo(pipelineName string) bool {
+	if len(role.Pipelines) == 0 {
+		return true
+	}
+
+	if pipelineName == "" {
+		return false
+	}
+
+	for _, pattern := range role.Pipelines {
+		if matched, _ := path.Match(pattern, pipelineName); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+type TeamRoles []TeamRole
+
+func (roles TeamRoles) Lookup(name string) (TeamRole, bool) {
+	for _, role := range roles {
+		if role.Name == name {
+			return role, true
+		}
+	}
+
+	return TeamRole{}, false
+}
+
+func (roles TeamRoles) Validate() error {
+	actions := map[string]bool{}
+	for _, route := range Routes {
+		actions[route.Name] = true
+	}
+
+	names := map[string]bool{}
+
+	for _, role := range roles {
+		if role.Name == "" {
+			return errors.New("role must have a name")
+		}
+
+		if IsBuiltinRole(role.Name) {
+			return fmt.Errorf("role '%s' conflicts with a built-in role", role.Name)
+		}
+
+		if names[role.Name] {
+			return fmt.Errorf("role '%s' is defined more than once", role.Name)
+		}
+
+		names[role.Name] = true
+
+		if len(role.Actions) == 0 {
+			return fmt.Errorf("role '%s' has no actions", role.Name)
+		}
+
+		for _, action := range role.Actions {
+			if !actions[action] && !IsBuiltinRole(action) {
+				return fmt.Errorf("role '%s' has unknown action '%s'", role.Name, action)
+			}
+		}
+
+		for _, pattern := range role.Pipelines {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return fmt.Errorf("role '%s' has invalid pipeline pattern '%s'", role.Name, pattern)
+			}
+		}
+	}
+
+	return nil
+}
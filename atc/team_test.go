@@ -0,0 +1,119 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Team", func() {
+	Describe("Validate", func() {
+		var team atc.Team
+
+		BeforeEach(func() {
+			team = atc.Team{
+				Auth: atc.TeamAuth{
+					"owner":    {"users": {"local:some-owner"}},
+					"releaser": {"groups": {"github:some-org:releasers"}},
+				},
+				Roles: atc.TeamRoles{
+					{
+						Name:      "releaser",
+						Actions:   []string{atc.CreateJobBuild, atc.PinResourceVersion, atc.ViewerRole},
+						Pipelines: []string{"release-*"},
+					},
+				},
+			}
+		})
+
+		It("accepts custom roles", func() {
+			Expect(team.Validate()).To(Succeed())
+		})
+
+		Context("when auth is empty", func() {
+			BeforeEach(func() {
+				team.Auth = nil
+			})
+
+			It("returns an error", func() {
+				Expect(team.Validate()).To(Equal(atc.ErrAuthConfigEmpty))
+			})
+		})
+
+		Context("when auth refers to a role that is not defined", func() {
+			BeforeEach(func() {
+				team.Roles = nil
+			})
+
+			It("returns an error", func() {
+				Expect(team.Validate()).To(MatchError("auth config refers to undefined role 'releaser'"))
+			})
+		})
+
+		DescribeTable("invalid roles",
+			func(role atc.TeamRole, message string) {
+				team.Roles = atc.TeamRoles{role}
+				Expect(team.Validate()).To(MatchError(message))
+			},
+
+			Entry("without a name",
+				atc.TeamRole{Actions: []string{atc.CreateJobBuild}},
+				"role must have a name"),
+			Entry("named after a built-in role",
+				atc.TeamRole{Name: "member", Actions: []string{atc.CreateJobBuild}},
+				"role 'member' conflicts with a built-in role"),
+			Entry("without actions",
+				atc.TeamRole{Name: "releaser"},
+				"role 'releaser' has no actions"),
+			Entry("with an unknown action",
+				atc.TeamRole{Name: "releaser", Actions: []string{"ShipIt"}},
+				"role 'releaser' has unknown action 'ShipIt'"),
+			Entry("with a malformed pipeline pattern",
+				atc.TeamRole{Name: "releaser", Actions: []string{atc.CreateJobBuild}, Pipelines: []string{"release-["}},
+				"role 'releaser' has invalid pipeline pattern 'release-['"),
+		)
+
+		Context("when a role is defined more than once", func() {
+			BeforeEach(func() {
+				team.Roles = append(team.Roles, atc.TeamRole{
+					Name:    "releaser",
+					Actions: []string{atc.SaveConfig},
+				})
+			})
+
+			It("returns an error", func() {
+				Expect(team.Validate()).To(MatchError("role 'releaser' is defined more than once"))
+			})
+		})
+	})
+})
+
+var _ = Describe("TeamRole", func() {
+	DescribeTable("AppliesTo",
+		func(pipelines []string, pipelineName string, expected bool) {
+			role := atc.TeamRole{Name: "some-role", Pipelines: pipelines}
+			Expect(role.AppliesTo(pipelineName)).To(Equal(expected))
+		},
+
+		Entry("unscoped role on a pipeline", nil, "some-pipeline", true),
+		Entry("unscoped role on the team", nil, "", true),
+		Entry("pipeline named by the role", []string{"some-pipeline"}, "some-pipeline", true),
+		Entry("pipeline matching a glob", []string{"other", "release-*"}, "release-6.0", true),
+		Entry("pipeline matching nothing", []string{"release-*"}, "some-pipeline", false),
+		Entry("scoped role on the team", []string{"*"}, "", false),
+	)
+})
+
+var _ = DescribeTable("RolesCover",
+	func(held []string, role string, covered bool) {
+		Expect(atc.RolesCover(held, role)).To(Equal(covered))
+	},
+	Entry("holding the role", []string{"viewer"}, "viewer", true),
+	Entry("holding a built-in role above it", []string{"member"}, "viewer", true),
+	Entry("holding a built-in role below it", []string{"viewer"}, "member", false),
+	Entry("holding owner, for a custom role", []string{"owner"}, "releaser", true),
+	Entry("holding a custom role, for a built-in role", []string{"releaser"}, "viewer", false),
+	Entry("holding nothing", nil, "viewer", false),
+)
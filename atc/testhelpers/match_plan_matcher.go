@@ -140,6 +140,20 @@ func stripIDs(plan atc.Plan) (atc.Plan, []string) {
 		ids = append(ids, subIDs...)
 	}
 
+	if plan.Across != nil {
+		for i, p := range plan.Across.Steps {
+			plan.Across.Steps[i].Step, subIDs = stripIDs(p.Step)
+			ids = append(ids, subIDs...)
+		}
+
+		if plan.Across.SubStepTemplate != nil {
+			var template atc.Plan
+			template, subIDs = stripIDs(*plan.Across.SubStepTemplate)
+			plan.Across.SubStepTemplate = &template
+			ids = append(ids, subIDs...)
+		}
+	}
+
 	if plan.Get != nil {
 		if plan.Get.VersionFrom != nil {
 			planID := atc.PlanID("<stripped>")
@@ -20,6 +20,21 @@ type Worker struct {
 	ActiveVolumes    int `json:"active_volumes"`
 	ActiveTasks      int `json:"active_tasks"`
 
+	// AllocatableCPU is in CPU shares, where 1024 shares is one core, and
+	// AllocatableMemory is in bytes. Zero means the worker does not report
+	// it.
+	AllocatableCPU    uint64 `json:"allocatable_cpu,omitempty"`
+	AllocatableMemory uint64 `json:"allocatable_memory,omitempty"`
+
+	// AllocatedCPU and AllocatedMemory are the sums of the limits of the
+	// active tasks on the worker.
+	AllocatedCPU    uint64 `json:"allocated_cpu,omitempty"`
+	AllocatedMemory uint64 `json:"allocated_memory,omitempty"`
+
+	// Usage is what the worker was using as of its last heartbeat, if it could
+	// be measured.
+	Usage *WorkerUsage `json:"usage,omitempty"`
+
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
 	Platform  string   `json:"platform"`
@@ -30,6 +45,81 @@ type Worker struct {
 	StartTime int64    `json:"start_time"`
 	Ephemeral bool     `json:"ephemeral"`
 	State     string   `json:"state"`
+
+	// Cordon is set when the worker is, or is scheduled to be, cordoned.
+	Cordon *WorkerCordon `json:"cordon,omitempty"`
+}
+
+// WorkerCordon is a window of time during which a worker is not given any new
+// containers, while the builds already running on it carry on. The times are
+// unix timestamps. A StartTime of zero starts the cordon right away, and an
+// EndTime of zero keeps it until the worker is uncordoned.
+type WorkerCordon struct {
+	StartTime int64 `json:"start_time,omitempty"`
+	EndTime   int64 `json:"end_time,omitempty"`
+}
+
+func (cordon WorkerCordon) Validate() error {
+	if cordon.StartTime < 0 || cordon.EndTime < 0 {
+		return errors.New("cordon times must not be negative")
+	}
+
+	if cordon.EndTime != 0 && cordon.EndTime <= cordon.StartTime {
+		return errors.New("cordon must end after it starts")
+	}
+
+	return nil
+}
+
+// ActiveAt returns whether the cordon applies at the given unix time.
+func (cordon WorkerCordon) ActiveAt(t int64) bool {
+	return t >= cordon.StartTime && (cordon.EndTime == 0 || t < cordon.EndTime)
+}
+
+// WorkerTagCordon cordons every worker that has all of its tags, including
+// workers that register after it was set.
+type WorkerTagCordon struct {
+	Tags []string `json:"tags"`
+
+	WorkerCordon
+}
+
+func (cordon WorkerTagCordon) Validate() error {
+	if len(cordon.Tags) == 0 {
+		return errors.New("at least one tag is required")
+	}
+
+	return cordon.WorkerCordon.Validate()
+}
+
+// WorkerUsage is the resource usage of a worker. Workers measure it on their
+// host, with the disk being the filesystem holding their volumes. For workers
+// that don't, it is the sum of what their containers use according to their
+// container runtime.
+type WorkerUsage struct {
+	// CPU is the number of cores used on average since the previous
+	// measurement, in thousandths of a core. CPUCapacity is the number of
+	// cores the host has in the same unit, or zero if it isn't known.
+	CPU         uint64 `json:"cpu"`
+	CPUCapacity uint64 `json:"cpu_capacity,omitempty"`
+
+	// Memory and Disk are the bytes used. MemoryCapacity and DiskCapacity are
+	// the bytes available in total, or zero if they aren't known.
+	Memory         uint64 `json:"memory"`
+	MemoryCapacity uint64 `json:"memory_capacity,omitempty"`
+	Disk           uint64 `json:"disk"`
+	DiskCapacity   uint64 `json:"disk_capacity,omitempty"`
+
+	Containers []ContainerUsage `json:"containers,omitempty"`
+}
+
+// ContainerUsage is the resource usage of a single container, in the same
+// units as WorkerUsage.
+type ContainerUsage struct {
+	Handle string `json:"handle"`
+	CPU    uint64 `json:"cpu"`
+	Memory uint64 `json:"memory"`
+	Disk   uint64 `json:"disk"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
@@ -82,7 +82,8 @@ var _ = Describe("RunTaskStep", func() {
 				fakeProvider,
 				fakeCompression,
 				workerInterval,
-				workerStatusInterval)
+				workerStatusInterval,
+				0)
 		})
 
 		Context("worker is available", func() {
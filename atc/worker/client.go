@@ -7,6 +7,7 @@ import (
 	"io"
 	"path"
 	"strconv"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/garden"
@@ -93,19 +94,37 @@ type Client interface {
 		db.UsedResourceCache,
 		resource.Resource,
 	) (GetResult, error)
+
+	// PrefetchResourceCache streams a fetched resource cache volume to every
+	// other running worker that satisfies the spec and does not have the
+	// cache yet. It returns immediately; the volumes are streamed in the
+	// background.
+	PrefetchResourceCache(
+		lager.Logger,
+		WorkerSpec,
+		db.UsedResourceCache,
+		runtime.GetArtifact,
+	)
 }
 
 func NewClient(pool Pool,
 	provider WorkerProvider,
 	compression compression.Compression,
 	workerPollingInterval time.Duration,
-	WorkerStatusPublishInterval time.Duration) *client {
+	WorkerStatusPublishInterval time.Duration,
+	prefetchLimit int) *client {
+	var prefetchSlots chan struct{}
+	if prefetchLimit > 0 {
+		prefetchSlots = make(chan struct{}, prefetchLimit)
+	}
+
 	return &client{
 		pool:                        pool,
 		provider:                    provider,
 		compression:                 compression,
 		workerPollingInterval:       workerPollingInterval,
 		workerStatusPublishInterval: WorkerStatusPublishInterval,
+		prefetchSlots:               prefetchSlots,
 	}
 }
 
@@ -115,6 +134,15 @@ type client struct {
 	compression                 compression.Compression
 	workerPollingInterval       time.Duration
 	workerStatusPublishInterval time.Duration
+
+	// prefetchSlots limits how many resource cache volumes are streamed to
+	// other workers at once. It is nil when prefetching is disabled.
+	prefetchSlots chan struct{}
+
+	// prefetching holds the IDs of the resource caches currently being
+	// prefetched, so that concurrent gets of the same version only stream it
+	// once.
+	prefetching sync.Map
 }
 
 type TaskResult struct {
@@ -271,7 +299,7 @@ func (client *client) RunTaskStep(
 		}
 	}
 
-	chosenWorker, err := client.chooseTaskWorker(
+	chosenWorker, increased, err := client.chooseTaskWorker(
 		ctx,
 		logger,
 		strategy,
@@ -285,18 +313,19 @@ func (client *client) RunTaskStep(
 		return TaskResult{}, err
 	}
 
-	if strategy.ModifiesActiveTasks() {
-		defer decreaseActiveTasks(logger.Session("decrease-active-tasks"), chosenWorker)
+	if increased {
+		defer decreaseActiveTasks(logger.Session("decrease-active-tasks"), chosenWorker, containerSpec.Limits)
 	}
 
-	container, err := chosenWorker.FindOrCreateContainer(
+	container, err := client.findOrCreateTaskContainer(
 		ctx,
 		logger,
-		imageFetcherSpec.Delegate,
+		chosenWorker,
+		imageFetcherSpec,
 		owner,
 		metadata,
 		containerSpec,
-		imageFetcherSpec.ResourceTypes,
+		processSpec.StdoutWriter,
 	)
 
 	if err != nil {
@@ -554,6 +583,154 @@ func (client *client) StreamFileFromArtifact(
 	return source.StreamFile(ctx, logger, filePath)
 }
 
+func (client *client) PrefetchResourceCache(
+	logger lager.Logger,
+	workerSpec WorkerSpec,
+	resourceCache db.UsedResourceCache,
+	artifact runtime.GetArtifact,
+) {
+	if client.prefetchSlots == nil {
+		return
+	}
+
+	_, inProgress := client.prefetching.LoadOrStore(resourceCache.ID(), true)
+	if inProgress {
+		return
+	}
+
+	logger = logger.Session("prefetch-resource-cache", lager.Data{
+		"resource-cache": resourceCache.ID(),
+	})
+
+	go func() {
+		defer client.prefetching.Delete(resourceCache.ID())
+
+		client.prefetchResourceCache(logger, workerSpec, resourceCache, artifact)
+	}()
+}
+
+func (client *client) prefetchResourceCache(
+	logger lager.Logger,
+	workerSpec WorkerSpec,
+	resourceCache db.UsedResourceCache,
+	artifact runtime.GetArtifact,
+) {
+	sourceVolume, found, err := client.FindVolume(logger, workerSpec.TeamID, artifact.ID())
+	if err != nil {
+		logger.Error("failed-to-find-source-volume", err)
+		return
+	}
+
+	if !found {
+		logger.Info("source-volume-not-found")
+		return
+	}
+
+	workers, err := client.provider.RunningWorkers(logger)
+	if err != nil {
+		logger.Error("failed-to-get-running-workers", err)
+		return
+	}
+
+	wg := new(sync.WaitGroup)
+	for _, worker := range workers {
+		if worker.Name() == sourceVolume.WorkerName() || !worker.Satisfies(logger, workerSpec) {
+			continue
+		}
+
+		wg.Add(1)
+		go func(worker Worker) {
+			defer wg.Done()
+
+			client.prefetchSlots <- struct{}{}
+			defer func() { <-client.prefetchSlots }()
+
+			workerLogger := logger.WithData(lager.Data{"worker": worker.Name()})
+
+			err := client.prefetchToWorker(workerLogger, worker, workerSpec.TeamID, resourceCache, artifact, sourceVolume)
+			if err != nil {
+				workerLogger.Error("failed-to-prefetch", err)
+			}
+		}(worker)
+	}
+
+	wg.Wait()
+}
+
+func (client *client) prefetchToWorker(
+	logger lager.Logger,
+	worker Worker,
+	teamID int,
+	resourceCache db.UsedResourceCache,
+	artifact runtime.GetArtifact,
+	sourceVolume Volume,
+) error {
+	_, found, err := worker.FindVolumeForResourceCache(logger, resourceCache)
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return nil
+	}
+
+	volume, err := worker.CreateVolume(
+		logger,
+		VolumeSpec{
+			Strategy: baggageclaim.EmptyStrategy{},
+		},
+		teamID,
+		db.VolumeTypeResource,
+	)
+	if err != nil {
+		return err
+	}
+
+	destination := &countingDestination{ArtifactDestination: volume}
+
+	source := NewStreamableArtifactSource(artifact, sourceVolume, client.compression)
+	err = source.StreamTo(context.Background(), logger, destination)
+	metric.ResourceCacheBytesPrefetched.IncDelta(int(destination.bytes))
+	if err != nil {
+		return err
+	}
+
+	err = volume.InitializeResourceCache(resourceCache)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("prefetched", lager.Data{"bytes": destination.bytes})
+
+	return nil
+}
+
+// countingDestination counts the bytes of the tar stream read by the
+// destination it wraps.
+type countingDestination struct {
+	ArtifactDestination
+
+	bytes int64
+}
+
+func (destination *countingDestination) StreamIn(ctx context.Context, path string, encoding baggageclaim.Encoding, tarStream io.Reader) error {
+	return destination.ArtifactDestination.StreamIn(ctx, path, encoding, &countingReader{
+		reader: tarStream,
+		bytes:  &destination.bytes,
+	})
+}
+
+type countingReader struct {
+	reader io.Reader
+	bytes  *int64
+}
+
+func (reader *countingReader) Read(p []byte) (int, error) {
+	n, err := reader.reader.Read(p)
+	*reader.bytes += int64(n)
+	return n, err
+}
+
 func (client *client) chooseTaskWorker(
 	ctx context.Context,
 	logger lager.Logger,
@@ -563,11 +740,12 @@ func (client *client) chooseTaskWorker(
 	containerSpec ContainerSpec,
 	workerSpec WorkerSpec,
 	outputWriter io.Writer,
-) (Worker, error) {
+) (Worker, bool, error) {
 	var (
 		chosenWorker    Worker
 		activeTasksLock lock.Lock
 		lockAcquired    bool
+		increased       bool
 		elapsed         time.Duration
 		err             error
 	)
@@ -587,15 +765,15 @@ func (client *client) chooseTaskWorker(
 			workerSpec,
 			strategy,
 		); err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		if !strategy.ModifiesActiveTasks() {
-			return chosenWorker, nil
+			return chosenWorker, false, nil
 		}
 
 		if activeTasksLock, lockAcquired, err = lockFactory.Acquire(logger, lock.NewActiveTasksLockID()); err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		if !lockAcquired {
@@ -607,12 +785,12 @@ func (client *client) chooseTaskWorker(
 		case <-ctx.Done():
 			logger.Info("aborted-waiting-worker")
 			e := multierror.Append(err, activeTasksLock.Release(), ctx.Err())
-			return nil, e
+			return nil, false, e
 		default:
 		}
 
 		if chosenWorker != nil {
-			err = increaseActiveTasks(logger,
+			increased, err = increaseActiveTasks(logger,
 				client.pool,
 				chosenWorker,
 				activeTasksLock,
@@ -625,12 +803,12 @@ func (client *client) chooseTaskWorker(
 				writeOutputMessage(logger, outputWriter, message)
 			}
 
-			return chosenWorker, err
+			return chosenWorker, increased, err
 		}
 
 		err := activeTasksLock.Release()
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 
 		// Increase task waiting only once
@@ -647,6 +825,56 @@ func (client *client) chooseTaskWorker(
 	}
 }
 
+// findOrCreateTaskContainer waits for the team and the cluster to be under
+// their task container quotas before creating the container.
+func (client *client) findOrCreateTaskContainer(
+	ctx context.Context,
+	logger lager.Logger,
+	chosenWorker Worker,
+	imageFetcherSpec ImageFetcherSpec,
+	owner db.ContainerOwner,
+	metadata db.ContainerMetadata,
+	containerSpec ContainerSpec,
+	outputWriter io.Writer,
+) (Container, error) {
+	var waiting bool
+
+	quotaPollingTicker := time.NewTicker(client.workerPollingInterval)
+	defer quotaPollingTicker.Stop()
+	quotaStatusPublishTicker := time.NewTicker(client.workerStatusPublishInterval)
+	defer quotaStatusPublishTicker.Stop()
+
+	for {
+		container, err := chosenWorker.FindOrCreateContainer(
+			ctx,
+			logger,
+			imageFetcherSpec.Delegate,
+			owner,
+			metadata,
+			containerSpec,
+			imageFetcherSpec.ResourceTypes,
+		)
+		if err != db.ErrTaskContainerQuotaReached {
+			return container, err
+		}
+
+		message := "The task container quota has been reached, please stand-by.\n"
+		if !waiting {
+			writeOutputMessage(logger, outputWriter, message)
+			waiting = true
+		}
+
+		select {
+		case <-ctx.Done():
+			logger.Info("aborted-waiting-for-task-container-quota")
+			return nil, ctx.Err()
+		case <-quotaPollingTicker.C:
+		case <-quotaStatusPublishTicker.C:
+			writeOutputMessage(logger, outputWriter, message)
+		}
+	}
+}
+
 // TODO (runtime) don't modify spec inside here, Specs don't change after you write them
 func (client *client) wireInputsAndCaches(logger lager.Logger, spec *ContainerSpec) error {
 	var inputs []InputSource
@@ -693,11 +921,18 @@ func (client *client) wireImageVolume(logger lager.Logger, spec *ImageSpec) erro
 	return nil
 }
 
-func decreaseActiveTasks(logger lager.Logger, w Worker) {
+// decreaseActiveTasks releases the active task and the resources reserved
+// by increaseActiveTasks. The resources are released even if the active task
+// could not be, so that neither failure leaks the other.
+func decreaseActiveTasks(logger lager.Logger, w Worker, limits ContainerLimits) {
 	err := w.DecreaseActiveTasks()
 	if err != nil {
 		logger.Error("failed-to-decrease-active-tasks", err)
-		return
+	}
+
+	err = w.DecreaseAllocatedResources(limits)
+	if err != nil {
+		logger.Error("failed-to-release-allocated-resources", err)
 	}
 }
 
@@ -739,23 +974,39 @@ func increaseActiveTasks(
 	activeTasksLock lock.Lock,
 	owner db.ContainerOwner,
 	containerSpec ContainerSpec,
-	workerSpec WorkerSpec) (err error) {
+	workerSpec WorkerSpec) (increased bool, err error) {
 
 	var existingContainer bool
 	defer release(activeTasksLock, err)
 
 	existingContainer, err = pool.ContainerInWorker(logger, owner, workerSpec)
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	// the task was already counted when its container was created
+	if existingContainer {
+		return false, nil
 	}
 
-	if !existingContainer {
-		if err = chosenWorker.IncreaseActiveTasks(); err != nil {
-			logger.Error("failed-to-increase-active-tasks", err)
+	if err = chosenWorker.IncreaseActiveTasks(); err != nil {
+		logger.Error("failed-to-increase-active-tasks", err)
+		return false, err
+	}
+
+	// reserve the task's resources while still holding the lock, so that
+	// they're accounted for when placing the next task
+	if err = chosenWorker.IncreaseAllocatedResources(containerSpec.Limits); err != nil {
+		logger.Error("failed-to-allocate-resources", err)
+
+		if decreaseErr := chosenWorker.DecreaseActiveTasks(); decreaseErr != nil {
+			logger.Error("failed-to-decrease-active-tasks", decreaseErr)
 		}
+
+		return false, err
 	}
 
-	return err
+	return true, nil
 }
 
 func release(activeTasksLock lock.Lock, err error) {
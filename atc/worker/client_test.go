@@ -5,7 +5,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"path"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/garden"
@@ -15,6 +18,7 @@ import (
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource/resourcefakes"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
@@ -49,7 +53,7 @@ var _ = Describe("Client", func() {
 		workerPolling := 1 * time.Second
 		workerStatus := 2 * time.Second
 
-		client = worker.NewClient(fakePool, fakeProvider, fakeCompression, workerPolling, workerStatus)
+		client = worker.NewClient(fakePool, fakeProvider, fakeCompression, workerPolling, workerStatus, 1)
 	})
 
 	Describe("FindContainer", func() {
@@ -649,6 +653,12 @@ var _ = Describe("Client", func() {
 					})
 					It("increase the active tasks on the worker", func() {
 						Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(Equal(1))
+						Expect(fakeWorker.IncreaseAllocatedResourcesCallCount()).To(Equal(1))
+					})
+
+					It("releases the active task and its resources when it finishes", func() {
+						Expect(fakeWorker.DecreaseActiveTasksCallCount()).To(Equal(1))
+						Expect(fakeWorker.DecreaseAllocatedResourcesCallCount()).To(Equal(1))
 					})
 
 					Context("when the container is already present on the worker", func() {
@@ -657,6 +667,39 @@ var _ = Describe("Client", func() {
 						})
 						It("does not increase the active tasks on the worker", func() {
 							Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(Equal(0))
+							Expect(fakeWorker.IncreaseAllocatedResourcesCallCount()).To(Equal(0))
+						})
+
+						It("does not decrease them either", func() {
+							Expect(fakeWorker.DecreaseActiveTasksCallCount()).To(Equal(0))
+							Expect(fakeWorker.DecreaseAllocatedResourcesCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when allocating the resources fails", func() {
+						BeforeEach(func() {
+							fakeWorker.IncreaseAllocatedResourcesReturns(errors.New("nope"))
+						})
+
+						It("returns the error", func() {
+							Expect(err).To(MatchError("nope"))
+						})
+
+						It("undoes the increase of the active tasks", func() {
+							Expect(fakeWorker.IncreaseActiveTasksCallCount()).To(Equal(1))
+							Expect(fakeWorker.DecreaseActiveTasksCallCount()).To(Equal(1))
+							Expect(fakeWorker.DecreaseAllocatedResourcesCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when decreasing the active tasks fails", func() {
+						BeforeEach(func() {
+							fakeWorker.DecreaseActiveTasksStub = nil
+							fakeWorker.DecreaseActiveTasksReturns(errors.New("nope"))
+						})
+
+						It("still releases the resources", func() {
+							Expect(fakeWorker.DecreaseAllocatedResourcesCallCount()).To(Equal(1))
 						})
 					})
 				})
@@ -710,6 +753,34 @@ var _ = Describe("Client", func() {
 			}))
 		})
 
+		Context("when the task container quota has been reached", func() {
+			BeforeEach(func() {
+				fakeWorker.FindOrCreateContainerReturnsOnCall(0, nil, db.ErrTaskContainerQuotaReached)
+				fakeWorker.FindOrCreateContainerReturnsOnCall(1, fakeContainer, nil)
+			})
+
+			It("waits to create the container", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeWorker.FindOrCreateContainerCallCount()).To(Equal(2))
+			})
+
+			It("writes status to output writer", func() {
+				output := fakeTaskProcessSpec.StdoutWriter.(*bytes.Buffer).String()
+				Expect(output).To(ContainSubstring("The task container quota has been reached, please stand-by."))
+			})
+
+			Context("when the context is canceled while waiting", func() {
+				BeforeEach(func() {
+					fakeWorker.FindOrCreateContainerReturnsOnCall(1, nil, db.ErrTaskContainerQuotaReached)
+					cancel()
+				})
+
+				It("returns the context error", func() {
+					Expect(err).To(Equal(context.Canceled))
+				})
+			})
+		})
+
 		Context("found a container that has already exited", func() {
 			BeforeEach(func() {
 				fakeContainer.PropertiesReturns(garden.Properties{"concourse:exit-status": "8"}, nil)
@@ -1484,4 +1555,162 @@ var _ = Describe("Client", func() {
 			})
 		})
 	})
+
+	Describe("PrefetchResourceCache", func() {
+		var (
+			fakeResourceCache *dbfakes.FakeUsedResourceCache
+			fakeSourceWorker  *workerfakes.FakeWorker
+			fakeSourceVolume  *workerfakes.FakeVolume
+			fakeOtherWorker   *workerfakes.FakeWorker
+			fakeOtherVolume   *workerfakes.FakeVolume
+			fakeCachedWorker  *workerfakes.FakeWorker
+			fakeOtherTeam     *workerfakes.FakeWorker
+
+			workerSpec worker.WorkerSpec
+			streamedIn *gbytes.Buffer
+		)
+
+		BeforeEach(func() {
+			fakeResourceCache = new(dbfakes.FakeUsedResourceCache)
+			fakeResourceCache.IDReturns(42)
+
+			workerSpec = worker.WorkerSpec{
+				ResourceType: "some-resource-type",
+				TeamID:       123,
+			}
+
+			fakeSourceVolume = new(workerfakes.FakeVolume)
+			fakeSourceVolume.WorkerNameReturns("source-worker")
+			fakeSourceVolume.StreamOutReturns(ioutil.NopCloser(strings.NewReader("some-tar-stream")), nil)
+
+			fakeSourceWorker = new(workerfakes.FakeWorker)
+			fakeSourceWorker.NameReturns("source-worker")
+			fakeSourceWorker.SatisfiesReturns(true)
+			fakeSourceWorker.LookupVolumeReturns(fakeSourceVolume, true, nil)
+			fakeProvider.FindWorkerForVolumeReturns(fakeSourceWorker, true, nil)
+
+			streamedIn = gbytes.NewBuffer()
+			fakeOtherVolume = new(workerfakes.FakeVolume)
+			fakeOtherVolume.StreamInStub = func(ctx context.Context, path string, encoding baggageclaim.Encoding, tarStream io.Reader) error {
+				_, err := io.Copy(streamedIn, tarStream)
+				return err
+			}
+
+			fakeOtherWorker = new(workerfakes.FakeWorker)
+			fakeOtherWorker.NameReturns("other-worker")
+			fakeOtherWorker.SatisfiesReturns(true)
+			fakeOtherWorker.CreateVolumeReturns(fakeOtherVolume, nil)
+
+			fakeCachedWorker = new(workerfakes.FakeWorker)
+			fakeCachedWorker.NameReturns("cached-worker")
+			fakeCachedWorker.SatisfiesReturns(true)
+			fakeCachedWorker.FindVolumeForResourceCacheReturns(new(workerfakes.FakeVolume), true, nil)
+
+			fakeOtherTeam = new(workerfakes.FakeWorker)
+			fakeOtherTeam.NameReturns("other-team-worker")
+			fakeOtherTeam.SatisfiesReturns(false)
+
+			fakeProvider.RunningWorkersReturns([]worker.Worker{
+				fakeSourceWorker,
+				fakeOtherWorker,
+				fakeCachedWorker,
+				fakeOtherTeam,
+			}, nil)
+
+			metric.ResourceCacheBytesPrefetched.Delta()
+		})
+
+		JustBeforeEach(func() {
+			client.PrefetchResourceCache(logger, workerSpec, fakeResourceCache, runtime.GetArtifact{VolumeHandle: "some-volume-handle"})
+		})
+
+		It("finds the source volume by the artifact handle", func() {
+			Eventually(fakeProvider.FindWorkerForVolumeCallCount).Should(Equal(1))
+			_, teamID, handle := fakeProvider.FindWorkerForVolumeArgsForCall(0)
+			Expect(teamID).To(Equal(123))
+			Expect(handle).To(Equal("some-volume-handle"))
+		})
+
+		It("streams the volume to the satisfying workers without the cache", func() {
+			Eventually(fakeOtherVolume.InitializeResourceCacheCallCount).Should(Equal(1))
+			Expect(fakeOtherVolume.InitializeResourceCacheArgsForCall(0)).To(Equal(fakeResourceCache))
+			Expect(streamedIn.Contents()).To(Equal([]byte("some-tar-stream")))
+
+			_, volumeSpec, teamID, volumeType := fakeOtherWorker.CreateVolumeArgsForCall(0)
+			Expect(volumeSpec).To(Equal(worker.VolumeSpec{Strategy: baggageclaim.EmptyStrategy{}}))
+			Expect(teamID).To(Equal(123))
+			Expect(volumeType).To(Equal(db.VolumeTypeResource))
+
+			_, actualSpec := fakeOtherWorker.SatisfiesArgsForCall(0)
+			Expect(actualSpec).To(Equal(workerSpec))
+		})
+
+		It("does not stream the volume to other workers", func() {
+			Eventually(fakeOtherVolume.InitializeResourceCacheCallCount).Should(Equal(1))
+			Expect(fakeSourceWorker.CreateVolumeCallCount()).To(Equal(0))
+			Expect(fakeCachedWorker.CreateVolumeCallCount()).To(Equal(0))
+			Expect(fakeOtherTeam.CreateVolumeCallCount()).To(Equal(0))
+		})
+
+		It("counts the bytes streamed", func() {
+			Eventually(fakeOtherVolume.InitializeResourceCacheCallCount).Should(Equal(1))
+			Expect(metric.ResourceCacheBytesPrefetched.Delta()).To(Equal(float64(len("some-tar-stream"))))
+		})
+
+		Context("when streaming fails", func() {
+			BeforeEach(func() {
+				fakeOtherVolume.StreamInStub = nil
+				fakeOtherVolume.StreamInReturns(errors.New("nope"))
+			})
+
+			It("does not initialize the resource cache", func() {
+				Eventually(fakeOtherVolume.StreamInCallCount).Should(Equal(1))
+				Consistently(fakeOtherVolume.InitializeResourceCacheCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when the source volume cannot be found", func() {
+			BeforeEach(func() {
+				fakeSourceWorker.LookupVolumeReturns(nil, false, nil)
+			})
+
+			It("does not stream anything", func() {
+				Eventually(fakeSourceWorker.LookupVolumeCallCount).Should(Equal(1))
+				Consistently(fakeOtherWorker.CreateVolumeCallCount).Should(Equal(0))
+			})
+		})
+
+		Context("when the resource cache is already being prefetched", func() {
+			var streaming chan struct{}
+
+			BeforeEach(func() {
+				streaming = make(chan struct{})
+				fakeOtherVolume.StreamInStub = func(context.Context, string, baggageclaim.Encoding, io.Reader) error {
+					<-streaming
+					return nil
+				}
+			})
+
+			AfterEach(func() {
+				close(streaming)
+			})
+
+			It("does not prefetch it again", func() {
+				Eventually(fakeOtherVolume.StreamInCallCount).Should(Equal(1))
+
+				client.PrefetchResourceCache(logger, workerSpec, fakeResourceCache, runtime.GetArtifact{VolumeHandle: "some-volume-handle"})
+				Consistently(fakeProvider.FindWorkerForVolumeCallCount).Should(Equal(1))
+			})
+		})
+
+		Context("when prefetching is disabled", func() {
+			BeforeEach(func() {
+				client = worker.NewClient(fakePool, fakeProvider, fakeCompression, time.Second, time.Second, 0)
+			})
+
+			It("does nothing", func() {
+				Consistently(fakeProvider.FindWorkerForVolumeCallCount).Should(Equal(0))
+			})
+		})
+	})
 })
@@ -86,6 +86,10 @@ func (provider *dbWorkerProvider) RunningWorkers(logger lager.Logger) ([]Worker,
 			continue
 		}
 
+		if cordon, cordoned := savedWorker.CordonWindow(); cordoned && cordon.ActiveAt(time.Now()) {
+			continue
+		}
+
 		workerLog := logger.Session("running-worker")
 		worker := provider.NewGardenWorker(
 			workerLog,
@@ -262,6 +262,45 @@ var _ = Describe("DBProvider", func() {
 				})
 			})
 
+			Context("when a worker is cordoned", func() {
+				BeforeEach(func() {
+					fakeWorker1.CordonWindowReturns(db.WorkerCordon{
+						StartTime: time.Now().Add(-time.Hour),
+					}, true)
+				})
+
+				It("does not return the worker", func() {
+					Expect(workers).To(HaveLen(1))
+					Expect(workers[0].Name()).To(Equal("some-other-worker"))
+				})
+			})
+
+			Context("when a worker's cordon has not started yet", func() {
+				BeforeEach(func() {
+					fakeWorker1.CordonWindowReturns(db.WorkerCordon{
+						StartTime: time.Now().Add(time.Hour),
+						EndTime:   time.Now().Add(2 * time.Hour),
+					}, true)
+				})
+
+				It("returns the worker", func() {
+					Expect(workers).To(HaveLen(2))
+				})
+			})
+
+			Context("when a worker's cordon has ended", func() {
+				BeforeEach(func() {
+					fakeWorker1.CordonWindowReturns(db.WorkerCordon{
+						StartTime: time.Now().Add(-2 * time.Hour),
+						EndTime:   time.Now().Add(-time.Hour),
+					}, true)
+				})
+
+				It("returns the worker", func() {
+					Expect(workers).To(HaveLen(2))
+				})
+			})
+
 			Context("when a worker's major version is higher or lower than the atc worker version", func() {
 				BeforeEach(func() {
 					worker1 := new(dbfakes.FakeWorker)
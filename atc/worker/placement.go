@@ -1,6 +1,8 @@
 package worker
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
 	"time"
 
@@ -15,17 +17,116 @@ type ContainerPlacementStrategy interface {
 	ModifiesActiveTasks() bool
 }
 
-type VolumeLocalityPlacementStrategy struct {
-	rand *rand.Rand
+// ContainerPlacementStrategyChainNode narrows down the candidate workers for
+// a container. Nodes are chained together, each one choosing from the workers
+// left over by the previous one.
+type ContainerPlacementStrategyChainNode interface {
+	Choose(lager.Logger, []Worker, ContainerSpec) ([]Worker, error)
+	ModifiesActiveTasks() bool
 }
 
-func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
-	return &VolumeLocalityPlacementStrategy{
-		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+type ContainerPlacementStrategyOptions struct {
+	ContainerPlacementStrategy []string
+	MaxActiveTasksPerWorker    int
+}
+
+// NewContainerPlacementStrategy chains together the strategies named in the
+// options, in order. The limit-active-tasks and limit-resources strategies
+// only filter out workers when they are followed by other strategies; as the
+// last strategy they also pick the least busy of the remaining workers.
+func NewContainerPlacementStrategy(opts ContainerPlacementStrategyOptions) (ContainerPlacementStrategy, error) {
+	if opts.MaxActiveTasksPerWorker < 0 {
+		return nil, errors.New("max-active-tasks-per-worker must be greater or equal than 0")
+	}
+
+	limitActiveTasks := false
+
+	var nodes []ContainerPlacementStrategyChainNode
+	for i, name := range opts.ContainerPlacementStrategy {
+		last := i == len(opts.ContainerPlacementStrategy)-1
+
+		switch name {
+		case "random":
+			// the chain always picks randomly from whatever is left over
+		case "volume-locality":
+			nodes = append(nodes, NewVolumeLocalityPlacementStrategyNode())
+		case "fewest-build-containers":
+			nodes = append(nodes, NewFewestBuildContainersPlacementStrategyNode())
+		case "limit-active-tasks":
+			limitActiveTasks = true
+			nodes = append(nodes, NewLimitActiveTasksPlacementStrategyNode(opts.MaxActiveTasksPerWorker, last))
+		case "limit-resources":
+			nodes = append(nodes, NewLimitResourcesPlacementStrategyNode(last))
+		default:
+			return nil, fmt.Errorf("invalid container placement strategy %s", name)
+		}
+	}
+
+	if opts.MaxActiveTasksPerWorker != 0 && !limitActiveTasks {
+		return nil, errors.New("max-active-tasks-per-worker has only effect with limit-active-tasks strategy")
+	}
+
+	return NewChainPlacementStrategy(nodes...), nil
+}
+
+type ChainPlacementStrategy struct {
+	rand  *rand.Rand
+	nodes []ContainerPlacementStrategyChainNode
+}
+
+// NewChainPlacementStrategy passes the candidate workers through each node in
+// turn and picks a random one of the workers left over at the end. With no
+// nodes, any worker may be picked.
+func NewChainPlacementStrategy(nodes ...ContainerPlacementStrategyChainNode) ContainerPlacementStrategy {
+	return &ChainPlacementStrategy{
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		nodes: nodes,
 	}
 }
 
-func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+func (strategy *ChainPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	candidates := workers
+
+	for _, node := range strategy.nodes {
+		var err error
+		candidates, err = node.Choose(logger, candidates, spec)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(candidates) == 0 {
+			return nil, nil
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	return candidates[strategy.rand.Intn(len(candidates))], nil
+}
+
+func (strategy *ChainPlacementStrategy) ModifiesActiveTasks() bool {
+	for _, node := range strategy.nodes {
+		if node.ModifiesActiveTasks() {
+			return true
+		}
+	}
+
+	return false
+}
+
+type VolumeLocalityPlacementStrategyNode struct{}
+
+func NewVolumeLocalityPlacementStrategyNode() ContainerPlacementStrategyChainNode {
+	return &VolumeLocalityPlacementStrategyNode{}
+}
+
+func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
+	return NewChainPlacementStrategy(NewVolumeLocalityPlacementStrategyNode())
+}
+
+func (strategy *VolumeLocalityPlacementStrategyNode) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByCount := map[int][]Worker{}
 	var highestCount int
 	for _, w := range workers {
@@ -49,26 +150,24 @@ func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, wor
 		}
 	}
 
-	highestLocalityWorkers := workersByCount[highestCount]
-
-	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+	return workersByCount[highestCount], nil
 }
 
-func (strategy *VolumeLocalityPlacementStrategy) ModifiesActiveTasks() bool {
+func (strategy *VolumeLocalityPlacementStrategyNode) ModifiesActiveTasks() bool {
 	return false
 }
 
-type FewestBuildContainersPlacementStrategy struct {
-	rand *rand.Rand
+type FewestBuildContainersPlacementStrategyNode struct{}
+
+func NewFewestBuildContainersPlacementStrategyNode() ContainerPlacementStrategyChainNode {
+	return &FewestBuildContainersPlacementStrategyNode{}
 }
 
 func NewFewestBuildContainersPlacementStrategy() ContainerPlacementStrategy {
-	return &FewestBuildContainersPlacementStrategy{
-		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
-	}
+	return NewChainPlacementStrategy(NewFewestBuildContainersPlacementStrategyNode())
 }
 
-func (strategy *FewestBuildContainersPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+func (strategy *FewestBuildContainersPlacementStrategyNode) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
 	workersByWork := map[int][]Worker{}
 	var minWork int
 
@@ -80,27 +179,34 @@ func (strategy *FewestBuildContainersPlacementStrategy) Choose(logger lager.Logg
 		}
 	}
 
-	leastBusyWorkers := workersByWork[minWork]
-	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
+	return workersByWork[minWork], nil
 }
 
-func (strategy *FewestBuildContainersPlacementStrategy) ModifiesActiveTasks() bool {
+func (strategy *FewestBuildContainersPlacementStrategyNode) ModifiesActiveTasks() bool {
 	return false
 }
 
-type LimitActiveTasksPlacementStrategy struct {
-	rand     *rand.Rand
-	maxTasks int
+type LimitActiveTasksPlacementStrategyNode struct {
+	maxTasks    int
+	leastActive bool
 }
 
-func NewLimitActiveTasksPlacementStrategy(maxTasks int) ContainerPlacementStrategy {
-	return &LimitActiveTasksPlacementStrategy{
-		rand:     rand.New(rand.NewSource(time.Now().UnixNano())),
-		maxTasks: maxTasks,
+// NewLimitActiveTasksPlacementStrategyNode filters out the workers which
+// already run maxTasks active tasks. If leastActive is set, only the workers
+// with the fewest active tasks are kept.
+func NewLimitActiveTasksPlacementStrategyNode(maxTasks int, leastActive bool) ContainerPlacementStrategyChainNode {
+	return &LimitActiveTasksPlacementStrategyNode{
+		maxTasks:    maxTasks,
+		leastActive: leastActive,
 	}
 }
 
-func (strategy *LimitActiveTasksPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+func NewLimitActiveTasksPlacementStrategy(maxTasks int) ContainerPlacementStrategy {
+	return NewChainPlacementStrategy(NewLimitActiveTasksPlacementStrategyNode(maxTasks, true))
+}
+
+func (strategy *LimitActiveTasksPlacementStrategyNode) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	var candidates []Worker
 	workersByWork := map[int][]Worker{}
 	minActiveTasks := -1
 
@@ -117,37 +223,119 @@ func (strategy *LimitActiveTasksPlacementStrategy) Choose(logger lager.Logger, w
 			continue
 		}
 
+		candidates = append(candidates, w)
+
 		workersByWork[activeTasks] = append(workersByWork[activeTasks], w)
 		if minActiveTasks == -1 || activeTasks < minActiveTasks {
 			minActiveTasks = activeTasks
 		}
 	}
 
-	leastBusyWorkers := workersByWork[minActiveTasks]
-	if len(leastBusyWorkers) < 1 {
-		return nil, nil
+	if !strategy.leastActive {
+		return candidates, nil
 	}
-	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
+
+	return workersByWork[minActiveTasks], nil
 }
 
-func (strategy *LimitActiveTasksPlacementStrategy) ModifiesActiveTasks() bool {
+func (strategy *LimitActiveTasksPlacementStrategyNode) ModifiesActiveTasks() bool {
 	return true
 }
 
-type RandomPlacementStrategy struct {
-	rand *rand.Rand
+type LimitResourcesPlacementStrategyNode struct {
+	mostHeadroom bool
 }
 
-func NewRandomPlacementStrategy() ContainerPlacementStrategy {
-	return &RandomPlacementStrategy{
-		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+// NewLimitResourcesPlacementStrategyNode filters out the workers which don't
+// have enough CPU and memory left over for a task container's limits, as
+// reported by the workers and reserved by their other active tasks. Workers
+// that don't report a resource are not limited by it. If mostHeadroom is set,
+// only the workers with the most memory left over are kept.
+func NewLimitResourcesPlacementStrategyNode(mostHeadroom bool) ContainerPlacementStrategyChainNode {
+	return &LimitResourcesPlacementStrategyNode{
+		mostHeadroom: mostHeadroom,
 	}
 }
 
-func (strategy *RandomPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
-	return workers[strategy.rand.Intn(len(workers))], nil
+// NewLimitResourcesPlacementStrategy places task containers on the worker
+// with the most memory left over among those with enough CPU and memory for
+// the container's limits.
+func NewLimitResourcesPlacementStrategy() ContainerPlacementStrategy {
+	return NewChainPlacementStrategy(NewLimitResourcesPlacementStrategyNode(true))
 }
 
-func (strategy *RandomPlacementStrategy) ModifiesActiveTasks() bool {
-	return false
+func (strategy *LimitResourcesPlacementStrategyNode) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	var requestedCPU, requestedMemory uint64
+	if spec.Type == db.ContainerTypeTask {
+		if spec.Limits.CPU != nil {
+			requestedCPU = *spec.Limits.CPU
+		}
+		if spec.Limits.Memory != nil {
+			requestedMemory = *spec.Limits.Memory
+		}
+	}
+
+	var candidates []Worker
+	var mostHeadroomWorkers []Worker
+	var maxHeadroom float64
+
+	for _, w := range workers {
+		allocatedCPU, allocatedMemory, err := w.AllocatedResources()
+		if err != nil {
+			logger.Error("failed-to-get-allocated-resources", err, lager.Data{"worker": w.Name()})
+			continue
+		}
+
+		cpuFits, _ := fits(w.AllocatableCPU(), allocatedCPU, requestedCPU)
+		memoryFits, memoryHeadroom := fits(w.AllocatableMemory(), allocatedMemory, requestedMemory)
+
+		if !cpuFits || !memoryFits {
+			logger.Debug("worker-lacks-resources", lager.Data{
+				"worker":           w.Name(),
+				"allocated-cpu":    allocatedCPU,
+				"allocated-memory": allocatedMemory,
+			})
+			continue
+		}
+
+		candidates = append(candidates, w)
+
+		switch {
+		case len(mostHeadroomWorkers) == 0 || memoryHeadroom > maxHeadroom:
+			mostHeadroomWorkers = []Worker{w}
+			maxHeadroom = memoryHeadroom
+		case memoryHeadroom == maxHeadroom:
+			mostHeadroomWorkers = append(mostHeadroomWorkers, w)
+		}
+	}
+
+	if !strategy.mostHeadroom {
+		return candidates, nil
+	}
+
+	return mostHeadroomWorkers, nil
+}
+
+// fits returns whether the requested amount fits in what is left of the
+// allocatable amount, along with the fraction that would be left over
+// afterwards. If nothing is allocatable, the worker does not report the
+// resource, so anything fits.
+func fits(allocatable uint64, allocated uint64, requested uint64) (bool, float64) {
+	if allocatable == 0 {
+		return true, 1
+	}
+
+	if allocated+requested > allocatable {
+		return false, 0
+	}
+
+	return true, float64(allocatable-allocated-requested) / float64(allocatable)
+}
+
+func (strategy *LimitResourcesPlacementStrategyNode) ModifiesActiveTasks() bool {
+	return true
+}
+
+func NewRandomPlacementStrategy() ContainerPlacementStrategy {
+	return NewChainPlacementStrategy()
 }
@@ -1,6 +1,8 @@
 package worker_test
 
 import (
+	"errors"
+
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
 	"github.com/concourse/concourse/atc/db"
@@ -8,6 +10,7 @@ import (
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -450,3 +453,377 @@ var _ = Describe("LimitActiveTasksPlacementStrategy", func() {
 		})
 	})
 })
+
+var _ = Describe("LimitResourcesPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var compatibleWorker1 *workerfakes.FakeWorker
+		var compatibleWorker2 *workerfakes.FakeWorker
+		var compatibleWorker3 *workerfakes.FakeWorker
+
+		gb := uint64(1024 * 1024 * 1024)
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("limit-resources-placement-test")
+			strategy = NewLimitResourcesPlacementStrategy()
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker1.NameReturns("worker1")
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker2.NameReturns("worker2")
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+			compatibleWorker3.NameReturns("worker3")
+
+			cpu := uint64(1024)
+			memory := 2 * gb
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+
+				Type: "task",
+
+				TeamID: 4567,
+
+				Inputs: []InputSource{},
+
+				Limits: ContainerLimits{CPU: &cpu, Memory: &memory},
+			}
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+
+			for _, w := range []*workerfakes.FakeWorker{compatibleWorker1, compatibleWorker2, compatibleWorker3} {
+				w.AllocatableCPUReturns(4096)
+				w.AllocatableMemoryReturns(8 * gb)
+			}
+		})
+
+		Context("when the workers have different memory headroom", func() {
+			BeforeEach(func() {
+				compatibleWorker1.AllocatedResourcesReturns(1024, 4*gb, nil)
+				compatibleWorker2.AllocatedResourcesReturns(1024, 1*gb, nil)
+				compatibleWorker3.AllocatedResourcesReturns(0, 2*gb, nil)
+			})
+
+			It("picks the one with the most memory left over", func() {
+				Consistently(func() Worker {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					return chosenWorker
+				}).Should(Equal(compatibleWorker2))
+			})
+		})
+
+		Context("when a worker does not have enough CPU left over", func() {
+			BeforeEach(func() {
+				compatibleWorker1.AllocatedResourcesReturns(0, 4*gb, nil)
+				compatibleWorker2.AllocatedResourcesReturns(3584, 0, nil)
+				compatibleWorker3.AllocatedResourcesReturns(0, 4*gb, nil)
+			})
+
+			It("does not pick it", func() {
+				Consistently(func() Worker {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					return chosenWorker
+				}).Should(Or(Equal(compatibleWorker1), Equal(compatibleWorker3)))
+			})
+		})
+
+		Context("when no worker has enough memory left over", func() {
+			BeforeEach(func() {
+				compatibleWorker1.AllocatedResourcesReturns(0, 7*gb, nil)
+				compatibleWorker2.AllocatedResourcesReturns(0, 6*gb+1, nil)
+				compatibleWorker3.AllocatedResourcesReturns(0, 8*gb, nil)
+			})
+
+			It("picks no worker", func() {
+				chosenWorker, chooseErr = strategy.Choose(
+					logger,
+					workers,
+					spec,
+				)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(BeNil())
+			})
+
+			Context("when the container is not of type 'task'", func() {
+				BeforeEach(func() {
+					spec.Type = ""
+				})
+
+				It("picks the worker with the most memory left over", func() {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					Expect(chosenWorker).To(Equal(compatibleWorker2))
+				})
+			})
+		})
+
+		Context("when a worker does not report its resources", func() {
+			BeforeEach(func() {
+				compatibleWorker1.AllocatedResourcesReturns(0, 8*gb, nil)
+				compatibleWorker2.AllocatableCPUReturns(0)
+				compatibleWorker2.AllocatableMemoryReturns(0)
+				compatibleWorker2.AllocatedResourcesReturns(8192, 16*gb, nil)
+				compatibleWorker3.AllocatedResourcesReturns(0, 8*gb, nil)
+			})
+
+			It("is not limited by them", func() {
+				chosenWorker, chooseErr = strategy.Choose(
+					logger,
+					workers,
+					spec,
+				)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker2))
+			})
+		})
+
+		Context("when the allocated resources of a worker cannot be determined", func() {
+			BeforeEach(func() {
+				compatibleWorker1.AllocatedResourcesReturns(0, 0, errors.New("disaster"))
+				compatibleWorker2.AllocatedResourcesReturns(0, 0, errors.New("disaster"))
+				compatibleWorker3.AllocatedResourcesReturns(0, 4*gb, nil)
+			})
+
+			It("skips it", func() {
+				chosenWorker, chooseErr = strategy.Choose(
+					logger,
+					workers,
+					spec,
+				)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker3))
+			})
+		})
+	})
+})
+
+var _ = Describe("NewContainerPlacementStrategy", func() {
+	var opts ContainerPlacementStrategyOptions
+	var buildErr error
+
+	JustBeforeEach(func() {
+		strategy, buildErr = NewContainerPlacementStrategy(opts)
+	})
+
+	Context("with an unknown strategy", func() {
+		BeforeEach(func() {
+			opts = ContainerPlacementStrategyOptions{
+				ContainerPlacementStrategy: []string{"volume-locality", "bogus"},
+			}
+		})
+
+		It("errors", func() {
+			Expect(buildErr).To(MatchError("invalid container placement strategy bogus"))
+		})
+	})
+
+	Context("when max-active-tasks-per-worker is negative", func() {
+		BeforeEach(func() {
+			opts = ContainerPlacementStrategyOptions{
+				ContainerPlacementStrategy: []string{"limit-active-tasks"},
+				MaxActiveTasksPerWorker:    -1,
+			}
+		})
+
+		It("errors", func() {
+			Expect(buildErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when max-active-tasks-per-worker is set without limit-active-tasks", func() {
+		BeforeEach(func() {
+			opts = ContainerPlacementStrategyOptions{
+				ContainerPlacementStrategy: []string{"volume-locality", "fewest-build-containers"},
+				MaxActiveTasksPerWorker:    1,
+			}
+		})
+
+		It("errors", func() {
+			Expect(buildErr).To(HaveOccurred())
+		})
+	})
+
+	Context("when limit-active-tasks is anywhere in the chain", func() {
+		BeforeEach(func() {
+			opts = ContainerPlacementStrategyOptions{
+				ContainerPlacementStrategy: []string{"volume-locality", "limit-active-tasks", "fewest-build-containers"},
+				MaxActiveTasksPerWorker:    1,
+			}
+		})
+
+		It("modifies active tasks", func() {
+			Expect(buildErr).ToNot(HaveOccurred())
+			Expect(strategy.ModifiesActiveTasks()).To(BeTrue())
+		})
+	})
+
+	Context("when no strategy in the chain limits active tasks", func() {
+		BeforeEach(func() {
+			opts = ContainerPlacementStrategyOptions{
+				ContainerPlacementStrategy: []string{"volume-locality", "fewest-build-containers", "random"},
+			}
+		})
+
+		It("does not modify active tasks", func() {
+			Expect(buildErr).ToNot(HaveOccurred())
+			Expect(strategy.ModifiesActiveTasks()).To(BeFalse())
+		})
+	})
+
+	Describe("Choose", func() {
+		var (
+			busyLocalWorker  *workerfakes.FakeWorker
+			localWorker      *workerfakes.FakeWorker
+			someCachesWorker *workerfakes.FakeWorker
+			idleLocalWorker  *workerfakes.FakeWorker
+			noCachesWorker   *workerfakes.FakeWorker
+			workersByName    map[string]Worker
+		)
+
+		newWorker := func(buildContainers int, activeTasks int) *workerfakes.FakeWorker {
+			w := new(workerfakes.FakeWorker)
+			w.BuildContainersReturns(buildContainers)
+			w.ActiveTasksReturns(activeTasks, nil)
+			return w
+		}
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("chained-placement-test")
+
+			// both inputs are cached on the busy-local, local and idle-local
+			// workers, and one of them on the some-caches worker
+			busyLocalWorker = newWorker(5, 2)
+			localWorker = newWorker(3, 1)
+			someCachesWorker = newWorker(1, 0)
+			idleLocalWorker = newWorker(3, 0)
+			noCachesWorker = newWorker(1, 1)
+
+			workersByName = map[string]Worker{
+				"busy-local":  busyLocalWorker,
+				"local":       localWorker,
+				"some-caches": someCachesWorker,
+				"idle-local":  idleLocalWorker,
+				"no-caches":   noCachesWorker,
+			}
+
+			workers = []Worker{busyLocalWorker, localWorker, someCachesWorker, idleLocalWorker, noCachesWorker}
+
+			cachedOn := func(cached ...Worker) InputSource {
+				input := new(workerfakes.FakeInputSource)
+				source := new(workerfakes.FakeArtifactSource)
+				source.ExistsOnStub = func(logger lager.Logger, worker Worker) (Volume, bool, error) {
+					for _, w := range cached {
+						if w == worker {
+							return new(workerfakes.FakeVolume), true, nil
+						}
+					}
+					return nil, false, nil
+				}
+				input.SourceReturns(source)
+				return input
+			}
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+
+				Type: "task",
+
+				TeamID: 4567,
+
+				Inputs: []InputSource{
+					cachedOn(busyLocalWorker, localWorker, someCachesWorker, idleLocalWorker),
+					cachedOn(busyLocalWorker, localWorker, idleLocalWorker),
+				},
+			}
+		})
+
+		DescribeTable("chaining strategies",
+			func(strategies []string, expected ...string) {
+				maxTasks := 0
+				for _, s := range strategies {
+					if s == "limit-active-tasks" {
+						maxTasks = 2
+					}
+				}
+
+				var err error
+				strategy, err = NewContainerPlacementStrategy(ContainerPlacementStrategyOptions{
+					ContainerPlacementStrategy: strategies,
+					MaxActiveTasksPerWorker:    maxTasks,
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				var expectedWorkers []Worker
+				for _, name := range expected {
+					expectedWorkers = append(expectedWorkers, workersByName[name])
+				}
+
+				chosen := map[Worker]bool{}
+				for i := 0; i < 100; i++ {
+					chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					Expect(expectedWorkers).To(ContainElement(chosenWorker))
+					chosen[chosenWorker] = true
+				}
+
+				Expect(chosen).To(HaveLen(len(expectedWorkers)))
+			},
+
+			Entry("random", []string{"random"}, "busy-local", "local", "some-caches", "idle-local", "no-caches"),
+			Entry("volume-locality", []string{"volume-locality"}, "busy-local", "local", "idle-local"),
+			Entry("fewest-build-containers", []string{"fewest-build-containers"}, "some-caches", "no-caches"),
+			Entry("limit-active-tasks", []string{"limit-active-tasks"}, "some-caches", "idle-local"),
+
+			Entry("limit-active-tasks,volume-locality", []string{"limit-active-tasks", "volume-locality"}, "local", "idle-local"),
+			Entry("limit-active-tasks,fewest-build-containers", []string{"limit-active-tasks", "fewest-build-containers"}, "some-caches", "no-caches"),
+			Entry("limit-active-tasks,random", []string{"limit-active-tasks", "random"}, "local", "some-caches", "idle-local", "no-caches"),
+			Entry("volume-locality,fewest-build-containers", []string{"volume-locality", "fewest-build-containers"}, "local", "idle-local"),
+			Entry("volume-locality,random", []string{"volume-locality", "random"}, "busy-local", "local", "idle-local"),
+			Entry("fewest-build-containers,random", []string{"fewest-build-containers", "random"}, "some-caches", "no-caches"),
+
+			Entry("limit-active-tasks,volume-locality,fewest-build-containers", []string{"limit-active-tasks", "volume-locality", "fewest-build-containers"}, "local", "idle-local"),
+			Entry("limit-active-tasks,volume-locality,random", []string{"limit-active-tasks", "volume-locality", "random"}, "local", "idle-local"),
+			Entry("limit-active-tasks,fewest-build-containers,random", []string{"limit-active-tasks", "fewest-build-containers", "random"}, "some-caches", "no-caches"),
+			Entry("volume-locality,fewest-build-containers,random", []string{"volume-locality", "fewest-build-containers", "random"}, "local", "idle-local"),
+
+			Entry("limit-active-tasks,volume-locality,fewest-build-containers,random", []string{"limit-active-tasks", "volume-locality", "fewest-build-containers", "random"}, "local", "idle-local"),
+
+			Entry("fewest-build-containers,volume-locality", []string{"fewest-build-containers", "volume-locality"}, "some-caches"),
+			Entry("volume-locality,limit-active-tasks", []string{"volume-locality", "limit-active-tasks"}, "idle-local"),
+			Entry("fewest-build-containers,limit-active-tasks", []string{"fewest-build-containers", "limit-active-tasks"}, "some-caches"),
+		)
+
+		Context("when a strategy in the chain leaves no workers", func() {
+			BeforeEach(func() {
+				for _, w := range []*workerfakes.FakeWorker{busyLocalWorker, localWorker, someCachesWorker, idleLocalWorker, noCachesWorker} {
+					w.ActiveTasksReturns(2, nil)
+				}
+			})
+
+			It("picks no worker", func() {
+				var err error
+				strategy, err = NewContainerPlacementStrategy(ContainerPlacementStrategyOptions{
+					ContainerPlacementStrategy: []string{"limit-active-tasks", "volume-locality"},
+					MaxActiveTasksPerWorker:    2,
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(BeNil())
+			})
+		})
+	})
+})
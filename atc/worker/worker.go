@@ -81,6 +81,12 @@ type Worker interface {
 	ActiveTasks() (int, error)
 	IncreaseActiveTasks() error
 	DecreaseActiveTasks() error
+
+	AllocatableCPU() uint64
+	AllocatableMemory() uint64
+	AllocatedResources() (cpu uint64, memory uint64, err error)
+	IncreaseAllocatedResources(ContainerLimits) error
+	DecreaseAllocatedResources(ContainerLimits) error
 }
 
 type gardenWorker struct {
@@ -797,3 +803,19 @@ func (worker *gardenWorker) IncreaseActiveTasks() error {
 func (worker *gardenWorker) DecreaseActiveTasks() error {
 	return worker.dbWorker.DecreaseActiveTasks()
 }
+
+func (worker *gardenWorker) AllocatableCPU() uint64 {
+	return worker.dbWorker.AllocatableCPU()
+}
+func (worker *gardenWorker) AllocatableMemory() uint64 {
+	return worker.dbWorker.AllocatableMemory()
+}
+func (worker *gardenWorker) AllocatedResources() (uint64, uint64, error) {
+	return worker.dbWorker.AllocatedResources()
+}
+func (worker *gardenWorker) IncreaseAllocatedResources(limits ContainerLimits) error {
+	return worker.dbWorker.IncreaseAllocatedResources(atc.ContainerLimits(limits))
+}
+func (worker *gardenWorker) DecreaseAllocatedResources(limits ContainerLimits) error {
+	return worker.dbWorker.DecreaseAllocatedResources(atc.ContainerLimits(limits))
+}
@@ -67,6 +67,14 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	PrefetchResourceCacheStub        func(lager.Logger, worker.WorkerSpec, db.UsedResourceCache, runtime.GetArtifact)
+	prefetchResourceCacheMutex       sync.RWMutex
+	prefetchResourceCacheArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+		arg3 db.UsedResourceCache
+		arg4 runtime.GetArtifact
+	}
 	RunCheckStepStub        func(context.Context, lager.Logger, db.ContainerOwner, worker.ContainerSpec, worker.WorkerSpec, worker.ContainerPlacementStrategy, db.ContainerMetadata, atc.VersionedResourceTypes, time.Duration, resource.Resource) (worker.CheckResult, error)
 	runCheckStepMutex       sync.RWMutex
 	runCheckStepArgsForCall []struct {
@@ -381,6 +389,40 @@ func (fake *FakeClient) FindVolumeReturnsOnCall(i int, result1 worker.Volume, re
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) PrefetchResourceCache(arg1 lager.Logger, arg2 worker.WorkerSpec, arg3 db.UsedResourceCache, arg4 runtime.GetArtifact) {
+	fake.prefetchResourceCacheMutex.Lock()
+	fake.prefetchResourceCacheArgsForCall = append(fake.prefetchResourceCacheArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 worker.WorkerSpec
+		arg3 db.UsedResourceCache
+		arg4 runtime.GetArtifact
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("PrefetchResourceCache", []interface{}{arg1, arg2, arg3, arg4})
+	fake.prefetchResourceCacheMutex.Unlock()
+	if fake.PrefetchResourceCacheStub != nil {
+		fake.PrefetchResourceCacheStub(arg1, arg2, arg3, arg4)
+	}
+}
+
+func (fake *FakeClient) PrefetchResourceCacheCallCount() int {
+	fake.prefetchResourceCacheMutex.RLock()
+	defer fake.prefetchResourceCacheMutex.RUnlock()
+	return len(fake.prefetchResourceCacheArgsForCall)
+}
+
+func (fake *FakeClient) PrefetchResourceCacheCalls(stub func(lager.Logger, worker.WorkerSpec, db.UsedResourceCache, runtime.GetArtifact)) {
+	fake.prefetchResourceCacheMutex.Lock()
+	defer fake.prefetchResourceCacheMutex.Unlock()
+	fake.PrefetchResourceCacheStub = stub
+}
+
+func (fake *FakeClient) PrefetchResourceCacheArgsForCall(i int) (lager.Logger, worker.WorkerSpec, db.UsedResourceCache, runtime.GetArtifact) {
+	fake.prefetchResourceCacheMutex.RLock()
+	defer fake.prefetchResourceCacheMutex.RUnlock()
+	argsForCall := fake.prefetchResourceCacheArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
 func (fake *FakeClient) RunCheckStep(arg1 context.Context, arg2 lager.Logger, arg3 db.ContainerOwner, arg4 worker.ContainerSpec, arg5 worker.WorkerSpec, arg6 worker.ContainerPlacementStrategy, arg7 db.ContainerMetadata, arg8 atc.VersionedResourceTypes, arg9 time.Duration, arg10 resource.Resource) (worker.CheckResult, error) {
 	fake.runCheckStepMutex.Lock()
 	ret, specificReturn := fake.runCheckStepReturnsOnCall[len(fake.runCheckStepArgsForCall)]
@@ -748,6 +790,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.findContainerMutex.RUnlock()
 	fake.findVolumeMutex.RLock()
 	defer fake.findVolumeMutex.RUnlock()
+	fake.prefetchResourceCacheMutex.RLock()
+	defer fake.prefetchResourceCacheMutex.RUnlock()
 	fake.runCheckStepMutex.RLock()
 	defer fake.runCheckStepMutex.RUnlock()
 	fake.runGetStepMutex.RLock()
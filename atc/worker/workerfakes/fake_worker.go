@@ -29,6 +29,40 @@ type FakeWorker struct {
 		result1 int
 		result2 error
 	}
+	AllocatableCPUStub        func() uint64
+	allocatableCPUMutex       sync.RWMutex
+	allocatableCPUArgsForCall []struct {
+	}
+	allocatableCPUReturns struct {
+		result1 uint64
+	}
+	allocatableCPUReturnsOnCall map[int]struct {
+		result1 uint64
+	}
+	AllocatableMemoryStub        func() uint64
+	allocatableMemoryMutex       sync.RWMutex
+	allocatableMemoryArgsForCall []struct {
+	}
+	allocatableMemoryReturns struct {
+		result1 uint64
+	}
+	allocatableMemoryReturnsOnCall map[int]struct {
+		result1 uint64
+	}
+	AllocatedResourcesStub        func() (uint64, uint64, error)
+	allocatedResourcesMutex       sync.RWMutex
+	allocatedResourcesArgsForCall []struct {
+	}
+	allocatedResourcesReturns struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
+	allocatedResourcesReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
 	BuildContainersStub        func() int
 	buildContainersMutex       sync.RWMutex
 	buildContainersArgsForCall []struct {
@@ -80,6 +114,17 @@ type FakeWorker struct {
 	decreaseActiveTasksReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DecreaseAllocatedResourcesStub        func(worker.ContainerLimits) error
+	decreaseAllocatedResourcesMutex       sync.RWMutex
+	decreaseAllocatedResourcesArgsForCall []struct {
+		arg1 worker.ContainerLimits
+	}
+	decreaseAllocatedResourcesReturns struct {
+		result1 error
+	}
+	decreaseAllocatedResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DescriptionStub        func() string
 	descriptionMutex       sync.RWMutex
 	descriptionArgsForCall []struct {
@@ -231,6 +276,17 @@ type FakeWorker struct {
 	increaseActiveTasksReturnsOnCall map[int]struct {
 		result1 error
 	}
+	IncreaseAllocatedResourcesStub        func(worker.ContainerLimits) error
+	increaseAllocatedResourcesMutex       sync.RWMutex
+	increaseAllocatedResourcesArgsForCall []struct {
+		arg1 worker.ContainerLimits
+	}
+	increaseAllocatedResourcesReturns struct {
+		result1 error
+	}
+	increaseAllocatedResourcesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	IsOwnedByTeamStub        func() bool
 	isOwnedByTeamMutex       sync.RWMutex
 	isOwnedByTeamArgsForCall []struct {
@@ -380,6 +436,168 @@ func (fake *FakeWorker) ActiveTasksReturnsOnCall(i int, result1 int, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeWorker) AllocatableCPU() uint64 {
+	fake.allocatableCPUMutex.Lock()
+	ret, specificReturn := fake.allocatableCPUReturnsOnCall[len(fake.allocatableCPUArgsForCall)]
+	fake.allocatableCPUArgsForCall = append(fake.allocatableCPUArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AllocatableCPU", []interface{}{})
+	fake.allocatableCPUMutex.Unlock()
+	if fake.AllocatableCPUStub != nil {
+		return fake.AllocatableCPUStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.allocatableCPUReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AllocatableCPUCallCount() int {
+	fake.allocatableCPUMutex.RLock()
+	defer fake.allocatableCPUMutex.RUnlock()
+	return len(fake.allocatableCPUArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatableCPUCalls(stub func() uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = stub
+}
+
+func (fake *FakeWorker) AllocatableCPUReturns(result1 uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = nil
+	fake.allocatableCPUReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableCPUReturnsOnCall(i int, result1 uint64) {
+	fake.allocatableCPUMutex.Lock()
+	defer fake.allocatableCPUMutex.Unlock()
+	fake.AllocatableCPUStub = nil
+	if fake.allocatableCPUReturnsOnCall == nil {
+		fake.allocatableCPUReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.allocatableCPUReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableMemory() uint64 {
+	fake.allocatableMemoryMutex.Lock()
+	ret, specificReturn := fake.allocatableMemoryReturnsOnCall[len(fake.allocatableMemoryArgsForCall)]
+	fake.allocatableMemoryArgsForCall = append(fake.allocatableMemoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AllocatableMemory", []interface{}{})
+	fake.allocatableMemoryMutex.Unlock()
+	if fake.AllocatableMemoryStub != nil {
+		return fake.AllocatableMemoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.allocatableMemoryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) AllocatableMemoryCallCount() int {
+	fake.allocatableMemoryMutex.RLock()
+	defer fake.allocatableMemoryMutex.RUnlock()
+	return len(fake.allocatableMemoryArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatableMemoryCalls(stub func() uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = stub
+}
+
+func (fake *FakeWorker) AllocatableMemoryReturns(result1 uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = nil
+	fake.allocatableMemoryReturns = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatableMemoryReturnsOnCall(i int, result1 uint64) {
+	fake.allocatableMemoryMutex.Lock()
+	defer fake.allocatableMemoryMutex.Unlock()
+	fake.AllocatableMemoryStub = nil
+	if fake.allocatableMemoryReturnsOnCall == nil {
+		fake.allocatableMemoryReturnsOnCall = make(map[int]struct {
+			result1 uint64
+		})
+	}
+	fake.allocatableMemoryReturnsOnCall[i] = struct {
+		result1 uint64
+	}{result1}
+}
+
+func (fake *FakeWorker) AllocatedResources() (uint64, uint64, error) {
+	fake.allocatedResourcesMutex.Lock()
+	ret, specificReturn := fake.allocatedResourcesReturnsOnCall[len(fake.allocatedResourcesArgsForCall)]
+	fake.allocatedResourcesArgsForCall = append(fake.allocatedResourcesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AllocatedResources", []interface{}{})
+	fake.allocatedResourcesMutex.Unlock()
+	if fake.AllocatedResourcesStub != nil {
+		return fake.AllocatedResourcesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.allocatedResourcesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeWorker) AllocatedResourcesCallCount() int {
+	fake.allocatedResourcesMutex.RLock()
+	defer fake.allocatedResourcesMutex.RUnlock()
+	return len(fake.allocatedResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) AllocatedResourcesCalls(stub func() (uint64, uint64, error)) {
+	fake.allocatedResourcesMutex.Lock()
+	defer fake.allocatedResourcesMutex.Unlock()
+	fake.AllocatedResourcesStub = stub
+}
+
+func (fake *FakeWorker) AllocatedResourcesReturns(result1 uint64, result2 uint64, result3 error) {
+	fake.allocatedResourcesMutex.Lock()
+	defer fake.allocatedResourcesMutex.Unlock()
+	fake.AllocatedResourcesStub = nil
+	fake.allocatedResourcesReturns = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeWorker) AllocatedResourcesReturnsOnCall(i int, result1 uint64, result2 uint64, result3 error) {
+	fake.allocatedResourcesMutex.Lock()
+	defer fake.allocatedResourcesMutex.Unlock()
+	fake.AllocatedResourcesStub = nil
+	if fake.allocatedResourcesReturnsOnCall == nil {
+		fake.allocatedResourcesReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.allocatedResourcesReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeWorker) BuildContainers() int {
 	fake.buildContainersMutex.Lock()
 	ret, specificReturn := fake.buildContainersReturnsOnCall[len(fake.buildContainersArgsForCall)]
@@ -616,6 +834,66 @@ func (fake *FakeWorker) DecreaseActiveTasksReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) DecreaseAllocatedResources(arg1 worker.ContainerLimits) error {
+	fake.decreaseAllocatedResourcesMutex.Lock()
+	ret, specificReturn := fake.decreaseAllocatedResourcesReturnsOnCall[len(fake.decreaseAllocatedResourcesArgsForCall)]
+	fake.decreaseAllocatedResourcesArgsForCall = append(fake.decreaseAllocatedResourcesArgsForCall, struct {
+		arg1 worker.ContainerLimits
+	}{arg1})
+	fake.recordInvocation("DecreaseAllocatedResources", []interface{}{arg1})
+	fake.decreaseAllocatedResourcesMutex.Unlock()
+	if fake.DecreaseAllocatedResourcesStub != nil {
+		return fake.DecreaseAllocatedResourcesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.decreaseAllocatedResourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) DecreaseAllocatedResourcesCallCount() int {
+	fake.decreaseAllocatedResourcesMutex.RLock()
+	defer fake.decreaseAllocatedResourcesMutex.RUnlock()
+	return len(fake.decreaseAllocatedResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) DecreaseAllocatedResourcesCalls(stub func(worker.ContainerLimits) error) {
+	fake.decreaseAllocatedResourcesMutex.Lock()
+	defer fake.decreaseAllocatedResourcesMutex.Unlock()
+	fake.DecreaseAllocatedResourcesStub = stub
+}
+
+func (fake *FakeWorker) DecreaseAllocatedResourcesArgsForCall(i int) worker.ContainerLimits {
+	fake.decreaseAllocatedResourcesMutex.RLock()
+	defer fake.decreaseAllocatedResourcesMutex.RUnlock()
+	argsForCall := fake.decreaseAllocatedResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) DecreaseAllocatedResourcesReturns(result1 error) {
+	fake.decreaseAllocatedResourcesMutex.Lock()
+	defer fake.decreaseAllocatedResourcesMutex.Unlock()
+	fake.DecreaseAllocatedResourcesStub = nil
+	fake.decreaseAllocatedResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) DecreaseAllocatedResourcesReturnsOnCall(i int, result1 error) {
+	fake.decreaseAllocatedResourcesMutex.Lock()
+	defer fake.decreaseAllocatedResourcesMutex.Unlock()
+	fake.DecreaseAllocatedResourcesStub = nil
+	if fake.decreaseAllocatedResourcesReturnsOnCall == nil {
+		fake.decreaseAllocatedResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.decreaseAllocatedResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) Description() string {
 	fake.descriptionMutex.Lock()
 	ret, specificReturn := fake.descriptionReturnsOnCall[len(fake.descriptionArgsForCall)]
@@ -1240,6 +1518,66 @@ func (fake *FakeWorker) IncreaseActiveTasksReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeWorker) IncreaseAllocatedResources(arg1 worker.ContainerLimits) error {
+	fake.increaseAllocatedResourcesMutex.Lock()
+	ret, specificReturn := fake.increaseAllocatedResourcesReturnsOnCall[len(fake.increaseAllocatedResourcesArgsForCall)]
+	fake.increaseAllocatedResourcesArgsForCall = append(fake.increaseAllocatedResourcesArgsForCall, struct {
+		arg1 worker.ContainerLimits
+	}{arg1})
+	fake.recordInvocation("IncreaseAllocatedResources", []interface{}{arg1})
+	fake.increaseAllocatedResourcesMutex.Unlock()
+	if fake.IncreaseAllocatedResourcesStub != nil {
+		return fake.IncreaseAllocatedResourcesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.increaseAllocatedResourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) IncreaseAllocatedResourcesCallCount() int {
+	fake.increaseAllocatedResourcesMutex.RLock()
+	defer fake.increaseAllocatedResourcesMutex.RUnlock()
+	return len(fake.increaseAllocatedResourcesArgsForCall)
+}
+
+func (fake *FakeWorker) IncreaseAllocatedResourcesCalls(stub func(worker.ContainerLimits) error) {
+	fake.increaseAllocatedResourcesMutex.Lock()
+	defer fake.increaseAllocatedResourcesMutex.Unlock()
+	fake.IncreaseAllocatedResourcesStub = stub
+}
+
+func (fake *FakeWorker) IncreaseAllocatedResourcesArgsForCall(i int) worker.ContainerLimits {
+	fake.increaseAllocatedResourcesMutex.RLock()
+	defer fake.increaseAllocatedResourcesMutex.RUnlock()
+	argsForCall := fake.increaseAllocatedResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWorker) IncreaseAllocatedResourcesReturns(result1 error) {
+	fake.increaseAllocatedResourcesMutex.Lock()
+	defer fake.increaseAllocatedResourcesMutex.Unlock()
+	fake.IncreaseAllocatedResourcesStub = nil
+	fake.increaseAllocatedResourcesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWorker) IncreaseAllocatedResourcesReturnsOnCall(i int, result1 error) {
+	fake.increaseAllocatedResourcesMutex.Lock()
+	defer fake.increaseAllocatedResourcesMutex.Unlock()
+	fake.IncreaseAllocatedResourcesStub = nil
+	if fake.increaseAllocatedResourcesReturnsOnCall == nil {
+		fake.increaseAllocatedResourcesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.increaseAllocatedResourcesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeWorker) IsOwnedByTeam() bool {
 	fake.isOwnedByTeamMutex.Lock()
 	ret, specificReturn := fake.isOwnedByTeamReturnsOnCall[len(fake.isOwnedByTeamArgsForCall)]
@@ -1694,6 +2032,12 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.activeTasksMutex.RLock()
 	defer fake.activeTasksMutex.RUnlock()
+	fake.allocatableCPUMutex.RLock()
+	defer fake.allocatableCPUMutex.RUnlock()
+	fake.allocatableMemoryMutex.RLock()
+	defer fake.allocatableMemoryMutex.RUnlock()
+	fake.allocatedResourcesMutex.RLock()
+	defer fake.allocatedResourcesMutex.RUnlock()
 	fake.buildContainersMutex.RLock()
 	defer fake.buildContainersMutex.RUnlock()
 	fake.certsVolumeMutex.RLock()
@@ -1702,6 +2046,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.createVolumeMutex.RUnlock()
 	fake.decreaseActiveTasksMutex.RLock()
 	defer fake.decreaseActiveTasksMutex.RUnlock()
+	fake.decreaseAllocatedResourcesMutex.RLock()
+	defer fake.decreaseAllocatedResourcesMutex.RUnlock()
 	fake.descriptionMutex.RLock()
 	defer fake.descriptionMutex.RUnlock()
 	fake.ephemeralMutex.RLock()
@@ -1722,6 +2068,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.gardenClientMutex.RUnlock()
 	fake.increaseActiveTasksMutex.RLock()
 	defer fake.increaseActiveTasksMutex.RUnlock()
+	fake.increaseAllocatedResourcesMutex.RLock()
+	defer fake.increaseAllocatedResourcesMutex.RUnlock()
 	fake.isOwnedByTeamMutex.RLock()
 	defer fake.isOwnedByTeamMutex.RUnlock()
 	fake.isVersionCompatibleMutex.RLock()
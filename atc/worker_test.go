@@ -61,4 +61,66 @@ var _ = Describe("Worker", func() {
 			})
 		})
 	})
+
+	Describe("WorkerCordon", func() {
+		Describe("Validate", func() {
+			It("accepts an empty cordon", func() {
+				Expect(atc.WorkerCordon{}.Validate()).To(Succeed())
+			})
+
+			It("accepts a cordon without an end", func() {
+				Expect(atc.WorkerCordon{StartTime: 100}.Validate()).To(Succeed())
+			})
+
+			It("rejects a cordon that ends before it starts", func() {
+				Expect(atc.WorkerCordon{StartTime: 100, EndTime: 50}.Validate()).To(MatchError("cordon must end after it starts"))
+			})
+
+			It("rejects negative times", func() {
+				Expect(atc.WorkerCordon{EndTime: -1}.Validate()).To(MatchError("cordon times must not be negative"))
+			})
+		})
+
+		Describe("ActiveAt", func() {
+			cordon := atc.WorkerCordon{StartTime: 100, EndTime: 200}
+
+			It("is inactive before the cordon starts", func() {
+				Expect(cordon.ActiveAt(99)).To(BeFalse())
+			})
+
+			It("is active during the cordon", func() {
+				Expect(cordon.ActiveAt(100)).To(BeTrue())
+				Expect(cordon.ActiveAt(199)).To(BeTrue())
+			})
+
+			It("is inactive once the cordon ends", func() {
+				Expect(cordon.ActiveAt(200)).To(BeFalse())
+			})
+
+			It("stays active when the cordon has no end", func() {
+				Expect(atc.WorkerCordon{StartTime: 100}.ActiveAt(1 << 40)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("WorkerTagCordon", func() {
+		Describe("Validate", func() {
+			It("accepts a cordon of tags", func() {
+				Expect(atc.WorkerTagCordon{Tags: []string{"some-tag"}}.Validate()).To(Succeed())
+			})
+
+			It("rejects a cordon without tags", func() {
+				Expect(atc.WorkerTagCordon{}.Validate()).To(MatchError("at least one tag is required"))
+			})
+
+			It("validates the window", func() {
+				cordon := atc.WorkerTagCordon{
+					Tags:         []string{"some-tag"},
+					WorkerCordon: atc.WorkerCordon{StartTime: 100, EndTime: 50},
+				}
+
+				Expect(cordon.Validate()).To(MatchError("cordon must end after it starts"))
+			})
+		})
+	})
 })
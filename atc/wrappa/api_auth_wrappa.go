@@ -43,19 +43,24 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		// pipeline and job are public or authorized
 		case atc.GetBuildPreparation,
+			atc.ListBuildNotifications,
 			atc.BuildEvents,
 			atc.GetBuildPlan,
 			atc.ListBuildArtifacts:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 			// resource belongs to authorized team
-		case atc.AbortBuild:
+		case atc.AbortBuild,
+			atc.RetainBuildContainers,
+			atc.ReleaseBuildContainers:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
 		case atc.PruneWorker,
 			atc.LandWorker,
 			atc.RetireWorker,
+			atc.CordonWorker,
+			atc.UncordonWorker,
 			atc.ListDestroyingVolumes,
 			atc.ListDestroyingContainers,
 			atc.ReportWorkerContainers,
@@ -96,7 +101,9 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RenameTeam,
 			atc.DestroyTeam,
 			atc.ListVolumes,
-			atc.GetUser:
+			atc.GetUser,
+			atc.ListAuditEvents,
+			atc.GetClusterQuota:
 			newHandler = auth.CheckAuthenticationHandler(handler, rejector)
 
 		// unauthenticated / delegating to handler (validate token if provided)
@@ -119,7 +126,11 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
-			atc.ClearWall:
+			atc.ClearWall,
+			atc.SetClusterQuota,
+			atc.SetTeamQuota,
+			atc.CordonTaggedWorkers,
+			atc.UncordonTaggedWorkers:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team)
@@ -135,9 +146,13 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
+			atc.ListConfigVersions,
+			atc.GetConfigVersion,
+			atc.DiffConfigVersions,
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.GetJobTaskPlan,
 			atc.OrderPipelines,
 			atc.PauseJob,
 			atc.PausePipeline,
@@ -151,7 +166,11 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
 			atc.ScheduleJob,
-			atc.GetArtifact:
+			atc.GetArtifact,
+			atc.ListAPITokens,
+			atc.CreateAPIToken,
+			atc.RevokeAPIToken,
+			atc.GetTeamQuota:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
 		// think about it!
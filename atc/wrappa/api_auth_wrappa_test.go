@@ -121,13 +121,16 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.BuildResources: doesNotCheckIfPrivateJob(inputHandlers[atc.BuildResources]),
 
 				// authorized or public pipeline and public job
-				atc.BuildEvents:         checksIfPrivateJob(inputHandlers[atc.BuildEvents]),
-				atc.ListBuildArtifacts:  checksIfPrivateJob(inputHandlers[atc.ListBuildArtifacts]),
-				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
-				atc.GetBuildPlan:        checksIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
+				atc.BuildEvents:            checksIfPrivateJob(inputHandlers[atc.BuildEvents]),
+				atc.ListBuildArtifacts:     checksIfPrivateJob(inputHandlers[atc.ListBuildArtifacts]),
+				atc.GetBuildPreparation:    checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
+				atc.ListBuildNotifications: checksIfPrivateJob(inputHandlers[atc.ListBuildNotifications]),
+				atc.GetBuildPlan:           checksIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
 
 				// resource belongs to authorized team
-				atc.AbortBuild: checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.AbortBuild:             checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
+				atc.RetainBuildContainers:  checkWritePermissionForBuild(inputHandlers[atc.RetainBuildContainers]),
+				atc.ReleaseBuildContainers: checkWritePermissionForBuild(inputHandlers[atc.ReleaseBuildContainers]),
 
 				// resource belongs to authorized team
 				atc.PruneWorker:              checkTeamAccessForWorker(inputHandlers[atc.PruneWorker]),
@@ -135,6 +138,8 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ReportWorkerContainers:   checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerContainers]),
 				atc.ReportWorkerVolumes:      checkTeamAccessForWorker(inputHandlers[atc.ReportWorkerVolumes]),
 				atc.RetireWorker:             checkTeamAccessForWorker(inputHandlers[atc.RetireWorker]),
+				atc.CordonWorker:             checkTeamAccessForWorker(inputHandlers[atc.CordonWorker]),
+				atc.UncordonWorker:           checkTeamAccessForWorker(inputHandlers[atc.UncordonWorker]),
 				atc.ListDestroyingContainers: checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingContainers]),
 				atc.ListDestroyingVolumes:    checkTeamAccessForWorker(inputHandlers[atc.ListDestroyingVolumes]),
 
@@ -172,6 +177,8 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.RenameTeam:      authenticated(inputHandlers[atc.RenameTeam]),
 				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
 				atc.GetUser:         authenticated(inputHandlers[atc.GetUser]),
+				atc.ListAuditEvents: authenticated(inputHandlers[atc.ListAuditEvents]),
+				atc.GetClusterQuota: authenticated(inputHandlers[atc.GetClusterQuota]),
 
 				//authenticateIfTokenProvided / delegating to handler
 				atc.GetInfo:              authenticateIfTokenProvided(inputHandlers[atc.GetInfo]),
@@ -188,12 +195,16 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.GetWall:              authenticateIfTokenProvided(inputHandlers[atc.GetWall]),
 
 				// authenticated and is admin
-				atc.GetLogLevel:          authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
-				atc.SetLogLevel:          authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
-				atc.GetInfoCreds:         authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
-				atc.ListActiveUsersSince: authenticatedAndAdmin(inputHandlers[atc.ListActiveUsersSince]),
-				atc.SetWall:              authenticatedAndAdmin(inputHandlers[atc.SetWall]),
-				atc.ClearWall:            authenticatedAndAdmin(inputHandlers[atc.ClearWall]),
+				atc.GetLogLevel:           authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
+				atc.SetLogLevel:           authenticatedAndAdmin(inputHandlers[atc.SetLogLevel]),
+				atc.GetInfoCreds:          authenticatedAndAdmin(inputHandlers[atc.GetInfoCreds]),
+				atc.ListActiveUsersSince:  authenticatedAndAdmin(inputHandlers[atc.ListActiveUsersSince]),
+				atc.SetWall:               authenticatedAndAdmin(inputHandlers[atc.SetWall]),
+				atc.ClearWall:             authenticatedAndAdmin(inputHandlers[atc.ClearWall]),
+				atc.SetClusterQuota:       authenticatedAndAdmin(inputHandlers[atc.SetClusterQuota]),
+				atc.SetTeamQuota:          authenticatedAndAdmin(inputHandlers[atc.SetTeamQuota]),
+				atc.CordonTaggedWorkers:   authenticatedAndAdmin(inputHandlers[atc.CordonTaggedWorkers]),
+				atc.UncordonTaggedWorkers: authenticatedAndAdmin(inputHandlers[atc.UncordonTaggedWorkers]),
 
 				// authorized (requested team matches resource team)
 				atc.CheckResource:           authorized(inputHandlers[atc.CheckResource]),
@@ -207,9 +218,13 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.UnpinResource:           authorized(inputHandlers[atc.UnpinResource]),
 				atc.SetPinCommentOnResource: authorized(inputHandlers[atc.SetPinCommentOnResource]),
 				atc.GetConfig:               authorized(inputHandlers[atc.GetConfig]),
+				atc.ListConfigVersions:      authorized(inputHandlers[atc.ListConfigVersions]),
+				atc.GetConfigVersion:        authorized(inputHandlers[atc.GetConfigVersion]),
+				atc.DiffConfigVersions:      authorized(inputHandlers[atc.DiffConfigVersions]),
 				atc.GetCC:                   authorized(inputHandlers[atc.GetCC]),
 				atc.GetVersionsDB:           authorized(inputHandlers[atc.GetVersionsDB]),
 				atc.ListJobInputs:           authorized(inputHandlers[atc.ListJobInputs]),
+				atc.GetJobTaskPlan:          authorized(inputHandlers[atc.GetJobTaskPlan]),
 				atc.OrderPipelines:          authorized(inputHandlers[atc.OrderPipelines]),
 				atc.PauseJob:                authorized(inputHandlers[atc.PauseJob]),
 				atc.PausePipeline:           authorized(inputHandlers[atc.PausePipeline]),
@@ -225,6 +240,10 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
 				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
 				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
+				atc.ListAPITokens:           authorized(inputHandlers[atc.ListAPITokens]),
+				atc.CreateAPIToken:          authorized(inputHandlers[atc.CreateAPIToken]),
+				atc.RevokeAPIToken:          authorized(inputHandlers[atc.RevokeAPIToken]),
+				atc.GetTeamQuota:            authorized(inputHandlers[atc.GetTeamQuota]),
 			}
 		})
 
@@ -0,0 +1,107 @@
+package wrappa
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/tedsuo/rata"
+	"sigs.k8s.io/yaml"
+)
+
+type PolicyCheckWrappa struct {
+	logger  lager.Logger
+	checker policy.Checker
+}
+
+// NewPolicyCheckWrappa wraps every API action with a policy check. If no
+// checker is configured the handlers are left as-is.
+func NewPolicyCheckWrappa(logger lager.Logger, checker policy.Checker) Wrappa {
+	return PolicyCheckWrappa{
+		logger:  logger,
+		checker: checker,
+	}
+}
+
+func (wrappa PolicyCheckWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
+	if wrappa.checker == nil {
+		return handlers
+	}
+
+	wrapped := rata.Handlers{}
+
+	for name, handler := range handlers {
+		wrapped[name] = policyCheckHandler{
+			logger:  wrappa.logger.Session("policy-check", lager.Data{"action": name}),
+			action:  name,
+			handler: handler,
+			checker: wrappa.checker,
+		}
+	}
+
+	return wrapped
+}
+
+type policyCheckHandler struct {
+	logger  lager.Logger
+	action  string
+	handler http.Handler
+	checker policy.Checker
+}
+
+func (h policyCheckHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !h.checker.ShouldCheck(h.action, r.Method) {
+		h.handler.ServeHTTP(w, r)
+		return
+	}
+
+	input := policy.PolicyCheckInput{
+		HttpMethod: r.Method,
+		Action:     h.action,
+		User:       accessor.GetAccessor(r).Claims().UserName,
+		Team:       r.URL.Query().Get(":team_name"),
+		Pipeline:   r.URL.Query().Get(":pipeline_name"),
+	}
+
+	if r.Body != nil {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			h.logger.Error("failed-to-read-body", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		r.Body.Close()
+		r.Body = ioutil.NopCloser(bytes.NewBuffer(body))
+
+		// pipeline configs and most other payloads are either YAML or JSON;
+		// anything else is checked without data
+		var data interface{}
+		if len(body) > 0 && yaml.Unmarshal(body, &data) == nil {
+			input.Data = data
+		}
+	}
+
+	output, err := h.checker.Check(input)
+	if err != nil {
+		h.logger.Error("failed-to-check-policy", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !output.Allowed {
+		h.logger.Info("rejected", lager.Data{"reasons": output.Reasons})
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(output.Message()))
+		return
+	}
+
+	if output.Warning {
+		h.logger.Info("warning", lager.Data{"reasons": output.Reasons})
+	}
+
+	h.handler.ServeHTTP(w, r)
+}
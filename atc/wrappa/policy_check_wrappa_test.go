@@ -0,0 +1,156 @@
+package wrappa_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
+	"github.com/concourse/concourse/atc/wrappa"
+	"github.com/tedsuo/rata"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PolicyCheckWrappa", func() {
+	var (
+		fakeChecker *policyfakes.FakeChecker
+		delegate    *recordingHandler
+
+		wrappedHandlers rata.Handlers
+		response        *httptest.ResponseRecorder
+	)
+
+	BeforeEach(func() {
+		fakeChecker = new(policyfakes.FakeChecker)
+		delegate = &recordingHandler{}
+	})
+
+	JustBeforeEach(func() {
+		wrappedHandlers = wrappa.NewPolicyCheckWrappa(lagertest.NewTestLogger("test"), fakeChecker).Wrap(rata.Handlers{
+			atc.SaveConfig: delegate,
+		})
+
+		request := httptest.NewRequest(
+			"PUT",
+			"/api/v1/teams/some-team/pipelines/some-pipeline/config?:team_name=some-team&:pipeline_name=some-pipeline",
+			strings.NewReader("jobs: [{name: some-job}]"),
+		)
+
+		response = httptest.NewRecorder()
+		wrappedHandlers[atc.SaveConfig].ServeHTTP(response, request)
+	})
+
+	Context("when the action is not checked", func() {
+		BeforeEach(func() {
+			fakeChecker.ShouldCheckReturns(false)
+		})
+
+		It("calls the handler without checking", func() {
+			Expect(fakeChecker.CheckCallCount()).To(Equal(0))
+			Expect(delegate.called).To(BeTrue())
+		})
+	})
+
+	Context("when the action is checked", func() {
+		BeforeEach(func() {
+			fakeChecker.ShouldCheckReturns(true)
+		})
+
+		It("filters by the action and http method", func() {
+			action, method := fakeChecker.ShouldCheckArgsForCall(0)
+			Expect(action).To(Equal(atc.SaveConfig))
+			Expect(method).To(Equal("PUT"))
+		})
+
+		It("checks the action with the request details", func() {
+			Expect(fakeChecker.CheckCallCount()).To(Equal(1))
+			Expect(fakeChecker.CheckArgsForCall(0)).To(Equal(policy.PolicyCheckInput{
+				HttpMethod: "PUT",
+				Action:     atc.SaveConfig,
+				Team:       "some-team",
+				Pipeline:   "some-pipeline",
+				Data: map[string]interface{}{
+					"jobs": []interface{}{
+						map[string]interface{}{"name": "some-job"},
+					},
+				},
+			}))
+		})
+
+		Context("when the policy check passes", func() {
+			BeforeEach(func() {
+				fakeChecker.CheckReturns(policy.PolicyCheckOutput{Allowed: true}, nil)
+			})
+
+			It("calls the handler with the original body", func() {
+				Expect(delegate.called).To(BeTrue())
+				Expect(delegate.body).To(Equal("jobs: [{name: some-job}]"))
+			})
+		})
+
+		Context("when the policy check only warns", func() {
+			BeforeEach(func() {
+				fakeChecker.CheckReturns(policy.PolicyCheckOutput{Allowed: true, Warning: true, Reasons: []string{"careful"}}, nil)
+			})
+
+			It("calls the handler", func() {
+				Expect(delegate.called).To(BeTrue())
+			})
+		})
+
+		Context("when the policy check does not pass", func() {
+			BeforeEach(func() {
+				fakeChecker.CheckReturns(policy.PolicyCheckOutput{Allowed: false, Reasons: []string{"nope"}}, nil)
+			})
+
+			It("returns 403 with the reasons", func() {
+				Expect(response.Code).To(Equal(http.StatusForbidden))
+				Expect(response.Body.String()).To(Equal("policy check failed: nope"))
+			})
+
+			It("does not call the handler", func() {
+				Expect(delegate.called).To(BeFalse())
+			})
+		})
+
+		Context("when the policy check errors", func() {
+			BeforeEach(func() {
+				fakeChecker.CheckReturns(policy.PolicyCheckOutput{}, errors.New("disaster"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.Code).To(Equal(http.StatusInternalServerError))
+			})
+
+			It("does not call the handler", func() {
+				Expect(delegate.called).To(BeFalse())
+			})
+		})
+	})
+
+	Context("when no checker is configured", func() {
+		It("leaves the handlers as-is", func() {
+			handlers := rata.Handlers{atc.SaveConfig: delegate}
+			Expect(wrappa.NewPolicyCheckWrappa(lagertest.NewTestLogger("test"), nil).Wrap(handlers)).To(Equal(handlers))
+		})
+	})
+})
+
+type recordingHandler struct {
+	called bool
+	body   string
+}
+
+func (h *recordingHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.called = true
+
+	body, _ := ioutil.ReadAll(r.Body)
+	h.body = string(body)
+}
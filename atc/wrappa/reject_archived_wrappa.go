@@ -42,18 +42,26 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			// leave the handler as-is
 		case
 			atc.GetConfig,
+			atc.ListConfigVersions,
+			atc.GetConfigVersion,
+			atc.DiffConfigVersions,
 			atc.GetBuild,
 			atc.BuildResources,
 			atc.BuildEvents,
 			atc.ListBuildArtifacts,
 			atc.GetBuildPreparation,
+			atc.ListBuildNotifications,
 			atc.GetBuildPlan,
 			atc.AbortBuild,
+			atc.RetainBuildContainers,
+			atc.ReleaseBuildContainers,
 			atc.PruneWorker,
 			atc.LandWorker,
 			atc.ReportWorkerContainers,
 			atc.ReportWorkerVolumes,
 			atc.RetireWorker,
+			atc.CordonWorker,
+			atc.UncordonWorker,
 			atc.ListDestroyingContainers,
 			atc.ListDestroyingVolumes,
 			atc.GetPipeline,
@@ -78,6 +86,9 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListContainers,
 			atc.ListVolumes,
 			atc.ListTeamBuilds,
+			atc.ListAPITokens,
+			atc.CreateAPIToken,
+			atc.RevokeAPIToken,
 			atc.ListWorkers,
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
@@ -105,10 +116,18 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListActiveUsersSince,
 			atc.SetWall,
 			atc.ClearWall,
+			atc.ListAuditEvents,
+			atc.GetClusterQuota,
+			atc.SetClusterQuota,
+			atc.GetTeamQuota,
+			atc.SetTeamQuota,
+			atc.CordonTaggedWorkers,
+			atc.UncordonTaggedWorkers,
 			atc.DeletePipeline,
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.GetJobTaskPlan,
 			atc.OrderPipelines,
 			atc.PauseJob,
 			atc.ArchivePipeline,
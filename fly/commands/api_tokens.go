@@ -0,0 +1,98 @@
+package commands
+
+import (
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type APITokensCommand struct {
+	Team string `long:"team" description:"Name of the team to list the tokens of, if different from the target default"`
+	Json bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *APITokensCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	tokens, err := team.ListAPITokens()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(tokens)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "owner", Color: color.New(color.Bold)},
+			{Contents: "role", Color: color.New(color.Bold)},
+			{Contents: "expires", Color: color.New(color.Bold)},
+			{Contents: "last used", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, token := range tokens {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: token.Name},
+			apiTokenOwnerCell(token),
+			{Contents: token.Role},
+			apiTokenExpiryCell(token),
+			apiTokenLastUsedCell(token),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func apiTokenOwnerCell(token atc.APIToken) ui.TableCell {
+	if token.ServiceAccount != "" {
+		return ui.TableCell{Contents: "service-account:" + token.ServiceAccount}
+	}
+
+	return ui.TableCell{Contents: token.User}
+}
+
+func apiTokenExpiryCell(token atc.APIToken) ui.TableCell {
+	expiresAt := time.Unix(token.ExpiresAt, 0)
+
+	cell := ui.TableCell{Contents: expiresAt.Format(timeDateLayout)}
+	if !expiresAt.After(time.Now()) {
+		cell.Color = ui.ErroredColor
+	}
+
+	return cell
+}
+
+func apiTokenLastUsedCell(token atc.APIToken) ui.TableCell {
+	if token.LastUsedAt == 0 {
+		return ui.TableCell{Contents: "never", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: time.Unix(token.LastUsedAt, 0).Format(timeDateLayout)}
+}
@@ -0,0 +1,194 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type AuditLogCommand struct {
+	AllTeams bool                     `short:"a" long:"all-teams" description:"Show the events of every team, and those not related to a team (admins only)"`
+	Team     string                   `short:"n" long:"team" description:"Show the events of this team, if different from the target default"`
+	User     string                   `short:"u" long:"user" description:"Only show the events of requests made by this user"`
+	Action   string                   `long:"action" description:"Only show the events of this API action, e.g. SetTeam"`
+	Pipeline string                   `short:"p" long:"pipeline" description:"Only show the events related to this pipeline"`
+	Job      flaghelpers.JobFlag      `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Only show the events related to this job"`
+	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" value-name:"PIPELINE/RESOURCE" description:"Only show the events related to this resource"`
+	Build    int                      `short:"b" long:"build" description:"Only show the events related to the build with this ID"`
+	Since    string                   `long:"since" description:"Start of the range to show events from"`
+	Until    string                   `long:"until" description:"End of the range to show events from"`
+	Count    int                      `short:"c" long:"count" default:"50" description:"Number of events to show"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *AuditLogCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	filter, err := command.filter(target.Team().Name())
+	if err != nil {
+		return err
+	}
+
+	events, err := command.listEvents(target.Client(), filter)
+	if err != nil {
+		if err == concourse.ErrForbidden {
+			return errors.New("only admins and the team's owners can see its audit log")
+		}
+
+		return err
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(events)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "time", Color: color.New(color.Bold)},
+			{Contents: "user", Color: color.New(color.Bold)},
+			{Contents: "action", Color: color.New(color.Bold)},
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "target", Color: color.New(color.Bold)},
+			{Contents: "source ip", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, event := range events {
+		row := ui.TableRow{
+			{Contents: time.Unix(event.Time, 0).Local().Format(timeDateLayout)},
+			auditLogCell(event.UserName),
+			{Contents: event.Action},
+			auditLogCell(event.TeamName),
+			auditLogCell(auditEventTarget(event)),
+			auditLogCell(event.SourceIP),
+		}
+
+		table.Data = append(table.Data, row)
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *AuditLogCommand) filter(targetTeam string) (concourse.AuditEventFilter, error) {
+	if command.AllTeams && command.Team != "" {
+		return concourse.AuditEventFilter{}, errors.New("Cannot specify both --all-teams and --team")
+	}
+
+	filter := concourse.AuditEventFilter{
+		User:     command.User,
+		Action:   command.Action,
+		Pipeline: command.Pipeline,
+		Build:    command.Build,
+	}
+
+	switch {
+	case command.AllTeams:
+	case command.Team != "":
+		filter.Team = command.Team
+	default:
+		filter.Team = targetTeam
+	}
+
+	if command.Job.JobName != "" {
+		filter.Pipeline = command.Job.PipelineName
+		filter.Job = command.Job.JobName
+	}
+
+	if command.Resource.ResourceName != "" {
+		filter.Pipeline = command.Resource.PipelineName
+		filter.Resource = command.Resource.ResourceName
+	}
+
+	var err error
+	if command.Since != "" {
+		filter.From, err = time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return filter, errors.New("Since time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Until != "" {
+		filter.To, err = time.ParseInLocation(inputTimeLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return filter, errors.New("Until time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Since != "" && command.Until != "" && filter.From.After(filter.To) {
+		return filter, errors.New("Cannot have --since after --until")
+	}
+
+	return filter, nil
+}
+
+// listEvents pages through the events, newest first, until it has as many as
+// were asked for.
+func (command *AuditLogCommand) listEvents(client concourse.Client, filter concourse.AuditEventFilter) ([]atc.AuditEvent, error) {
+	events := []atc.AuditEvent{}
+
+	page := concourse.Page{Limit: command.Count}
+	for len(events) < command.Count {
+		pageEvents, pagination, err := client.ListAuditEvents(filter, page)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, pageEvents...)
+
+		if pagination.Next == nil {
+			break
+		}
+
+		page = *pagination.Next
+		page.Limit = command.Count - len(events)
+	}
+
+	if len(events) > command.Count {
+		events = events[:command.Count]
+	}
+
+	return events, nil
+}
+
+func auditEventTarget(event atc.AuditEvent) string {
+	switch {
+	case event.BuildID != 0:
+		return "build " + strconv.Itoa(event.BuildID)
+	case event.JobName != "":
+		return fmt.Sprintf("%s/%s", event.PipelineName, event.JobName)
+	case event.ResourceName != "":
+		return fmt.Sprintf("%s/%s", event.PipelineName, event.ResourceName)
+	default:
+		return event.PipelineName
+	}
+}
+
+func auditLogCell(contents string) ui.TableCell {
+	if contents == "" {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: contents}
+}
@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type BuildNotificationsCommand struct {
+	Job   flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of the job the build belongs to"`
+	Build string              `short:"b" long:"build" required:"true" description:"If job is specified: build number. If job not specified: build id"`
+	Json  bool                `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *BuildNotificationsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineName == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineName, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	notifications, found, err := target.Client().BuildNotifications(build.ID)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("build does not exist")
+	}
+
+	if command.Json {
+		err = displayhelpers.JsonPrint(notifications)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "attempts", Color: color.New(color.Bold)},
+			{Contents: "response", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+			{Contents: "delivered", Color: color.New(color.Bold)},
+			{Contents: "error", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, notification := range notifications {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: notification.Name},
+			notificationStatusCell(notification.Status),
+			{Contents: strconv.Itoa(notification.Attempts)},
+			responseCodeCell(notification.ResponseCode),
+			{Contents: time.Unix(notification.CreatedAt, 0).Local().Format(timeDateLayout)},
+			deliveredAtCell(notification.DeliveredAt),
+			stringOrDefault(notification.LastError),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func notificationStatusCell(status string) ui.TableCell {
+	cell := ui.TableCell{Contents: status}
+
+	switch status {
+	case "delivered":
+		cell.Color = ui.SucceededColor
+	case "failed":
+		cell.Color = ui.FailedColor
+	default:
+		cell.Color = ui.PendingColor
+	}
+
+	return cell
+}
+
+func responseCodeCell(code int) ui.TableCell {
+	if code == 0 {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: strconv.Itoa(code)}
+}
+
+func deliveredAtCell(deliveredAt int64) ui.TableCell {
+	if deliveredAt == 0 {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: time.Unix(deliveredAt, 0).Local().Format(timeDateLayout)}
+}
@@ -157,7 +157,7 @@ func (command *BuildsCommand) validatePipelineBuilds(builds []atc.Build, current
 
 	var found bool
 	builds, _, found, err = currentTeam.PipelineBuilds(
-		string(command.Pipeline),
+		command.Pipeline.Ref(),
 		page,
 	)
 
@@ -228,6 +228,10 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			statusCell.Color = ui.PausedColor
 		}
 
+		if b.ContainersRetainedUntil != 0 {
+			statusCell.Contents += " (containers retained)"
+		}
+
 		table.Data = append(table.Data, []ui.TableCell{
 			{Contents: strconv.Itoa(b.ID)},
 			pipelineJobCell,
@@ -313,7 +317,7 @@ func (command *BuildsCommand) jobFlag() bool {
 }
 
 func (command *BuildsCommand) pipelineFlag() bool {
-	return command.Pipeline != ""
+	return command.Pipeline.Name != ""
 }
 
 func (command *BuildsCommand) buildCap(builds []atc.Build) int {
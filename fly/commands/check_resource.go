@@ -106,7 +106,7 @@ func (command *CheckResourceCommand) checkParent(target rc.Target) error {
 		return fmt.Errorf("resource '%s' not found\n", command.Resource.ResourceName)
 	}
 
-	resourceTypes, found, err := target.Team().VersionedResourceTypes(command.Resource.PipelineName)
+	resourceTypes, found, err := target.Team().VersionedResourceTypes(atc.PipelineRef{Name: command.Resource.PipelineName})
 	if err != nil {
 		return err
 	}
@@ -98,7 +98,7 @@ func (command *CheckResourceTypeCommand) Execute(args []string) error {
 }
 
 func (command *CheckResourceTypeCommand) checkParent(target rc.Target) error {
-	resourceTypes, found, err := target.Team().VersionedResourceTypes(command.ResourceType.PipelineName)
+	resourceTypes, found, err := target.Team().VersionedResourceTypes(atc.PipelineRef{Name: command.ResourceType.PipelineName})
 	if err != nil {
 		return err
 	}
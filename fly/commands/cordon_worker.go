@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type CordonWorkerCommand struct {
+	Workers []flaghelpers.WorkerFlag `short:"w" long:"worker" description:"Worker to cordon. Can be specified multiple times."`
+	Tags    []string                 `long:"tag" description:"Cordon the workers with this tag, including ones that register later on. Can be specified multiple times to select the workers with all of the tags."`
+
+	Start string `long:"start" description:"When the cordon starts, in the format '2006-01-02 15:04:05'. Defaults to now."`
+	End   string `long:"end" description:"When the cordon ends, in the format '2006-01-02 15:04:05'. Defaults to when the worker is uncordoned."`
+}
+
+func (command *CordonWorkerCommand) Execute(args []string) error {
+	if len(command.Workers) == 0 && len(command.Tags) == 0 {
+		displayhelpers.Failf("Either a worker name or --tag are required")
+	}
+
+	var cordon atc.WorkerCordon
+
+	if command.Start != "" {
+		start, err := time.ParseInLocation(inputTimeLayout, command.Start, time.Now().Location())
+		if err != nil {
+			return errors.New("Start time should be in the format: " + inputTimeLayout)
+		}
+
+		cordon.StartTime = start.Unix()
+	}
+
+	if command.End != "" {
+		end, err := time.ParseInLocation(inputTimeLayout, command.End, time.Now().Location())
+		if err != nil {
+			return errors.New("End time should be in the format: " + inputTimeLayout)
+		}
+
+		cordon.EndTime = end.Unix()
+	}
+
+	err := cordon.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	for _, worker := range command.Workers {
+		err = target.Client().CordonWorker(worker.Name(), cordon)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("cordoned '%s'\n", worker.Name())
+	}
+
+	if len(command.Tags) > 0 {
+		// the tags are kept on the ATC, which matches them against the workers'
+		// tags as they register and heartbeat
+		err = target.Client().CordonTaggedWorkers(atc.WorkerTagCordon{
+			Tags:         command.Tags,
+			WorkerCordon: cordon,
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("cordoned workers tagged '%s'\n", strings.Join(command.Tags, ", "))
+	}
+
+	return nil
+}
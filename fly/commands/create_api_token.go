@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type CreateAPITokenCommand struct {
+	Name           string        `short:"n" long:"name" required:"true" description:"Name of the token"`
+	Role           string        `short:"r" long:"role" required:"true" description:"Role the token is limited to on the team"`
+	ServiceAccount string        `long:"service-account" description:"Create the token for this service account of the team instead of for yourself. Only team owners can do this"`
+	ExpiresIn      time.Duration `long:"expires-in" default:"2160h" description:"How long the token is valid for"`
+	Team           string        `long:"team" description:"Name of the team to create the token for, if different from the target default"`
+}
+
+func (command *CreateAPITokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	token, err := team.CreateAPIToken(atc.APIToken{
+		Name:           command.Name,
+		Role:           command.Role,
+		ServiceAccount: command.ServiceAccount,
+		ExpiresAt:      time.Now().Add(command.ExpiresIn).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("created token '%s', valid until %s\n\n", token.Name, time.Unix(token.ExpiresAt, 0).Format(timeDateLayout))
+	fmt.Println(token.Token)
+	fmt.Println()
+	fmt.Println(ui.WarningColor("this is the only time the token is shown; keep it somewhere safe"))
+
+	return nil
+}
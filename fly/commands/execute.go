@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -24,7 +25,9 @@ import (
 )
 
 type ExecuteCommand struct {
-	TaskConfig     atc.PathFlag                       `short:"c" long:"config" required:"true"                description:"The task config to execute"`
+	TaskConfig     atc.PathFlag                       `short:"c" long:"config"                                description:"The task config to execute"`
+	Job            flaghelpers.JobFlag                `          long:"job"         value-name:"PIPELINE/JOB" description:"A job whose task step to execute, instead of a task config (requires --step)"`
+	Step           string                             `          long:"step"        value-name:"STEP"         description:"The name of the task step of the job to execute"`
 	Privileged     bool                               `short:"p" long:"privileged"                            description:"Run the task with full privileges"`
 	IncludeIgnored bool                               `          long:"include-ignored"                       description:"Including .gitignored paths. Disregards .gitignore entries and uploads everything"`
 	Inputs         []flaghelpers.InputPairFlag        `short:"i" long:"input"       value-name:"NAME=PATH"    description:"An input to provide to the task (can be specified multiple times)"`
@@ -49,54 +52,26 @@ func (command *ExecuteCommand) Execute(args []string) error {
 		return err
 	}
 
-	taskConfig, err := command.CreateTaskConfig(args)
-	if err != nil {
-		return err
-	}
-
 	planFactory := atc.NewPlanFactory(time.Now().Unix())
 
-	inputs, inputMappings, imageResource, err := executehelpers.DetermineInputs(
-		planFactory,
-		target.Team(),
-		taskConfig.Inputs,
-		command.Inputs,
-		command.InputMappings,
-		command.Image,
-		command.InputsFrom,
-		command.IncludeIgnored,
-		taskConfig.Platform,
-	)
-	if err != nil {
-		return err
-	}
-
-	if imageResource != nil {
-		taskConfig.ImageResource = imageResource
-	}
+	var plan atc.Plan
+	var outputs []executehelpers.Output
+	var pipelineRef atc.PipelineRef
 
-	outputs, err := executehelpers.DetermineOutputs(
-		planFactory,
-		taskConfig.Outputs,
-		command.Outputs,
-	)
-	if err != nil {
-		return err
-	}
+	if command.Job.JobName != "" || command.Step != "" {
+		plan, outputs, err = command.jobStepPlan(planFactory, target, args)
+		if err != nil {
+			return err
+		}
 
-	plan, err := executehelpers.CreateBuildPlan(
-		planFactory,
-		target,
-		command.Privileged,
-		inputs,
-		inputMappings,
-		outputs,
-		taskConfig,
-		command.Tags,
-	)
+		pipelineRef = command.Job.PipelineRef()
+	} else {
+		plan, outputs, err = command.taskConfigPlan(planFactory, target, args)
+		if err != nil {
+			return err
+		}
 
-	if err != nil {
-		return err
+		pipelineRef = command.InputsFrom.PipelineRef()
 	}
 
 	client := target.Client()
@@ -108,8 +83,8 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	var build atc.Build
 	var buildURL *url.URL
 
-	if command.InputsFrom.PipelineName != "" {
-		build, err = target.Team().CreatePipelineBuild(command.InputsFrom.PipelineName, plan)
+	if pipelineRef.Name != "" {
+		build, err = target.Team().CreatePipelineBuild(pipelineRef, plan)
 		if err != nil {
 			return err
 		}
@@ -181,6 +156,125 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	return nil
 }
 
+func (command *ExecuteCommand) taskConfigPlan(planFactory atc.PlanFactory, target rc.Target, args []string) (atc.Plan, []executehelpers.Output, error) {
+	if command.TaskConfig == "" {
+		return atc.Plan{}, nil, errors.New("either --config or --job and --step must be specified")
+	}
+
+	taskConfig, err := command.CreateTaskConfig(args)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	inputs, inputMappings, imageResource, err := executehelpers.DetermineInputs(
+		planFactory,
+		target.Team(),
+		taskConfig.Inputs,
+		command.Inputs,
+		command.InputMappings,
+		command.Image,
+		command.InputsFrom,
+		command.IncludeIgnored,
+		taskConfig.Platform,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	if imageResource != nil {
+		taskConfig.ImageResource = imageResource
+	}
+
+	outputs, err := executehelpers.DetermineOutputs(
+		planFactory,
+		taskConfig.Outputs,
+		command.Outputs,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	plan, err := executehelpers.CreateBuildPlan(
+		planFactory,
+		target,
+		command.Privileged,
+		inputs,
+		inputMappings,
+		outputs,
+		taskConfig,
+		command.Tags,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	return plan, outputs, nil
+}
+
+func (command *ExecuteCommand) jobStepPlan(planFactory atc.PlanFactory, target rc.Target, args []string) (atc.Plan, []executehelpers.Output, error) {
+	switch {
+	case command.Job.JobName == "" || command.Step == "":
+		return atc.Plan{}, nil, errors.New("--job and --step must be specified together")
+	case command.TaskConfig != "":
+		return atc.Plan{}, nil, errors.New("--config cannot be used with --job")
+	case command.InputsFrom.JobName != "":
+		return atc.Plan{}, nil, errors.New("--inputs-from cannot be used with --job, inputs are based on the job")
+	case len(command.InputMappings) != 0:
+		return atc.Plan{}, nil, errors.New("--input-mapping cannot be used with --job, the step's input mapping is used")
+	case command.Image != "":
+		return atc.Plan{}, nil, errors.New("--image cannot be used with --job, the step's image is used")
+	case len(command.Var) != 0 || len(command.YAMLVar) != 0 || len(command.VarsFrom) != 0:
+		return atc.Plan{}, nil, errors.New("--var, --yaml-var and --load-vars-from cannot be used with --job, the step's vars are resolved by the pipeline")
+	case len(args) != 0:
+		return atc.Plan{}, nil, errors.New("task arguments cannot be given with --job, the step's config is used")
+	}
+
+	step, err := executehelpers.FetchJobTaskPlan(target.Team(), command.Job, command.Step)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	taskConfig, err := executehelpers.JobStepTaskConfig(step, command.Inputs)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	inputs, err := executehelpers.DetermineJobStepInputs(
+		planFactory,
+		target.Team(),
+		step,
+		taskConfig.Inputs,
+		command.Inputs,
+		command.Job,
+		command.IncludeIgnored,
+		taskConfig.Platform,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	outputs, err := executehelpers.DetermineJobStepOutputs(
+		planFactory,
+		step,
+		taskConfig.Outputs,
+		command.Outputs,
+	)
+	if err != nil {
+		return atc.Plan{}, nil, err
+	}
+
+	plan := executehelpers.CreateJobStepBuildPlan(
+		planFactory,
+		step,
+		command.Privileged,
+		inputs,
+		outputs,
+		command.Tags,
+	)
+
+	return plan, outputs, nil
+}
+
 func (command *ExecuteCommand) CreateTaskConfig(args []string) (atc.TaskConfig, error) {
 
 	taskTemplate := templatehelpers.NewYamlTemplateWithParams(
@@ -25,6 +25,7 @@ type FlyCommand struct {
 
 	ActiveUsers ActiveUsersCommand `command:"active-users" alias:"au" description:"List the active users since a date or for the past 2 months"`
 	Userinfo    UserinfoCommand    `command:"userinfo" description:"User information"`
+	AuditLog    AuditLogCommand    `command:"audit-log" alias:"al" description:"List the audited API requests"`
 
 	Teams       TeamsCommand       `command:"teams" alias:"t" description:"List the configured teams"`
 	GetTeam     GetTeamCommand     `command:"get-team"  alias:"gt" description:"Show team configuration"`
@@ -32,6 +33,13 @@ type FlyCommand struct {
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	APITokens      APITokensCommand      `command:"api-tokens"       alias:"ats" description:"List the API tokens of a team"`
+	CreateAPIToken CreateAPITokenCommand `command:"create-api-token" alias:"cat" description:"Create an API token for yourself or a service account of a team"`
+	RevokeAPIToken RevokeAPITokenCommand `command:"revoke-api-token" alias:"rat" description:"Revoke an API token"`
+
+	GetQuota GetQuotaCommand `command:"get-quota" alias:"gq" description:"Show the quota on running builds and task containers of a team or the cluster"`
+	SetQuota SetQuotaCommand `command:"set-quota" alias:"sq" description:"Limit the running builds and task containers of a team or the cluster"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
 	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
@@ -49,6 +57,8 @@ type FlyCommand struct {
 	DestroyPipeline  DestroyPipelineCommand  `command:"destroy-pipeline"    alias:"dp"   description:"Destroy a pipeline"`
 	GetPipeline      GetPipelineCommand      `command:"get-pipeline"        alias:"gp"   description:"Get a pipeline's current configuration"`
 	SetPipeline      SetPipelineCommand      `command:"set-pipeline"        alias:"sp"   description:"Create or update a pipeline's configuration"`
+	PipelineHistory  PipelineHistoryCommand  `command:"pipeline-history"    alias:"ph"   description:"List the configs a pipeline has been saved with, or show the changes made by one"`
+	RollbackPipeline RollbackPipelineCommand `command:"rollback-pipeline"   alias:"rbp"  description:"Set a pipeline back to a config it was saved with before"`
 	PausePipeline    PausePipelineCommand    `command:"pause-pipeline"      alias:"pp"   description:"Pause a pipeline"`
 	ArchivePipeline  ArchivePipelineCommand  `command:"archive-pipeline"    alias:"ap"   description:"Archive a pipeline"`
 	UnpausePipeline  UnpausePipelineCommand  `command:"unpause-pipeline"    alias:"up"   description:"Un-pause a pipeline"`
@@ -71,17 +81,22 @@ type FlyCommand struct {
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
-	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Builds                 BuildsCommand                 `command:"builds"                   alias:"bs"  description:"List builds data"`
+	AbortBuild             AbortBuildCommand             `command:"abort-build"              alias:"ab"  description:"Abort a build"`
+	RerunBuild             RerunBuildCommand             `command:"rerun-build"              alias:"rb"  description:"Rerun a build"`
+	RetainBuildContainers  RetainBuildContainersCommand  `command:"retain-build-containers"  alias:"rbc" description:"Keep the containers of a failed build around for intercepting"`
+	ReleaseBuildContainers ReleaseBuildContainersCommand `command:"release-build-containers" alias:"lbc" description:"Let the retained containers of a build be garbage collected"`
+	BuildNotifications     BuildNotificationsCommand     `command:"build-notifications"      alias:"bn"  description:"List the deliveries of a build's notifications"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
-	Workers     WorkersCommand     `command:"workers" alias:"ws" description:"List the registered workers"`
-	LandWorker  LandWorkerCommand  `command:"land-worker" alias:"lw" description:"Land a worker"`
-	PruneWorker PruneWorkerCommand `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
+	Workers        WorkersCommand        `command:"workers" alias:"ws" description:"List the registered workers"`
+	LandWorker     LandWorkerCommand     `command:"land-worker" alias:"lw" description:"Land a worker"`
+	PruneWorker    PruneWorkerCommand    `command:"prune-worker" alias:"pw" description:"Prune a stalled, landing, landed, or retiring worker"`
+	CordonWorker   CordonWorkerCommand   `command:"cordon-worker" alias:"cw" description:"Stop placing new containers on workers, now or during a window of time"`
+	UncordonWorker UncordonWorkerCommand `command:"uncordon-worker" alias:"ucw" description:"Allow new containers on cordoned workers again"`
 
 	Curl CurlCommand `command:"curl" alias:"c" description:"curl the api"`
 
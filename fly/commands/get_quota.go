@@ -0,0 +1,89 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type GetQuotaCommand struct {
+	Team    string `short:"n" long:"team-name" description:"Show the quota of this team, if different from the target default"`
+	Cluster bool   `long:"cluster" description:"Show the quota of the whole cluster"`
+	JSON    bool   `short:"j" long:"json" description:"Print command result as JSON"`
+}
+
+func (command *GetQuotaCommand) Execute([]string) error {
+	if command.Cluster && command.Team != "" {
+		return errors.New("Cannot specify both --cluster and --team-name")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var usage atc.QuotaUsage
+	if command.Cluster {
+		usage, err = target.Client().ClusterQuota()
+	} else {
+		team := target.Team()
+		if command.Team != "" {
+			team, err = target.FindTeam(command.Team)
+			if err != nil {
+				return err
+			}
+		}
+
+		usage, err = team.Quota()
+	}
+	if err != nil {
+		return err
+	}
+
+	if command.JSON {
+		return displayhelpers.JsonPrint(usage)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "limit", Color: color.New(color.Bold)},
+			{Contents: "quota", Color: color.New(color.Bold)},
+			{Contents: "running", Color: color.New(color.Bold)},
+		},
+		Data: []ui.TableRow{
+			quotaRow("builds", usage.Quota.Builds, usage.Running.Builds),
+			quotaRow("task containers", usage.Quota.TaskContainers, usage.Running.TaskContainers),
+		},
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func quotaRow(name string, quota int, running int) ui.TableRow {
+	quotaCell := ui.TableCell{Contents: strconv.Itoa(quota)}
+	if quota == 0 {
+		quotaCell = ui.TableCell{Contents: "none", Color: ui.OffColor}
+	}
+
+	runningCell := ui.TableCell{Contents: strconv.Itoa(running)}
+	if quota != 0 && running >= quota {
+		runningCell.Color = ui.ErroredColor
+	}
+
+	return ui.TableRow{
+		{Contents: name},
+		quotaCell,
+		runningCell,
+	}
+}
@@ -19,11 +19,6 @@ func CreateBuildPlan(
 		return atc.Plan{}, err
 	}
 
-	buildInputs := atc.AggregatePlan{}
-	for _, input := range inputs {
-		buildInputs = append(buildInputs, input.Plan)
-	}
-
 	taskPlan := fact.NewPlan(atc.TaskPlan{
 		Name:         "one-off",
 		Privileged:   privileged,
@@ -35,26 +30,56 @@ func CreateBuildPlan(
 		taskPlan.Task.Tags = tags
 	}
 
+	return wrapTaskPlan(fact, inputs, taskPlan, outputs), nil
+}
+
+// CreateJobStepBuildPlan runs the job's task step as the ATC planned it. The
+// privileged flag and tags given on the command line take precedence.
+func CreateJobStepBuildPlan(
+	fact atc.PlanFactory,
+	step atc.TaskPlan,
+	privileged bool,
+	inputs []Input,
+	outputs []Output,
+	tags []string,
+) atc.Plan {
+	step.Privileged = step.Privileged || privileged
+
+	if len(tags) != 0 {
+		step.Tags = tags
+	}
+
+	return wrapTaskPlan(fact, inputs, fact.NewPlan(step), outputs)
+}
+
+func wrapTaskPlan(
+	fact atc.PlanFactory,
+	inputs []Input,
+	taskPlan atc.Plan,
+	outputs []Output,
+) atc.Plan {
+	buildInputs := atc.AggregatePlan{}
+	for _, input := range inputs {
+		buildInputs = append(buildInputs, input.Plan)
+	}
+
 	buildOutputs := atc.AggregatePlan{}
 	for _, output := range outputs {
 		buildOutputs = append(buildOutputs, output.Plan)
 	}
 
-	var plan atc.Plan
 	if len(buildOutputs) == 0 {
-		plan = fact.NewPlan(atc.DoPlan{
+		return fact.NewPlan(atc.DoPlan{
 			fact.NewPlan(buildInputs),
 			taskPlan,
 		})
-	} else {
-		plan = fact.NewPlan(atc.EnsurePlan{
-			Step: fact.NewPlan(atc.DoPlan{
-				fact.NewPlan(buildInputs),
-				taskPlan,
-			}),
-			Next: fact.NewPlan(buildOutputs),
-		})
 	}
 
-	return plan, nil
+	return fact.NewPlan(atc.EnsurePlan{
+		Step: fact.NewPlan(atc.DoPlan{
+			fact.NewPlan(buildInputs),
+			taskPlan,
+		}),
+		Next: fact.NewPlan(buildOutputs),
+	})
 }
@@ -191,22 +191,22 @@ func FetchInputsFromJob(fact atc.PlanFactory, team concourse.Team, inputsFrom fl
 		return kvMap, nil, nil
 	}
 
-	buildInputs, found, err := team.BuildInputsForJob(inputsFrom.PipelineName, inputsFrom.JobName)
+	buildInputs, found, err := team.BuildInputsForJob(inputsFrom.PipelineRef(), inputsFrom.JobName)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if !found {
-		return nil, nil, fmt.Errorf("build inputs for %s/%s not found", inputsFrom.PipelineName, inputsFrom.JobName)
+		return nil, nil, fmt.Errorf("build inputs for %s/%s not found", inputsFrom.PipelineRef(), inputsFrom.JobName)
 	}
 
-	versionedResourceTypes, found, err := team.VersionedResourceTypes(inputsFrom.PipelineName)
+	versionedResourceTypes, found, err := team.VersionedResourceTypes(inputsFrom.PipelineRef())
 	if err != nil {
 		return nil, nil, err
 	}
 
 	if !found {
-		return nil, nil, fmt.Errorf("versioned resource types of %s not found", inputsFrom.PipelineName)
+		return nil, nil, fmt.Errorf("versioned resource types of %s not found", inputsFrom.PipelineRef())
 	}
 
 	var imageResource *atc.ImageResource
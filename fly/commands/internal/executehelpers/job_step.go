@@ -0,0 +1,193 @@
+package executehelpers
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/vars"
+	"sigs.k8s.io/yaml"
+)
+
+// FetchJobTaskPlan gets the plan of the job's task step from the ATC, as the
+// job's builds run it. Its config file and vars are resolved by the build,
+// using the pipeline's var sources like the job's own builds do.
+func FetchJobTaskPlan(team concourse.Team, job flaghelpers.JobFlag, stepName string) (atc.TaskPlan, error) {
+	plan, found, err := team.JobTaskPlan(job.PipelineRef(), job.JobName, stepName)
+	if err != nil {
+		return atc.TaskPlan{}, err
+	}
+
+	if !found {
+		return atc.TaskPlan{}, fmt.Errorf("task step '%s' not found in job '%s' of pipeline '%s'", stepName, job.JobName, job.PipelineRef())
+	}
+
+	return plan, nil
+}
+
+// JobStepArtifactName returns the name of the artifact in the job's build
+// that is mapped onto the task input.
+func JobStepArtifactName(step atc.TaskPlan, taskInputName string) string {
+	if name, ok := step.InputMapping[taskInputName]; ok {
+		return name
+	}
+
+	return taskInputName
+}
+
+// JobStepTaskConfig returns the step's config, only to find out which inputs
+// to provide and which outputs to fetch. A config file is read from the local
+// input containing it, with the step's vars filled in. The build loads and
+// interpolates the config itself when it runs.
+func JobStepTaskConfig(step atc.TaskPlan, localInputMappings []flaghelpers.InputPairFlag) (atc.TaskConfig, error) {
+	if step.Config != nil {
+		return *step.Config, nil
+	}
+
+	configPath, err := JobStepConfigPath(step, localInputMappings)
+	if err != nil {
+		return atc.TaskConfig{}, err
+	}
+
+	configFile, err := ioutil.ReadFile(string(configPath))
+	if err != nil {
+		return atc.TaskConfig{}, fmt.Errorf("could not read file: %s", err.Error())
+	}
+
+	configFile, err = vars.NewTemplateResolver(configFile, []vars.Variables{vars.StaticVariables(step.Vars)}).Resolve(false, false)
+	if err != nil {
+		return atc.TaskConfig{}, err
+	}
+
+	var config atc.TaskConfig
+	err = yaml.Unmarshal(configFile, &config)
+	if err != nil {
+		return atc.TaskConfig{}, fmt.Errorf("invalid task config '%s': %s", step.ConfigPath, err.Error())
+	}
+
+	return config, nil
+}
+
+// JobStepConfigPath finds the task config file of the step among the local
+// inputs. The file path starts with the name of an artifact in the job's
+// build, so it is only found if that artifact is provided as a local input.
+func JobStepConfigPath(step atc.TaskPlan, localInputMappings []flaghelpers.InputPairFlag) (atc.PathFlag, error) {
+	segs := strings.SplitN(step.ConfigPath, "/", 2)
+	if len(segs) != 2 {
+		return "", fmt.Errorf("invalid task config path '%s'", step.ConfigPath)
+	}
+
+	artifactName, filePath := segs[0], segs[1]
+
+	for _, mapping := range localInputMappings {
+		if JobStepArtifactName(step, mapping.Name) == artifactName {
+			return atc.PathFlag(filepath.Join(mapping.Path, filePath)), nil
+		}
+	}
+
+	return "", fmt.Errorf("task config '%s' is in artifact '%s', which must be provided as a local input", step.ConfigPath, artifactName)
+}
+
+// DetermineJobStepInputs provides the task inputs of a job's step, plus its
+// image artifact if it has one. Local inputs are named after the artifacts
+// the step's input mapping refers to, so that the mapping applies to them
+// just like in the job. Any other input is fetched at the latest version the
+// job would run with.
+func DetermineJobStepInputs(
+	fact atc.PlanFactory,
+	team concourse.Team,
+	step atc.TaskPlan,
+	taskInputs []atc.TaskInputConfig,
+	localInputMappings []flaghelpers.InputPairFlag,
+	job flaghelpers.JobFlag,
+	includeIgnored bool,
+	platform string,
+) ([]Input, error) {
+	err := CheckForUnknownInputMappings(localInputMappings, taskInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CheckForInputType(localInputMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	artifactMappings := []flaghelpers.InputPairFlag{}
+	for _, mapping := range localInputMappings {
+		artifactMappings = append(artifactMappings, flaghelpers.InputPairFlag{
+			Name: JobStepArtifactName(step, mapping.Name),
+			Path: mapping.Path,
+		})
+	}
+
+	inputsFromLocal, err := GenerateLocalInputs(fact, team, artifactMappings, includeIgnored, platform)
+	if err != nil {
+		return nil, err
+	}
+
+	inputsFromJob, _, err := FetchInputsFromJob(fact, team, job, "")
+	if err != nil {
+		return nil, err
+	}
+
+	inputs := []Input{}
+	for _, taskInput := range taskInputs {
+		artifactName := JobStepArtifactName(step, taskInput.Name)
+
+		input, found := inputsFromLocal[artifactName]
+		if !found {
+			input, found = inputsFromJob[artifactName]
+			if !found {
+				if taskInput.Optional {
+					continue
+				} else {
+					return nil, fmt.Errorf("missing required input `%s`", taskInput.Name)
+				}
+			}
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	if step.ImageArtifactName != "" {
+		input, found := inputsFromLocal[step.ImageArtifactName]
+		if !found {
+			input, found = inputsFromJob[step.ImageArtifactName]
+			if !found {
+				return nil, fmt.Errorf("missing image artifact `%s`", step.ImageArtifactName)
+			}
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	return inputs, nil
+}
+
+// DetermineJobStepOutputs fetches the task outputs of a job's step from the
+// artifacts the step's output mapping renames them to.
+func DetermineJobStepOutputs(
+	fact atc.PlanFactory,
+	step atc.TaskPlan,
+	taskOutputs []atc.TaskOutputConfig,
+	outputMappings []flaghelpers.OutputPairFlag,
+) ([]Output, error) {
+	outputs, err := DetermineOutputs(fact, taskOutputs, outputMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, output := range outputs {
+		if name, ok := step.OutputMapping[output.Name]; ok {
+			outputs[i].Name = name
+			outputs[i].Plan.ArtifactOutput.Name = name
+		}
+	}
+
+	return outputs, nil
+}
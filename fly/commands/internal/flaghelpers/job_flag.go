@@ -7,27 +7,48 @@ import (
 
 	"github.com/jessevdk/go-flags"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
+// JobFlag identifies a job of a pipeline, or of a pipeline instance when the
+// pipeline is followed by its instance vars, e.g.
+// <pipeline>/branch:master/<job>
 type JobFlag struct {
-	PipelineName string
-	JobName      string
+	PipelineName         string
+	PipelineInstanceVars atc.InstanceVars
+	JobName              string
 }
 
 func (job *JobFlag) UnmarshalFlag(value string) error {
 	vs := strings.SplitN(value, "/", -1)
 
-	if len(vs) != 2 {
+	if len(vs) < 2 {
 		return errors.New("argument format should be <pipeline>/<job>")
 	}
 
-	job.PipelineName = vs[0]
-	job.JobName = vs[1]
+	pipeline := PipelineFlag{}
+	err := pipeline.UnmarshalFlag(strings.Join(vs[:len(vs)-1], "/"))
+	if err != nil {
+		return err
+	}
+
+	err = pipeline.Validate()
+	if err != nil {
+		return errors.New("argument format should be <pipeline>/<job>")
+	}
+
+	job.PipelineName = pipeline.Name
+	job.PipelineInstanceVars = pipeline.InstanceVars
+	job.JobName = vs[len(vs)-1]
 
 	return nil
 }
 
+func (job JobFlag) PipelineRef() atc.PipelineRef {
+	return atc.PipelineRef{Name: job.PipelineName, InstanceVars: job.PipelineInstanceVars}
+}
+
 func (flag *JobFlag) Complete(match string) []flags.Completion {
 	fly := parseFlags()
 
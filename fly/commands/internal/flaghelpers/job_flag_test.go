@@ -1,6 +1,7 @@
 package flaghelpers_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 
 	. "github.com/onsi/ginkgo"
@@ -16,4 +17,50 @@ var _ = Describe("JobFlag", func() {
 			Expect(err).To(MatchError("argument format should be <pipeline>/<job>"))
 		})
 	})
+
+	Context("when a pipeline and a job are specified", func() {
+		It("refers to the job of the pipeline", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("some-pipeline/some-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(jobFlag.JobName).To(Equal("some-job"))
+			Expect(jobFlag.PipelineRef()).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+		})
+	})
+
+	Context("when the pipeline has instance vars", func() {
+		It("refers to the job of the pipeline instance", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("some-pipeline/branch:master,version:1/some-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(jobFlag.JobName).To(Equal("some-job"))
+			Expect(jobFlag.PipelineRef()).To(Equal(atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "master", "version": float64(1)},
+			}))
+		})
+
+		It("allows a '/' in the instance vars", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("some-pipeline/branch:feature/foo/some-job")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(jobFlag.JobName).To(Equal("some-job"))
+			Expect(jobFlag.PipelineRef()).To(Equal(atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature/foo"},
+			}))
+		})
+	})
+
+	Context("when there are too many segments without instance vars", func() {
+		It("displays an error message", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag("some-pipeline/some-group/some-job")
+			Expect(err).To(MatchError("argument format should be <pipeline>/<job>"))
+		})
+	})
 })
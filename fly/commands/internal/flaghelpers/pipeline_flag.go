@@ -2,22 +2,80 @@ package flaghelpers
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/jessevdk/go-flags"
+	"sigs.k8s.io/yaml"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
-type PipelineFlag string
+// PipelineFlag identifies a pipeline, or an instance of a pipeline when
+// followed by its instance vars, e.g. <pipeline>/branch:master,version:1
+type PipelineFlag struct {
+	Name         string
+	InstanceVars atc.InstanceVars
+}
+
+func (flag *PipelineFlag) UnmarshalFlag(value string) error {
+	flag.Name = value
+	flag.InstanceVars = nil
+
+	vs := strings.SplitN(value, "/", 2)
+	if len(vs) != 2 {
+		return nil
+	}
+
+	instanceVars := atc.InstanceVars{}
+	for _, pair := range strings.Split(vs[1], ",") {
+		kv := strings.SplitN(pair, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			// not instance vars; leave the '/' in the name so that Validate
+			// rejects it
+			return nil
+		}
+
+		var v interface{}
+		err := yaml.Unmarshal([]byte(kv[1]), &v)
+		if err != nil {
+			return fmt.Errorf("invalid value for instance var '%s': %s", kv[0], err)
+		}
+
+		instanceVars[kv[0]] = v
+	}
+
+	flag.Name = vs[0]
+	flag.InstanceVars = instanceVars
+
+	return nil
+}
 
 func (flag *PipelineFlag) Validate() error {
-	if strings.Contains(string(*flag), "/") {
+	if strings.Contains(flag.Name, "/") {
 		return errors.New("pipeline name cannot contain '/'")
 	}
 	return nil
 }
 
+// ValidateName is like Validate, but also rejects instance vars for flags
+// which only take a plain pipeline name.
+func (flag *PipelineFlag) ValidateName() error {
+	if len(flag.InstanceVars) > 0 {
+		return errors.New("pipeline name cannot contain '/'")
+	}
+	return flag.Validate()
+}
+
+func (flag PipelineFlag) Ref() atc.PipelineRef {
+	return atc.PipelineRef{Name: flag.Name, InstanceVars: flag.InstanceVars}
+}
+
+func (flag PipelineFlag) String() string {
+	return flag.Ref().String()
+}
+
 func (flag *PipelineFlag) Complete(match string) []flags.Completion {
 	fly := parseFlags()
 
@@ -0,0 +1,54 @@
+package flaghelpers_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineFlag", func() {
+	Context("when there is only a pipeline name specified", func() {
+		It("refers to the pipeline", func() {
+			pipelineFlag := &PipelineFlag{}
+
+			err := pipelineFlag.UnmarshalFlag("some-pipeline")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineFlag.Validate()).To(Succeed())
+			Expect(pipelineFlag.Ref()).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+		})
+	})
+
+	Context("when instance vars are specified", func() {
+		It("refers to the pipeline instance", func() {
+			pipelineFlag := &PipelineFlag{}
+
+			err := pipelineFlag.UnmarshalFlag("some-pipeline/branch:master,version:1")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineFlag.Validate()).To(Succeed())
+			Expect(pipelineFlag.Ref()).To(Equal(atc.PipelineRef{
+				Name:         "some-pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "master", "version": float64(1)},
+			}))
+		})
+
+		It("is not a valid plain pipeline name", func() {
+			pipelineFlag := &PipelineFlag{}
+
+			err := pipelineFlag.UnmarshalFlag("some-pipeline/branch:master")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineFlag.ValidateName()).To(MatchError("pipeline name cannot contain '/'"))
+		})
+	})
+
+	Context("when the pipeline name contains a '/'", func() {
+		It("fails validation", func() {
+			pipelineFlag := &PipelineFlag{}
+
+			err := pipelineFlag.UnmarshalFlag("forbidden/pipelinename")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(pipelineFlag.Validate()).To(MatchError("pipeline name cannot contain '/'"))
+		})
+	})
+})
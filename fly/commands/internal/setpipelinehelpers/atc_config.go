@@ -1,6 +1,7 @@
 package setpipelinehelpers
 
 import (
+	"encoding/json"
 	"fmt"
 	"net/url"
 	"os"
@@ -17,7 +18,7 @@ import (
 )
 
 type ATCConfig struct {
-	PipelineName     string
+	PipelineRef      atc.PipelineRef
 	Team             concourse.Team
 	TargetName       rc.TargetName
 	Target           string
@@ -45,13 +46,28 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return err
 	}
 
-	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineName)
+	var newConfig atc.Config
+	err = yaml.Unmarshal([]byte(evaluatedTemplate), &newConfig)
 	if err != nil {
 		return err
 	}
 
-	var newConfig atc.Config
-	err = yaml.Unmarshal([]byte(evaluatedTemplate), &newConfig)
+	return atcConfig.apply(newConfig, evaluatedTemplate)
+}
+
+// Rollback sets the pipeline back to a config it was saved with before. Like
+// Set, it shows the changes and asks for confirmation first.
+func (atcConfig ATCConfig) Rollback(config atc.Config) error {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	return atcConfig.apply(config, payload)
+}
+
+func (atcConfig ATCConfig) apply(newConfig atc.Config, payload []byte) error {
+	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
 	if err != nil {
 		return err
 	}
@@ -69,9 +85,9 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 	}
 
 	created, updated, warnings, err := atcConfig.Team.CreateOrUpdatePipelineConfig(
-		atcConfig.PipelineName,
+		atcConfig.PipelineRef,
 		existingConfigVersion,
-		evaluatedTemplate,
+		payload,
 		atcConfig.CheckCredentials,
 	)
 	if err != nil {
@@ -87,7 +103,12 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 }
 
 func (atcConfig ATCConfig) UnpausePipelineCommand() string {
-	return fmt.Sprintf("%s -t %s unpause-pipeline -p %s", os.Args[0], atcConfig.TargetName, atcConfig.PipelineName)
+	pipeline := atcConfig.PipelineRef.String()
+	if len(atcConfig.PipelineRef.InstanceVars) > 0 {
+		pipeline = "'" + pipeline + "'"
+	}
+
+	return fmt.Sprintf("%s -t %s unpause-pipeline -p %s", os.Args[0], atcConfig.TargetName, pipeline)
 }
 
 func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool) {
@@ -100,11 +121,13 @@ func (atcConfig ATCConfig) showPipelineUpdateResult(created bool, updated bool)
 			fmt.Println("Could not parse targetURL")
 		}
 
-		pipelineURL, err := url.Parse("/teams/" + atcConfig.Team.Name() + "/pipelines/" + atcConfig.PipelineName)
+		pipelineURL, err := url.Parse("/teams/" + atcConfig.Team.Name() + "/pipelines/" + atcConfig.PipelineRef.Name)
 		if err != nil {
 			fmt.Println("Could not parse pipelineURL")
 		}
 
+		pipelineURL.RawQuery = atcConfig.PipelineRef.QueryParams().Encode()
+
 		fmt.Println("pipeline created!")
 		fmt.Printf("you can view your pipeline here: %s\n", targetURL.ResolveReference(pipelineURL))
 		fmt.Println("")
@@ -57,7 +57,7 @@ func (command *JobsCommand) Execute([]string) error {
 		return nil
 	}
 
-	headers = []string{"name", "paused", "status", "next"}
+	headers = []string{"name", "paused", "status", "next", "schedule"}
 	table := ui.Table{Headers: ui.TableRow{}}
 	for _, h := range headers {
 		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
@@ -115,6 +115,14 @@ func (command *JobsCommand) Execute([]string) error {
 		}
 		row = append(row, nextColumn)
 
+		var scheduleColumn ui.TableCell
+		if p.Schedule != nil {
+			scheduleColumn.Contents = p.Schedule.String()
+		} else {
+			scheduleColumn.Contents = "n/a"
+		}
+		row = append(row, scheduleColumn)
+
 		table.Data = append(table.Data, row)
 	}
 
@@ -27,6 +27,7 @@ type LoginCommand struct {
 	TeamName    string       `short:"n" long:"team-name" description:"Team to authenticate with"`
 	CACert      atc.PathFlag `long:"ca-cert" description:"Path to Concourse PEM-encoded CA certificate file."`
 	OpenBrowser bool         `short:"b" long:"open-browser" description:"Open browser to the auth endpoint"`
+	APIToken    string       `long:"api-token" description:"API token to authenticate with, as created by create-api-token"`
 
 	BrowserOnly bool
 }
@@ -115,7 +116,9 @@ func (command *LoginCommand) Execute(args []string) error {
 		// Legacy Auth Support
 		tokenType, tokenValue, err = command.legacyAuth(target, command.BrowserOnly)
 	} else {
-		if command.Username != "" && command.Password != "" {
+		if command.APIToken != "" {
+			tokenType, tokenValue, err = command.apiToken(command.APIToken)
+		} else if command.Username != "" && command.Password != "" {
 			tokenType, tokenValue, err = command.passwordGrant(client, command.Username, command.Password)
 		} else {
 			tokenType, tokenValue, err = command.authCodeGrant(client.URL(), command.BrowserOnly)
@@ -167,6 +170,14 @@ func (command *LoginCommand) Execute(args []string) error {
 	)
 }
 
+func (command *LoginCommand) apiToken(token string) (string, string, error) {
+	if !atc.IsAPIToken(token) {
+		return "", "", errors.New("not an API token; API tokens start with '" + atc.APITokenPrefix + "'")
+	}
+
+	return "Bearer", token, nil
+}
+
 func (command *LoginCommand) passwordGrant(client concourse.Client, username, password string) (string, string, error) {
 
 	oauth2Config := oauth2.Config{
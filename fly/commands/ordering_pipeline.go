@@ -21,11 +21,11 @@ func (command *OrderPipelinesCommand) Validate() ([]string, error) {
 	var pipelines []string
 
 	for _, p := range command.Pipelines {
-		err := p.Validate()
+		err := p.ValidateName()
 		if err != nil {
 			return nil, err
 		}
-		pipelines = append(pipelines, string(p))
+		pipelines = append(pipelines, p.Name)
 	}
 	return pipelines, nil
 
@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
@@ -18,11 +19,11 @@ func (command *PausePipelineCommand) Validate() error {
 }
 
 func (command *PausePipelineCommand) Execute(args []string) error {
-	if string(command.Pipeline) == "" && !command.All {
+	if command.Pipeline.Name == "" && !command.All {
 		displayhelpers.Failf("one of the flags '-p, --pipeline' or '-a, --all' is required")
 	}
 
-	if string(command.Pipeline) != "" && command.All {
+	if command.Pipeline.Name != "" && command.All {
 		displayhelpers.Failf("only one of the flags '-p, --pipeline' or '-a, --all' is allowed")
 	}
 
@@ -41,9 +42,9 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	var pipelineNames []string
-	if string(command.Pipeline) != "" {
-		pipelineNames = []string{string(command.Pipeline)}
+	var pipelineRefs []atc.PipelineRef
+	if command.Pipeline.Name != "" {
+		pipelineRefs = []atc.PipelineRef{command.Pipeline.Ref()}
 	}
 
 	if command.All {
@@ -53,20 +54,20 @@ func (command *PausePipelineCommand) Execute(args []string) error {
 		}
 
 		for _, pipeline := range pipelines {
-			pipelineNames = append(pipelineNames, pipeline.Name)
+			pipelineRefs = append(pipelineRefs, pipeline.Ref())
 		}
 	}
 
-	for _, pipelineName := range pipelineNames {
-		found, err := target.Team().PausePipeline(pipelineName)
+	for _, pipelineRef := range pipelineRefs {
+		found, err := target.Team().PausePipeline(pipelineRef)
 		if err != nil {
 			return err
 		}
 
 		if found {
-			fmt.Printf("paused '%s'\n", pipelineName)
+			fmt.Printf("paused '%s'\n", pipelineRef.String())
 		} else {
-			displayhelpers.Failf("pipeline '%s' not found\n", pipelineName)
+			displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
 		}
 	}
 
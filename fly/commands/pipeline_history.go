@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type PipelineHistoryCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to show the config history of"`
+	Version  int                      `long:"version" description:"Show the changes made by this config version instead of listing the versions"`
+	From     int                      `long:"from" description:"Show the changes since this config version instead of since the version before --version"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *PipelineHistoryCommand) Validate() error {
+	if command.From != 0 && command.Version == 0 {
+		return errors.New("--from can only be given with --version")
+	}
+
+	return command.Pipeline.Validate()
+}
+
+func (command *PipelineHistoryCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	if command.Version != 0 {
+		diff, found, err := target.Team().DiffPipelineConfigVersions(pipelineRef, command.From, command.Version)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return errors.New("pipeline or config version not found")
+		}
+
+		if command.Json {
+			return displayhelpers.JsonPrint(diff)
+		}
+
+		return displayConfigDiff(diff)
+	}
+
+	versions, found, err := target.Team().PipelineConfigVersions(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("pipeline not found")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(versions)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "saved at", Color: color.New(color.Bold)},
+			{Contents: "saved by", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, version := range versions {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(version.Version)},
+			{Contents: time.Unix(version.SavedAt, 0).Format(timeDateLayout)},
+			configAuthorCell(version),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func configAuthorCell(version atc.PipelineConfigVersion) ui.TableCell {
+	switch {
+	case version.SavedByBuild != 0:
+		return ui.TableCell{Contents: fmt.Sprintf("build %d", version.SavedByBuild)}
+	case version.SavedBy != "":
+		return ui.TableCell{Contents: version.SavedBy}
+	default:
+		return ui.TableCell{Contents: "unknown", Color: ui.OffColor}
+	}
+}
+
+func displayConfigDiff(diff atc.PipelineConfigDiff) error {
+	if diff.From.Version == 0 {
+		fmt.Printf("changes made by version %d, the first version:\n\n", diff.To.Version)
+	} else {
+		fmt.Printf("changes from version %d to version %d:\n\n", diff.From.Version, diff.To.Version)
+	}
+
+	from, to := atc.Config{}, atc.Config{}
+	if diff.From.Config != nil {
+		from = *diff.From.Config
+	}
+
+	if diff.To.Config != nil {
+		to = *diff.To.Config
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+	if !from.Diff(stdout, to) {
+		fmt.Println("no changes")
+	}
+
+	return nil
+}
@@ -19,7 +19,7 @@ func (command *RenamePipelineCommand) Validate() error {
 		return err
 	}
 
-	return command.Name.Validate()
+	return command.Name.ValidateName()
 }
 
 func (command *RenamePipelineCommand) Execute([]string) error {
@@ -38,16 +38,16 @@ func (command *RenamePipelineCommand) Execute([]string) error {
 		return err
 	}
 
-	oldName := string(command.Pipeline)
-	newName := string(command.Name)
+	pipelineRef := command.Pipeline.Ref()
+	newName := command.Name.Name
 
-	found, err := target.Team().RenamePipeline(oldName, newName)
+	found, err := target.Team().RenamePipeline(pipelineRef, newName)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		displayhelpers.Failf("pipeline '%s' not found\n", oldName)
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
 		return nil
 	}
 
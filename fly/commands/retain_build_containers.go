@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type RetainBuildContainersCommand struct {
+	Job      flaghelpers.JobFlag `short:"j" long:"job" value-name:"PIPELINE/JOB"   description:"Name of the job the build belongs to"`
+	Build    string              `short:"b" long:"build" required:"true" description:"If job is specified: build number. If job not specified: build id"`
+	Duration time.Duration       `short:"d" long:"duration" default:"1h" description:"How long to keep the containers around once the build has failed"`
+}
+
+func (command *RetainBuildContainersCommand) Execute([]string) error {
+	if command.Duration <= 0 {
+		return fmt.Errorf("duration must be positive")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineName == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineName, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	build, err = target.Client().RetainBuildContainers(strconv.Itoa(build.ID), command.Duration)
+	if err != nil {
+		return err
+	}
+
+	if build.ContainersRetainedUntil != 0 {
+		fmt.Printf("containers retained until %s\n", time.Unix(build.ContainersRetainedUntil, 0).Format(time.RFC1123Z))
+	} else {
+		fmt.Printf("containers will be retained for %s if the build fails\n", command.Duration)
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type RevokeAPITokenCommand struct {
+	Name string `short:"n" long:"name" required:"true" description:"Name of the token to revoke"`
+	Team string `long:"team" description:"Name of the team the token belongs to, if different from the target default"`
+}
+
+func (command *RevokeAPITokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	found, err := team.RevokeAPIToken(command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("token '%s' not found on team %s", command.Name, team.Name())
+	}
+
+	fmt.Printf("revoked '%s'\n", command.Name)
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/setpipelinehelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/mgutz/ansi"
+)
+
+type RollbackPipelineCommand struct {
+	SkipInteractive  bool `short:"n"  long:"non-interactive"  description:"Skips interactions, uses default values"`
+	DisableAnsiColor bool `long:"no-color"  description:"Disable color output"`
+
+	CheckCredentials bool `long:"check-creds"  description:"Validate credential variables against credential manager"`
+
+	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline"  required:"true"  description:"Pipeline to roll back"`
+	Version  int                      `long:"version"  required:"true"  description:"Config version to roll back to, as listed by pipeline-history"`
+}
+
+func (command *RollbackPipelineCommand) Validate() error {
+	return command.Pipeline.Validate()
+}
+
+func (command *RollbackPipelineCommand) Execute(args []string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	ansi.DisableColors(command.DisableAnsiColor)
+
+	pipelineRef := command.Pipeline.Ref()
+
+	version, found, err := target.Team().PipelineConfigVersion(pipelineRef, command.Version)
+	if err != nil {
+		return err
+	}
+
+	if !found || version.Config == nil {
+		return errors.New("pipeline or config version not found")
+	}
+
+	fmt.Printf("rolling back to version %d\n\n", version.Version)
+
+	atcConfig := setpipelinehelpers.ATCConfig{
+		Team:             target.Team(),
+		PipelineRef:      pipelineRef,
+		TargetName:       Fly.Target,
+		Target:           target.Client().URL(),
+		SkipInteraction:  command.SkipInteractive,
+		CheckCredentials: command.CheckCredentials,
+	}
+
+	return atcConfig.Rollback(*version.Config)
+}
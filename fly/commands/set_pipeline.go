@@ -18,6 +18,8 @@ type SetPipelineCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p"  long:"pipeline"  required:"true"  description:"Pipeline to configure"`
 	Config   atc.PathFlag             `short:"c"  long:"config"    required:"true"  description:"Pipeline configuration file"`
 
+	InstanceVars []flaghelpers.YAMLVariablePairFlag `short:"i"  long:"instance-var"  value-name:"[NAME=YAML]"  description:"Specify a YAML value to set for an instance var of the pipeline. Instance vars identify the pipeline instance and are also used for filling in template values"`
+
 	Var     []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    description:"Specify a YAML value to set for a variable in the pipeline"`
 
@@ -35,7 +37,25 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 	}
 	configPath := command.Config
 	templateVariablesFiles := command.VarsFrom
-	pipelineName := string(command.Pipeline)
+	yamlVars := command.YAMLVar
+
+	pipelineRef := command.Pipeline.Ref()
+	if len(command.InstanceVars) > 0 {
+		instanceVars := atc.InstanceVars{}
+		for k, v := range pipelineRef.InstanceVars {
+			instanceVars[k] = v
+		}
+		for _, iv := range command.InstanceVars {
+			instanceVars[iv.Name] = iv.Value
+		}
+		pipelineRef.InstanceVars = instanceVars
+	}
+
+	// instance vars are also template vars, and take precedence over the
+	// other vars so that the config always matches the instance
+	for k, v := range pipelineRef.InstanceVars {
+		yamlVars = append(yamlVars, flaghelpers.YAMLVariablePairFlag{Name: k, Value: v})
+	}
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -51,13 +71,13 @@ func (command *SetPipelineCommand) Execute(args []string) error {
 
 	atcConfig := setpipelinehelpers.ATCConfig{
 		Team:             target.Team(),
-		PipelineName:     pipelineName,
+		PipelineRef:      pipelineRef,
 		TargetName:       Fly.Target,
 		Target:           target.Client().URL(),
 		SkipInteraction:  command.SkipInteractive,
 		CheckCredentials: command.CheckCredentials,
 	}
 
-	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, command.YAMLVar)
+	yamlTemplateWithParams := templatehelpers.NewYamlTemplateWithParams(configPath, templateVariablesFiles, command.Var, yamlVars)
 	return atcConfig.Set(yamlTemplateWithParams)
 }
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type SetQuotaCommand struct {
+	Team    string `short:"n" long:"team-name" description:"Set the quota of this team, if different from the target default"`
+	Cluster bool   `long:"cluster" description:"Set the quota of the whole cluster"`
+
+	Builds         *int `long:"builds" value-name:"COUNT" description:"Maximum number of builds running at once. 0 means no limit."`
+	TaskContainers *int `long:"task-containers" value-name:"COUNT" description:"Maximum number of task containers at once. 0 means no limit."`
+}
+
+func (command *SetQuotaCommand) Execute([]string) error {
+	if command.Cluster && command.Team != "" {
+		return errors.New("Cannot specify both --cluster and --team-name")
+	}
+
+	if command.Builds == nil && command.TaskContainers == nil {
+		return errors.New("Must specify --builds, --task-containers or both")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var (
+		team  concourse.Team
+		usage atc.QuotaUsage
+	)
+
+	if command.Cluster {
+		usage, err = target.Client().ClusterQuota()
+	} else {
+		team = target.Team()
+		if command.Team != "" {
+			team, err = target.FindTeam(command.Team)
+			if err != nil {
+				return err
+			}
+		}
+
+		usage, err = team.Quota()
+	}
+	if err != nil {
+		return err
+	}
+
+	// limits which weren't given are left as they are
+	quota := usage.Quota
+	if command.Builds != nil {
+		quota.Builds = *command.Builds
+	}
+
+	if command.TaskContainers != nil {
+		quota.TaskContainers = *command.TaskContainers
+	}
+
+	err = quota.Validate()
+	if err != nil {
+		return err
+	}
+
+	if command.Cluster {
+		err = target.Client().SetClusterQuota(quota)
+	} else {
+		err = team.SetQuota(quota)
+	}
+	if err != nil {
+		if err == concourse.ErrForbidden {
+			return errors.New("only admins can set quotas")
+		}
+
+		return err
+	}
+
+	if command.Cluster {
+		fmt.Println("quota set for the cluster")
+	} else {
+		fmt.Printf("quota set for team '%s'\n", team.Name())
+	}
+
+	return nil
+}
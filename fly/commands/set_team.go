@@ -47,6 +47,20 @@ func (command *SetTeamCommand) Execute([]string) error {
 		os.Exit(1)
 	}
 
+	customRoles, err := command.AuthFlags.Roles()
+	if err != nil {
+		fmt.Fprintln(ui.Stderr, "error:", err)
+		os.Exit(1)
+	}
+
+	team := atc.Team{Auth: authRoles, Roles: customRoles}
+
+	err = team.Validate()
+	if err != nil {
+		fmt.Fprintln(ui.Stderr, "error:", err)
+		os.Exit(1)
+	}
+
 	roles := []string{}
 	for role := range authRoles {
 		roles = append(roles, role)
@@ -80,6 +94,24 @@ func (command *SetTeamCommand) Execute([]string) error {
 		} else {
 			fmt.Printf("    %s\n", ui.OffColor.Sprint("none"))
 		}
+
+		if customRole, found := customRoles.Lookup(role); found {
+			fmt.Println()
+			fmt.Printf("  actions:\n")
+			for _, action := range customRole.Actions {
+				fmt.Printf("  - %s\n", action)
+			}
+
+			fmt.Println()
+			fmt.Printf("  pipelines:\n")
+			if len(customRole.Pipelines) > 0 {
+				for _, pipeline := range customRole.Pipelines {
+					fmt.Printf("  - %s\n", pipeline)
+				}
+			} else {
+				fmt.Printf("    %s\n", ui.OffColor.Sprint("all"))
+			}
+		}
 	}
 
 	confirm := true
@@ -95,8 +127,6 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: authRoles}
-
 	_, created, updated, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
 		return err
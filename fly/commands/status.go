@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	jwt "github.com/dgrijalva/jwt-go"
@@ -24,9 +25,12 @@ func (c *StatusCommand) Execute([]string) error {
 	}
 
 	if tToken != nil {
-		_, err := jwt.Parse(tToken.Value, func(token *jwt.Token) (interface{}, error) {
-			return nil, token.Claims.Valid()
-		})
+		var err error
+		if !atc.IsAPIToken(tToken.Value) {
+			_, err = jwt.Parse(tToken.Value, func(token *jwt.Token) (interface{}, error) {
+				return nil, token.Claims.Valid()
+			})
+		}
 
 		if err != nil && err.Error() != jwt.ErrInvalidKeyType.Error() {
 			displayhelpers.FailWithErrorf("please login again.\n\ntoken validation failed with error ", err)
@@ -0,0 +1,55 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type UncordonWorkerCommand struct {
+	Workers []flaghelpers.WorkerFlag `short:"w" long:"worker" description:"Worker to uncordon. Can be specified multiple times."`
+	Tags    []string                 `long:"tag" description:"Remove the cordon of the workers with this tag. Can be specified multiple times, giving the same tags as the cordon."`
+}
+
+func (command *UncordonWorkerCommand) Execute(args []string) error {
+	if len(command.Workers) == 0 && len(command.Tags) == 0 {
+		displayhelpers.Failf("Either a worker name or --tag are required")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	for _, worker := range command.Workers {
+		err = target.Client().UncordonWorker(worker.Name())
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("uncordoned '%s'\n", worker.Name())
+	}
+
+	if len(command.Tags) > 0 {
+		found, err := target.Client().UncordonTaggedWorkers(command.Tags)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return fmt.Errorf("workers tagged '%s' are not cordoned", strings.Join(command.Tags, ", "))
+		}
+
+		fmt.Printf("uncordoned workers tagged '%s'\n", strings.Join(command.Tags, ", "))
+	}
+
+	return nil
+}
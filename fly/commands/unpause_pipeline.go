@@ -3,6 +3,7 @@ package commands
 import (
 	"fmt"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
@@ -20,11 +21,11 @@ func (command *UnpausePipelineCommand) Validate() error {
 }
 
 func (command *UnpausePipelineCommand) Execute(args []string) error {
-	if string(command.Pipeline) == "" && !command.All {
+	if command.Pipeline.Name == "" && !command.All {
 		displayhelpers.Failf("one of the flags '-p, --pipeline' or '-a, --all' is required")
 	}
 
-	if string(command.Pipeline) != "" && command.All {
+	if command.Pipeline.Name != "" && command.All {
 		displayhelpers.Failf("only one of the flags '-p, --pipeline' or '-a, --all' is allowed")
 	}
 
@@ -54,9 +55,9 @@ func (command *UnpausePipelineCommand) Execute(args []string) error {
 		team = target.Team()
 	}
 
-	var pipelineNames []string
-	if string(command.Pipeline) != "" {
-		pipelineNames = []string{string(command.Pipeline)}
+	var pipelineRefs []atc.PipelineRef
+	if command.Pipeline.Name != "" {
+		pipelineRefs = []atc.PipelineRef{command.Pipeline.Ref()}
 	}
 
 	if command.All {
@@ -66,20 +67,20 @@ func (command *UnpausePipelineCommand) Execute(args []string) error {
 		}
 
 		for _, pipeline := range pipelines {
-			pipelineNames = append(pipelineNames, pipeline.Name)
+			pipelineRefs = append(pipelineRefs, pipeline.Ref())
 		}
 	}
 
-	for _, pipelineName := range pipelineNames {
-		found, err := team.UnpausePipeline(pipelineName)
+	for _, pipelineRef := range pipelineRefs {
+		found, err := team.UnpausePipeline(pipelineRef)
 		if err != nil {
 			return err
 		}
 
 		if found {
-			fmt.Printf("unpaused '%s'\n", pipelineName)
+			fmt.Printf("unpaused '%s'\n", pipelineRef.String())
 		} else {
-			displayhelpers.Failf("pipeline '%s' not found\n", pipelineName)
+			displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
 		}
 	}
 
@@ -132,6 +132,9 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			ui.TableCell{Contents: "baggageclaim url", Color: color.New(color.Bold)},
 			ui.TableCell{Contents: "active tasks", Color: color.New(color.Bold)},
 			ui.TableCell{Contents: "resource types", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "cpu", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "memory", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "disk", Color: color.New(color.Bold)},
 		)
 	}
 
@@ -144,7 +147,7 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			{Contents: w.Platform},
 			stringOrDefault(strings.Join(w.Tags, ", ")),
 			stringOrDefault(w.Team),
-			{Contents: w.State},
+			w.stateCell(),
 			w.versionCell(),
 			w.ageCell(),
 		}
@@ -159,6 +162,7 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			row = append(row, stringOrDefault(w.BaggageclaimURL))
 			row = append(row, stringOrDefault(strconv.Itoa(w.ActiveTasks)))
 			row = append(row, stringOrDefault(strings.Join(resourceTypes, ", ")))
+			row = append(row, w.usageCells()...)
 		}
 
 		table.Data = append(table.Data, row)
@@ -179,6 +183,15 @@ type worker struct {
 	outdated bool
 }
 
+func (w *worker) stateCell() ui.TableCell {
+	column := ui.TableCell{Contents: w.State}
+	if w.Cordon != nil && w.Cordon.ActiveAt(time.Now().Unix()) {
+		column.Contents += ", cordoned"
+	}
+
+	return column
+}
+
 func (w *worker) versionCell() ui.TableCell {
 	var column ui.TableCell
 	if w.Version != "" {
@@ -195,6 +208,54 @@ func (w *worker) versionCell() ui.TableCell {
 	return column
 }
 
+func (w *worker) usageCells() []ui.TableCell {
+	if w.Usage == nil {
+		return []ui.TableCell{stringOrDefault(""), stringOrDefault(""), stringOrDefault("")}
+	}
+
+	return []ui.TableCell{
+		{Contents: formatUsedCPU(w.Usage.CPU, w.Usage.CPUCapacity)},
+		{Contents: formatUsedBytes(w.Usage.Memory, w.Usage.MemoryCapacity)},
+		{Contents: formatUsedBytes(w.Usage.Disk, w.Usage.DiskCapacity)},
+	}
+}
+
+func formatUsedCPU(used, capacity uint64) string {
+	if capacity == 0 {
+		return fmt.Sprintf("%dm", used)
+	}
+
+	return fmt.Sprintf("%dm/%dm", used, capacity)
+}
+
+func formatUsedBytes(used, capacity uint64) string {
+	if capacity == 0 {
+		return formatBytes(used)
+	}
+
+	return formatBytes(used) + "/" + formatBytes(capacity)
+}
+
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	value := float64(bytes)
+	prefix := -1
+	for value >= unit && prefix < len("KMGTPE")-1 {
+		value /= unit
+		prefix++
+	}
+
+	if value < 10 {
+		return fmt.Sprintf("%.1f%ciB", value, "KMGTPE"[prefix])
+	}
+
+	return fmt.Sprintf("%.0f%ciB", value, "KMGTPE"[prefix])
+}
+
 func (w *worker) ageCell() ui.TableCell {
 	var column ui.TableCell
 
@@ -0,0 +1,162 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("api-tokens", func() {
+		expiresAt := time.Now().Add(time.Hour).Unix()
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.APIToken{
+						{Name: "ci", TeamName: "main", Role: "member", ServiceAccount: "ci-bot", ExpiresAt: expiresAt, LastUsedAt: 100},
+						{Name: "laptop", TeamName: "main", Role: "viewer", User: "github:some-user", ExpiresAt: expiresAt},
+					}),
+				),
+			)
+		})
+
+		It("lists the team's tokens", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "api-tokens")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+			Eventually(sess).Should(gexec.Exit(0))
+
+			expires := time.Unix(expiresAt, 0).Format("2006-01-02@15:04:05-0700")
+
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "owner", Color: color.New(color.Bold)},
+					{Contents: "role", Color: color.New(color.Bold)},
+					{Contents: "expires", Color: color.New(color.Bold)},
+					{Contents: "last used", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{{Contents: "ci"}, {Contents: "service-account:ci-bot"}, {Contents: "member"}, {Contents: expires}, {Contents: time.Unix(100, 0).Format("2006-01-02@15:04:05-0700")}},
+					{{Contents: "laptop"}, {Contents: "github:some-user"}, {Contents: "viewer"}, {Contents: expires}, {Contents: "never", Color: color.New(color.Faint)}},
+				},
+			}))
+		})
+	})
+
+	Describe("create-api-token", func() {
+		Context("when the token is created", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+						func(w http.ResponseWriter, r *http.Request) {
+							var request atc.APIToken
+							Expect(json.NewDecoder(r.Body).Decode(&request)).To(Succeed())
+							Expect(request.Name).To(Equal("ci"))
+							Expect(request.Role).To(Equal("member"))
+							Expect(request.ServiceAccount).To(Equal("ci-bot"))
+							Expect(time.Unix(request.ExpiresAt, 0)).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+						},
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+							Name:           "ci",
+							TeamName:       "main",
+							Role:           "member",
+							ServiceAccount: "ci-bot",
+							ExpiresAt:      time.Now().Add(24 * time.Hour).Unix(),
+							Token:          "concourse_some-secret",
+						}),
+					),
+				)
+			})
+
+			It("prints the token", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-api-token", "-n", "ci", "-r", "member", "--service-account", "ci-bot", "--expires-in", "24h")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("created token 'ci'"))
+				Expect(sess.Out).To(gbytes.Say("concourse_some-secret"))
+			})
+		})
+
+		Context("when the token is not allowed", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-api-token", "-n", "ci", "-r", "owner")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+
+	Describe("revoke-api-token", func() {
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/tokens/ci"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("revokes it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-api-token", "-n", "ci")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("revoked 'ci'"))
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/tokens/ci"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-api-token", "-n", "ci")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("token 'ci' not found on team main"))
+			})
+		})
+	})
+})
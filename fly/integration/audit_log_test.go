@@ -0,0 +1,133 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("audit-log", func() {
+		var events []atc.AuditEvent
+
+		BeforeEach(func() {
+			events = []atc.AuditEvent{
+				{ID: 3, Time: 300, Action: atc.PauseJob, UserName: "some-user", TeamName: "main", PipelineName: "some-pipeline", JobName: "some-job", SourceIP: "10.0.0.1"},
+				{ID: 2, Time: 200, Action: atc.AbortBuild, UserName: "some-user", BuildID: 42, SourceIP: "10.0.0.1"},
+				{ID: 1, Time: 100, Action: atc.CheckResourceWebHook, TeamName: "main", PipelineName: "some-pipeline", ResourceName: "some-resource"},
+			}
+		})
+
+		Context("with no flags", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events", "limit=50&team=main"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, events),
+					),
+				)
+			})
+
+			It("lists the target team's events", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "audit-log")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				format := func(t int64) string {
+					return time.Unix(t, 0).Format("2006-01-02@15:04:05-0700")
+				}
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "time", Color: color.New(color.Bold)},
+						{Contents: "user", Color: color.New(color.Bold)},
+						{Contents: "action", Color: color.New(color.Bold)},
+						{Contents: "team", Color: color.New(color.Bold)},
+						{Contents: "target", Color: color.New(color.Bold)},
+						{Contents: "source ip", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: format(300)}, {Contents: "some-user"}, {Contents: "PauseJob"}, {Contents: "main"}, {Contents: "some-pipeline/some-job"}, {Contents: "10.0.0.1"}},
+						{{Contents: format(200)}, {Contents: "some-user"}, {Contents: "AbortBuild"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "build 42"}, {Contents: "10.0.0.1"}},
+						{{Contents: format(100)}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "CheckResourceWebHook"}, {Contents: "main"}, {Contents: "some-pipeline/some-resource"}, {Contents: "n/a", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+		})
+
+		Context("with filters", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events", "action=PauseJob&job=some-job&limit=2&pipeline=some-pipeline&user=some-user"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, events[:1], http.Header{
+							"Link": []string{`<https://example.com/api/v1/audit-events?limit=2&since=3>; rel="next"`},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events", "action=PauseJob&job=some-job&limit=1&pipeline=some-pipeline&since=3&user=some-user"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, events[1:2]),
+					),
+				)
+			})
+
+			It("pages through the matching events", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "audit-log", "--all-teams", "-u", "some-user", "--action", "PauseJob", "-j", "some-pipeline/some-job", "-c", "2", "--json")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{"id": 3, "time": 300, "action": "PauseJob", "user_name": "some-user", "team_name": "main", "pipeline_name": "some-pipeline", "job_name": "some-job", "source_ip": "10.0.0.1"},
+					{"id": 2, "time": 200, "action": "AbortBuild", "user_name": "some-user", "build_id": 42, "source_ip": "10.0.0.1"}
+				]`))
+			})
+		})
+
+		Context("when the user is not allowed to see the events", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "audit-log", "-n", "other-team")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("only admins and the team's owners can see its audit log"))
+			})
+		})
+
+		Context("when both --all-teams and --team are given", func() {
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "audit-log", "-a", "-n", "other-team")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("Cannot specify both --all-teams and --team"))
+			})
+		})
+	})
+})
@@ -0,0 +1,92 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Build containers", func() {
+	var expectedBuild = atc.Build{
+		ID:      23,
+		Name:    "42",
+		Status:  "failed",
+		JobName: "myjob",
+		APIURL:  "api/v1/builds/23",
+	}
+
+	Describe("retain-build-containers", func() {
+		Context("when the build exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/builds/23/containers/retain", "duration=2h0m0s"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{
+							ID:     23,
+							Status: "failed",
+						}),
+					),
+				)
+			})
+
+			It("retains the build's containers", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "retain-build-containers", "-b", "23", "--duration", "2h")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("containers will be retained for 2h0m0s if the build fails"))
+			})
+		})
+
+		Context("when the duration is not positive", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "retain-build-containers", "-b", "23", "--duration", "0s")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("duration must be positive"))
+			})
+		})
+	})
+
+	Describe("release-build-containers", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/myjob/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/23/containers/release"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("releases the build's containers", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "release-build-containers", "-j", "my-pipeline/myjob", "-b", "42")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("build containers released"))
+		})
+	})
+})
@@ -0,0 +1,157 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("build-notifications", func() {
+		var notifications []atc.BuildNotification
+
+		BeforeEach(func() {
+			notifications = []atc.BuildNotification{
+				{
+					ID:           1,
+					BuildID:      23,
+					Name:         "slack",
+					Status:       "delivered",
+					Attempts:     1,
+					ResponseCode: 200,
+					CreatedAt:    100,
+					DeliveredAt:  101,
+				},
+				{
+					ID:           2,
+					BuildID:      23,
+					Name:         "pager",
+					Status:       "failed",
+					Attempts:     5,
+					ResponseCode: 500,
+					LastError:    "unexpected response 500: oh no",
+					CreatedAt:    100,
+				},
+			}
+		})
+
+		Context("when the build exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/builds/42"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 23, Name: "42"}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/23/notifications"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, notifications),
+					),
+				)
+			})
+
+			It("lists the deliveries of the build's notifications", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "build-notifications", "-j", "some-pipeline/some-job", "-b", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "attempts", Color: color.New(color.Bold)},
+						{Contents: "response", Color: color.New(color.Bold)},
+						{Contents: "created", Color: color.New(color.Bold)},
+						{Contents: "delivered", Color: color.New(color.Bold)},
+						{Contents: "error", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "slack"},
+							{Contents: "delivered", Color: color.New(color.FgGreen)},
+							{Contents: "1"},
+							{Contents: "200"},
+							{Contents: time.Unix(100, 0).Format("2006-01-02@15:04:05-0700")},
+							{Contents: time.Unix(101, 0).Format("2006-01-02@15:04:05-0700")},
+							{Contents: "none", Color: color.New(color.Faint)},
+						},
+						{
+							{Contents: "pager"},
+							{Contents: "failed", Color: color.New(color.FgRed)},
+							{Contents: "5"},
+							{Contents: "500"},
+							{Contents: time.Unix(100, 0).Format("2006-01-02@15:04:05-0700")},
+							{Contents: "n/a", Color: color.New(color.Faint)},
+							{Contents: "unexpected response 500: oh no"},
+						},
+					},
+				}))
+			})
+
+			Context("when --json is given", func() {
+				It("prints the deliveries as JSON", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "build-notifications", "-j", "some-pipeline/some-job", "-b", "42", "--json")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out.Contents()).To(MatchJSON(`[
+						{
+							"id": 1,
+							"build_id": 23,
+							"name": "slack",
+							"status": "delivered",
+							"attempts": 1,
+							"response_code": 200,
+							"created_at": 100,
+							"delivered_at": 101
+						},
+						{
+							"id": 2,
+							"build_id": 23,
+							"name": "pager",
+							"status": "failed",
+							"attempts": 5,
+							"response_code": 500,
+							"last_error": "unexpected response 500: oh no",
+							"created_at": 100
+						}
+					]`))
+				})
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "build-notifications", "-b", "23")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("build does not exist"))
+			})
+		})
+	})
+})
@@ -0,0 +1,202 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("cordon-worker", func() {
+		Context("when a worker is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+						ghttp.VerifyJSONRepresenting(atc.WorkerCordon{}),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("cordons the worker", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cordon-worker", "-w", "some-worker")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("cordoned 'some-worker'"))
+			})
+		})
+
+		Context("when a window is given", func() {
+			var start, end time.Time
+
+			BeforeEach(func() {
+				start = time.Date(2030, 7, 28, 2, 0, 0, 0, time.Local)
+				end = time.Date(2030, 7, 28, 6, 0, 0, 0, time.Local)
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+						ghttp.VerifyJSONRepresenting(atc.WorkerCordon{
+							StartTime: start.Unix(),
+							EndTime:   end.Unix(),
+						}),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("cordons the worker during the window", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cordon-worker", "-w", "some-worker",
+					"--start", "2030-07-28 02:00:00",
+					"--end", "2030-07-28 06:00:00",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("cordoned 'some-worker'"))
+			})
+		})
+
+		Context("when the window ends before it starts", func() {
+			It("errors without cordoning anything", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cordon-worker", "-w", "some-worker",
+					"--start", "2030-07-28 06:00:00",
+					"--end", "2030-07-28 02:00:00",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("cordon must end after it starts"))
+			})
+		})
+
+		Context("when a time is malformed", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cordon-worker", "-w", "some-worker", "--end", "tomorrow")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("End time should be in the format: 2006-01-02 15:04:05"))
+			})
+		})
+
+		Context("when a tag is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/worker-cordons"),
+						ghttp.VerifyJSONRepresenting(atc.WorkerTagCordon{
+							Tags: []string{"gpu", "us-east"},
+						}),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("cordons the workers with all of the tags", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cordon-worker", "--tag", "gpu", "--tag", "us-east")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("cordoned workers tagged 'gpu, us-east'"))
+			})
+		})
+
+		Context("when neither a worker nor a tag is given", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "cordon-worker")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("Either a worker name or --tag are required"))
+			})
+		})
+	})
+
+	Describe("uncordon-worker", func() {
+		Context("when a worker is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/workers/some-worker/cordon"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("uncordons the worker", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "uncordon-worker", "-w", "some-worker")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("uncordoned 'some-worker'"))
+			})
+		})
+
+		Context("when a tag is given", func() {
+			var status int
+
+			BeforeEach(func() {
+				status = http.StatusOK
+			})
+
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/worker-cordons", "tag=gpu&tag=us-east"),
+						ghttp.RespondWith(status, nil),
+					),
+				)
+			})
+
+			It("removes the cordon of the tags", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "uncordon-worker", "--tag", "gpu", "--tag", "us-east")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("uncordoned workers tagged 'gpu, us-east'"))
+			})
+
+			Context("when the tags aren't cordoned", func() {
+				BeforeEach(func() {
+					status = http.StatusNotFound
+				})
+
+				It("errors", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "uncordon-worker", "--tag", "gpu", "--tag", "us-east")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(1))
+					Expect(sess.Err).To(gbytes.Say("workers tagged 'gpu, us-east' are not cordoned"))
+				})
+			})
+		})
+	})
+})
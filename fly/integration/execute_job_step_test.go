@@ -0,0 +1,373 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("execute --job --step", func() {
+		var buildDir string
+
+		var streaming chan struct{}
+		var events chan atc.Event
+		var uploading chan struct{}
+
+		var taskPlan atc.TaskPlan
+		var expectedPlan atc.Plan
+		var workerArtifact = atc.WorkerArtifact{
+			ID:   125,
+			Name: "source",
+		}
+
+		BeforeEach(func() {
+			var err error
+
+			buildDir, err = ioutil.TempDir("", "fly-build-dir")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = os.MkdirAll(filepath.Join(buildDir, "ci"), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(
+				filepath.Join(buildDir, "ci", "unit.yml"),
+				[]byte(`---
+platform: some-platform
+
+image_resource:
+  type: registry-image
+  source:
+    repository: ((repository))
+
+inputs:
+- name: source
+- name: some-other-input
+
+params:
+  FOO: bar
+
+run:
+  path: find
+  args: [.]
+`),
+				0644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			taskPlan = atc.TaskPlan{
+				Name:         "unit",
+				Privileged:   true,
+				Tags:         atc.Tags{"some-tag"},
+				ConfigPath:   "some-input/ci/unit.yml",
+				Vars:         atc.Params{"repository": "((registry))/ubuntu"},
+				Params:       atc.Params{"FOO": "((foo))"},
+				InputMapping: map[string]string{"source": "some-input"},
+			}
+
+			streaming = make(chan struct{})
+			events = make(chan atc.Event)
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(buildDir)
+		})
+
+		JustBeforeEach(func() {
+			uploading = make(chan struct{})
+
+			planFactory := atc.NewPlanFactory(0)
+
+			expectedPlan = planFactory.NewPlan(atc.DoPlan{
+				planFactory.NewPlan(atc.AggregatePlan{
+					planFactory.NewPlan(atc.ArtifactInputPlan{
+						ArtifactID: 125,
+						Name:       "some-input",
+					}),
+					planFactory.NewPlan(atc.GetPlan{
+						Name:    "some-other-input",
+						Type:    "git",
+						Source:  atc.Source{"uri": "https://example.com"},
+						Params:  atc.Params{"some": "other-params"},
+						Version: &atc.Version{"some": "other-version"},
+					}),
+				}),
+				planFactory.NewPlan(taskPlan),
+			})
+
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/tasks/unit/plan",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, taskPlan),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/inputs",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.BuildInput{
+					{
+						Name:     "some-input",
+						Type:     "git",
+						Resource: "some-resource",
+						Source:   atc.Source{"uri": "https://internet.com"},
+						Version:  atc.Version{"some": "version"},
+					},
+					{
+						Name:     "some-other-input",
+						Type:     "git",
+						Resource: "some-other-resource",
+						Source:   atc.Source{"uri": "https://example.com"},
+						Params:   atc.Params{"some": "other-params"},
+						Version:  atc.Version{"some": "other-version"},
+					},
+				}),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/resource-types",
+				ghttp.RespondWithJSONEncoded(http.StatusOK, nil),
+			)
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/artifacts",
+				ghttp.CombineHandlers(
+					func(w http.ResponseWriter, req *http.Request) {
+						close(uploading)
+					},
+					ghttp.RespondWithJSONEncoded(201, workerArtifact),
+				),
+			)
+			atcServer.RouteToHandler("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds",
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds"),
+					VerifyPlan(expectedPlan),
+					ghttp.RespondWith(201, `{"id":128}`),
+				),
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/events",
+				func(w http.ResponseWriter, r *http.Request) {
+					flusher := w.(http.Flusher)
+
+					w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+					w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+					w.Header().Add("Connection", "keep-alive")
+
+					w.WriteHeader(http.StatusOK)
+
+					flusher.Flush()
+
+					close(streaming)
+
+					id := 0
+
+					for e := range events {
+						payload, err := json.Marshal(event.Message{Event: e})
+						Expect(err).NotTo(HaveOccurred())
+
+						event := sse.Event{
+							ID:   fmt.Sprintf("%d", id),
+							Name: "event",
+							Data: payload,
+						}
+
+						err = event.Write(w)
+						Expect(err).NotTo(HaveOccurred())
+
+						flusher.Flush()
+
+						id++
+					}
+
+					err := sse.Event{
+						Name: "end",
+					}.Write(w)
+					Expect(err).NotTo(HaveOccurred())
+				},
+			)
+			atcServer.RouteToHandler("GET", "/api/v1/builds/128/artifacts",
+				ghttp.RespondWithJSONEncoded(200, []atc.WorkerArtifact{}),
+			)
+		})
+
+		It("runs the job's task step against the local inputs and the job's latest inputs", func() {
+			flyCmd := exec.Command(
+				flyPath, "-t", targetName, "e",
+				"--job", "some-pipeline/some-job",
+				"--step", "unit",
+				"--input", fmt.Sprintf("source=%s", buildDir),
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(uploading).Should(BeClosed())
+			Eventually(streaming).Should(BeClosed())
+
+			events <- event.Log{Payload: "sup"}
+			close(events)
+
+			Eventually(sess.Out).Should(gbytes.Say("sup"))
+
+			<-sess.Exited
+			Expect(sess).To(gexec.Exit(0))
+		})
+
+		Context("when the job belongs to a pipeline instance", func() {
+			var instanceVarsQuery = `instance_vars=%7B%22branch%22%3A%22master%22%7D`
+
+			JustBeforeEach(func() {
+				atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/tasks/unit/plan",
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/tasks/unit/plan", instanceVarsQuery),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, taskPlan),
+					),
+				)
+				atcServer.RouteToHandler("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds",
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/pipelines/some-pipeline/builds", instanceVarsQuery),
+						VerifyPlan(expectedPlan),
+						ghttp.RespondWith(201, `{"id":128}`),
+					),
+				)
+			})
+
+			It("runs the task step of the job in the pipeline instance", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--job", "some-pipeline/branch:master/some-job",
+					"--step", "unit",
+					"--input", fmt.Sprintf("source=%s", buildDir),
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(uploading).Should(BeClosed())
+				Eventually(streaming).Should(BeClosed())
+
+				close(events)
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(0))
+			})
+		})
+
+		Context("when the input containing the task config is not provided", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--job", "some-pipeline/some-job",
+					"--step", "unit",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("task config 'some-input/ci/unit.yml' is in artifact 'some-input', which must be provided as a local input"))
+			})
+		})
+
+		Context("when the step has an inline config", func() {
+			BeforeEach(func() {
+				taskPlan.ConfigPath = ""
+				taskPlan.Vars = nil
+				taskPlan.Config = &atc.TaskConfig{
+					Platform: "some-platform",
+					ImageResource: &atc.ImageResource{
+						Type:   "registry-image",
+						Source: atc.Source{"repository": "((registry))/ubuntu"},
+					},
+					Inputs: []atc.TaskInputConfig{
+						{Name: "source"},
+						{Name: "some-other-input"},
+					},
+					Run: atc.TaskRunConfig{Path: "find"},
+				}
+			})
+
+			It("runs the step's config without resolving its vars", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--job", "some-pipeline/some-job",
+					"--step", "unit",
+					"--input", fmt.Sprintf("source=%s", buildDir),
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(uploading).Should(BeClosed())
+				Eventually(streaming).Should(BeClosed())
+
+				close(events)
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(0))
+			})
+		})
+
+		Context("when vars are given", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--job", "some-pipeline/some-job",
+					"--step", "unit",
+					"--input", fmt.Sprintf("source=%s", buildDir),
+					"--var", "repository=ubuntu",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--var, --yaml-var and --load-vars-from cannot be used with --job"))
+			})
+		})
+
+		Context("when the step does not exist", func() {
+			JustBeforeEach(func() {
+				atcServer.RouteToHandler("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/tasks/bogus/plan",
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--job", "some-pipeline/some-job",
+					"--step", "bogus",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("task step 'bogus' not found in job 'some-job'"))
+			})
+		})
+
+		Context("when --step is not given", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(
+					flyPath, "-t", targetName, "e",
+					"--job", "some-pipeline/some-job",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				<-sess.Exited
+				Expect(sess).To(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("--job and --step must be specified together"))
+			})
+		})
+	})
+})
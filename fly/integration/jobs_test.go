@@ -42,7 +42,11 @@ var _ = Describe("Fly CLI", func() {
                   "status": "succeeded",
                   "api_url": ""
                 },
-                "groups": null
+                "groups": null,
+                "schedule": {
+                  "cron": "0 2 * * *",
+                  "location": "America/Toronto"
+                }
               },
               {
                 "id": 0,
@@ -112,6 +116,7 @@ var _ = Describe("Fly CLI", func() {
 				createJob(2, true, "failed", ""),
 				createJob(3, false, "", ""),
 			}
+			sampleJobs[0].Schedule = &atc.ScheduleConfig{Cron: "0 2 * * *", Location: "America/Toronto"}
 
 			BeforeEach(func() {
 				flyCmd = exec.Command(flyPath, "-t", targetName, "jobs", "--pipeline", pipelineName)
@@ -144,9 +149,9 @@ var _ = Describe("Fly CLI", func() {
 
 				Expect(sess.Out).To(PrintTable(ui.Table{
 					Data: []ui.TableRow{
-						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}},
-						{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}},
-						{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-1"}, {Contents: "no"}, {Contents: "succeeded"}, {Contents: "started"}, {Contents: "0 2 * * * America/Toronto"}},
+						{{Contents: "job-2"}, {Contents: "yes", Color: color.New(color.FgCyan)}, {Contents: "failed"}, {Contents: "n/a"}, {Contents: "n/a"}},
+						{{Contents: "job-3"}, {Contents: "no"}, {Contents: "n/a"}, {Contents: "n/a"}, {Contents: "n/a"}},
 					},
 				}))
 			})
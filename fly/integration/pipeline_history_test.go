@@ -0,0 +1,125 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("pipeline-history", func() {
+		Context("when listing the versions", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.PipelineConfigVersion{
+							{Version: 3, SavedAt: 300, SavedByBuild: 42},
+							{Version: 2, SavedAt: 200, SavedBy: "some-user"},
+							{Version: 1, SavedAt: 100},
+						}),
+					),
+				)
+			})
+
+			It("shows who saved each version", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "version", Color: color.New(color.Bold)},
+						{Contents: "saved at", Color: color.New(color.Bold)},
+						{Contents: "saved by", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "3"}, {Contents: time.Unix(300, 0).Format("2006-01-02@15:04:05-0700")}, {Contents: "build 42"}},
+						{{Contents: "2"}, {Contents: time.Unix(200, 0).Format("2006-01-02@15:04:05-0700")}, {Contents: "some-user"}},
+						{{Contents: "1"}, {Contents: time.Unix(100, 0).Format("2006-01-02@15:04:05-0700")}, {Contents: "unknown", Color: color.New(color.Faint)}},
+					},
+				}))
+			})
+		})
+
+		Context("when showing the changes made by a version", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/diff", "to=3"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigDiff{
+							From: atc.PipelineConfigVersion{
+								Version: 2,
+								Config: &atc.Config{
+									Jobs: atc.JobConfigs{{Name: "some-job"}},
+								},
+							},
+							To: atc.PipelineConfigVersion{
+								Version: 3,
+								Config: &atc.Config{
+									Jobs: atc.JobConfigs{{Name: "some-job"}, {Name: "some-new-job"}},
+								},
+							},
+						}),
+					),
+				)
+			})
+
+			It("renders the diff", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline", "--version", "3")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("changes from version 2 to version 3:"))
+				Expect(sess.Out).To(gbytes.Say("job some-new-job has been added:"))
+			})
+		})
+
+		Context("when --from is given without --version", func() {
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline", "--from", "1")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("--from can only be given with --version"))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("pipeline not found"))
+			})
+		})
+	})
+})
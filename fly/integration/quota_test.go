@@ -0,0 +1,169 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("get-quota", func() {
+		Context("for the target's team", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/quota"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.QuotaUsage{
+							Quota:   atc.Quota{Builds: 2},
+							Running: atc.Quota{Builds: 2, TaskContainers: 3},
+						}),
+					),
+				)
+			})
+
+			It("shows the quota and its usage", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "get-quota")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "limit", Color: color.New(color.Bold)},
+						{Contents: "quota", Color: color.New(color.Bold)},
+						{Contents: "running", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "builds"}, {Contents: "2"}, {Contents: "2", Color: color.New(color.FgRed, color.Bold)}},
+						{{Contents: "task containers"}, {Contents: "none", Color: color.New(color.Faint)}, {Contents: "3"}},
+					},
+				}))
+			})
+		})
+
+		Context("for the cluster", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/quota"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.QuotaUsage{
+							Quota: atc.Quota{Builds: 50},
+						}),
+					),
+				)
+			})
+
+			It("prints the quota as JSON", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "get-quota", "--cluster", "--json")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out.Contents()).To(MatchJSON(`{"quota": {"builds": 50}, "running": {}}`))
+			})
+		})
+	})
+
+	Describe("set-quota", func() {
+		Context("for a team", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/other-team"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{Name: "other-team"}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/other-team/quota"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.QuotaUsage{
+							Quota: atc.Quota{Builds: 2, TaskContainers: 10},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/other-team/quota"),
+						ghttp.VerifyJSONRepresenting(atc.Quota{Builds: 5, TaskContainers: 10}),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("changes only the limits that were given", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-quota", "-n", "other-team", "--builds", "5")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("quota set for team 'other-team'"))
+			})
+		})
+
+		Context("for the cluster when not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/quota"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.QuotaUsage{}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/quota"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-quota", "--cluster", "--task-containers", "100")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("only admins can set quotas"))
+			})
+		})
+
+		Context("when no limit is given", func() {
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-quota", "--cluster")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("Must specify --builds, --task-containers or both"))
+			})
+		})
+
+		Context("when a limit is negative", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/quota"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.QuotaUsage{}),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-quota", "--cluster", "--builds", "-1")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("quota limits must not be negative"))
+			})
+		})
+	})
+})
@@ -0,0 +1,111 @@
+package integration_test
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"sigs.k8s.io/yaml"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("rollback-pipeline", func() {
+		var (
+			oldConfig     atc.Config
+			currentConfig atc.Config
+		)
+
+		yes := func(stdin io.Writer) {
+			_, err := stdin.Write([]byte("y\n"))
+			Expect(err).NotTo(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			oldConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job", Public: true}},
+			}
+
+			currentConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job"}, {Name: "some-broken-job"}},
+			}
+		})
+
+		Context("when the version exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/2"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigVersion{
+							Version: 2,
+							Config:  &oldConfig,
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: currentConfig}, http.Header{atc.ConfigVersionHeader: {"5"}}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+						ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "5"),
+						func(w http.ResponseWriter, r *http.Request) {
+							body, err := ioutil.ReadAll(r.Body)
+							Expect(err).NotTo(HaveOccurred())
+
+							var receivedConfig atc.Config
+							Expect(yaml.Unmarshal(body, &receivedConfig)).To(Succeed())
+							Expect(receivedConfig).To(Equal(oldConfig))
+						},
+						ghttp.RespondWith(http.StatusOK, `{}`),
+					),
+				)
+			})
+
+			It("shows the changes and saves the old config", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "some-pipeline", "--version", "2")
+
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say("rolling back to version 2"))
+				Eventually(sess).Should(gbytes.Say("job some-broken-job has been removed:"))
+				Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess).Should(gbytes.Say("configuration updated"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config/versions/2"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "some-pipeline", "--version", "2")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("pipeline or config version not found"))
+			})
+		})
+	})
+})
@@ -88,6 +88,93 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("custom roles", func() {
+			Context("when a role has an unknown action", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"-c", "fixtures/team_config_with_unknown_action.yml"}
+				})
+
+				It("returns an error", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("role 'releaser' has unknown action 'ShipIt'"))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
+			Context("when users are given a role that is not defined", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"-c", "fixtures/team_config_with_undefined_role.yml"}
+				})
+
+				It("returns an error", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("auth config refers to undefined role 'releaser'"))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
+			Context("when the roles are valid", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"-c", "fixtures/team_config_with_custom_roles.yml"}
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.VerifyJSON(`{
+								"auth": {
+									"owner": {
+										"users": ["local:some-owner"],
+										"groups": []
+									},
+									"releaser": {
+										"users": ["local:some-releaser"],
+										"groups": []
+									}
+								},
+								"roles": [
+									{
+										"name": "releaser",
+										"actions": ["CreateJobBuild", "PinResourceVersion", "viewer"],
+										"pipelines": ["release-*"]
+									}
+								]
+							}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+								Name: "venture",
+								ID:   8,
+							}),
+						),
+					)
+				})
+
+				It("shows the actions and pipelines of the role and sends it", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("role releaser:"))
+					Eventually(sess.Out).Should(gbytes.Say("- local:some-releaser"))
+					Eventually(sess.Out).Should(gbytes.Say("actions:"))
+					Eventually(sess.Out).Should(gbytes.Say("- CreateJobBuild"))
+					Eventually(sess.Out).Should(gbytes.Say("- PinResourceVersion"))
+					Eventually(sess.Out).Should(gbytes.Say("- viewer"))
+					Eventually(sess.Out).Should(gbytes.Say("pipelines:"))
+					Eventually(sess.Out).Should(gbytes.Say(`- release-\*`))
+
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+		})
+
 		Describe("Display", func() {
 			Context("Setting local auth", func() {
 				BeforeEach(func() {
@@ -0,0 +1,66 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) ListAPITokens() ([]atc.APIToken, error) {
+	var tokens []atc.APIToken
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListAPITokens,
+		Params:      rata.Params{"team_name": team.name},
+	}, &internal.Response{
+		Result: &tokens,
+	})
+
+	return tokens, err
+}
+
+// CreateAPIToken creates an API token as described. The token returned is
+// the only chance to see its secret.
+func (team *team) CreateAPIToken(token atc.APIToken) (atc.APIToken, error) {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(token)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	var created atc.APIToken
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateAPIToken,
+		Params:      rata.Params{"team_name": team.name},
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, &internal.Response{
+		Result: &created,
+	})
+
+	return created, err
+}
+
+func (team *team) RevokeAPIToken(name string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RevokeAPIToken,
+		Params: rata.Params{
+			"team_name":  team.name,
+			"token_name": name,
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
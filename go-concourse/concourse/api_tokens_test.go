@@ -0,0 +1,120 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler API Tokens", func() {
+	Describe("ListAPITokens", func() {
+		expectedURL := "/api/v1/teams/some-team/tokens"
+
+		expectedTokens := []atc.APIToken{
+			{Name: "ci", TeamName: "some-team", Role: "member", ServiceAccount: "ci-bot", ExpiresAt: 200},
+			{Name: "laptop", TeamName: "some-team", Role: "viewer", User: "github:some-user", ExpiresAt: 100},
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTokens),
+				),
+			)
+		})
+
+		It("returns the team's tokens", func() {
+			tokens, err := team.ListAPITokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(Equal(expectedTokens))
+		})
+	})
+
+	Describe("CreateAPIToken", func() {
+		expectedURL := "/api/v1/teams/some-team/tokens"
+
+		request := atc.APIToken{Name: "ci", Role: "member", ServiceAccount: "ci-bot", ExpiresAt: 200}
+
+		Context("when the token is created", func() {
+			BeforeEach(func() {
+				created := request
+				created.TeamName = "some-team"
+				created.Token = "concourse_some-secret"
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", expectedURL),
+						ghttp.VerifyJSONRepresenting(request),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, created),
+					),
+				)
+			})
+
+			It("returns the token with its secret", func() {
+				token, err := team.CreateAPIToken(request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(token.Name).To(Equal("ci"))
+				Expect(token.Token).To(Equal("concourse_some-secret"))
+			})
+		})
+
+		Context("when the token is not allowed", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", expectedURL),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := team.CreateAPIToken(request)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("RevokeAPIToken", func() {
+		expectedURL := "/api/v1/teams/some-team/tokens/ci"
+
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", expectedURL),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("revokes it", func() {
+				found, err := team.RevokeAPIToken("ci")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				found, err := team.RevokeAPIToken("ci")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
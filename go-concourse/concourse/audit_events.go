@@ -0,0 +1,85 @@
+package concourse
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+// AuditEventFilter narrows down the audit events listed to those matching
+// all of its non-zero fields.
+type AuditEventFilter struct {
+	Team     string
+	User     string
+	Action   string
+	Pipeline string
+	Job      string
+	Resource string
+	Build    int
+
+	From time.Time
+	To   time.Time
+}
+
+func (filter AuditEventFilter) queryParams() url.Values {
+	queryParams := url.Values{}
+
+	for param, value := range map[string]string{
+		"team":     filter.Team,
+		"user":     filter.User,
+		"action":   filter.Action,
+		"pipeline": filter.Pipeline,
+		"job":      filter.Job,
+		"resource": filter.Resource,
+	} {
+		if value != "" {
+			queryParams.Add(param, value)
+		}
+	}
+
+	if filter.Build != 0 {
+		queryParams.Add("build", strconv.Itoa(filter.Build))
+	}
+
+	if !filter.From.IsZero() {
+		queryParams.Add("from", strconv.FormatInt(filter.From.Unix(), 10))
+	}
+
+	if !filter.To.IsZero() {
+		queryParams.Add("to", strconv.FormatInt(filter.To.Unix(), 10))
+	}
+
+	return queryParams
+}
+
+func (client *client) ListAuditEvents(filter AuditEventFilter, page Page) ([]atc.AuditEvent, Pagination, error) {
+	var events []atc.AuditEvent
+
+	query := filter.queryParams()
+	for param, values := range page.QueryParams() {
+		query[param] = values
+	}
+
+	headers := http.Header{}
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListAuditEvents,
+		Query:       query,
+	}, &internal.Response{
+		Result:  &events,
+		Headers: &headers,
+	})
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	pagination, err := paginationFromHeaders(headers)
+	if err != nil {
+		return nil, Pagination{}, err
+	}
+
+	return events, pagination, nil
+}
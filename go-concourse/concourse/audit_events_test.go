@@ -0,0 +1,107 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Audit Events", func() {
+	Describe("ListAuditEvents", func() {
+		var (
+			filter concourse.AuditEventFilter
+			page   concourse.Page
+
+			expectedEvents []atc.AuditEvent
+
+			events     []atc.AuditEvent
+			pagination concourse.Pagination
+			clientErr  error
+		)
+
+		BeforeEach(func() {
+			filter = concourse.AuditEventFilter{}
+			page = concourse.Page{}
+
+			expectedEvents = []atc.AuditEvent{
+				{ID: 2, Time: 1594719003, Action: atc.PauseJob, UserName: "some-user", TeamName: "some-team"},
+				{ID: 1, Time: 1594719000, Action: atc.SetLogLevel, UserName: "some-user"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			events, pagination, clientErr = client.ListAuditEvents(filter, page)
+		})
+
+		Context("without a filter", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents),
+					),
+				)
+			})
+
+			It("returns the events", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(events).To(Equal(expectedEvents))
+				Expect(pagination).To(Equal(concourse.Pagination{}))
+			})
+		})
+
+		Context("with a filter and a page", func() {
+			BeforeEach(func() {
+				filter = concourse.AuditEventFilter{
+					Team:   "some-team",
+					Action: atc.PauseJob,
+					Build:  42,
+					From:   time.Unix(1594719000, 0),
+				}
+				page = concourse.Page{Since: 10, Limit: 2}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events", "action=PauseJob&build=42&from=1594719000&limit=2&since=10&team=some-team"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents, http.Header{
+							"Link": []string{
+								`<https://example.com/api/v1/audit-events?limit=2&since=1&team=some-team>; rel="next"`,
+								`<https://example.com/api/v1/audit-events?limit=2&team=some-team&until=2>; rel="previous"`,
+							},
+						}),
+					),
+				)
+			})
+
+			It("sends the filter and returns the pagination", func() {
+				Expect(clientErr).NotTo(HaveOccurred())
+				Expect(events).To(Equal(expectedEvents))
+				Expect(pagination).To(Equal(concourse.Pagination{
+					Previous: &concourse.Page{Until: 2, Limit: 2},
+					Next:     &concourse.Page{Since: 1, Limit: 2},
+				}))
+			})
+		})
+
+		Context("when the user is not allowed to see the events", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-events"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("returns a forbidden error", func() {
+				Expect(clientErr).To(HaveOccurred())
+				Expect(clientErr).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+})
@@ -38,13 +38,33 @@ var _ = Describe("ATC Handler Build Inputs", func() {
 			})
 
 			It("returns the input configuration for the given job", func() {
-				buildInputs, found, err := team.BuildInputsForJob("mypipeline", "myjob")
+				buildInputs, found, err := team.BuildInputsForJob(atc.PipelineRef{Name: "mypipeline"}, "myjob")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(buildInputs).To(Equal(expectedBuildInputs))
 				Expect(found).To(BeTrue())
 			})
 		})
 
+		Context("when the pipeline has instance vars", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, `instance_vars=%7B%22branch%22%3A%22master%22%7D`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.BuildInput{}),
+					),
+				)
+			})
+
+			It("addresses the pipeline instance", func() {
+				_, found, err := team.BuildInputsForJob(atc.PipelineRef{
+					Name:         "mypipeline",
+					InstanceVars: atc.InstanceVars{"branch": "master"},
+				}, "myjob")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
 		Context("when pipeline/job does not exist", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(
@@ -56,7 +76,7 @@ var _ = Describe("ATC Handler Build Inputs", func() {
 			})
 
 			It("returns false in the found value and no error", func() {
-				_, found, err := team.BuildInputsForJob("mypipeline", "myjob")
+				_, found, err := team.BuildInputsForJob(atc.PipelineRef{Name: "mypipeline"}, "myjob")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
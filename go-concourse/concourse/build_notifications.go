@@ -0,0 +1,32 @@
+package concourse
+
+import (
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) BuildNotifications(buildID int) ([]atc.BuildNotification, bool, error) {
+	params := rata.Params{
+		"build_id": strconv.Itoa(buildID),
+	}
+
+	var notifications []atc.BuildNotification
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListBuildNotifications,
+		Params:      params,
+	}, &internal.Response{
+		Result: &notifications,
+	})
+
+	switch err.(type) {
+	case nil:
+		return notifications, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
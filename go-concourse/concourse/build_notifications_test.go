@@ -0,0 +1,76 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Notifications", func() {
+	Describe("BuildNotifications", func() {
+		expectedURL := "/api/v1/builds/6/notifications"
+
+		Context("when build exists", func() {
+			var expectedNotifications []atc.BuildNotification
+
+			BeforeEach(func() {
+				expectedNotifications = []atc.BuildNotification{
+					{
+						ID:           1,
+						BuildID:      6,
+						Name:         "slack",
+						Status:       "delivered",
+						Attempts:     1,
+						ResponseCode: 200,
+						CreatedAt:    100,
+						DeliveredAt:  101,
+					},
+					{
+						ID:        2,
+						BuildID:   6,
+						Name:      "pager",
+						Status:    "pending",
+						Attempts:  1,
+						LastError: "Post: connection refused",
+						CreatedAt: 100,
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedNotifications),
+					),
+				)
+			})
+
+			It("returns the build's notification deliveries", func() {
+				notifications, found, err := client.BuildNotifications(6)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(notifications).To(Equal(expectedNotifications))
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false in the found value and no error", func() {
+				_, found, err := client.BuildNotifications(6)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -157,6 +159,35 @@ func (client *client) AbortBuild(buildID string) error {
 	}, nil)
 }
 
+func (client *client) RetainBuildContainers(buildID string, duration time.Duration) (atc.Build, error) {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	var build atc.Build
+
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.RetainBuildContainers,
+		Params:      params,
+		Query:       url.Values{"duration": {duration.String()}},
+	}, &internal.Response{
+		Result: &build,
+	})
+
+	return build, err
+}
+
+func (client *client) ReleaseBuildContainers(buildID string) error {
+	params := rata.Params{
+		"build_id": buildID,
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.ReleaseBuildContainers,
+		Params:      params,
+	}, nil)
+}
+
 func (team *team) Builds(page Page) ([]atc.Build, Pagination, error) {
 	var builds []atc.Build
 
@@ -3,6 +3,7 @@ package concourse_test
 import (
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
@@ -460,6 +461,48 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("RetainBuildContainers", func() {
+		BeforeEach(func() {
+			expectedURL := "/api/v1/builds/123/containers/retain"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL, "duration=1h30m0s"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{
+						ID:                      123,
+						ContainersRetainedUntil: 1234,
+					}),
+				),
+			)
+		})
+
+		It("asks ATC to retain the build's containers", func() {
+			build, err := client.RetainBuildContainers("123", 90*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(build.ContainersRetainedUntil).To(Equal(int64(1234)))
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("ReleaseBuildContainers", func() {
+		BeforeEach(func() {
+			expectedURL := "/api/v1/builds/123/containers/release"
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", expectedURL),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("asks ATC to release the build's containers", func() {
+			err := client.ReleaseBuildContainers("123")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
 	Describe("team.Builds", func() {
 		expectedURL := "/api/v1/teams/some-team/builds"
 
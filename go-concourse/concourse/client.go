@@ -18,13 +18,20 @@ type Client interface {
 	Build(buildID string) (atc.Build, bool, error)
 	BuildEvents(buildID string) (Events, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
+	BuildNotifications(buildID int) ([]atc.BuildNotification, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
+	RetainBuildContainers(buildID string, duration time.Duration) (atc.Build, error)
+	ReleaseBuildContainers(buildID string) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
 	LandWorker(workerName string) error
+	CordonWorker(workerName string, cordon atc.WorkerCordon) error
+	UncordonWorker(workerName string) error
+	CordonTaggedWorkers(cordon atc.WorkerTagCordon) error
+	UncordonTaggedWorkers(tags []string) (bool, error)
 	GetInfo() (atc.Info, error)
 	GetCLIReader(arch, platform string) (io.ReadCloser, http.Header, error)
 	ListPipelines() ([]atc.Pipeline, error)
@@ -33,6 +40,9 @@ type Client interface {
 	Team(teamName string) Team
 	UserInfo() (map[string]interface{}, error)
 	ListActiveUsersSince(since time.Time) ([]atc.User, error)
+	ListAuditEvents(AuditEventFilter, Page) ([]atc.AuditEvent, Pagination, error)
+	ClusterQuota() (atc.QuotaUsage, error)
+	SetClusterQuota(atc.Quota) error
 	Check(checkID string) (atc.Check, bool, error)
 }
 
@@ -51,6 +51,21 @@ type FakeClient struct {
 		result1 concourse.Events
 		result2 error
 	}
+	BuildNotificationsStub        func(int) ([]atc.BuildNotification, bool, error)
+	buildNotificationsMutex       sync.RWMutex
+	buildNotificationsArgsForCall []struct {
+		arg1 int
+	}
+	buildNotificationsReturns struct {
+		result1 []atc.BuildNotification
+		result2 bool
+		result3 error
+	}
+	buildNotificationsReturnsOnCall map[int]struct {
+		result1 []atc.BuildNotification
+		result2 bool
+		result3 error
+	}
 	BuildPlanStub        func(int) (atc.PublicBuildPlan, bool, error)
 	buildPlanMutex       sync.RWMutex
 	buildPlanArgsForCall []struct {
@@ -111,6 +126,41 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	ClusterQuotaStub        func() (atc.QuotaUsage, error)
+	clusterQuotaMutex       sync.RWMutex
+	clusterQuotaArgsForCall []struct {
+	}
+	clusterQuotaReturns struct {
+		result1 atc.QuotaUsage
+		result2 error
+	}
+	clusterQuotaReturnsOnCall map[int]struct {
+		result1 atc.QuotaUsage
+		result2 error
+	}
+	CordonTaggedWorkersStub        func(atc.WorkerTagCordon) error
+	cordonTaggedWorkersMutex       sync.RWMutex
+	cordonTaggedWorkersArgsForCall []struct {
+		arg1 atc.WorkerTagCordon
+	}
+	cordonTaggedWorkersReturns struct {
+		result1 error
+	}
+	cordonTaggedWorkersReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CordonWorkerStub        func(string, atc.WorkerCordon) error
+	cordonWorkerMutex       sync.RWMutex
+	cordonWorkerArgsForCall []struct {
+		arg1 string
+		arg2 atc.WorkerCordon
+	}
+	cordonWorkerReturns struct {
+		result1 error
+	}
+	cordonWorkerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	FindTeamStub        func(string) (concourse.Team, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -186,6 +236,22 @@ type FakeClient struct {
 		result1 []atc.User
 		result2 error
 	}
+	ListAuditEventsStub        func(concourse.AuditEventFilter, concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error)
+	listAuditEventsMutex       sync.RWMutex
+	listAuditEventsArgsForCall []struct {
+		arg1 concourse.AuditEventFilter
+		arg2 concourse.Page
+	}
+	listAuditEventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}
+	listAuditEventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}
 	ListBuildArtifactsStub        func(string) ([]atc.WorkerArtifact, error)
 	listBuildArtifactsMutex       sync.RWMutex
 	listBuildArtifactsArgsForCall []struct {
@@ -246,6 +312,31 @@ type FakeClient struct {
 	pruneWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ReleaseBuildContainersStub        func(string) error
+	releaseBuildContainersMutex       sync.RWMutex
+	releaseBuildContainersArgsForCall []struct {
+		arg1 string
+	}
+	releaseBuildContainersReturns struct {
+		result1 error
+	}
+	releaseBuildContainersReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RetainBuildContainersStub        func(string, time.Duration) (atc.Build, error)
+	retainBuildContainersMutex       sync.RWMutex
+	retainBuildContainersArgsForCall []struct {
+		arg1 string
+		arg2 time.Duration
+	}
+	retainBuildContainersReturns struct {
+		result1 atc.Build
+		result2 error
+	}
+	retainBuildContainersReturnsOnCall map[int]struct {
+		result1 atc.Build
+		result2 error
+	}
 	SaveWorkerStub        func(atc.Worker, *time.Duration) (*atc.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -260,6 +351,17 @@ type FakeClient struct {
 		result1 *atc.Worker
 		result2 error
 	}
+	SetClusterQuotaStub        func(atc.Quota) error
+	setClusterQuotaMutex       sync.RWMutex
+	setClusterQuotaArgsForCall []struct {
+		arg1 atc.Quota
+	}
+	setClusterQuotaReturns struct {
+		result1 error
+	}
+	setClusterQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
 	TeamStub        func(string) concourse.Team
 	teamMutex       sync.RWMutex
 	teamArgsForCall []struct {
@@ -281,6 +383,30 @@ type FakeClient struct {
 	uRLReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UncordonTaggedWorkersStub        func([]string) (bool, error)
+	uncordonTaggedWorkersMutex       sync.RWMutex
+	uncordonTaggedWorkersArgsForCall []struct {
+		arg1 []string
+	}
+	uncordonTaggedWorkersReturns struct {
+		result1 bool
+		result2 error
+	}
+	uncordonTaggedWorkersReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	UncordonWorkerStub        func(string) error
+	uncordonWorkerMutex       sync.RWMutex
+	uncordonWorkerArgsForCall []struct {
+		arg1 string
+	}
+	uncordonWorkerReturns struct {
+		result1 error
+	}
+	uncordonWorkerReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UserInfoStub        func() (map[string]interface{}, error)
 	userInfoMutex       sync.RWMutex
 	userInfoArgsForCall []struct {
@@ -486,6 +612,72 @@ func (fake *FakeClient) BuildEventsReturnsOnCall(i int, result1 concourse.Events
 	}{result1, result2}
 }
 
+func (fake *FakeClient) BuildNotifications(arg1 int) ([]atc.BuildNotification, bool, error) {
+	fake.buildNotificationsMutex.Lock()
+	ret, specificReturn := fake.buildNotificationsReturnsOnCall[len(fake.buildNotificationsArgsForCall)]
+	fake.buildNotificationsArgsForCall = append(fake.buildNotificationsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("BuildNotifications", []interface{}{arg1})
+	fake.buildNotificationsMutex.Unlock()
+	if fake.BuildNotificationsStub != nil {
+		return fake.BuildNotificationsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildNotificationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildNotificationsCallCount() int {
+	fake.buildNotificationsMutex.RLock()
+	defer fake.buildNotificationsMutex.RUnlock()
+	return len(fake.buildNotificationsArgsForCall)
+}
+
+func (fake *FakeClient) BuildNotificationsCalls(stub func(int) ([]atc.BuildNotification, bool, error)) {
+	fake.buildNotificationsMutex.Lock()
+	defer fake.buildNotificationsMutex.Unlock()
+	fake.BuildNotificationsStub = stub
+}
+
+func (fake *FakeClient) BuildNotificationsArgsForCall(i int) int {
+	fake.buildNotificationsMutex.RLock()
+	defer fake.buildNotificationsMutex.RUnlock()
+	argsForCall := fake.buildNotificationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildNotificationsReturns(result1 []atc.BuildNotification, result2 bool, result3 error) {
+	fake.buildNotificationsMutex.Lock()
+	defer fake.buildNotificationsMutex.Unlock()
+	fake.BuildNotificationsStub = nil
+	fake.buildNotificationsReturns = struct {
+		result1 []atc.BuildNotification
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildNotificationsReturnsOnCall(i int, result1 []atc.BuildNotification, result2 bool, result3 error) {
+	fake.buildNotificationsMutex.Lock()
+	defer fake.buildNotificationsMutex.Unlock()
+	fake.BuildNotificationsStub = nil
+	if fake.buildNotificationsReturnsOnCall == nil {
+		fake.buildNotificationsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildNotification
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildNotificationsReturnsOnCall[i] = struct {
+		result1 []atc.BuildNotification
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) BuildPlan(arg1 int) (atc.PublicBuildPlan, bool, error) {
 	fake.buildPlanMutex.Lock()
 	ret, specificReturn := fake.buildPlanReturnsOnCall[len(fake.buildPlanArgsForCall)]
@@ -750,6 +942,182 @@ func (fake *FakeClient) CheckReturnsOnCall(i int, result1 atc.Check, result2 boo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) ClusterQuota() (atc.QuotaUsage, error) {
+	fake.clusterQuotaMutex.Lock()
+	ret, specificReturn := fake.clusterQuotaReturnsOnCall[len(fake.clusterQuotaArgsForCall)]
+	fake.clusterQuotaArgsForCall = append(fake.clusterQuotaArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ClusterQuota", []interface{}{})
+	fake.clusterQuotaMutex.Unlock()
+	if fake.ClusterQuotaStub != nil {
+		return fake.ClusterQuotaStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.clusterQuotaReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ClusterQuotaCallCount() int {
+	fake.clusterQuotaMutex.RLock()
+	defer fake.clusterQuotaMutex.RUnlock()
+	return len(fake.clusterQuotaArgsForCall)
+}
+
+func (fake *FakeClient) ClusterQuotaCalls(stub func() (atc.QuotaUsage, error)) {
+	fake.clusterQuotaMutex.Lock()
+	defer fake.clusterQuotaMutex.Unlock()
+	fake.ClusterQuotaStub = stub
+}
+
+func (fake *FakeClient) ClusterQuotaReturns(result1 atc.QuotaUsage, result2 error) {
+	fake.clusterQuotaMutex.Lock()
+	defer fake.clusterQuotaMutex.Unlock()
+	fake.ClusterQuotaStub = nil
+	fake.clusterQuotaReturns = struct {
+		result1 atc.QuotaUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ClusterQuotaReturnsOnCall(i int, result1 atc.QuotaUsage, result2 error) {
+	fake.clusterQuotaMutex.Lock()
+	defer fake.clusterQuotaMutex.Unlock()
+	fake.ClusterQuotaStub = nil
+	if fake.clusterQuotaReturnsOnCall == nil {
+		fake.clusterQuotaReturnsOnCall = make(map[int]struct {
+			result1 atc.QuotaUsage
+			result2 error
+		})
+	}
+	fake.clusterQuotaReturnsOnCall[i] = struct {
+		result1 atc.QuotaUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CordonTaggedWorkers(arg1 atc.WorkerTagCordon) error {
+	fake.cordonTaggedWorkersMutex.Lock()
+	ret, specificReturn := fake.cordonTaggedWorkersReturnsOnCall[len(fake.cordonTaggedWorkersArgsForCall)]
+	fake.cordonTaggedWorkersArgsForCall = append(fake.cordonTaggedWorkersArgsForCall, struct {
+		arg1 atc.WorkerTagCordon
+	}{arg1})
+	fake.recordInvocation("CordonTaggedWorkers", []interface{}{arg1})
+	fake.cordonTaggedWorkersMutex.Unlock()
+	if fake.CordonTaggedWorkersStub != nil {
+		return fake.CordonTaggedWorkersStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonTaggedWorkersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CordonTaggedWorkersCallCount() int {
+	fake.cordonTaggedWorkersMutex.RLock()
+	defer fake.cordonTaggedWorkersMutex.RUnlock()
+	return len(fake.cordonTaggedWorkersArgsForCall)
+}
+
+func (fake *FakeClient) CordonTaggedWorkersCalls(stub func(atc.WorkerTagCordon) error) {
+	fake.cordonTaggedWorkersMutex.Lock()
+	defer fake.cordonTaggedWorkersMutex.Unlock()
+	fake.CordonTaggedWorkersStub = stub
+}
+
+func (fake *FakeClient) CordonTaggedWorkersArgsForCall(i int) atc.WorkerTagCordon {
+	fake.cordonTaggedWorkersMutex.RLock()
+	defer fake.cordonTaggedWorkersMutex.RUnlock()
+	argsForCall := fake.cordonTaggedWorkersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CordonTaggedWorkersReturns(result1 error) {
+	fake.cordonTaggedWorkersMutex.Lock()
+	defer fake.cordonTaggedWorkersMutex.Unlock()
+	fake.CordonTaggedWorkersStub = nil
+	fake.cordonTaggedWorkersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CordonTaggedWorkersReturnsOnCall(i int, result1 error) {
+	fake.cordonTaggedWorkersMutex.Lock()
+	defer fake.cordonTaggedWorkersMutex.Unlock()
+	fake.CordonTaggedWorkersStub = nil
+	if fake.cordonTaggedWorkersReturnsOnCall == nil {
+		fake.cordonTaggedWorkersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonTaggedWorkersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CordonWorker(arg1 string, arg2 atc.WorkerCordon) error {
+	fake.cordonWorkerMutex.Lock()
+	ret, specificReturn := fake.cordonWorkerReturnsOnCall[len(fake.cordonWorkerArgsForCall)]
+	fake.cordonWorkerArgsForCall = append(fake.cordonWorkerArgsForCall, struct {
+		arg1 string
+		arg2 atc.WorkerCordon
+	}{arg1, arg2})
+	fake.recordInvocation("CordonWorker", []interface{}{arg1, arg2})
+	fake.cordonWorkerMutex.Unlock()
+	if fake.CordonWorkerStub != nil {
+		return fake.CordonWorkerStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.cordonWorkerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) CordonWorkerCallCount() int {
+	fake.cordonWorkerMutex.RLock()
+	defer fake.cordonWorkerMutex.RUnlock()
+	return len(fake.cordonWorkerArgsForCall)
+}
+
+func (fake *FakeClient) CordonWorkerCalls(stub func(string, atc.WorkerCordon) error) {
+	fake.cordonWorkerMutex.Lock()
+	defer fake.cordonWorkerMutex.Unlock()
+	fake.CordonWorkerStub = stub
+}
+
+func (fake *FakeClient) CordonWorkerArgsForCall(i int) (string, atc.WorkerCordon) {
+	fake.cordonWorkerMutex.RLock()
+	defer fake.cordonWorkerMutex.RUnlock()
+	argsForCall := fake.cordonWorkerArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) CordonWorkerReturns(result1 error) {
+	fake.cordonWorkerMutex.Lock()
+	defer fake.cordonWorkerMutex.Unlock()
+	fake.CordonWorkerStub = nil
+	fake.cordonWorkerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CordonWorkerReturnsOnCall(i int, result1 error) {
+	fake.cordonWorkerMutex.Lock()
+	defer fake.cordonWorkerMutex.Unlock()
+	fake.CordonWorkerStub = nil
+	if fake.cordonWorkerReturnsOnCall == nil {
+		fake.cordonWorkerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cordonWorkerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
 	fake.findTeamMutex.Lock()
 	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
@@ -1110,6 +1478,73 @@ func (fake *FakeClient) ListActiveUsersSinceReturnsOnCall(i int, result1 []atc.U
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListAuditEvents(arg1 concourse.AuditEventFilter, arg2 concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error) {
+	fake.listAuditEventsMutex.Lock()
+	ret, specificReturn := fake.listAuditEventsReturnsOnCall[len(fake.listAuditEventsArgsForCall)]
+	fake.listAuditEventsArgsForCall = append(fake.listAuditEventsArgsForCall, struct {
+		arg1 concourse.AuditEventFilter
+		arg2 concourse.Page
+	}{arg1, arg2})
+	fake.recordInvocation("ListAuditEvents", []interface{}{arg1, arg2})
+	fake.listAuditEventsMutex.Unlock()
+	if fake.ListAuditEventsStub != nil {
+		return fake.ListAuditEventsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.listAuditEventsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) ListAuditEventsCallCount() int {
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	return len(fake.listAuditEventsArgsForCall)
+}
+
+func (fake *FakeClient) ListAuditEventsCalls(stub func(concourse.AuditEventFilter, concourse.Page) ([]atc.AuditEvent, concourse.Pagination, error)) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = stub
+}
+
+func (fake *FakeClient) ListAuditEventsArgsForCall(i int) (concourse.AuditEventFilter, concourse.Page) {
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	argsForCall := fake.listAuditEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) ListAuditEventsReturns(result1 []atc.AuditEvent, result2 concourse.Pagination, result3 error) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = nil
+	fake.listAuditEventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ListAuditEventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 concourse.Pagination, result3 error) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = nil
+	if fake.listAuditEventsReturnsOnCall == nil {
+		fake.listAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 concourse.Pagination
+			result3 error
+		})
+	}
+	fake.listAuditEventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 concourse.Pagination
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) ListBuildArtifacts(arg1 string) ([]atc.WorkerArtifact, error) {
 	fake.listBuildArtifactsMutex.Lock()
 	ret, specificReturn := fake.listBuildArtifactsReturnsOnCall[len(fake.listBuildArtifactsArgsForCall)]
@@ -1398,6 +1833,130 @@ func (fake *FakeClient) PruneWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ReleaseBuildContainers(arg1 string) error {
+	fake.releaseBuildContainersMutex.Lock()
+	ret, specificReturn := fake.releaseBuildContainersReturnsOnCall[len(fake.releaseBuildContainersArgsForCall)]
+	fake.releaseBuildContainersArgsForCall = append(fake.releaseBuildContainersArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ReleaseBuildContainers", []interface{}{arg1})
+	fake.releaseBuildContainersMutex.Unlock()
+	if fake.ReleaseBuildContainersStub != nil {
+		return fake.ReleaseBuildContainersStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.releaseBuildContainersReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) ReleaseBuildContainersCallCount() int {
+	fake.releaseBuildContainersMutex.RLock()
+	defer fake.releaseBuildContainersMutex.RUnlock()
+	return len(fake.releaseBuildContainersArgsForCall)
+}
+
+func (fake *FakeClient) ReleaseBuildContainersCalls(stub func(string) error) {
+	fake.releaseBuildContainersMutex.Lock()
+	defer fake.releaseBuildContainersMutex.Unlock()
+	fake.ReleaseBuildContainersStub = stub
+}
+
+func (fake *FakeClient) ReleaseBuildContainersArgsForCall(i int) string {
+	fake.releaseBuildContainersMutex.RLock()
+	defer fake.releaseBuildContainersMutex.RUnlock()
+	argsForCall := fake.releaseBuildContainersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ReleaseBuildContainersReturns(result1 error) {
+	fake.releaseBuildContainersMutex.Lock()
+	defer fake.releaseBuildContainersMutex.Unlock()
+	fake.ReleaseBuildContainersStub = nil
+	fake.releaseBuildContainersReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ReleaseBuildContainersReturnsOnCall(i int, result1 error) {
+	fake.releaseBuildContainersMutex.Lock()
+	defer fake.releaseBuildContainersMutex.Unlock()
+	fake.ReleaseBuildContainersStub = nil
+	if fake.releaseBuildContainersReturnsOnCall == nil {
+		fake.releaseBuildContainersReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.releaseBuildContainersReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) RetainBuildContainers(arg1 string, arg2 time.Duration) (atc.Build, error) {
+	fake.retainBuildContainersMutex.Lock()
+	ret, specificReturn := fake.retainBuildContainersReturnsOnCall[len(fake.retainBuildContainersArgsForCall)]
+	fake.retainBuildContainersArgsForCall = append(fake.retainBuildContainersArgsForCall, struct {
+		arg1 string
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("RetainBuildContainers", []interface{}{arg1, arg2})
+	fake.retainBuildContainersMutex.Unlock()
+	if fake.RetainBuildContainersStub != nil {
+		return fake.RetainBuildContainersStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.retainBuildContainersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RetainBuildContainersCallCount() int {
+	fake.retainBuildContainersMutex.RLock()
+	defer fake.retainBuildContainersMutex.RUnlock()
+	return len(fake.retainBuildContainersArgsForCall)
+}
+
+func (fake *FakeClient) RetainBuildContainersCalls(stub func(string, time.Duration) (atc.Build, error)) {
+	fake.retainBuildContainersMutex.Lock()
+	defer fake.retainBuildContainersMutex.Unlock()
+	fake.RetainBuildContainersStub = stub
+}
+
+func (fake *FakeClient) RetainBuildContainersArgsForCall(i int) (string, time.Duration) {
+	fake.retainBuildContainersMutex.RLock()
+	defer fake.retainBuildContainersMutex.RUnlock()
+	argsForCall := fake.retainBuildContainersArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) RetainBuildContainersReturns(result1 atc.Build, result2 error) {
+	fake.retainBuildContainersMutex.Lock()
+	defer fake.retainBuildContainersMutex.Unlock()
+	fake.RetainBuildContainersStub = nil
+	fake.retainBuildContainersReturns = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RetainBuildContainersReturnsOnCall(i int, result1 atc.Build, result2 error) {
+	fake.retainBuildContainersMutex.Lock()
+	defer fake.retainBuildContainersMutex.Unlock()
+	fake.RetainBuildContainersStub = nil
+	if fake.retainBuildContainersReturnsOnCall == nil {
+		fake.retainBuildContainersReturnsOnCall = make(map[int]struct {
+			result1 atc.Build
+			result2 error
+		})
+	}
+	fake.retainBuildContainersReturnsOnCall[i] = struct {
+		result1 atc.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) SaveWorker(arg1 atc.Worker, arg2 *time.Duration) (*atc.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -1462,6 +2021,66 @@ func (fake *FakeClient) SaveWorkerReturnsOnCall(i int, result1 *atc.Worker, resu
 	}{result1, result2}
 }
 
+func (fake *FakeClient) SetClusterQuota(arg1 atc.Quota) error {
+	fake.setClusterQuotaMutex.Lock()
+	ret, specificReturn := fake.setClusterQuotaReturnsOnCall[len(fake.setClusterQuotaArgsForCall)]
+	fake.setClusterQuotaArgsForCall = append(fake.setClusterQuotaArgsForCall, struct {
+		arg1 atc.Quota
+	}{arg1})
+	fake.recordInvocation("SetClusterQuota", []interface{}{arg1})
+	fake.setClusterQuotaMutex.Unlock()
+	if fake.SetClusterQuotaStub != nil {
+		return fake.SetClusterQuotaStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setClusterQuotaReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) SetClusterQuotaCallCount() int {
+	fake.setClusterQuotaMutex.RLock()
+	defer fake.setClusterQuotaMutex.RUnlock()
+	return len(fake.setClusterQuotaArgsForCall)
+}
+
+func (fake *FakeClient) SetClusterQuotaCalls(stub func(atc.Quota) error) {
+	fake.setClusterQuotaMutex.Lock()
+	defer fake.setClusterQuotaMutex.Unlock()
+	fake.SetClusterQuotaStub = stub
+}
+
+func (fake *FakeClient) SetClusterQuotaArgsForCall(i int) atc.Quota {
+	fake.setClusterQuotaMutex.RLock()
+	defer fake.setClusterQuotaMutex.RUnlock()
+	argsForCall := fake.setClusterQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) SetClusterQuotaReturns(result1 error) {
+	fake.setClusterQuotaMutex.Lock()
+	defer fake.setClusterQuotaMutex.Unlock()
+	fake.SetClusterQuotaStub = nil
+	fake.setClusterQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) SetClusterQuotaReturnsOnCall(i int, result1 error) {
+	fake.setClusterQuotaMutex.Lock()
+	defer fake.setClusterQuotaMutex.Unlock()
+	fake.SetClusterQuotaStub = nil
+	if fake.setClusterQuotaReturnsOnCall == nil {
+		fake.setClusterQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setClusterQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) Team(arg1 string) concourse.Team {
 	fake.teamMutex.Lock()
 	ret, specificReturn := fake.teamReturnsOnCall[len(fake.teamArgsForCall)]
@@ -1574,6 +2193,134 @@ func (fake *FakeClient) URLReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeClient) UncordonTaggedWorkers(arg1 []string) (bool, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.uncordonTaggedWorkersMutex.Lock()
+	ret, specificReturn := fake.uncordonTaggedWorkersReturnsOnCall[len(fake.uncordonTaggedWorkersArgsForCall)]
+	fake.uncordonTaggedWorkersArgsForCall = append(fake.uncordonTaggedWorkersArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("UncordonTaggedWorkers", []interface{}{arg1Copy})
+	fake.uncordonTaggedWorkersMutex.Unlock()
+	if fake.UncordonTaggedWorkersStub != nil {
+		return fake.UncordonTaggedWorkersStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.uncordonTaggedWorkersReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) UncordonTaggedWorkersCallCount() int {
+	fake.uncordonTaggedWorkersMutex.RLock()
+	defer fake.uncordonTaggedWorkersMutex.RUnlock()
+	return len(fake.uncordonTaggedWorkersArgsForCall)
+}
+
+func (fake *FakeClient) UncordonTaggedWorkersCalls(stub func([]string) (bool, error)) {
+	fake.uncordonTaggedWorkersMutex.Lock()
+	defer fake.uncordonTaggedWorkersMutex.Unlock()
+	fake.UncordonTaggedWorkersStub = stub
+}
+
+func (fake *FakeClient) UncordonTaggedWorkersArgsForCall(i int) []string {
+	fake.uncordonTaggedWorkersMutex.RLock()
+	defer fake.uncordonTaggedWorkersMutex.RUnlock()
+	argsForCall := fake.uncordonTaggedWorkersArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) UncordonTaggedWorkersReturns(result1 bool, result2 error) {
+	fake.uncordonTaggedWorkersMutex.Lock()
+	defer fake.uncordonTaggedWorkersMutex.Unlock()
+	fake.UncordonTaggedWorkersStub = nil
+	fake.uncordonTaggedWorkersReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UncordonTaggedWorkersReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.uncordonTaggedWorkersMutex.Lock()
+	defer fake.uncordonTaggedWorkersMutex.Unlock()
+	fake.UncordonTaggedWorkersStub = nil
+	if fake.uncordonTaggedWorkersReturnsOnCall == nil {
+		fake.uncordonTaggedWorkersReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.uncordonTaggedWorkersReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UncordonWorker(arg1 string) error {
+	fake.uncordonWorkerMutex.Lock()
+	ret, specificReturn := fake.uncordonWorkerReturnsOnCall[len(fake.uncordonWorkerArgsForCall)]
+	fake.uncordonWorkerArgsForCall = append(fake.uncordonWorkerArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UncordonWorker", []interface{}{arg1})
+	fake.uncordonWorkerMutex.Unlock()
+	if fake.UncordonWorkerStub != nil {
+		return fake.UncordonWorkerStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uncordonWorkerReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeClient) UncordonWorkerCallCount() int {
+	fake.uncordonWorkerMutex.RLock()
+	defer fake.uncordonWorkerMutex.RUnlock()
+	return len(fake.uncordonWorkerArgsForCall)
+}
+
+func (fake *FakeClient) UncordonWorkerCalls(stub func(string) error) {
+	fake.uncordonWorkerMutex.Lock()
+	defer fake.uncordonWorkerMutex.Unlock()
+	fake.UncordonWorkerStub = stub
+}
+
+func (fake *FakeClient) UncordonWorkerArgsForCall(i int) string {
+	fake.uncordonWorkerMutex.RLock()
+	defer fake.uncordonWorkerMutex.RUnlock()
+	argsForCall := fake.uncordonWorkerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) UncordonWorkerReturns(result1 error) {
+	fake.uncordonWorkerMutex.Lock()
+	defer fake.uncordonWorkerMutex.Unlock()
+	fake.UncordonWorkerStub = nil
+	fake.uncordonWorkerReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UncordonWorkerReturnsOnCall(i int, result1 error) {
+	fake.uncordonWorkerMutex.Lock()
+	defer fake.uncordonWorkerMutex.Unlock()
+	fake.UncordonWorkerStub = nil
+	if fake.uncordonWorkerReturnsOnCall == nil {
+		fake.uncordonWorkerReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uncordonWorkerReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeClient) UserInfo() (map[string]interface{}, error) {
 	fake.userInfoMutex.Lock()
 	ret, specificReturn := fake.userInfoReturnsOnCall[len(fake.userInfoArgsForCall)]
@@ -1638,6 +2385,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
 	defer fake.buildEventsMutex.RUnlock()
+	fake.buildNotificationsMutex.RLock()
+	defer fake.buildNotificationsMutex.RUnlock()
 	fake.buildPlanMutex.RLock()
 	defer fake.buildPlanMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
@@ -1646,6 +2395,12 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildsMutex.RUnlock()
 	fake.checkMutex.RLock()
 	defer fake.checkMutex.RUnlock()
+	fake.clusterQuotaMutex.RLock()
+	defer fake.clusterQuotaMutex.RUnlock()
+	fake.cordonTaggedWorkersMutex.RLock()
+	defer fake.cordonTaggedWorkersMutex.RUnlock()
+	fake.cordonWorkerMutex.RLock()
+	defer fake.cordonWorkerMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
@@ -1658,6 +2413,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.landWorkerMutex.RUnlock()
 	fake.listActiveUsersSinceMutex.RLock()
 	defer fake.listActiveUsersSinceMutex.RUnlock()
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
 	fake.listBuildArtifactsMutex.RLock()
 	defer fake.listBuildArtifactsMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
@@ -1668,12 +2425,22 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listWorkersMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
 	defer fake.pruneWorkerMutex.RUnlock()
+	fake.releaseBuildContainersMutex.RLock()
+	defer fake.releaseBuildContainersMutex.RUnlock()
+	fake.retainBuildContainersMutex.RLock()
+	defer fake.retainBuildContainersMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.setClusterQuotaMutex.RLock()
+	defer fake.setClusterQuotaMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.uRLMutex.RLock()
 	defer fake.uRLMutex.RUnlock()
+	fake.uncordonTaggedWorkersMutex.RLock()
+	defer fake.uncordonTaggedWorkersMutex.RUnlock()
+	fake.uncordonWorkerMutex.RLock()
+	defer fake.uncordonWorkerMutex.RUnlock()
 	fake.userInfoMutex.RLock()
 	defer fake.userInfoMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -10,10 +10,10 @@ import (
 )
 
 type FakeTeam struct {
-	ArchivePipelineStub        func(string) (bool, error)
+	ArchivePipelineStub        func(atc.PipelineRef) (bool, error)
 	archivePipelineMutex       sync.RWMutex
 	archivePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	archivePipelineReturns struct {
 		result1 bool
@@ -33,10 +33,10 @@ type FakeTeam struct {
 	authReturnsOnCall map[int]struct {
 		result1 atc.TeamAuth
 	}
-	BuildInputsForJobStub        func(string, string) ([]atc.BuildInput, bool, error)
+	BuildInputsForJobStub        func(atc.PipelineRef, string) ([]atc.BuildInput, bool, error)
 	buildInputsForJobMutex       sync.RWMutex
 	buildInputsForJobArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}
 	buildInputsForJobReturns struct {
@@ -148,6 +148,19 @@ type FakeTeam struct {
 		result1 int64
 		result2 error
 	}
+	CreateAPITokenStub        func(atc.APIToken) (atc.APIToken, error)
+	createAPITokenMutex       sync.RWMutex
+	createAPITokenArgsForCall []struct {
+		arg1 atc.APIToken
+	}
+	createAPITokenReturns struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	createAPITokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 error
+	}
 	CreateArtifactStub        func(io.Reader, string) (atc.WorkerArtifact, error)
 	createArtifactMutex       sync.RWMutex
 	createArtifactArgsForCall []struct {
@@ -206,10 +219,10 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
-	CreateOrUpdatePipelineConfigStub        func(string, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)
+	CreateOrUpdatePipelineConfigStub        func(atc.PipelineRef, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)
 	createOrUpdatePipelineConfigMutex       sync.RWMutex
 	createOrUpdatePipelineConfigArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 []byte
 		arg4 bool
@@ -226,10 +239,10 @@ type FakeTeam struct {
 		result3 []concourse.ConfigWarning
 		result4 error
 	}
-	CreatePipelineBuildStub        func(string, atc.Plan) (atc.Build, error)
+	CreatePipelineBuildStub        func(atc.PipelineRef, atc.Plan) (atc.Build, error)
 	createPipelineBuildMutex       sync.RWMutex
 	createPipelineBuildArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Plan
 	}
 	createPipelineBuildReturns struct {
@@ -240,10 +253,10 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
-	DeletePipelineStub        func(string) (bool, error)
+	DeletePipelineStub        func(atc.PipelineRef) (bool, error)
 	deletePipelineMutex       sync.RWMutex
 	deletePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	deletePipelineReturns struct {
 		result1 bool
@@ -264,6 +277,23 @@ type FakeTeam struct {
 	destroyTeamReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DiffPipelineConfigVersionsStub        func(atc.PipelineRef, int, int) (atc.PipelineConfigDiff, bool, error)
+	diffPipelineConfigVersionsMutex       sync.RWMutex
+	diffPipelineConfigVersionsArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+		arg3 int
+	}
+	diffPipelineConfigVersionsReturns struct {
+		result1 atc.PipelineConfigDiff
+		result2 bool
+		result3 error
+	}
+	diffPipelineConfigVersionsReturnsOnCall map[int]struct {
+		result1 atc.PipelineConfigDiff
+		result2 bool
+		result3 error
+	}
 	DisableResourceVersionStub        func(string, string, int) (bool, error)
 	disableResourceVersionMutex       sync.RWMutex
 	disableResourceVersionArgsForCall []struct {
@@ -294,10 +324,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	ExposePipelineStub        func(string) (bool, error)
+	ExposePipelineStub        func(atc.PipelineRef) (bool, error)
 	exposePipelineMutex       sync.RWMutex
 	exposePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	exposePipelineReturns struct {
 		result1 bool
@@ -333,10 +363,10 @@ type FakeTeam struct {
 		result1 atc.Container
 		result2 error
 	}
-	HidePipelineStub        func(string) (bool, error)
+	HidePipelineStub        func(atc.PipelineRef) (bool, error)
 	hidePipelineMutex       sync.RWMutex
 	hidePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	hidePipelineReturns struct {
 		result1 bool
@@ -398,6 +428,35 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	JobTaskPlanStub        func(atc.PipelineRef, string, string) (atc.TaskPlan, bool, error)
+	jobTaskPlanMutex       sync.RWMutex
+	jobTaskPlanArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 string
+	}
+	jobTaskPlanReturns struct {
+		result1 atc.TaskPlan
+		result2 bool
+		result3 error
+	}
+	jobTaskPlanReturnsOnCall map[int]struct {
+		result1 atc.TaskPlan
+		result2 bool
+		result3 error
+	}
+	ListAPITokensStub        func() ([]atc.APIToken, error)
+	listAPITokensMutex       sync.RWMutex
+	listAPITokensArgsForCall []struct {
+	}
+	listAPITokensReturns struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	listAPITokensReturnsOnCall map[int]struct {
+		result1 []atc.APIToken
+		result2 error
+	}
 	ListContainersStub        func(map[string]string) ([]atc.Container, error)
 	listContainersMutex       sync.RWMutex
 	listContainersArgsForCall []struct {
@@ -496,10 +555,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	PausePipelineStub        func(string) (bool, error)
+	PausePipelineStub        func(atc.PipelineRef) (bool, error)
 	pausePipelineMutex       sync.RWMutex
 	pausePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pausePipelineReturns struct {
 		result1 bool
@@ -524,10 +583,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	PipelineStub        func(string) (atc.Pipeline, bool, error)
+	PipelineStub        func(atc.PipelineRef) (atc.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineReturns struct {
 		result1 atc.Pipeline
@@ -539,10 +598,10 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
-	PipelineBuildsStub        func(string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)
+	PipelineBuildsStub        func(atc.PipelineRef, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)
 	pipelineBuildsMutex       sync.RWMutex
 	pipelineBuildsArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 concourse.Page
 	}
 	pipelineBuildsReturns struct {
@@ -557,10 +616,10 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
-	PipelineConfigStub        func(string) (atc.Config, string, bool, error)
+	PipelineConfigStub        func(atc.PipelineRef) (atc.Config, string, bool, error)
 	pipelineConfigMutex       sync.RWMutex
 	pipelineConfigArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineConfigReturns struct {
 		result1 atc.Config
@@ -574,10 +633,53 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
-	RenamePipelineStub        func(string, string) (bool, error)
+	PipelineConfigVersionStub        func(atc.PipelineRef, int) (atc.PipelineConfigVersion, bool, error)
+	pipelineConfigVersionMutex       sync.RWMutex
+	pipelineConfigVersionArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}
+	pipelineConfigVersionReturns struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	pipelineConfigVersionReturnsOnCall map[int]struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	PipelineConfigVersionsStub        func(atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error)
+	pipelineConfigVersionsMutex       sync.RWMutex
+	pipelineConfigVersionsArgsForCall []struct {
+		arg1 atc.PipelineRef
+	}
+	pipelineConfigVersionsReturns struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	pipelineConfigVersionsReturnsOnCall map[int]struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	QuotaStub        func() (atc.QuotaUsage, error)
+	quotaMutex       sync.RWMutex
+	quotaArgsForCall []struct {
+	}
+	quotaReturns struct {
+		result1 atc.QuotaUsage
+		result2 error
+	}
+	quotaReturnsOnCall map[int]struct {
+		result1 atc.QuotaUsage
+		result2 error
+	}
+	RenamePipelineStub        func(atc.PipelineRef, string) (bool, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}
 	renamePipelineReturns struct {
@@ -653,6 +755,19 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	RevokeAPITokenStub        func(string) (bool, error)
+	revokeAPITokenMutex       sync.RWMutex
+	revokeAPITokenArgsForCall []struct {
+		arg1 string
+	}
+	revokeAPITokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ScheduleJobStub        func(string, string) (bool, error)
 	scheduleJobMutex       sync.RWMutex
 	scheduleJobArgsForCall []struct {
@@ -682,6 +797,17 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SetQuotaStub        func(atc.Quota) error
+	setQuotaMutex       sync.RWMutex
+	setQuotaArgsForCall []struct {
+		arg1 atc.Quota
+	}
+	setQuotaReturns struct {
+		result1 error
+	}
+	setQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UnpauseJobStub        func(string, string) (bool, error)
 	unpauseJobMutex       sync.RWMutex
 	unpauseJobArgsForCall []struct {
@@ -696,10 +822,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	UnpausePipelineStub        func(string) (bool, error)
+	UnpausePipelineStub        func(atc.PipelineRef) (bool, error)
 	unpausePipelineMutex       sync.RWMutex
 	unpausePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	unpausePipelineReturns struct {
 		result1 bool
@@ -723,10 +849,10 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	VersionedResourceTypesStub        func(string) (atc.VersionedResourceTypes, bool, error)
+	VersionedResourceTypesStub        func(atc.PipelineRef) (atc.VersionedResourceTypes, bool, error)
 	versionedResourceTypesMutex       sync.RWMutex
 	versionedResourceTypesArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	versionedResourceTypesReturns struct {
 		result1 atc.VersionedResourceTypes
@@ -742,11 +868,11 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeTeam) ArchivePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) ArchivePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.archivePipelineMutex.Lock()
 	ret, specificReturn := fake.archivePipelineReturnsOnCall[len(fake.archivePipelineArgsForCall)]
 	fake.archivePipelineArgsForCall = append(fake.archivePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("ArchivePipeline", []interface{}{arg1})
 	fake.archivePipelineMutex.Unlock()
@@ -766,13 +892,13 @@ func (fake *FakeTeam) ArchivePipelineCallCount() int {
 	return len(fake.archivePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) ArchivePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) ArchivePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.archivePipelineMutex.Lock()
 	defer fake.archivePipelineMutex.Unlock()
 	fake.ArchivePipelineStub = stub
 }
 
-func (fake *FakeTeam) ArchivePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) ArchivePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.archivePipelineMutex.RLock()
 	defer fake.archivePipelineMutex.RUnlock()
 	argsForCall := fake.archivePipelineArgsForCall[i]
@@ -857,11 +983,11 @@ func (fake *FakeTeam) AuthReturnsOnCall(i int, result1 atc.TeamAuth) {
 	}{result1}
 }
 
-func (fake *FakeTeam) BuildInputsForJob(arg1 string, arg2 string) ([]atc.BuildInput, bool, error) {
+func (fake *FakeTeam) BuildInputsForJob(arg1 atc.PipelineRef, arg2 string) ([]atc.BuildInput, bool, error) {
 	fake.buildInputsForJobMutex.Lock()
 	ret, specificReturn := fake.buildInputsForJobReturnsOnCall[len(fake.buildInputsForJobArgsForCall)]
 	fake.buildInputsForJobArgsForCall = append(fake.buildInputsForJobArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}{arg1, arg2})
 	fake.recordInvocation("BuildInputsForJob", []interface{}{arg1, arg2})
@@ -882,13 +1008,13 @@ func (fake *FakeTeam) BuildInputsForJobCallCount() int {
 	return len(fake.buildInputsForJobArgsForCall)
 }
 
-func (fake *FakeTeam) BuildInputsForJobCalls(stub func(string, string) ([]atc.BuildInput, bool, error)) {
+func (fake *FakeTeam) BuildInputsForJobCalls(stub func(atc.PipelineRef, string) ([]atc.BuildInput, bool, error)) {
 	fake.buildInputsForJobMutex.Lock()
 	defer fake.buildInputsForJobMutex.Unlock()
 	fake.BuildInputsForJobStub = stub
 }
 
-func (fake *FakeTeam) BuildInputsForJobArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) BuildInputsForJobArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.buildInputsForJobMutex.RLock()
 	defer fake.buildInputsForJobMutex.RUnlock()
 	argsForCall := fake.buildInputsForJobArgsForCall[i]
@@ -1328,6 +1454,69 @@ func (fake *FakeTeam) ClearTaskCacheReturnsOnCall(i int, result1 int64, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateAPIToken(arg1 atc.APIToken) (atc.APIToken, error) {
+	fake.createAPITokenMutex.Lock()
+	ret, specificReturn := fake.createAPITokenReturnsOnCall[len(fake.createAPITokenArgsForCall)]
+	fake.createAPITokenArgsForCall = append(fake.createAPITokenArgsForCall, struct {
+		arg1 atc.APIToken
+	}{arg1})
+	fake.recordInvocation("CreateAPIToken", []interface{}{arg1})
+	fake.createAPITokenMutex.Unlock()
+	if fake.CreateAPITokenStub != nil {
+		return fake.CreateAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateAPITokenCallCount() int {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	return len(fake.createAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateAPITokenCalls(stub func(atc.APIToken) (atc.APIToken, error)) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = stub
+}
+
+func (fake *FakeTeam) CreateAPITokenArgsForCall(i int) atc.APIToken {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	argsForCall := fake.createAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateAPITokenReturns(result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	fake.createAPITokenReturns = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateAPITokenReturnsOnCall(i int, result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	if fake.createAPITokenReturnsOnCall == nil {
+		fake.createAPITokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 error
+		})
+	}
+	fake.createAPITokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateArtifact(arg1 io.Reader, arg2 string) (atc.WorkerArtifact, error) {
 	fake.createArtifactMutex.Lock()
 	ret, specificReturn := fake.createArtifactReturnsOnCall[len(fake.createArtifactArgsForCall)]
@@ -1588,7 +1777,7 @@ func (fake *FakeTeam) CreateOrUpdateReturnsOnCall(i int, result1 atc.Team, resul
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) CreateOrUpdatePipelineConfig(arg1 string, arg2 string, arg3 []byte, arg4 bool) (bool, bool, []concourse.ConfigWarning, error) {
+func (fake *FakeTeam) CreateOrUpdatePipelineConfig(arg1 atc.PipelineRef, arg2 string, arg3 []byte, arg4 bool) (bool, bool, []concourse.ConfigWarning, error) {
 	var arg3Copy []byte
 	if arg3 != nil {
 		arg3Copy = make([]byte, len(arg3))
@@ -1597,7 +1786,7 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfig(arg1 string, arg2 string, arg
 	fake.createOrUpdatePipelineConfigMutex.Lock()
 	ret, specificReturn := fake.createOrUpdatePipelineConfigReturnsOnCall[len(fake.createOrUpdatePipelineConfigArgsForCall)]
 	fake.createOrUpdatePipelineConfigArgsForCall = append(fake.createOrUpdatePipelineConfigArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 		arg3 []byte
 		arg4 bool
@@ -1620,13 +1809,13 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfigCallCount() int {
 	return len(fake.createOrUpdatePipelineConfigArgsForCall)
 }
 
-func (fake *FakeTeam) CreateOrUpdatePipelineConfigCalls(stub func(string, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)) {
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigCalls(stub func(atc.PipelineRef, string, []byte, bool) (bool, bool, []concourse.ConfigWarning, error)) {
 	fake.createOrUpdatePipelineConfigMutex.Lock()
 	defer fake.createOrUpdatePipelineConfigMutex.Unlock()
 	fake.CreateOrUpdatePipelineConfigStub = stub
 }
 
-func (fake *FakeTeam) CreateOrUpdatePipelineConfigArgsForCall(i int) (string, string, []byte, bool) {
+func (fake *FakeTeam) CreateOrUpdatePipelineConfigArgsForCall(i int) (atc.PipelineRef, string, []byte, bool) {
 	fake.createOrUpdatePipelineConfigMutex.RLock()
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
 	argsForCall := fake.createOrUpdatePipelineConfigArgsForCall[i]
@@ -1665,11 +1854,11 @@ func (fake *FakeTeam) CreateOrUpdatePipelineConfigReturnsOnCall(i int, result1 b
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) CreatePipelineBuild(arg1 string, arg2 atc.Plan) (atc.Build, error) {
+func (fake *FakeTeam) CreatePipelineBuild(arg1 atc.PipelineRef, arg2 atc.Plan) (atc.Build, error) {
 	fake.createPipelineBuildMutex.Lock()
 	ret, specificReturn := fake.createPipelineBuildReturnsOnCall[len(fake.createPipelineBuildArgsForCall)]
 	fake.createPipelineBuildArgsForCall = append(fake.createPipelineBuildArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Plan
 	}{arg1, arg2})
 	fake.recordInvocation("CreatePipelineBuild", []interface{}{arg1, arg2})
@@ -1690,13 +1879,13 @@ func (fake *FakeTeam) CreatePipelineBuildCallCount() int {
 	return len(fake.createPipelineBuildArgsForCall)
 }
 
-func (fake *FakeTeam) CreatePipelineBuildCalls(stub func(string, atc.Plan) (atc.Build, error)) {
+func (fake *FakeTeam) CreatePipelineBuildCalls(stub func(atc.PipelineRef, atc.Plan) (atc.Build, error)) {
 	fake.createPipelineBuildMutex.Lock()
 	defer fake.createPipelineBuildMutex.Unlock()
 	fake.CreatePipelineBuildStub = stub
 }
 
-func (fake *FakeTeam) CreatePipelineBuildArgsForCall(i int) (string, atc.Plan) {
+func (fake *FakeTeam) CreatePipelineBuildArgsForCall(i int) (atc.PipelineRef, atc.Plan) {
 	fake.createPipelineBuildMutex.RLock()
 	defer fake.createPipelineBuildMutex.RUnlock()
 	argsForCall := fake.createPipelineBuildArgsForCall[i]
@@ -1729,11 +1918,11 @@ func (fake *FakeTeam) CreatePipelineBuildReturnsOnCall(i int, result1 atc.Build,
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) DeletePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) DeletePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.deletePipelineMutex.Lock()
 	ret, specificReturn := fake.deletePipelineReturnsOnCall[len(fake.deletePipelineArgsForCall)]
 	fake.deletePipelineArgsForCall = append(fake.deletePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("DeletePipeline", []interface{}{arg1})
 	fake.deletePipelineMutex.Unlock()
@@ -1753,13 +1942,13 @@ func (fake *FakeTeam) DeletePipelineCallCount() int {
 	return len(fake.deletePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) DeletePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) DeletePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.deletePipelineMutex.Lock()
 	defer fake.deletePipelineMutex.Unlock()
 	fake.DeletePipelineStub = stub
 }
 
-func (fake *FakeTeam) DeletePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) DeletePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
 	argsForCall := fake.deletePipelineArgsForCall[i]
@@ -1852,6 +2041,74 @@ func (fake *FakeTeam) DestroyTeamReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DiffPipelineConfigVersions(arg1 atc.PipelineRef, arg2 int, arg3 int) (atc.PipelineConfigDiff, bool, error) {
+	fake.diffPipelineConfigVersionsMutex.Lock()
+	ret, specificReturn := fake.diffPipelineConfigVersionsReturnsOnCall[len(fake.diffPipelineConfigVersionsArgsForCall)]
+	fake.diffPipelineConfigVersionsArgsForCall = append(fake.diffPipelineConfigVersionsArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+		arg3 int
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DiffPipelineConfigVersions", []interface{}{arg1, arg2, arg3})
+	fake.diffPipelineConfigVersionsMutex.Unlock()
+	if fake.DiffPipelineConfigVersionsStub != nil {
+		return fake.DiffPipelineConfigVersionsStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.diffPipelineConfigVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) DiffPipelineConfigVersionsCallCount() int {
+	fake.diffPipelineConfigVersionsMutex.RLock()
+	defer fake.diffPipelineConfigVersionsMutex.RUnlock()
+	return len(fake.diffPipelineConfigVersionsArgsForCall)
+}
+
+func (fake *FakeTeam) DiffPipelineConfigVersionsCalls(stub func(atc.PipelineRef, int, int) (atc.PipelineConfigDiff, bool, error)) {
+	fake.diffPipelineConfigVersionsMutex.Lock()
+	defer fake.diffPipelineConfigVersionsMutex.Unlock()
+	fake.DiffPipelineConfigVersionsStub = stub
+}
+
+func (fake *FakeTeam) DiffPipelineConfigVersionsArgsForCall(i int) (atc.PipelineRef, int, int) {
+	fake.diffPipelineConfigVersionsMutex.RLock()
+	defer fake.diffPipelineConfigVersionsMutex.RUnlock()
+	argsForCall := fake.diffPipelineConfigVersionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) DiffPipelineConfigVersionsReturns(result1 atc.PipelineConfigDiff, result2 bool, result3 error) {
+	fake.diffPipelineConfigVersionsMutex.Lock()
+	defer fake.diffPipelineConfigVersionsMutex.Unlock()
+	fake.DiffPipelineConfigVersionsStub = nil
+	fake.diffPipelineConfigVersionsReturns = struct {
+		result1 atc.PipelineConfigDiff
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) DiffPipelineConfigVersionsReturnsOnCall(i int, result1 atc.PipelineConfigDiff, result2 bool, result3 error) {
+	fake.diffPipelineConfigVersionsMutex.Lock()
+	defer fake.diffPipelineConfigVersionsMutex.Unlock()
+	fake.DiffPipelineConfigVersionsStub = nil
+	if fake.diffPipelineConfigVersionsReturnsOnCall == nil {
+		fake.diffPipelineConfigVersionsReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineConfigDiff
+			result2 bool
+			result3 error
+		})
+	}
+	fake.diffPipelineConfigVersionsReturnsOnCall[i] = struct {
+		result1 atc.PipelineConfigDiff
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) DisableResourceVersion(arg1 string, arg2 string, arg3 int) (bool, error) {
 	fake.disableResourceVersionMutex.Lock()
 	ret, specificReturn := fake.disableResourceVersionReturnsOnCall[len(fake.disableResourceVersionArgsForCall)]
@@ -1982,11 +2239,11 @@ func (fake *FakeTeam) EnableResourceVersionReturnsOnCall(i int, result1 bool, re
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) ExposePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) ExposePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.exposePipelineMutex.Lock()
 	ret, specificReturn := fake.exposePipelineReturnsOnCall[len(fake.exposePipelineArgsForCall)]
 	fake.exposePipelineArgsForCall = append(fake.exposePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("ExposePipeline", []interface{}{arg1})
 	fake.exposePipelineMutex.Unlock()
@@ -2006,13 +2263,13 @@ func (fake *FakeTeam) ExposePipelineCallCount() int {
 	return len(fake.exposePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) ExposePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) ExposePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.exposePipelineMutex.Lock()
 	defer fake.exposePipelineMutex.Unlock()
 	fake.ExposePipelineStub = stub
 }
 
-func (fake *FakeTeam) ExposePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) ExposePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.exposePipelineMutex.RLock()
 	defer fake.exposePipelineMutex.RUnlock()
 	argsForCall := fake.exposePipelineArgsForCall[i]
@@ -2171,11 +2428,11 @@ func (fake *FakeTeam) GetContainerReturnsOnCall(i int, result1 atc.Container, re
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) HidePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) HidePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.hidePipelineMutex.Lock()
 	ret, specificReturn := fake.hidePipelineReturnsOnCall[len(fake.hidePipelineArgsForCall)]
 	fake.hidePipelineArgsForCall = append(fake.hidePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("HidePipeline", []interface{}{arg1})
 	fake.hidePipelineMutex.Unlock()
@@ -2195,13 +2452,13 @@ func (fake *FakeTeam) HidePipelineCallCount() int {
 	return len(fake.hidePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) HidePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) HidePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.hidePipelineMutex.Lock()
 	defer fake.hidePipelineMutex.Unlock()
 	fake.HidePipelineStub = stub
 }
 
-func (fake *FakeTeam) HidePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) HidePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.hidePipelineMutex.RLock()
 	defer fake.hidePipelineMutex.RUnlock()
 	argsForCall := fake.hidePipelineArgsForCall[i]
@@ -2440,6 +2697,129 @@ func (fake *FakeTeam) JobBuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) JobTaskPlan(arg1 atc.PipelineRef, arg2 string, arg3 string) (atc.TaskPlan, bool, error) {
+	fake.jobTaskPlanMutex.Lock()
+	ret, specificReturn := fake.jobTaskPlanReturnsOnCall[len(fake.jobTaskPlanArgsForCall)]
+	fake.jobTaskPlanArgsForCall = append(fake.jobTaskPlanArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("JobTaskPlan", []interface{}{arg1, arg2, arg3})
+	fake.jobTaskPlanMutex.Unlock()
+	if fake.JobTaskPlanStub != nil {
+		return fake.JobTaskPlanStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.jobTaskPlanReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) JobTaskPlanCallCount() int {
+	fake.jobTaskPlanMutex.RLock()
+	defer fake.jobTaskPlanMutex.RUnlock()
+	return len(fake.jobTaskPlanArgsForCall)
+}
+
+func (fake *FakeTeam) JobTaskPlanCalls(stub func(atc.PipelineRef, string, string) (atc.TaskPlan, bool, error)) {
+	fake.jobTaskPlanMutex.Lock()
+	defer fake.jobTaskPlanMutex.Unlock()
+	fake.JobTaskPlanStub = stub
+}
+
+func (fake *FakeTeam) JobTaskPlanArgsForCall(i int) (atc.PipelineRef, string, string) {
+	fake.jobTaskPlanMutex.RLock()
+	defer fake.jobTaskPlanMutex.RUnlock()
+	argsForCall := fake.jobTaskPlanArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) JobTaskPlanReturns(result1 atc.TaskPlan, result2 bool, result3 error) {
+	fake.jobTaskPlanMutex.Lock()
+	defer fake.jobTaskPlanMutex.Unlock()
+	fake.JobTaskPlanStub = nil
+	fake.jobTaskPlanReturns = struct {
+		result1 atc.TaskPlan
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobTaskPlanReturnsOnCall(i int, result1 atc.TaskPlan, result2 bool, result3 error) {
+	fake.jobTaskPlanMutex.Lock()
+	defer fake.jobTaskPlanMutex.Unlock()
+	fake.JobTaskPlanStub = nil
+	if fake.jobTaskPlanReturnsOnCall == nil {
+		fake.jobTaskPlanReturnsOnCall = make(map[int]struct {
+			result1 atc.TaskPlan
+			result2 bool
+			result3 error
+		})
+	}
+	fake.jobTaskPlanReturnsOnCall[i] = struct {
+		result1 atc.TaskPlan
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) ListAPITokens() ([]atc.APIToken, error) {
+	fake.listAPITokensMutex.Lock()
+	ret, specificReturn := fake.listAPITokensReturnsOnCall[len(fake.listAPITokensArgsForCall)]
+	fake.listAPITokensArgsForCall = append(fake.listAPITokensArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListAPITokens", []interface{}{})
+	fake.listAPITokensMutex.Unlock()
+	if fake.ListAPITokensStub != nil {
+		return fake.ListAPITokensStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listAPITokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) ListAPITokensCallCount() int {
+	fake.listAPITokensMutex.RLock()
+	defer fake.listAPITokensMutex.RUnlock()
+	return len(fake.listAPITokensArgsForCall)
+}
+
+func (fake *FakeTeam) ListAPITokensCalls(stub func() ([]atc.APIToken, error)) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = stub
+}
+
+func (fake *FakeTeam) ListAPITokensReturns(result1 []atc.APIToken, result2 error) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = nil
+	fake.listAPITokensReturns = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) ListAPITokensReturnsOnCall(i int, result1 []atc.APIToken, result2 error) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = nil
+	if fake.listAPITokensReturnsOnCall == nil {
+		fake.listAPITokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.APIToken
+			result2 error
+		})
+	}
+	fake.listAPITokensReturnsOnCall[i] = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ListContainers(arg1 map[string]string) ([]atc.Container, error) {
 	fake.listContainersMutex.Lock()
 	ret, specificReturn := fake.listContainersReturnsOnCall[len(fake.listContainersArgsForCall)]
@@ -2920,11 +3300,11 @@ func (fake *FakeTeam) PauseJobReturnsOnCall(i int, result1 bool, result2 error)
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) PausePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) PausePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.pausePipelineMutex.Lock()
 	ret, specificReturn := fake.pausePipelineReturnsOnCall[len(fake.pausePipelineArgsForCall)]
 	fake.pausePipelineArgsForCall = append(fake.pausePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("PausePipeline", []interface{}{arg1})
 	fake.pausePipelineMutex.Unlock()
@@ -2944,13 +3324,13 @@ func (fake *FakeTeam) PausePipelineCallCount() int {
 	return len(fake.pausePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PausePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) PausePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.pausePipelineMutex.Lock()
 	defer fake.pausePipelineMutex.Unlock()
 	fake.PausePipelineStub = stub
 }
 
-func (fake *FakeTeam) PausePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PausePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pausePipelineMutex.RLock()
 	defer fake.pausePipelineMutex.RUnlock()
 	argsForCall := fake.pausePipelineArgsForCall[i]
@@ -3048,11 +3428,11 @@ func (fake *FakeTeam) PinResourceVersionReturnsOnCall(i int, result1 bool, resul
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) Pipeline(arg1 string) (atc.Pipeline, bool, error) {
+func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (atc.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
 	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("Pipeline", []interface{}{arg1})
 	fake.pipelineMutex.Unlock()
@@ -3072,13 +3452,13 @@ func (fake *FakeTeam) PipelineCallCount() int {
 	return len(fake.pipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineCalls(stub func(string) (atc.Pipeline, bool, error)) {
+func (fake *FakeTeam) PipelineCalls(stub func(atc.PipelineRef) (atc.Pipeline, bool, error)) {
 	fake.pipelineMutex.Lock()
 	defer fake.pipelineMutex.Unlock()
 	fake.PipelineStub = stub
 }
 
-func (fake *FakeTeam) PipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	argsForCall := fake.pipelineArgsForCall[i]
@@ -3114,11 +3494,11 @@ func (fake *FakeTeam) PipelineReturnsOnCall(i int, result1 atc.Pipeline, result2
 	}{result1, result2, result3}
 }
 
-func (fake *FakeTeam) PipelineBuilds(arg1 string, arg2 concourse.Page) ([]atc.Build, concourse.Pagination, bool, error) {
+func (fake *FakeTeam) PipelineBuilds(arg1 atc.PipelineRef, arg2 concourse.Page) ([]atc.Build, concourse.Pagination, bool, error) {
 	fake.pipelineBuildsMutex.Lock()
 	ret, specificReturn := fake.pipelineBuildsReturnsOnCall[len(fake.pipelineBuildsArgsForCall)]
 	fake.pipelineBuildsArgsForCall = append(fake.pipelineBuildsArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 concourse.Page
 	}{arg1, arg2})
 	fake.recordInvocation("PipelineBuilds", []interface{}{arg1, arg2})
@@ -3139,13 +3519,13 @@ func (fake *FakeTeam) PipelineBuildsCallCount() int {
 	return len(fake.pipelineBuildsArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineBuildsCalls(stub func(string, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)) {
+func (fake *FakeTeam) PipelineBuildsCalls(stub func(atc.PipelineRef, concourse.Page) ([]atc.Build, concourse.Pagination, bool, error)) {
 	fake.pipelineBuildsMutex.Lock()
 	defer fake.pipelineBuildsMutex.Unlock()
 	fake.PipelineBuildsStub = stub
 }
 
-func (fake *FakeTeam) PipelineBuildsArgsForCall(i int) (string, concourse.Page) {
+func (fake *FakeTeam) PipelineBuildsArgsForCall(i int) (atc.PipelineRef, concourse.Page) {
 	fake.pipelineBuildsMutex.RLock()
 	defer fake.pipelineBuildsMutex.RUnlock()
 	argsForCall := fake.pipelineBuildsArgsForCall[i]
@@ -3184,11 +3564,11 @@ func (fake *FakeTeam) PipelineBuildsReturnsOnCall(i int, result1 []atc.Build, re
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) PipelineConfig(arg1 string) (atc.Config, string, bool, error) {
+func (fake *FakeTeam) PipelineConfig(arg1 atc.PipelineRef) (atc.Config, string, bool, error) {
 	fake.pipelineConfigMutex.Lock()
 	ret, specificReturn := fake.pipelineConfigReturnsOnCall[len(fake.pipelineConfigArgsForCall)]
 	fake.pipelineConfigArgsForCall = append(fake.pipelineConfigArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("PipelineConfig", []interface{}{arg1})
 	fake.pipelineConfigMutex.Unlock()
@@ -3208,13 +3588,13 @@ func (fake *FakeTeam) PipelineConfigCallCount() int {
 	return len(fake.pipelineConfigArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineConfigCalls(stub func(string) (atc.Config, string, bool, error)) {
+func (fake *FakeTeam) PipelineConfigCalls(stub func(atc.PipelineRef) (atc.Config, string, bool, error)) {
 	fake.pipelineConfigMutex.Lock()
 	defer fake.pipelineConfigMutex.Unlock()
 	fake.PipelineConfigStub = stub
 }
 
-func (fake *FakeTeam) PipelineConfigArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineConfigArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
 	argsForCall := fake.pipelineConfigArgsForCall[i]
@@ -3253,11 +3633,199 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, error) {
+func (fake *FakeTeam) PipelineConfigVersion(arg1 atc.PipelineRef, arg2 int) (atc.PipelineConfigVersion, bool, error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigVersionReturnsOnCall[len(fake.pipelineConfigVersionArgsForCall)]
+	fake.pipelineConfigVersionArgsForCall = append(fake.pipelineConfigVersionArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("PipelineConfigVersion", []interface{}{arg1, arg2})
+	fake.pipelineConfigVersionMutex.Unlock()
+	if fake.PipelineConfigVersionStub != nil {
+		return fake.PipelineConfigVersionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineConfigVersionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigVersionCallCount() int {
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	return len(fake.pipelineConfigVersionArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigVersionCalls(stub func(atc.PipelineRef, int) (atc.PipelineConfigVersion, bool, error)) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigVersionArgsForCall(i int) (atc.PipelineRef, int) {
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	argsForCall := fake.pipelineConfigVersionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineConfigVersionReturns(result1 atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = nil
+	fake.pipelineConfigVersionReturns = struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersionReturnsOnCall(i int, result1 atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionMutex.Lock()
+	defer fake.pipelineConfigVersionMutex.Unlock()
+	fake.PipelineConfigVersionStub = nil
+	if fake.pipelineConfigVersionReturnsOnCall == nil {
+		fake.pipelineConfigVersionReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigVersionReturnsOnCall[i] = struct {
+		result1 atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersions(arg1 atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigVersionsReturnsOnCall[len(fake.pipelineConfigVersionsArgsForCall)]
+	fake.pipelineConfigVersionsArgsForCall = append(fake.pipelineConfigVersionsArgsForCall, struct {
+		arg1 atc.PipelineRef
+	}{arg1})
+	fake.recordInvocation("PipelineConfigVersions", []interface{}{arg1})
+	fake.pipelineConfigVersionsMutex.Unlock()
+	if fake.PipelineConfigVersionsStub != nil {
+		return fake.PipelineConfigVersionsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.pipelineConfigVersionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsCallCount() int {
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	return len(fake.pipelineConfigVersionsArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsCalls(stub func(atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error)) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsArgsForCall(i int) atc.PipelineRef {
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	argsForCall := fake.pipelineConfigVersionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsReturns(result1 []atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = nil
+	fake.pipelineConfigVersionsReturns = struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigVersionsReturnsOnCall(i int, result1 []atc.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.pipelineConfigVersionsMutex.Lock()
+	defer fake.pipelineConfigVersionsMutex.Unlock()
+	fake.PipelineConfigVersionsStub = nil
+	if fake.pipelineConfigVersionsReturnsOnCall == nil {
+		fake.pipelineConfigVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigVersionsReturnsOnCall[i] = struct {
+		result1 []atc.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) Quota() (atc.QuotaUsage, error) {
+	fake.quotaMutex.Lock()
+	ret, specificReturn := fake.quotaReturnsOnCall[len(fake.quotaArgsForCall)]
+	fake.quotaArgsForCall = append(fake.quotaArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Quota", []interface{}{})
+	fake.quotaMutex.Unlock()
+	if fake.QuotaStub != nil {
+		return fake.QuotaStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.quotaReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) QuotaCallCount() int {
+	fake.quotaMutex.RLock()
+	defer fake.quotaMutex.RUnlock()
+	return len(fake.quotaArgsForCall)
+}
+
+func (fake *FakeTeam) QuotaCalls(stub func() (atc.QuotaUsage, error)) {
+	fake.quotaMutex.Lock()
+	defer fake.quotaMutex.Unlock()
+	fake.QuotaStub = stub
+}
+
+func (fake *FakeTeam) QuotaReturns(result1 atc.QuotaUsage, result2 error) {
+	fake.quotaMutex.Lock()
+	defer fake.quotaMutex.Unlock()
+	fake.QuotaStub = nil
+	fake.quotaReturns = struct {
+		result1 atc.QuotaUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) QuotaReturnsOnCall(i int, result1 atc.QuotaUsage, result2 error) {
+	fake.quotaMutex.Lock()
+	defer fake.quotaMutex.Unlock()
+	fake.QuotaStub = nil
+	if fake.quotaReturnsOnCall == nil {
+		fake.quotaReturnsOnCall = make(map[int]struct {
+			result1 atc.QuotaUsage
+			result2 error
+		})
+	}
+	fake.quotaReturnsOnCall[i] = struct {
+		result1 atc.QuotaUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RenamePipeline(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
 	fake.renamePipelineArgsForCall = append(fake.renamePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 string
 	}{arg1, arg2})
 	fake.recordInvocation("RenamePipeline", []interface{}{arg1, arg2})
@@ -3278,13 +3846,13 @@ func (fake *FakeTeam) RenamePipelineCallCount() int {
 	return len(fake.renamePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) RenamePipelineCalls(stub func(string, string) (bool, error)) {
+func (fake *FakeTeam) RenamePipelineCalls(stub func(atc.PipelineRef, string) (bool, error)) {
 	fake.renamePipelineMutex.Lock()
 	defer fake.renamePipelineMutex.Unlock()
 	fake.RenamePipelineStub = stub
 }
 
-func (fake *FakeTeam) RenamePipelineArgsForCall(i int) (string, string) {
+func (fake *FakeTeam) RenamePipelineArgsForCall(i int) (atc.PipelineRef, string) {
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	argsForCall := fake.renamePipelineArgsForCall[i]
@@ -3585,6 +4153,69 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) RevokeAPIToken(arg1 string) (bool, error) {
+	fake.revokeAPITokenMutex.Lock()
+	ret, specificReturn := fake.revokeAPITokenReturnsOnCall[len(fake.revokeAPITokenArgsForCall)]
+	fake.revokeAPITokenArgsForCall = append(fake.revokeAPITokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("RevokeAPIToken", []interface{}{arg1})
+	fake.revokeAPITokenMutex.Unlock()
+	if fake.RevokeAPITokenStub != nil {
+		return fake.RevokeAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RevokeAPITokenCallCount() int {
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
+	return len(fake.revokeAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) RevokeAPITokenCalls(stub func(string) (bool, error)) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = stub
+}
+
+func (fake *FakeTeam) RevokeAPITokenArgsForCall(i int) string {
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
+	argsForCall := fake.revokeAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) RevokeAPITokenReturns(result1 bool, result2 error) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = nil
+	fake.revokeAPITokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RevokeAPITokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = nil
+	if fake.revokeAPITokenReturnsOnCall == nil {
+		fake.revokeAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ScheduleJob(arg1 string, arg2 string) (bool, error) {
 	fake.scheduleJobMutex.Lock()
 	ret, specificReturn := fake.scheduleJobReturnsOnCall[len(fake.scheduleJobArgsForCall)]
@@ -3714,6 +4345,66 @@ func (fake *FakeTeam) SetPinCommentReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetQuota(arg1 atc.Quota) error {
+	fake.setQuotaMutex.Lock()
+	ret, specificReturn := fake.setQuotaReturnsOnCall[len(fake.setQuotaArgsForCall)]
+	fake.setQuotaArgsForCall = append(fake.setQuotaArgsForCall, struct {
+		arg1 atc.Quota
+	}{arg1})
+	fake.recordInvocation("SetQuota", []interface{}{arg1})
+	fake.setQuotaMutex.Unlock()
+	if fake.SetQuotaStub != nil {
+		return fake.SetQuotaStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setQuotaReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetQuotaCallCount() int {
+	fake.setQuotaMutex.RLock()
+	defer fake.setQuotaMutex.RUnlock()
+	return len(fake.setQuotaArgsForCall)
+}
+
+func (fake *FakeTeam) SetQuotaCalls(stub func(atc.Quota) error) {
+	fake.setQuotaMutex.Lock()
+	defer fake.setQuotaMutex.Unlock()
+	fake.SetQuotaStub = stub
+}
+
+func (fake *FakeTeam) SetQuotaArgsForCall(i int) atc.Quota {
+	fake.setQuotaMutex.RLock()
+	defer fake.setQuotaMutex.RUnlock()
+	argsForCall := fake.setQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetQuotaReturns(result1 error) {
+	fake.setQuotaMutex.Lock()
+	defer fake.setQuotaMutex.Unlock()
+	fake.SetQuotaStub = nil
+	fake.setQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetQuotaReturnsOnCall(i int, result1 error) {
+	fake.setQuotaMutex.Lock()
+	defer fake.setQuotaMutex.Unlock()
+	fake.SetQuotaStub = nil
+	if fake.setQuotaReturnsOnCall == nil {
+		fake.setQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UnpauseJob(arg1 string, arg2 string) (bool, error) {
 	fake.unpauseJobMutex.Lock()
 	ret, specificReturn := fake.unpauseJobReturnsOnCall[len(fake.unpauseJobArgsForCall)]
@@ -3778,11 +4469,11 @@ func (fake *FakeTeam) UnpauseJobReturnsOnCall(i int, result1 bool, result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) UnpausePipeline(arg1 string) (bool, error) {
+func (fake *FakeTeam) UnpausePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.unpausePipelineMutex.Lock()
 	ret, specificReturn := fake.unpausePipelineReturnsOnCall[len(fake.unpausePipelineArgsForCall)]
 	fake.unpausePipelineArgsForCall = append(fake.unpausePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("UnpausePipeline", []interface{}{arg1})
 	fake.unpausePipelineMutex.Unlock()
@@ -3802,13 +4493,13 @@ func (fake *FakeTeam) UnpausePipelineCallCount() int {
 	return len(fake.unpausePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) UnpausePipelineCalls(stub func(string) (bool, error)) {
+func (fake *FakeTeam) UnpausePipelineCalls(stub func(atc.PipelineRef) (bool, error)) {
 	fake.unpausePipelineMutex.Lock()
 	defer fake.unpausePipelineMutex.Unlock()
 	fake.UnpausePipelineStub = stub
 }
 
-func (fake *FakeTeam) UnpausePipelineArgsForCall(i int) string {
+func (fake *FakeTeam) UnpausePipelineArgsForCall(i int) atc.PipelineRef {
 	fake.unpausePipelineMutex.RLock()
 	defer fake.unpausePipelineMutex.RUnlock()
 	argsForCall := fake.unpausePipelineArgsForCall[i]
@@ -3905,11 +4596,11 @@ func (fake *FakeTeam) UnpinResourceReturnsOnCall(i int, result1 bool, result2 er
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) VersionedResourceTypes(arg1 string) (atc.VersionedResourceTypes, bool, error) {
+func (fake *FakeTeam) VersionedResourceTypes(arg1 atc.PipelineRef) (atc.VersionedResourceTypes, bool, error) {
 	fake.versionedResourceTypesMutex.Lock()
 	ret, specificReturn := fake.versionedResourceTypesReturnsOnCall[len(fake.versionedResourceTypesArgsForCall)]
 	fake.versionedResourceTypesArgsForCall = append(fake.versionedResourceTypesArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("VersionedResourceTypes", []interface{}{arg1})
 	fake.versionedResourceTypesMutex.Unlock()
@@ -3929,13 +4620,13 @@ func (fake *FakeTeam) VersionedResourceTypesCallCount() int {
 	return len(fake.versionedResourceTypesArgsForCall)
 }
 
-func (fake *FakeTeam) VersionedResourceTypesCalls(stub func(string) (atc.VersionedResourceTypes, bool, error)) {
+func (fake *FakeTeam) VersionedResourceTypesCalls(stub func(atc.PipelineRef) (atc.VersionedResourceTypes, bool, error)) {
 	fake.versionedResourceTypesMutex.Lock()
 	defer fake.versionedResourceTypesMutex.Unlock()
 	fake.VersionedResourceTypesStub = stub
 }
 
-func (fake *FakeTeam) VersionedResourceTypesArgsForCall(i int) string {
+func (fake *FakeTeam) VersionedResourceTypesArgsForCall(i int) atc.PipelineRef {
 	fake.versionedResourceTypesMutex.RLock()
 	defer fake.versionedResourceTypesMutex.RUnlock()
 	argsForCall := fake.versionedResourceTypesArgsForCall[i]
@@ -3992,6 +4683,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.checkResourceTypeMutex.RUnlock()
 	fake.clearTaskCacheMutex.RLock()
 	defer fake.clearTaskCacheMutex.RUnlock()
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
 	fake.createArtifactMutex.RLock()
 	defer fake.createArtifactMutex.RUnlock()
 	fake.createBuildMutex.RLock()
@@ -4008,6 +4701,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.deletePipelineMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
+	fake.diffPipelineConfigVersionsMutex.RLock()
+	defer fake.diffPipelineConfigVersionsMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
 	defer fake.disableResourceVersionMutex.RUnlock()
 	fake.enableResourceVersionMutex.RLock()
@@ -4026,6 +4721,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.jobBuildMutex.RUnlock()
 	fake.jobBuildsMutex.RLock()
 	defer fake.jobBuildsMutex.RUnlock()
+	fake.jobTaskPlanMutex.RLock()
+	defer fake.jobTaskPlanMutex.RUnlock()
+	fake.listAPITokensMutex.RLock()
+	defer fake.listAPITokensMutex.RUnlock()
 	fake.listContainersMutex.RLock()
 	defer fake.listContainersMutex.RUnlock()
 	fake.listJobsMutex.RLock()
@@ -4052,6 +4751,12 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineConfigVersionMutex.RLock()
+	defer fake.pipelineConfigVersionMutex.RUnlock()
+	fake.pipelineConfigVersionsMutex.RLock()
+	defer fake.pipelineConfigVersionsMutex.RUnlock()
+	fake.quotaMutex.RLock()
+	defer fake.quotaMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -4062,10 +4767,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
 	fake.scheduleJobMutex.RLock()
 	defer fake.scheduleJobMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
+	fake.setQuotaMutex.RLock()
+	defer fake.setQuotaMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
 	defer fake.unpauseJobMutex.RUnlock()
 	fake.unpausePipelineMutex.RLock()
@@ -0,0 +1,102 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) PipelineConfigVersions(pipelineRef atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.name,
+	}
+
+	var versions []atc.PipelineConfigVersion
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListConfigVersions,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &versions,
+	})
+
+	switch err.(type) {
+	case nil:
+		return versions, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) PipelineConfigVersion(pipelineRef atc.PipelineRef, version int) (atc.PipelineConfigVersion, bool, error) {
+	params := rata.Params{
+		"pipeline_name":  pipelineRef.Name,
+		"team_name":      team.name,
+		"config_version": strconv.Itoa(version),
+	}
+
+	var configVersion atc.PipelineConfigVersion
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetConfigVersion,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &configVersion,
+	})
+
+	switch err.(type) {
+	case nil:
+		return configVersion, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.PipelineConfigVersion{}, false, nil
+	default:
+		return atc.PipelineConfigVersion{}, false, err
+	}
+}
+
+// DiffPipelineConfigVersions fetches two versions of the pipeline's config to
+// compare. A zero "to" means the latest version, and a zero "from" means the
+// version saved before "to".
+func (team *team) DiffPipelineConfigVersions(pipelineRef atc.PipelineRef, from int, to int) (atc.PipelineConfigDiff, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.name,
+	}
+
+	query := pipelineRef.QueryParams()
+	if query == nil {
+		query = url.Values{}
+	}
+
+	if from != 0 {
+		query.Set(atc.DiffConfigVersionsFrom, strconv.Itoa(from))
+	}
+
+	if to != 0 {
+		query.Set(atc.DiffConfigVersionsTo, strconv.Itoa(to))
+	}
+
+	var diff atc.PipelineConfigDiff
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DiffConfigVersions,
+		Params:      params,
+		Query:       query,
+	}, &internal.Response{
+		Result: &diff,
+	})
+
+	switch err.(type) {
+	case nil:
+		return diff, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.PipelineConfigDiff{}, false, nil
+	default:
+		return atc.PipelineConfigDiff{}, false, err
+	}
+}
@@ -0,0 +1,149 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Config Versions", func() {
+	pipelineRef := atc.PipelineRef{Name: "mypipeline"}
+
+	Describe("PipelineConfigVersions", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/versions"
+
+		Context("when the pipeline exists", func() {
+			expectedVersions := []atc.PipelineConfigVersion{
+				{Version: 2, SavedAt: 200, SavedByBuild: 42},
+				{Version: 1, SavedAt: 100, SavedBy: "some-user"},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedVersions),
+					),
+				)
+			})
+
+			It("returns the versions", func() {
+				versions, found, err := team.PipelineConfigVersions(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(versions).To(Equal(expectedVersions))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineConfigVersions(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("PipelineConfigVersion", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/versions/7"
+
+		Context("when the version exists", func() {
+			expectedVersion := atc.PipelineConfigVersion{
+				Version: 7,
+				SavedAt: 700,
+				SavedBy: "some-user",
+				Config:  &atc.Config{Groups: atc.GroupConfigs{{Name: "some-group"}}},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedVersion),
+					),
+				)
+			})
+
+			It("returns the version with its config", func() {
+				version, found, err := team.PipelineConfigVersion(pipelineRef, 7)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(version).To(Equal(expectedVersion))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineConfigVersion(pipelineRef, 7)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("DiffPipelineConfigVersions", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/diff"
+
+		expectedDiff := atc.PipelineConfigDiff{
+			From: atc.PipelineConfigVersion{Version: 1, Config: &atc.Config{}},
+			To:   atc.PipelineConfigVersion{Version: 3, Config: &atc.Config{}},
+		}
+
+		Context("when given versions", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "from=1&to=3"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDiff),
+					),
+				)
+			})
+
+			It("returns the versions to compare", func() {
+				diff, found, err := team.DiffPipelineConfigVersions(pipelineRef, 1, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(diff).To(Equal(expectedDiff))
+			})
+		})
+
+		Context("when not given versions", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDiff),
+					),
+				)
+			})
+
+			It("leaves the choice to the server", func() {
+				_, found, err := team.DiffPipelineConfigVersions(pipelineRef, 0, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+	})
+})
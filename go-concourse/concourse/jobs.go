@@ -180,3 +180,30 @@ func (team *team) ClearTaskCache(pipelineName string, jobName string, stepName s
 		return ctcResponse.CachesRemoved, nil
 	}
 }
+
+func (team *team) JobTaskPlan(pipelineRef atc.PipelineRef, jobName string, stepName string) (atc.TaskPlan, bool, error) {
+	params := rata.Params{
+		"team_name":     team.name,
+		"pipeline_name": pipelineRef.Name,
+		"job_name":      jobName,
+		"step_name":     stepName,
+	}
+
+	var plan atc.TaskPlan
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetJobTaskPlan,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &plan,
+	})
+
+	switch err.(type) {
+	case nil:
+		return plan, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.TaskPlan{}, false, nil
+	default:
+		return atc.TaskPlan{}, false, err
+	}
+}
@@ -592,4 +592,52 @@ var _ = Describe("ATC Handler Jobs", func() {
 		})
 	})
 
+	Describe("JobTaskPlan", func() {
+		var expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/tasks/mystep/plan"
+		var pipelineRef = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+
+		Context("when the task step exists", func() {
+			var expectedPlan atc.TaskPlan
+
+			BeforeEach(func() {
+				expectedPlan = atc.TaskPlan{
+					Name:         "mystep",
+					ConfigPath:   "some-input/task.yml",
+					Vars:         atc.Params{"some": "((var))"},
+					InputMapping: map[string]string{"source": "some-input"},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "instance_vars=%7B%22branch%22%3A%22master%22%7D"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedPlan),
+					),
+				)
+			})
+
+			It("returns the step's plan", func() {
+				plan, found, err := team.JobTaskPlan(pipelineRef, "myjob", "mystep")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(plan).To(Equal(expectedPlan))
+			})
+		})
+
+		Context("when the task step does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := team.JobTaskPlan(pipelineRef, "myjob", "mystep")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
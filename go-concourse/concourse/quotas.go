@@ -0,0 +1,67 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) ClusterQuota() (atc.QuotaUsage, error) {
+	var usage atc.QuotaUsage
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetClusterQuota,
+	}, &internal.Response{
+		Result: &usage,
+	})
+
+	return usage, err
+}
+
+func (client *client) SetClusterQuota(quota atc.Quota) error {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(quota)
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.SetClusterQuota,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+}
+
+func (team *team) Quota() (atc.QuotaUsage, error) {
+	var usage atc.QuotaUsage
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetTeamQuota,
+		Params:      rata.Params{"team_name": team.name},
+	}, &internal.Response{
+		Result: &usage,
+	})
+
+	return usage, err
+}
+
+func (team *team) SetQuota(quota atc.Quota) error {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(quota)
+	if err != nil {
+		return err
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SetTeamQuota,
+		Params:      rata.Params{"team_name": team.name},
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+}
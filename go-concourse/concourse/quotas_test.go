@@ -0,0 +1,104 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Quotas", func() {
+	usage := atc.QuotaUsage{
+		Quota:   atc.Quota{Builds: 10, TaskContainers: 20},
+		Running: atc.Quota{Builds: 4, TaskContainers: 7},
+	}
+
+	Describe("ClusterQuota", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/quota"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, usage),
+				),
+			)
+		})
+
+		It("returns the cluster's quota and its usage", func() {
+			actual, err := client.ClusterQuota()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(usage))
+		})
+	})
+
+	Describe("SetClusterQuota", func() {
+		Context("when the quota is set", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/quota"),
+						ghttp.VerifyJSONRepresenting(atc.Quota{Builds: 10}),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("succeeds", func() {
+				err := client.SetClusterQuota(atc.Quota{Builds: 10})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/quota"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				err := client.SetClusterQuota(atc.Quota{Builds: 10})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Quota", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/quota"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, usage),
+				),
+			)
+		})
+
+		It("returns the team's quota and its usage", func() {
+			actual, err := team.Quota()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actual).To(Equal(usage))
+		})
+	})
+
+	Describe("SetQuota", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/quota"),
+					ghttp.VerifyJSONRepresenting(atc.Quota{TaskContainers: 5}),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("sets the team's quota", func() {
+			err := team.SetQuota(atc.Quota{TaskContainers: 5})
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -92,3 +93,59 @@ func (client *client) LandWorker(workerName string) error {
 
 	return err
 }
+
+func (client *client) CordonWorker(workerName string, cordon atc.WorkerCordon) error {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(cordon)
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.CordonWorker,
+		Params:      rata.Params{"worker_name": workerName},
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+}
+
+func (client *client) UncordonWorker(workerName string) error {
+	return client.connection.Send(internal.Request{
+		RequestName: atc.UncordonWorker,
+		Params:      rata.Params{"worker_name": workerName},
+	}, nil)
+}
+
+func (client *client) CordonTaggedWorkers(cordon atc.WorkerTagCordon) error {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(cordon)
+	if err != nil {
+		return err
+	}
+
+	return client.connection.Send(internal.Request{
+		RequestName: atc.CordonTaggedWorkers,
+		Body:        buffer,
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+}
+
+func (client *client) UncordonTaggedWorkers(tags []string) (bool, error) {
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.UncordonTaggedWorkers,
+		Query:       url.Values{"tag": tags},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
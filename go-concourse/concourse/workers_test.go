@@ -160,4 +160,166 @@ var _ = Describe("ATC Handler Workers", func() {
 			})
 		})
 	})
+
+	Describe("CordonWorker", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+						ghttp.VerifyJSONRepresenting(atc.WorkerCordon{StartTime: 100, EndTime: 200}),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("cordons the worker", func() {
+				err := client.CordonWorker("some-worker", atc.WorkerCordon{StartTime: 100, EndTime: 200})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("failing to cordon worker", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/workers/some-worker/cordon"),
+						ghttp.RespondWith(http.StatusBadRequest, "cordon must end in the future"),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				err := client.CordonWorker("some-worker", atc.WorkerCordon{EndTime: 200})
+				Expect(err).To(MatchError(ContainSubstring("cordon must end in the future")))
+			})
+		})
+	})
+
+	Describe("UncordonWorker", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/workers/some-worker/cordon"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("uncordons the worker", func() {
+				err := client.UncordonWorker("some-worker")
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("failing to uncordon worker", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/workers/some-worker/cordon"),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				err := client.UncordonWorker("some-worker")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("CordonTaggedWorkers", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/worker-cordons"),
+						ghttp.VerifyJSONRepresenting(atc.WorkerTagCordon{
+							Tags:         []string{"some-tag"},
+							WorkerCordon: atc.WorkerCordon{StartTime: 100, EndTime: 200},
+						}),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("cordons the workers with the tags", func() {
+				err := client.CordonTaggedWorkers(atc.WorkerTagCordon{
+					Tags:         []string{"some-tag"},
+					WorkerCordon: atc.WorkerCordon{StartTime: 100, EndTime: 200},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("failing to cordon the workers", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/worker-cordons"),
+						ghttp.RespondWith(http.StatusBadRequest, "cordon must end in the future"),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				err := client.CordonTaggedWorkers(atc.WorkerTagCordon{Tags: []string{"some-tag"}})
+				Expect(err).To(MatchError(ContainSubstring("cordon must end in the future")))
+			})
+		})
+	})
+
+	Describe("UncordonTaggedWorkers", func() {
+		Context("when succeeds", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/worker-cordons", "tag=some-tag&tag=other-tag"),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("uncordons the workers with the tags", func() {
+				found, err := client.UncordonTaggedWorkers([]string{"some-tag", "other-tag"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the tags are not cordoned", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/worker-cordons"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				found, err := client.UncordonTaggedWorkers([]string{"some-tag"})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("failing to uncordon the workers", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/worker-cordons"),
+						ghttp.RespondWith(http.StatusInternalServerError, nil),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, err := client.UncordonTaggedWorkers([]string{"some-tag"})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
@@ -150,6 +150,60 @@ func (flag *AuthTeamFlags) formatFromFile() (atc.TeamAuth, error) {
 	return auth, nil
 }
 
+// Custom roles can only be defined in a configuration file, alongside the
+// users and groups granted them, e.g.
+//
+// roles:
+// - name: releaser
+//   actions: [CreateJobBuild, PinResourceVersion, viewer]
+//   pipelines: [release-*]
+//   local:
+//     users: [some-user]
+
+func (flag *AuthTeamFlags) Roles() (atc.TeamRoles, error) {
+	path := flag.Config.Path()
+	if path == "" {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var data struct {
+		Roles []struct {
+			Name      string   `json:"name"`
+			Actions   []string `json:"actions"`
+			Pipelines []string `json:"pipelines"`
+		} `json:"roles"`
+	}
+	if err = yaml.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+
+	var roles atc.TeamRoles
+	for _, role := range data.Roles {
+		// an entry without actions or pipelines only grants a role, which
+		// must then be built in or defined by another entry
+		if len(role.Actions) == 0 && len(role.Pipelines) == 0 {
+			continue
+		}
+
+		roles = append(roles, atc.TeamRole{
+			Name:      role.Name,
+			Actions:   role.Actions,
+			Pipelines: role.Pipelines,
+		})
+	}
+
+	if err := roles.Validate(); err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
 // When formatting team config from the command line flags, the connector's
 // TeamConfig has already been populated by the flags library. All we need to
 // do is grab the teamConfig object and extract the users and groups.
@@ -0,0 +1,193 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel/api/core"
+	apitrace "go.opentelemetry.io/otel/api/trace"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// otlpExportTimeout bounds how long a batch of spans may take to be sent, so
+// that an unreachable collector doesn't hold spans in memory indefinitely.
+const otlpExportTimeout = 10 * time.Second
+
+type OTLP struct {
+	Address  string            `long:"otlp-address"  description:"otlp collector address: host:port for grpc, or a url for http"`
+	Protocol string            `long:"otlp-protocol" description:"protocol to send spans to the otlp collector with" choice:"grpc" choice:"http" default:"grpc"`
+	Headers  map[string]string `long:"otlp-header"   description:"headers to attach to every request to the otlp collector, e.g. for authentication"`
+	UseTLS   bool              `long:"otlp-use-tls"  description:"whether to use tls when sending spans over grpc"`
+	Service  string            `long:"otlp-service"  description:"otlp service name of the spans' resource" default:"web"`
+}
+
+func (o OTLP) IsConfigured() bool {
+	return o.Address != ""
+}
+
+func (o OTLP) Exporter() (export.SpanSyncer, error) {
+	send, err := OTLPCollector{
+		Address:  o.Address,
+		Protocol: o.Protocol,
+		Headers:  o.Headers,
+		UseTLS:   o.UseTLS,
+	}.Sender(OTLPTraces)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create otlp exporter: %w", err)
+	}
+
+	return &otlpExporter{
+		resource: &resourcepb.Resource{
+			Attributes: []*commonpb.KeyValue{
+				otlpKeyValue(core.Key("service.name").String(o.Service)),
+			},
+		},
+		send: send,
+	}, nil
+}
+
+// otlpExporter converts spans to OTLP and sends them to a collector. It is
+// registered as a batcher, so that spans are sent in batches rather than one
+// request per span.
+type otlpExporter struct {
+	resource *resourcepb.Resource
+	send     OTLPSender
+}
+
+func (e *otlpExporter) ExportSpan(ctx context.Context, span *export.SpanData) {
+	e.ExportSpans(ctx, []*export.SpanData{span})
+}
+
+func (e *otlpExporter) ExportSpans(ctx context.Context, spans []*export.SpanData) {
+	if len(spans) == 0 {
+		return
+	}
+
+	request := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{
+			{
+				Resource: e.resource,
+				ScopeSpans: []*tracepb.ScopeSpans{
+					{
+						Scope: &commonpb.InstrumentationScope{Name: "concourse"},
+						Spans: otlpSpans(spans),
+					},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, otlpExportTimeout)
+	defer cancel()
+
+	// like the other exporters, failures to export are not reported; tracing
+	// is best-effort and must never get in the way of what's being traced
+	_ = e.send(ctx, request)
+}
+
+func otlpSpans(spans []*export.SpanData) []*tracepb.Span {
+	converted := make([]*tracepb.Span, len(spans))
+
+	for i, span := range spans {
+		otlpSpan := &tracepb.Span{
+			TraceId:                span.SpanContext.TraceID[:],
+			SpanId:                 span.SpanContext.SpanID[:],
+			Name:                   span.Name,
+			Kind:                   otlpSpanKind(span.SpanKind),
+			StartTimeUnixNano:      uint64(span.StartTime.UnixNano()),
+			EndTimeUnixNano:        uint64(span.EndTime.UnixNano()),
+			Attributes:             otlpAttributes(span.Attributes),
+			DroppedAttributesCount: uint32(span.DroppedAttributeCount),
+			DroppedEventsCount:     uint32(span.DroppedMessageEventCount),
+			DroppedLinksCount:      uint32(span.DroppedLinkCount),
+			Status:                 &tracepb.Status{},
+		}
+
+		if span.ParentSpanID != (core.SpanID{}) {
+			otlpSpan.ParentSpanId = span.ParentSpanID[:]
+		}
+
+		if span.Status != codes.OK {
+			otlpSpan.Status = &tracepb.Status{
+				Code:    tracepb.Status_STATUS_CODE_ERROR,
+				Message: span.Status.String(),
+			}
+		}
+
+		for _, event := range span.MessageEvents {
+			otlpSpan.Events = append(otlpSpan.Events, &tracepb.Span_Event{
+				Name:         event.Name,
+				TimeUnixNano: uint64(event.Time.UnixNano()),
+				Attributes:   otlpAttributes(event.Attributes),
+			})
+		}
+
+		for _, link := range span.Links {
+			otlpSpan.Links = append(otlpSpan.Links, &tracepb.Span_Link{
+				TraceId:    link.TraceID[:],
+				SpanId:     link.SpanID[:],
+				Attributes: otlpAttributes(link.Attributes),
+			})
+		}
+
+		converted[i] = otlpSpan
+	}
+
+	return converted
+}
+
+func otlpSpanKind(kind apitrace.SpanKind) tracepb.Span_SpanKind {
+	switch kind {
+	case apitrace.SpanKindServer:
+		return tracepb.Span_SPAN_KIND_SERVER
+	case apitrace.SpanKindClient:
+		return tracepb.Span_SPAN_KIND_CLIENT
+	case apitrace.SpanKindProducer:
+		return tracepb.Span_SPAN_KIND_PRODUCER
+	case apitrace.SpanKindConsumer:
+		return tracepb.Span_SPAN_KIND_CONSUMER
+	default:
+		return tracepb.Span_SPAN_KIND_INTERNAL
+	}
+}
+
+func otlpAttributes(attrs []core.KeyValue) []*commonpb.KeyValue {
+	converted := make([]*commonpb.KeyValue, len(attrs))
+	for i, attr := range attrs {
+		converted[i] = otlpKeyValue(attr)
+	}
+
+	return converted
+}
+
+func otlpKeyValue(kv core.KeyValue) *commonpb.KeyValue {
+	value := &commonpb.AnyValue{}
+
+	switch kv.Value.Type() {
+	case core.BOOL:
+		value.Value = &commonpb.AnyValue_BoolValue{BoolValue: kv.Value.AsBool()}
+	case core.INT32:
+		value.Value = &commonpb.AnyValue_IntValue{IntValue: int64(kv.Value.AsInt32())}
+	case core.INT64:
+		value.Value = &commonpb.AnyValue_IntValue{IntValue: kv.Value.AsInt64()}
+	case core.UINT32:
+		value.Value = &commonpb.AnyValue_IntValue{IntValue: int64(kv.Value.AsUint32())}
+	case core.FLOAT32:
+		value.Value = &commonpb.AnyValue_DoubleValue{DoubleValue: float64(kv.Value.AsFloat32())}
+	case core.FLOAT64:
+		value.Value = &commonpb.AnyValue_DoubleValue{DoubleValue: kv.Value.AsFloat64()}
+	default:
+		value.Value = &commonpb.AnyValue_StringValue{StringValue: kv.Value.Emit()}
+	}
+
+	return &commonpb.KeyValue{
+		Key:   string(kv.Key),
+		Value: value,
+	}
+}
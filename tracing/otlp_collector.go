@@ -0,0 +1,133 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	OTLPProtocolGRPC = "grpc"
+	OTLPProtocolHTTP = "http"
+)
+
+// OTLPSignal is a kind of telemetry received by an OTLP collector, along with
+// where the collector receives it.
+type OTLPSignal struct {
+	httpPath   string
+	grpcMethod string
+	response   func() proto.Message
+}
+
+var (
+	OTLPTraces = OTLPSignal{
+		httpPath:   "/v1/traces",
+		grpcMethod: "/" + coltracepb.TraceService_ServiceDesc.ServiceName + "/Export",
+		response:   func() proto.Message { return &coltracepb.ExportTraceServiceResponse{} },
+	}
+
+	OTLPMetrics = OTLPSignal{
+		httpPath:   "/v1/metrics",
+		grpcMethod: "/" + colmetricspb.MetricsService_ServiceDesc.ServiceName + "/Export",
+		response:   func() proto.Message { return &colmetricspb.ExportMetricsServiceResponse{} },
+	}
+)
+
+// OTLPSender sends an export request to an OTLP collector.
+type OTLPSender func(context.Context, proto.Message) error
+
+// OTLPCollector is where both the OTLP exporter of spans and the OTLP emitter
+// of metrics send to.
+type OTLPCollector struct {
+	Address  string
+	Protocol string
+	Headers  map[string]string
+	UseTLS   bool
+}
+
+// Sender returns a sender of the signal's export requests. Over http, an
+// address without a path is given the signal's default path.
+func (c OTLPCollector) Sender(signal OTLPSignal) (OTLPSender, error) {
+	switch c.Protocol {
+	case OTLPProtocolHTTP:
+		endpoint, err := url.Parse(c.Address)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse otlp address: %w", err)
+		}
+
+		if endpoint.Path == "" || endpoint.Path == "/" {
+			endpoint.Path = signal.httpPath
+		}
+
+		return otlpHTTPSender(endpoint.String(), c.Headers), nil
+
+	case OTLPProtocolGRPC, "":
+		dialOpt := grpc.WithInsecure()
+		if c.UseTLS {
+			dialOpt = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{}))
+		}
+
+		conn, err := grpc.Dial(c.Address, dialOpt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to otlp collector: %w", err)
+		}
+
+		return otlpGRPCSender(conn, signal, c.Headers), nil
+
+	default:
+		return nil, fmt.Errorf("unknown otlp protocol '%s'", c.Protocol)
+	}
+}
+
+func otlpGRPCSender(conn *grpc.ClientConn, signal OTLPSignal, headers map[string]string) OTLPSender {
+	md := metadata.New(headers)
+
+	return func(ctx context.Context, request proto.Message) error {
+		return conn.Invoke(metadata.NewOutgoingContext(ctx, md), signal.grpcMethod, request, signal.response())
+	}
+}
+
+func otlpHTTPSender(endpoint string, headers map[string]string) OTLPSender {
+	return func(ctx context.Context, request proto.Message) error {
+		body, err := proto.Marshal(request)
+		if err != nil {
+			return err
+		}
+
+		req, err := http.NewRequest("POST", endpoint, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("Content-Type", "application/x-protobuf")
+		for name, value := range headers {
+			req.Header.Set(name, value)
+		}
+
+		resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+
+		defer resp.Body.Close()
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			return fmt.Errorf("otlp collector responded with %s", resp.Status)
+		}
+
+		return nil
+	}
+}
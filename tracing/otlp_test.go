@@ -0,0 +1,179 @@
+package tracing_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/tracing"
+	"go.opentelemetry.io/otel/api/core"
+	"go.opentelemetry.io/otel/api/key"
+	export "go.opentelemetry.io/otel/sdk/export/trace"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+// otlpReceiver is a stand-in for an OTLP collector's trace service.
+type otlpReceiver struct {
+	coltracepb.UnimplementedTraceServiceServer
+
+	requests chan *coltracepb.ExportTraceServiceRequest
+	metadata chan metadata.MD
+}
+
+func (r *otlpReceiver) Export(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	r.metadata <- md
+	r.requests <- req
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+var _ = Describe("OTLP", func() {
+	var (
+		config tracing.OTLP
+		span   *export.SpanData
+	)
+
+	BeforeEach(func() {
+		config = tracing.OTLP{
+			Headers: map[string]string{"x-api-key": "some-key"},
+			Service: "some-service",
+		}
+
+		span = &export.SpanData{
+			SpanContext: core.SpanContext{
+				TraceID: core.TraceID{1, 2, 3},
+				SpanID:  core.SpanID{4, 5, 6},
+			},
+			ParentSpanID: core.SpanID{7, 8, 9},
+			Name:         "task",
+			StartTime:    time.Unix(100, 0),
+			EndTime:      time.Unix(200, 0),
+			Attributes: []core.KeyValue{
+				key.New("team").String("some-team"),
+			},
+		}
+	})
+
+	expectSpan := func(request *coltracepb.ExportTraceServiceRequest) {
+		Expect(request.ResourceSpans).To(HaveLen(1))
+
+		resource := request.ResourceSpans[0].Resource
+		Expect(resource.Attributes).To(HaveLen(1))
+		Expect(resource.Attributes[0].Key).To(Equal("service.name"))
+		Expect(resource.Attributes[0].Value.GetStringValue()).To(Equal("some-service"))
+
+		Expect(request.ResourceSpans[0].ScopeSpans).To(HaveLen(1))
+		spans := request.ResourceSpans[0].ScopeSpans[0].Spans
+		Expect(spans).To(HaveLen(1))
+
+		Expect(spans[0].Name).To(Equal("task"))
+		Expect(spans[0].TraceId).To(Equal([]byte{1, 2, 3, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}))
+		Expect(spans[0].SpanId).To(Equal([]byte{4, 5, 6, 0, 0, 0, 0, 0}))
+		Expect(spans[0].ParentSpanId).To(Equal([]byte{7, 8, 9, 0, 0, 0, 0, 0}))
+		Expect(spans[0].Kind).To(Equal(tracepb.Span_SPAN_KIND_INTERNAL))
+		Expect(spans[0].StartTimeUnixNano).To(Equal(uint64(100 * time.Second)))
+		Expect(spans[0].EndTimeUnixNano).To(Equal(uint64(200 * time.Second)))
+		Expect(spans[0].Attributes).To(HaveLen(1))
+		Expect(spans[0].Attributes[0].Key).To(Equal("team"))
+		Expect(spans[0].Attributes[0].Value.GetStringValue()).To(Equal("some-team"))
+	}
+
+	It("is only configured with an address", func() {
+		Expect(config.IsConfigured()).To(BeFalse())
+
+		config.Address = "collector:4317"
+		Expect(config.IsConfigured()).To(BeTrue())
+	})
+
+	Context("over grpc", func() {
+		var (
+			server   *grpc.Server
+			receiver *otlpReceiver
+		)
+
+		BeforeEach(func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+
+			receiver = &otlpReceiver{
+				requests: make(chan *coltracepb.ExportTraceServiceRequest, 1),
+				metadata: make(chan metadata.MD, 1),
+			}
+
+			server = grpc.NewServer()
+			coltracepb.RegisterTraceServiceServer(server, receiver)
+			go server.Serve(listener)
+
+			config.Protocol = tracing.OTLPProtocolGRPC
+			config.Address = listener.Addr().String()
+		})
+
+		AfterEach(func() {
+			server.Stop()
+		})
+
+		It("sends spans to the collector", func() {
+			exporter, err := config.Exporter()
+			Expect(err).NotTo(HaveOccurred())
+
+			exporter.ExportSpan(context.Background(), span)
+
+			var request *coltracepb.ExportTraceServiceRequest
+			Eventually(receiver.requests).Should(Receive(&request))
+			expectSpan(request)
+
+			var md metadata.MD
+			Eventually(receiver.metadata).Should(Receive(&md))
+			Expect(md.Get("x-api-key")).To(Equal([]string{"some-key"}))
+		})
+	})
+
+	Context("over http", func() {
+		var server *ghttp.Server
+
+		BeforeEach(func() {
+			server = ghttp.NewServer()
+
+			config.Protocol = tracing.OTLPProtocolHTTP
+			config.Address = server.URL()
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("posts spans to the collector's traces endpoint", func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/v1/traces"),
+					ghttp.VerifyHeaderKV("Content-Type", "application/x-protobuf"),
+					ghttp.VerifyHeaderKV("X-Api-Key", "some-key"),
+					func(w http.ResponseWriter, r *http.Request) {
+						body, err := ioutil.ReadAll(r.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						request := &coltracepb.ExportTraceServiceRequest{}
+						Expect(proto.Unmarshal(body, request)).To(Succeed())
+						expectSpan(request)
+					},
+				),
+			)
+
+			exporter, err := config.Exporter()
+			Expect(err).NotTo(HaveOccurred())
+
+			exporter.ExportSpan(context.Background(), span)
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+})
@@ -2,6 +2,7 @@ package tracing
 
 import (
 	"context"
+	"fmt"
 
 	"go.opentelemetry.io/otel/api/global"
 	"go.opentelemetry.io/otel/api/key"
@@ -27,26 +28,51 @@ var Configured bool
 type Config struct {
 	Jaeger      Jaeger
 	Stackdriver Stackdriver
+	OTLP        OTLP
+
+	SamplingRatio float64 `long:"sampling-ratio" default:"1" description:"Ratio of traces to sample, from 0 to 1. Traces continuing a sampled trace are always sampled."`
+}
+
+type exporter interface {
+	IsConfigured() bool
+	Exporter() (export.SpanSyncer, error)
 }
 
+// Prepare configures tracing to send spans to every configured exporter.
 func (c Config) Prepare() error {
-	var exp export.SpanSyncer
-	var err error
-	switch {
-	case c.Jaeger.IsConfigured():
-		exp, err = c.Jaeger.Exporter()
-	case c.Stackdriver.IsConfigured():
-		exp, err = c.Stackdriver.Exporter()
+	if c.SamplingRatio < 0 || c.SamplingRatio > 1 {
+		return fmt.Errorf("sampling ratio must be between 0 and 1, got %v", c.SamplingRatio)
 	}
-	if err != nil {
-		return err
+
+	var exps []export.SpanSyncer
+	for _, e := range []exporter{c.Jaeger, c.Stackdriver, c.OTLP} {
+		if !e.IsConfigured() {
+			continue
+		}
+
+		exp, err := e.Exporter()
+		if err != nil {
+			return err
+		}
+
+		exps = append(exps, exp)
 	}
-	if exp != nil {
-		ConfigureTraceProvider(TraceProvider(exp))
+
+	if len(exps) != 0 {
+		ConfigureTraceProvider(traceProvider(c.sampler(), exps))
 	}
+
 	return nil
 }
 
+func (c Config) sampler() sdktrace.Sampler {
+	if c.SamplingRatio >= 1 {
+		return sdktrace.AlwaysSample()
+	}
+
+	return sdktrace.ProbabilitySampler(c.SamplingRatio)
+}
+
 // StartSpan creates a span, giving back a context that has itself added as the
 // parent span.
 //
@@ -161,14 +187,27 @@ func ConfigureTraceProvider(tp trace.Provider) {
 	Configured = true
 }
 
-func TraceProvider(exporter export.SpanSyncer) trace.Provider {
-	// the only way NewProvider can error is if exporter is nil, but
-	// this method is never called in such circumstances.
-	provider, _ := sdktrace.NewProvider(sdktrace.WithConfig(
-		sdktrace.Config{
-			DefaultSampler: sdktrace.AlwaysSample(),
+func TraceProvider(exporters ...export.SpanSyncer) trace.Provider {
+	return traceProvider(sdktrace.AlwaysSample(), exporters)
+}
+
+func traceProvider(sampler sdktrace.Sampler, exporters []export.SpanSyncer) trace.Provider {
+	opts := []sdktrace.ProviderOption{
+		sdktrace.WithConfig(sdktrace.Config{
+			DefaultSampler: sampler,
 		}),
-		sdktrace.WithSyncer(exporter),
-	)
+	}
+
+	for _, exporter := range exporters {
+		if batcher, ok := exporter.(export.SpanBatcher); ok {
+			opts = append(opts, sdktrace.WithBatcher(batcher))
+		} else {
+			opts = append(opts, sdktrace.WithSyncer(exporter))
+		}
+	}
+
+	// the only way NewProvider can error is if an exporter is nil, but
+	// this method is never called in such circumstances.
+	provider, _ := sdktrace.NewProvider(opts...)
 	return provider
 }
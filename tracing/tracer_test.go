@@ -96,5 +96,31 @@ var _ = Describe("Tracer", func() {
 			c.Prepare()
 			Expect(tracing.Configured).To(BeFalse())
 		})
+
+		It("configures tracing with more than one exporter", func() {
+			c := tracing.Config{
+				Jaeger: tracing.Jaeger{
+					Endpoint: "http://jaeger:14268/api/traces",
+				},
+				OTLP: tracing.OTLP{
+					Address:  "otel-collector:4317",
+					Protocol: tracing.OTLPProtocolGRPC,
+				},
+				SamplingRatio: 0.5,
+			}
+			Expect(c.Prepare()).To(Succeed())
+			Expect(tracing.Configured).To(BeTrue())
+		})
+
+		It("rejects sampling ratios outside of 0 to 1", func() {
+			c := tracing.Config{
+				Jaeger: tracing.Jaeger{
+					Endpoint: "http://jaeger:14268/api/traces",
+				},
+				SamplingRatio: 1.5,
+			}
+			Expect(c.Prepare()).To(MatchError("sampling ratio must be between 0 and 1, got 1.5"))
+			Expect(tracing.Configured).To(BeFalse())
+		})
 	})
 })
@@ -75,6 +75,14 @@ type RegisterOptions struct {
 	// after it has been idle for this duration, if configured.
 	ConnectionDrainTimeout time.Duration
 
+	// UsageFunc measures the worker's resource usage, if configured. It is
+	// called after registering and after each heartbeat, and the usage is sent
+	// to the SSH gateway to be reported with the next heartbeat.
+	//
+	// The function must be careful not to take too long, as it is called from
+	// the same goroutine as RegisteredFunc and HeartbeatedFunc.
+	UsageFunc func() (atc.WorkerUsage, error)
+
 	// RegisteredFunc is called when the initial registration has completed.
 	//
 	// The function must be careful not to take too long or become deadlocked, or
@@ -128,6 +136,30 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 
 	go proxyListenerTo(ctx, baggageclaimListener, opts.LocalBaggageclaimNetwork, opts.LocalBaggageclaimAddr)
 
+	var usages chan atc.WorkerUsage
+	if opts.UsageFunc != nil {
+		usages = make(chan atc.WorkerUsage, 1)
+	}
+
+	reportUsage := func() {
+		if opts.UsageFunc == nil {
+			return
+		}
+
+		usage, err := opts.UsageFunc()
+		if err != nil {
+			logger.Error("failed-to-measure-usage", err)
+			return
+		}
+
+		select {
+		case usages <- usage:
+		default:
+			// the previous usage hasn't been sent yet; skip this one rather than
+			// hold up the events
+		}
+	}
+
 	eventsR, eventsW := io.Pipe()
 	defer eventsW.Close()
 
@@ -145,11 +177,15 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 
 			switch ev.Type {
 			case EventTypeRegistered:
+				reportUsage()
+
 				if opts.RegisteredFunc != nil {
 					opts.RegisteredFunc()
 				}
 
 			case EventTypeHeartbeated:
+				reportUsage()
+
 				if opts.HeartbeatedFunc != nil {
 					opts.HeartbeatedFunc()
 				}
@@ -161,6 +197,7 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 		ctx,
 		sshClient,
 		"forward-worker --garden "+gardenForwardAddr+" --baggageclaim "+baggageclaimForwardAddr,
+		usages,
 		eventsW,
 	)
 	if err != nil {
@@ -191,7 +228,7 @@ func (client *Client) Land(ctx context.Context) error {
 
 	defer sshClient.Close()
 
-	return client.run(ctx, sshClient, "land-worker", os.Stdout)
+	return client.run(ctx, sshClient, "land-worker", nil, os.Stdout)
 }
 
 // Retire invokes the 'retire-worker' command, which will initiate the retiring
@@ -209,7 +246,7 @@ func (client *Client) Retire(ctx context.Context) error {
 
 	defer sshClient.Close()
 
-	return client.run(ctx, sshClient, "retire-worker", os.Stdout)
+	return client.run(ctx, sshClient, "retire-worker", nil, os.Stdout)
 }
 
 // Delete invokes the 'delete-worker' command, which will immediately
@@ -226,7 +263,7 @@ func (client *Client) Delete(ctx context.Context) error {
 
 	defer sshClient.Close()
 
-	return client.run(ctx, sshClient, "delete-worker", os.Stdout)
+	return client.run(ctx, sshClient, "delete-worker", nil, os.Stdout)
 }
 
 // ContainersToDestroy invokes the 'sweep-containers' command, returning a list
@@ -243,7 +280,7 @@ func (client *Client) ContainersToDestroy(ctx context.Context) ([]string, error)
 	defer sshClient.Close()
 
 	out := new(bytes.Buffer)
-	err = client.run(ctx, sshClient, "sweep-containers", out)
+	err = client.run(ctx, sshClient, "sweep-containers", nil, out)
 	if err != nil {
 		return nil, err
 	}
@@ -273,7 +310,7 @@ func (client *Client) ReportContainers(ctx context.Context, handles []string) er
 
 	command := append([]string{"report-containers"}, handles...)
 
-	return client.run(ctx, sshClient, strings.Join(command, " "), os.Stdout)
+	return client.run(ctx, sshClient, strings.Join(command, " "), nil, os.Stdout)
 }
 
 // VolumesToDestroy invokes the 'sweep-volumes' command, returning a list of
@@ -290,7 +327,7 @@ func (client *Client) VolumesToDestroy(ctx context.Context) ([]string, error) {
 	defer sshClient.Close()
 
 	out := new(bytes.Buffer)
-	err = client.run(ctx, sshClient, "sweep-volumes", out)
+	err = client.run(ctx, sshClient, "sweep-volumes", nil, out)
 	if err != nil {
 		return nil, err
 	}
@@ -320,7 +357,7 @@ func (client *Client) ReportVolumes(ctx context.Context, handles []string) error
 
 	command := append([]string{"report-volumes"}, handles...)
 
-	return client.run(ctx, sshClient, strings.Join(command, " "), os.Stdout)
+	return client.run(ctx, sshClient, strings.Join(command, " "), nil, os.Stdout)
 }
 
 func (client *Client) dial(ctx context.Context, idleTimeout time.Duration) (*ssh.Client, *net.TCPConn, error) {
@@ -408,7 +445,10 @@ func (client *Client) checkHostKey(hostname string, remote net.Addr, remoteKey s
 }
 
 
-func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command string, stdout io.Writer) error {
+// run runs the command, sending it the worker as JSON on stdin. If usages is
+// given, stdin is kept open and each usage is sent as JSON after the worker
+// until the command exits.
+func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command string, usages <-chan atc.WorkerUsage, stdout io.Writer) error {
 	argv := strings.Split(command, " ")
 	commandName := ""
 	if len(argv) > 0 {
@@ -432,7 +472,12 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
-	sess.Stdin = bytes.NewBuffer(workerPayload)
+	stdin, err := sess.StdinPipe()
+	if err != nil {
+		logger.Error("failed-to-open-stdin", err)
+		return err
+	}
+
 	sess.Stdout = stdout
 	sess.Stderr = os.Stderr
 
@@ -442,6 +487,32 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
+	exited := make(chan struct{})
+	defer close(exited)
+
+	go func() {
+		defer stdin.Close()
+
+		_, err := stdin.Write(workerPayload)
+		if err != nil || usages == nil {
+			return
+		}
+
+		encoder := json.NewEncoder(stdin)
+		for {
+			select {
+			case usage := <-usages:
+				err := encoder.Encode(usage)
+				if err != nil {
+					return
+				}
+
+			case <-exited:
+				return
+			}
+		}
+	}()
+
 	errs := make(chan error, 1)
 	go func() {
 		errs <- sess.Wait()
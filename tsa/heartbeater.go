@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/clock"
@@ -36,6 +37,15 @@ type Heartbeater struct {
 
 	registration atc.Worker
 	eventWriter  EventWriter
+
+	// the CPU time of each container as of the previous heartbeat, for
+	// working out how much CPU they've used since
+	lastCPUUsage  map[string]uint64
+	lastSampledAt time.Time
+
+	// the usage the worker measured on its host, if it reports it
+	hostUsage     *atc.WorkerUsage
+	hostUsageLock sync.Mutex
 }
 
 func NewHeartbeater(
@@ -282,9 +292,113 @@ func (heartbeater *Heartbeater) pingWorker(logger lager.Logger) (atc.Worker, boo
 	registration.ActiveContainers = len(containers)
 	registration.ActiveVolumes = len(volumes)
 
+	capacity, err := heartbeater.gardenClient.Capacity()
+	if err != nil {
+		// not every runtime reports its capacity, so this doesn't make the
+		// worker unhealthy
+		logger.Debug("failed-to-fetch-capacity", lager.Data{"error": err.Error()})
+		capacity = garden.Capacity{}
+	} else if registration.AllocatableMemory == 0 {
+		registration.AllocatableMemory = capacity.MemoryInBytes
+	}
+
+	registration.Usage = heartbeater.usage(logger, containers, capacity)
+
 	return registration, true
 }
 
+// ReportUsage records the usage the worker measured on its host. It is
+// heartbeated instead of the sum of the containers' usage, which misses
+// anything running outside of them, such as baggageclaim's volumes.
+func (heartbeater *Heartbeater) ReportUsage(usage atc.WorkerUsage) {
+	heartbeater.hostUsageLock.Lock()
+	heartbeater.hostUsage = &usage
+	heartbeater.hostUsageLock.Unlock()
+}
+
+// usage measures the resources used by the containers through Garden's
+// metrics, which come from the containers' cgroups. The usage of the worker as
+// a whole is the one reported by the worker if it has, otherwise it's the sum
+// of the containers' usage.
+//
+// CPU usage is averaged over the time since the previous measurement, so it is
+// zero for containers that weren't around back then.
+func (heartbeater *Heartbeater) usage(logger lager.Logger, containers []garden.Container, capacity garden.Capacity) *atc.WorkerUsage {
+	handles := make([]string, len(containers))
+	for i, container := range containers {
+		handles[i] = container.Handle()
+	}
+
+	heartbeater.hostUsageLock.Lock()
+	hostUsage := heartbeater.hostUsage
+	heartbeater.hostUsageLock.Unlock()
+
+	metrics, err := heartbeater.gardenClient.BulkMetrics(handles)
+	if err != nil {
+		// as with the capacity, not every runtime reports metrics
+		logger.Debug("failed-to-fetch-metrics", lager.Data{"error": err.Error()})
+
+		if hostUsage == nil {
+			return nil
+		}
+
+		reported := *hostUsage
+		return &reported
+	}
+
+	now := heartbeater.clock.Now()
+	elapsed := uint64(now.Sub(heartbeater.lastSampledAt).Nanoseconds())
+
+	usage := &atc.WorkerUsage{
+		MemoryCapacity: capacity.MemoryInBytes,
+		DiskCapacity:   capacity.DiskInBytes,
+	}
+
+	cpuUsage := map[string]uint64{}
+	for _, handle := range handles {
+		entry, found := metrics[handle]
+		if !found || entry.Err != nil {
+			continue
+		}
+
+		cpuTime := entry.Metrics.CPUStat.Usage
+		cpuUsage[handle] = cpuTime
+
+		var cpu uint64
+		lastCPUTime, found := heartbeater.lastCPUUsage[handle]
+		if found && cpuTime >= lastCPUTime && elapsed > 0 {
+			cpu = (cpuTime - lastCPUTime) * 1000 / elapsed
+		}
+
+		container := atc.ContainerUsage{
+			Handle: handle,
+			CPU:    cpu,
+			Memory: entry.Metrics.MemoryStat.TotalUsageTowardLimit,
+			Disk:   entry.Metrics.DiskStat.TotalBytesUsed,
+		}
+
+		usage.CPU += container.CPU
+		usage.Memory += container.Memory
+		usage.Disk += container.Disk
+		usage.Containers = append(usage.Containers, container)
+	}
+
+	heartbeater.lastCPUUsage = cpuUsage
+	heartbeater.lastSampledAt = now
+
+	if hostUsage != nil {
+		reported := *hostUsage
+		reported.Containers = usage.Containers
+		return &reported
+	}
+
+	if len(usage.Containers) == 0 && capacity == (garden.Capacity{}) {
+		return nil
+	}
+
+	return usage
+}
+
 func (heartbeater *Heartbeater) ttl() time.Duration {
 	return heartbeater.interval * 2
 }
@@ -3,6 +3,7 @@ package tsa_test
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"time"
 
@@ -57,7 +58,8 @@ var _ = Describe("Heartbeater", func() {
 		heartbeats    <-chan registration
 		clientWriter  *gbytes.Buffer
 
-		worker atc.Worker
+		worker    atc.Worker
+		hostUsage *atc.WorkerUsage
 	)
 
 	BeforeEach(func() {
@@ -149,6 +151,8 @@ var _ = Describe("Heartbeater", func() {
 
 		token := &oauth2.Token{TokenType: "Bearer", AccessToken: "yo"}
 		httpClient = oauth2.NewClient(oauth2.NoContext, oauth2.StaticTokenSource(token))
+
+		hostUsage = nil
 	})
 
 	JustBeforeEach(func() {
@@ -164,6 +168,10 @@ var _ = Describe("Heartbeater", func() {
 			NewEventWriter(clientWriter),
 		)
 
+		if hostUsage != nil {
+			heartbeater.ReportUsage(*hostUsage)
+		}
+
 		errs := make(chan error, 1)
 		heartbeatErr = errs
 		go func() {
@@ -272,6 +280,186 @@ var _ = Describe("Heartbeater", func() {
 			})
 		})
 
+		Context("when Garden reports its capacity", func() {
+			BeforeEach(func() {
+				fakeGardenClient.CapacityReturns(garden.Capacity{MemoryInBytes: 1024}, nil)
+				fakeATC1.AppendHandlers(verifyRegister)
+			})
+
+			It("registers its memory as allocatable", func() {
+				expectedWorker.ActiveContainers = 2
+				expectedWorker.ActiveVolumes = 3
+				expectedWorker.AllocatableMemory = 1024
+				expectedWorker.Usage = &atc.WorkerUsage{MemoryCapacity: 1024}
+				Eventually(registrations).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+			})
+
+			Context("when the worker is configured with allocatable memory", func() {
+				BeforeEach(func() {
+					worker.AllocatableMemory = 512
+				})
+
+				It("registers the configured memory", func() {
+					expectedWorker.ActiveContainers = 2
+					expectedWorker.ActiveVolumes = 3
+					expectedWorker.AllocatableMemory = 512
+					expectedWorker.Usage = &atc.WorkerUsage{MemoryCapacity: 1024}
+					Eventually(registrations).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+				})
+			})
+		})
+
+		Context("when Garden reports the containers' metrics", func() {
+			BeforeEach(func() {
+				fakeGardenClient.CapacityReturns(garden.Capacity{MemoryInBytes: 4096, DiskInBytes: 8192}, nil)
+
+				fakeGardenClient.ContainersStub = func(garden.Properties) ([]garden.Container, error) {
+					container1 := new(gardenfakes.FakeContainer)
+					container1.HandleReturns("container-1")
+					container2 := new(gardenfakes.FakeContainer)
+					container2.HandleReturns("container-2")
+					container3 := new(gardenfakes.FakeContainer)
+					container3.HandleReturns("container-3")
+					return []garden.Container{container1, container2, container3}, nil
+				}
+
+				calls := 0
+				fakeGardenClient.BulkMetricsStub = func(handles []string) (map[string]garden.ContainerMetricsEntry, error) {
+					Expect(handles).To(Equal([]string{"container-1", "container-2", "container-3"}))
+
+					calls++
+
+					metrics := func(cpu time.Duration, memory, disk uint64) garden.ContainerMetricsEntry {
+						return garden.ContainerMetricsEntry{
+							Metrics: garden.Metrics{
+								CPUStat:    garden.ContainerCPUStat{Usage: uint64(cpu)},
+								MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: memory},
+								DiskStat:   garden.ContainerDiskStat{TotalBytesUsed: disk},
+							},
+						}
+					}
+
+					return map[string]garden.ContainerMetricsEntry{
+						"container-1": metrics(time.Duration(calls)*500*time.Millisecond, 100, 10),
+						"container-2": metrics(time.Duration(calls)*250*time.Millisecond, 200, 20),
+						"container-3": {Err: garden.NewError("gone")},
+					}, nil
+				}
+
+				fakeATC1.AppendHandlers(verifyRegister)
+				fakeATC2.AppendHandlers(verifyHeartbeat)
+			})
+
+			It("registers the memory and disk used by each container", func() {
+				Eventually(registrations).Should(Receive(Equal(registration{
+					worker: atc.Worker{
+						Name:              "some-name",
+						GardenAddr:        addrToRegister,
+						ResourceTypes:     resourceTypes,
+						Platform:          "some-platform",
+						Tags:              []string{"some", "tags"},
+						ActiveContainers:  3,
+						ActiveVolumes:     3,
+						AllocatableMemory: 4096,
+						Usage: &atc.WorkerUsage{
+							Memory:         300,
+							MemoryCapacity: 4096,
+							Disk:           30,
+							DiskCapacity:   8192,
+							Containers: []atc.ContainerUsage{
+								{Handle: "container-1", Memory: 100, Disk: 10},
+								{Handle: "container-2", Memory: 200, Disk: 20},
+							},
+						},
+					},
+					ttl: 2 * interval,
+				})))
+			})
+
+			It("heartbeats the CPU used since the previous measurement", func() {
+				Eventually(registrations).Should(Receive())
+
+				fakeClock.WaitForWatcherAndIncrement(interval)
+
+				var heartbeat registration
+				Eventually(heartbeats).Should(Receive(&heartbeat))
+				Expect(heartbeat.worker.Usage.CPU).To(Equal(uint64(750)))
+				Expect(heartbeat.worker.Usage.Containers).To(Equal([]atc.ContainerUsage{
+					{Handle: "container-1", CPU: 500, Memory: 100, Disk: 10},
+					{Handle: "container-2", CPU: 250, Memory: 200, Disk: 20},
+				}))
+			})
+
+			Context("when Garden fails to report metrics", func() {
+				BeforeEach(func() {
+					fakeGardenClient.BulkMetricsStub = nil
+					fakeGardenClient.BulkMetricsReturns(nil, errors.New("not implemented"))
+				})
+
+				It("registers without usage", func() {
+					var registered registration
+					Eventually(registrations).Should(Receive(&registered))
+					Expect(registered.worker.Usage).To(BeNil())
+				})
+			})
+
+			Context("when the worker reports its host's usage", func() {
+				BeforeEach(func() {
+					hostUsage = &atc.WorkerUsage{
+						CPU:            1500,
+						CPUCapacity:    4000,
+						Memory:         2048,
+						MemoryCapacity: 4096,
+						Disk:           5000,
+						DiskCapacity:   8192,
+					}
+				})
+
+				It("registers the host's usage along with each container's", func() {
+					var registered registration
+					Eventually(registrations).Should(Receive(&registered))
+					Expect(registered.worker.Usage).To(Equal(&atc.WorkerUsage{
+						CPU:            1500,
+						CPUCapacity:    4000,
+						Memory:         2048,
+						MemoryCapacity: 4096,
+						Disk:           5000,
+						DiskCapacity:   8192,
+						Containers: []atc.ContainerUsage{
+							{Handle: "container-1", Memory: 100, Disk: 10},
+							{Handle: "container-2", Memory: 200, Disk: 20},
+						},
+					}))
+				})
+
+				Context("when Garden fails to report metrics", func() {
+					BeforeEach(func() {
+						fakeGardenClient.BulkMetricsStub = nil
+						fakeGardenClient.BulkMetricsReturns(nil, errors.New("not implemented"))
+					})
+
+					It("registers the host's usage alone", func() {
+						var registered registration
+						Eventually(registrations).Should(Receive(&registered))
+						Expect(registered.worker.Usage).To(Equal(hostUsage))
+					})
+				})
+			})
+		})
+
+		Context("when Garden fails to report its capacity", func() {
+			BeforeEach(func() {
+				fakeGardenClient.CapacityReturns(garden.Capacity{}, errors.New("not implemented"))
+				fakeATC1.AppendHandlers(verifyRegister)
+			})
+
+			It("registers without allocatable memory", func() {
+				expectedWorker.ActiveContainers = 2
+				expectedWorker.ActiveVolumes = 3
+				Eventually(registrations).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+			})
+		})
+
 		Context("when heartbeat returns worker is landed", func() {
 			BeforeEach(func() {
 				heartbeated := make(chan registration, 100)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
 	"time"
@@ -33,8 +34,10 @@ type forwardWorkerRequest struct {
 func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
 	logger := lagerctx.FromContext(ctx)
 
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -94,6 +97,24 @@ func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, cha
 		tsa.NewEventWriter(channel),
 	)
 
+	// workers that measure their usage keep sending it after the worker, until
+	// the session ends; older workers close stdin right away
+	go func() {
+		for {
+			var usage atc.WorkerUsage
+			err := decoder.Decode(&usage)
+			if err != nil {
+				if err != io.EOF {
+					logger.Debug("stopped-reading-usage", lager.Data{"error": err.Error()})
+				}
+
+				return
+			}
+
+			heartbeater.ReportUsage(usage)
+		}
+	}()
+
 	err = heartbeater.Heartbeat(ctx)
 	if err != nil {
 		logger.Error("failed-to-heartbeat", err)
@@ -160,8 +181,10 @@ func checkTeam(state ConnState, worker atc.Worker) error {
 }
 
 func (req landWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -181,8 +204,10 @@ type retireWorkerRequest struct {
 }
 
 func (req retireWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -202,8 +227,10 @@ type deleteWorkerRequest struct {
 }
 
 func (req deleteWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -223,8 +250,10 @@ type sweepContainersRequest struct {
 }
 
 func (req sweepContainersRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -257,8 +286,10 @@ type reportContainersRequest struct {
 }
 
 func (req reportContainersRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -279,8 +310,10 @@ type sweepVolumesRequest struct {
 }
 
 func (req sweepVolumesRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -313,8 +346,10 @@ type reportVolumesRequest struct {
 }
 
 func (req reportVolumesRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
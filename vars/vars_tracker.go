@@ -22,6 +22,11 @@ type CredVarsTracker interface {
 	Enabled() bool
 
 	AddLocalVar(string, interface{}, bool)
+
+	// NewLocalScope returns a tracker whose local vars shadow the local vars
+	// of this tracker without modifying them. Interpolated creds are shared
+	// with this tracker so that they are still redacted.
+	NewLocalScope() CredVarsTracker
 }
 
 func NewCredVarsTracker(credVars Variables, on bool) CredVarsTracker {
@@ -31,7 +36,7 @@ func NewCredVarsTracker(credVars Variables, on bool) CredVarsTracker {
 		enabled:           on,
 		interpolatedCreds: map[string]string{},
 		noRedactVarNames:  map[string]bool{},
-		lock:              sync.RWMutex{},
+		lock:              &sync.RWMutex{},
 	}
 }
 
@@ -39,6 +44,9 @@ type credVarsTracker struct {
 	credVars  Variables
 	localVars StaticVariables
 
+	// set on trackers created through NewLocalScope
+	parentScope *credVarsTracker
+
 	enabled bool
 
 	interpolatedCreds map[string]string
@@ -46,7 +54,7 @@ type credVarsTracker struct {
 	noRedactVarNames map[string]bool
 
 	// Considering in-parallel steps, a lock is need.
-	lock sync.RWMutex
+	lock *sync.RWMutex
 }
 
 func (t *credVarsTracker) Get(varDef VariableDefinition) (interface{}, bool, error) {
@@ -60,13 +68,7 @@ func (t *credVarsTracker) Get(varDef VariableDefinition) (interface{}, bool, err
 	parts := strings.Split(varDef.Name, ":")
 	if len(parts) == 2 && parts[0] == "." {
 		varDef.Name = parts[1]
-		val, found, err = t.localVars.Get(varDef)
-		if found {
-			parts = strings.Split(varDef.Name, ".")
-			if _, ok := t.noRedactVarNames[parts[0]]; ok {
-				redact = false
-			}
-		}
+		val, found, redact, err = t.getLocal(varDef)
 	} else {
 		val, found, err = t.credVars.Get(varDef)
 	}
@@ -80,6 +82,25 @@ func (t *credVarsTracker) Get(varDef VariableDefinition) (interface{}, bool, err
 	return val, found, err
 }
 
+func (t *credVarsTracker) getLocal(varDef VariableDefinition) (interface{}, bool, bool, error) {
+	val, found, err := t.localVars.Get(varDef)
+	if err != nil {
+		return nil, false, true, err
+	}
+
+	if found {
+		parts := strings.Split(varDef.Name, ".")
+		_, noRedact := t.noRedactVarNames[parts[0]]
+		return val, true, !noRedact, nil
+	}
+
+	if t.parentScope != nil {
+		return t.parentScope.getLocal(varDef)
+	}
+
+	return nil, false, true, nil
+}
+
 func (t *credVarsTracker) track(name string, val interface{}) {
 	switch v := val.(type) {
 	case map[interface{}]interface{}:
@@ -122,6 +143,18 @@ func (t *credVarsTracker) AddLocalVar(name string, value interface{}, redact boo
 	}
 }
 
+func (t *credVarsTracker) NewLocalScope() CredVarsTracker {
+	return &credVarsTracker{
+		localVars:         StaticVariables{},
+		parentScope:       t,
+		credVars:          t.credVars,
+		enabled:           t.enabled,
+		interpolatedCreds: t.interpolatedCreds,
+		noRedactVarNames:  map[string]bool{},
+		lock:              t.lock,
+	}
+}
+
 // MapCredVarsTrackerIterator implements a simple CredVarsTrackerIterator which just
 // populate interpolated secrets into a map. This could be useful in unit test.
 
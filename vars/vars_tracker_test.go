@@ -99,6 +99,51 @@ var _ = Describe("vars_tracker", func() {
 				Expect(mapit.Data["foo"]).To(BeNil())
 			})
 		})
+
+		Describe("NewLocalScope", func() {
+			var scope CredVarsTracker
+
+			BeforeEach(func() {
+				tracker.AddLocalVar("foo", "bar", true)
+				tracker.AddLocalVar("hello", "world", true)
+
+				scope = tracker.NewLocalScope()
+				scope.AddLocalVar("foo", "baz", false)
+			})
+
+			It("shadows local vars of the parent scope", func() {
+				val, found, err := scope.Get(VariableDefinition{Name: ".:foo"})
+				Expect(err).To(BeNil())
+				Expect(found).To(BeTrue())
+				Expect(val).To(Equal("baz"))
+			})
+
+			It("falls back to local vars of the parent scope", func() {
+				val, found, err := scope.Get(VariableDefinition{Name: ".:hello"})
+				Expect(err).To(BeNil())
+				Expect(found).To(BeTrue())
+				Expect(val).To(Equal("world"))
+			})
+
+			It("does not modify the parent scope", func() {
+				val, found, err := tracker.Get(VariableDefinition{Name: ".:foo"})
+				Expect(err).To(BeNil())
+				Expect(found).To(BeTrue())
+				Expect(val).To(Equal("bar"))
+			})
+
+			It("tracks fetched variables in the parent scope", func() {
+				scope.Get(VariableDefinition{Name: "k1"})
+				scope.Get(VariableDefinition{Name: ".:foo"})
+				scope.Get(VariableDefinition{Name: ".:hello"})
+
+				mapit := NewMapCredVarsTrackerIterator()
+				tracker.IterateInterpolatedCreds(mapit)
+				Expect(mapit.Data["k1"]).To(Equal("v1"))
+				Expect(mapit.Data["hello"]).To(Equal("world"))
+				Expect(mapit.Data["foo"]).To(BeNil())
+			})
+		})
 	})
 
 	Describe("turn off track", func() {
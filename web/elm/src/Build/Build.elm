@@ -108,6 +108,7 @@ init flags =
           , nextPage = Nothing
           , prep = Nothing
           , duration = { startedAt = Nothing, finishedAt = Nothing }
+          , containersRetainedUntil = Nothing
           , status = BuildStatusPending
           , output = Empty
           , autoScroll = True
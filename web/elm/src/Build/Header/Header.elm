@@ -48,6 +48,7 @@ header session model =
         [ Views.Title model.name model.job
         , Views.Duration (duration session model)
         ]
+            ++ containersRetained session model
     , rightWidgets =
         [ Views.Button
             (if Concourse.BuildStatus.isRunning model.status then
@@ -156,6 +157,7 @@ changeToBuild pageType ( model, effects ) =
                             | id = b.id
                             , status = b.status
                             , duration = b.duration
+                            , containersRetainedUntil = Nothing
                             , name = b.name
                         }
                     )
@@ -167,6 +169,16 @@ changeToBuild pageType ( model, effects ) =
             ( model, effects )
 
 
+containersRetained : Session -> Model r -> List Views.Widget
+containersRetained session model =
+    case model.containersRetainedUntil of
+        Just until ->
+            [ Views.ContainersRetained (format session.timeZone until) ]
+
+        Nothing ->
+            []
+
+
 duration : Session -> Model r -> Views.BuildDuration
 duration session model =
     case ( model.duration.startedAt, model.duration.finishedAt ) of
@@ -507,6 +519,7 @@ handleBuildFetched b ( model, effects ) =
                     model.history
             , fetchingHistory = True
             , duration = b.duration
+            , containersRetainedUntil = b.containersRetainedUntil
             , status = b.status
             , job = b.job
             , id = b.id
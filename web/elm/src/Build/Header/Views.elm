@@ -50,6 +50,7 @@ type Widget
     = Button (Maybe ButtonView)
     | Title String (Maybe Concourse.JobIdentifier)
     | Duration BuildDuration
+    | ContainersRetained String
 
 
 type BuildDuration
@@ -132,6 +133,14 @@ viewWidget widget =
         Duration duration ->
             viewDuration duration
 
+        ContainersRetained until ->
+            Html.table [ class "dictionary build-containers-retained" ]
+                [ Html.tr []
+                    [ Html.td [ class "dict-key" ] [ Html.text "containers retained until" ]
+                    , Html.td [ class "dict-value" ] [ Html.span [] [ Html.text until ] ]
+                    ]
+                ]
+
 
 viewDuration : BuildDuration -> Html Message
 viewDuration buildDuration =
@@ -163,6 +163,7 @@ type alias Build =
     , status : BuildStatus
     , duration : BuildDuration
     , reapTime : Maybe Time.Posix
+    , containersRetainedUntil : Maybe Time.Posix
     }
 
 
@@ -184,6 +185,7 @@ encodeBuild build =
          , optionalField "start_time" (secondsFromDate >> Json.Encode.int) build.duration.startedAt
          , optionalField "end_time" (secondsFromDate >> Json.Encode.int) build.duration.finishedAt
          , optionalField "reap_time" (secondsFromDate >> Json.Encode.int) build.reapTime
+         , optionalField "containers_retained_until" (secondsFromDate >> Json.Encode.int) build.containersRetainedUntil
          ]
             |> List.filterMap identity
         )
@@ -219,6 +221,7 @@ decodeBuild =
                 |> andMap (Json.Decode.maybe (Json.Decode.field "end_time" (Json.Decode.map dateFromSeconds Json.Decode.int)))
             )
         |> andMap (Json.Decode.maybe (Json.Decode.field "reap_time" (Json.Decode.map dateFromSeconds Json.Decode.int)))
+        |> andMap (Json.Decode.maybe (Json.Decode.field "containers_retained_until" (Json.Decode.map dateFromSeconds Json.Decode.int)))
 
 
 
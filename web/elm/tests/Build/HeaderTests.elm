@@ -99,6 +99,44 @@ all =
                                     }
                             )
             ]
+        , describe "retained containers"
+            [ test "are not shown by default" <|
+                \_ ->
+                    Header.header session model
+                        |> .leftWidgets
+                        |> List.filter
+                            (\w ->
+                                case w of
+                                    Views.ContainersRetained _ ->
+                                        True
+
+                                    _ ->
+                                        False
+                            )
+                        |> Expect.equal []
+            , test "shows until when the containers of the build are retained" <|
+                \_ ->
+                    Header.header session
+                        { model
+                            | containersRetainedUntil = Just <| Time.millisToPosix 0
+                        }
+                        |> .leftWidgets
+                        |> Common.contains
+                            (Views.ContainersRetained "Jan 1 1970 12:00:00 AM")
+            , test "are taken from the fetched build" <|
+                \_ ->
+                    ( model, [] )
+                        |> Header.handleCallback
+                            (Callback.BuildFetched <|
+                                Ok
+                                    { build
+                                        | containersRetainedUntil = Just <| Time.millisToPosix 0
+                                    }
+                            )
+                        |> Tuple.first
+                        |> .containersRetainedUntil
+                        |> Expect.equal (Just <| Time.millisToPosix 0)
+            ]
         , describe "buttons"
             [ describe "trigger"
                 [ test "has tooltip on hover when manual triggering is disabled" <|
@@ -370,6 +408,7 @@ model =
     , scrolledToCurrentBuild = False
     , history = []
     , duration = { startedAt = Nothing, finishedAt = Nothing }
+    , containersRetainedUntil = Nothing
     , status = BuildStatusPending
     , disableManualTrigger = False
     , now = Nothing
@@ -387,6 +426,7 @@ build =
     , status = model.status
     , duration = model.duration
     , reapTime = Nothing
+    , containersRetainedUntil = Nothing
     }
 
 
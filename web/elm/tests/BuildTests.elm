@@ -659,6 +659,7 @@ all =
                                         , finishedAt = Nothing
                                         }
                                     , reapTime = Nothing
+                                    , containersRetainedUntil = Nothing
                                     }
                             )
                         |> Tuple.first
@@ -707,6 +708,7 @@ all =
                                     , finishedAt = buildTime
                                     }
                                 , reapTime = buildTime
+                                , containersRetainedUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -749,6 +751,7 @@ all =
                                     , finishedAt = Just <| Time.millisToPosix 0
                                     }
                                 , reapTime = Nothing
+                                , containersRetainedUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -782,6 +785,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , containersRetainedUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -821,6 +825,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , containersRetainedUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -881,6 +886,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , containersRetainedUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -909,6 +915,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , containersRetainedUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -930,6 +937,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , containersRetainedUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -946,6 +954,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , containersRetainedUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -972,6 +981,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , containersRetainedUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -1005,6 +1015,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                                 , reapTime = Nothing
+                                , containersRetainedUntil = Nothing
                                 }
                         )
                     |> Tuple.first
@@ -1850,6 +1861,7 @@ all =
                                                 , finishedAt = Just <| Time.millisToPosix 0
                                                 }
                                           , reapTime = Nothing
+                                          , containersRetainedUntil = Nothing
                                           }
                                         ]
                                     }
@@ -1899,6 +1911,7 @@ all =
                                                 , finishedAt = Just <| Time.millisToPosix 0
                                                 }
                                           , reapTime = Nothing
+                                          , containersRetainedUntil = Nothing
                                           }
                                         ]
                                     }
@@ -1957,6 +1970,7 @@ all =
                                                 , finishedAt = Just <| Time.millisToPosix 0
                                                 }
                                           , reapTime = Nothing
+                                          , containersRetainedUntil = Nothing
                                           }
                                         ]
                                     }
@@ -2008,6 +2022,7 @@ all =
                                                 , finishedAt = Just <| Time.millisToPosix 0
                                                 }
                                           , reapTime = Nothing
+                                          , containersRetainedUntil = Nothing
                                           }
                                         ]
                                     }
@@ -2054,6 +2069,7 @@ all =
                                                 , finishedAt = Just <| Time.millisToPosix 0
                                                 }
                                           , reapTime = Nothing
+                                          , containersRetainedUntil = Nothing
                                           }
                                         ]
                                     }
@@ -2261,6 +2277,7 @@ all =
                                                 , finishedAt = Nothing
                                                 }
                                           , reapTime = Nothing
+                                          , containersRetainedUntil = Nothing
                                           }
                                         ]
                                     }
@@ -2373,6 +2390,7 @@ all =
                                                 , finishedAt = Nothing
                                                 }
                                           , reapTime = Nothing
+                                          , containersRetainedUntil = Nothing
                                           }
                                         ]
                                     }
@@ -3846,6 +3864,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                         , reapTime = Nothing
+                                        , containersRetainedUntil = Nothing
                                         }
                                 )
                             |> Tuple.first
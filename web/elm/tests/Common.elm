@@ -166,6 +166,7 @@ myBrowserFetchedTheBuild =
                         , finishedAt = Nothing
                         }
                     , reapTime = Nothing
+                    , containersRetainedUntil = Nothing
                     }
             )
 
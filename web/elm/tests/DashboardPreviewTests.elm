@@ -237,6 +237,7 @@ withNextBuild j =
                 , status = BuildStatusStarted
                 , duration = { startedAt = Nothing, finishedAt = Nothing }
                 , reapTime = Nothing
+                , containersRetainedUntil = Nothing
                 }
     }
 
@@ -252,6 +253,7 @@ withStatus status j =
                 , status = status
                 , duration = { startedAt = Nothing, finishedAt = Nothing }
                 , reapTime = Nothing
+                , containersRetainedUntil = Nothing
                 }
     }
 
@@ -58,6 +58,7 @@ hasData =
                                         , finishedAt = Nothing
                                         }
                                     , reapTime = Nothing
+                                    , containersRetainedUntil = Nothing
                                     }
                           , finishedBuild = Nothing
                           , transitionBuild = Nothing
@@ -718,6 +718,7 @@ all =
                                             , status = BuildStatusSucceeded
                                             , duration = { startedAt = Nothing, finishedAt = Nothing }
                                             , reapTime = Nothing
+                                            , containersRetainedUntil = Nothing
                                             }
                                   , transitionBuild = Nothing
                                   , paused = False
@@ -2107,6 +2108,7 @@ running j =
                     , finishedAt = Nothing
                     }
                 , reapTime = Nothing
+                , containersRetainedUntil = Nothing
                 }
     }
 
@@ -2143,6 +2145,7 @@ jobWithNameTransitionedAt jobName transitionedAt status =
                 , finishedAt = Nothing
                 }
             , reapTime = Nothing
+            , containersRetainedUntil = Nothing
             }
     , transitionBuild =
         transitionedAt
@@ -2162,6 +2165,7 @@ jobWithNameTransitionedAt jobName transitionedAt status =
                         , finishedAt = Just <| t
                         }
                     , reapTime = Nothing
+                    , containersRetainedUntil = Nothing
                     }
                 )
     , paused = False
@@ -2194,6 +2198,7 @@ circularJobs =
                     , finishedAt = Nothing
                     }
                 , reapTime = Nothing
+                , containersRetainedUntil = Nothing
                 }
       , transitionBuild =
             Just
@@ -2211,6 +2216,7 @@ circularJobs =
                     , finishedAt = Just <| Time.millisToPosix 0
                     }
                 , reapTime = Nothing
+                , containersRetainedUntil = Nothing
                 }
       , paused = False
       , disableManualTrigger = False
@@ -2244,6 +2250,7 @@ circularJobs =
                     , finishedAt = Nothing
                     }
                 , reapTime = Nothing
+                , containersRetainedUntil = Nothing
                 }
       , transitionBuild =
             Just
@@ -2261,6 +2268,7 @@ circularJobs =
                     , finishedAt = Just <| Time.millisToPosix 0
                     }
                 , reapTime = Nothing
+                , containersRetainedUntil = Nothing
                 }
       , paused = False
       , disableManualTrigger = False
@@ -264,6 +264,7 @@ jobBuild status =
                 Just <| Time.millisToPosix 0
         }
     , reapTime = Nothing
+    , containersRetainedUntil = Nothing
     }
 
 
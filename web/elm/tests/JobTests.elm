@@ -66,6 +66,7 @@ all =
                         , finishedAt = Just <| Time.millisToPosix 0
                         }
                     , reapTime = Just <| Time.millisToPosix 0
+                    , containersRetainedUntil = Nothing
                     }
 
                 someJob : Concourse.Job
@@ -210,6 +211,7 @@ all =
                                                 , finishedAt = Nothing
                                                 }
                                           , reapTime = Nothing
+                                          , containersRetainedUntil = Nothing
                                           }
                                         ]
                                 in
@@ -486,6 +488,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                       , reapTime = Nothing
+                                      , containersRetainedUntil = Nothing
                                       }
                                     ]
                             in
@@ -525,6 +528,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                       , reapTime = Nothing
+                                      , containersRetainedUntil = Nothing
                                       }
                                     ]
                             in
@@ -583,6 +587,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                       , reapTime = Nothing
+                                      , containersRetainedUntil = Nothing
                                       }
                                     ]
                             in
@@ -671,6 +676,7 @@ all =
                                             , finishedAt = Nothing
                                             }
                                       , reapTime = Nothing
+                                      , containersRetainedUntil = Nothing
                                       }
                                     ]
                             in
@@ -751,6 +757,7 @@ all =
                                     , finishedAt = Nothing
                                     }
                               , reapTime = Nothing
+                              , containersRetainedUntil = Nothing
                               }
                             ]
 
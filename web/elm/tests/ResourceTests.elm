@@ -306,6 +306,7 @@ all =
                                         , finishedAt = Nothing
                                         }
                                     , reapTime = Nothing
+                                    , containersRetainedUntil = Nothing
                                     }
                                   ]
                                 )
@@ -344,6 +345,7 @@ all =
                                         , finishedAt = Nothing
                                         }
                                     , reapTime = Nothing
+                                    , containersRetainedUntil = Nothing
                                     }
                                   ]
                                 )
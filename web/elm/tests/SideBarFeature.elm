@@ -1535,6 +1535,7 @@ iAmLookingAtAOneOffBuildPageOnANonPhoneScreen =
                     , status = BuildStatusStarted
                     , duration = { startedAt = Nothing, finishedAt = Nothing }
                     , reapTime = Nothing
+                    , containersRetainedUntil = Nothing
                     }
                 )
             )
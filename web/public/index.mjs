@@ -469,15 +469,19 @@ function createGraph(svg, jobs, resources) {
             sourceNode = sourceOutputNode;
           } else {
             if (!graph.node(sourceInputNode)) {
+              // a passed job in another pipeline is named pipeline/job; show
+              // where the versions come from and link to that job
+              var upstreamURL = graph.node(sourceJobNode) ? null : upstreamJobURL(job, input.passed[p]);
+
               addIcon(resourceIcons[input.resource], sourceInputNode);
               graph.setNode(sourceInputNode, new GraphNode({
                 id: sourceInputNode,
-                name: input.resource,
+                name: upstreamURL ? input.resource + " from " + input.passed[p] : input.resource,
                 icon: resourceIcons[input.resource],
                 key: input.resource,
                 class: "constrained-input" + (resourcePinned[input.resource] ? " pinned" : ""),
                 repeatable: true,
-                url: resourceURLs[input.resource],
+                url: upstreamURL || resourceURLs[input.resource],
                 svg: svg
               }));
             }
@@ -580,6 +584,18 @@ function gatewayNode(jobNames) {
   return "gateway-"+jobNames.sort().join("-");
 }
 
+function upstreamJobURL(job, passed) {
+  var slash = passed.indexOf("/");
+  if (slash <= 0 || slash == passed.length - 1) {
+    return null;
+  }
+
+  var pipelineName = passed.substring(0, slash);
+  var jobName = passed.substring(slash + 1);
+
+  return "/teams/"+job.team_name+"/pipelines/"+encodeURIComponent(pipelineName)+"/jobs/"+encodeURIComponent(jobName);
+}
+
 function outputNode(jobName, resourceName) {
   return "job-"+jobName+"-output-"+resourceName;
 }
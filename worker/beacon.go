@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 )
 
@@ -28,6 +29,10 @@ type Beacon struct {
 	LocalBaggageclaimNetwork string
 	LocalBaggageclaimAddr    string
 
+	// HostUsage is sent to the SSH gateway to be reported with each
+	// heartbeat, if configured.
+	HostUsage *HostUsage
+
 	drained int32
 }
 
@@ -182,6 +187,11 @@ func (beacon *Beacon) registerWorker(
 
 	once := &sync.Once{}
 
+	var usageFunc func() (atc.WorkerUsage, error)
+	if beacon.HostUsage != nil {
+		usageFunc = beacon.HostUsage.Measure
+	}
+
 	registeredOrFailed := make(chan struct{})
 	go func() {
 		defer cwg.Done()
@@ -195,6 +205,8 @@ func (beacon *Beacon) registerWorker(
 
 			ConnectionDrainTimeout: beacon.ConnectionDrainTimeout,
 
+			UsageFunc: usageFunc,
+
 			RegisteredFunc: func() {
 				logger.Info("registered")
 				once.Do(func() { close(registeredOrFailed) })
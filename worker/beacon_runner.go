@@ -19,6 +19,7 @@ func NewBeaconRunner(
 	connectionDrainTimeout time.Duration,
 	gardenAddr string,
 	baggageclaimAddr string,
+	hostUsage *HostUsage,
 ) ifrit.Runner {
 	signals := make(chan os.Signal, 2)
 	signal.Notify(signals, drainSignals...)
@@ -38,6 +39,8 @@ func NewBeaconRunner(
 
 		LocalBaggageclaimNetwork: "tcp",
 		LocalBaggageclaimAddr:    baggageclaimAddr,
+
+		HostUsage: hostUsage,
 	}
 
 	return restart.Restarter{
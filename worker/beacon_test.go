@@ -290,6 +290,27 @@ var _ = Describe("Beacon", func() {
 		})
 	})
 
+	It("doesn't measure the host's usage by default", func() {
+		Eventually(fakeClient.RegisterCallCount).Should(Equal(1))
+		_, opts := fakeClient.RegisterArgsForCall(0)
+		Expect(opts.UsageFunc).To(BeNil())
+	})
+
+	Context("when the host's usage is configured", func() {
+		BeforeEach(func() {
+			beacon.HostUsage = &worker.HostUsage{ProcDir: "/bogus/proc"}
+		})
+
+		It("measures it in the register options", func() {
+			Eventually(fakeClient.RegisterCallCount).Should(Equal(1))
+			_, opts := fakeClient.RegisterArgsForCall(0)
+			Expect(opts.UsageFunc).NotTo(BeNil())
+
+			_, err := opts.UsageFunc()
+			Expect(err).To(MatchError(ContainSubstring("/bogus/proc/stat")))
+		})
+	})
+
 	Context("when rebalancing is configured", func() {
 		BeforeEach(func() {
 			beacon.RebalanceInterval = 500 * time.Millisecond
@@ -0,0 +1,199 @@
+package worker
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// HostUsage measures the resources used on the worker's host, which is sent
+// along with its heartbeats.
+//
+// CPU and memory are read from /proc, so they include everything running on
+// the host and not just the containers. Disk is the usage of the filesystem
+// holding baggageclaim's volumes, which is where the containers' volumes and
+// caches live.
+type HostUsage struct {
+	// ProcDir is where procfs is mounted, defaulting to /proc.
+	ProcDir string
+
+	// VolumesDir is baggageclaim's volumes directory. Disk usage is not
+	// measured if it's empty.
+	VolumesDir string
+
+	lastCPU cpuTimes
+	lock    sync.Mutex
+}
+
+type cpuTimes struct {
+	busy  uint64
+	total uint64
+}
+
+// Measure returns the usage of the host. CPU usage is averaged over the time
+// since the previous measurement, or since boot for the first one.
+func (usage *HostUsage) Measure() (atc.WorkerUsage, error) {
+	cpu, cores, err := usage.cpuTimes()
+	if err != nil {
+		return atc.WorkerUsage{}, fmt.Errorf("cpu usage: %w", err)
+	}
+
+	memory, memoryCapacity, err := usage.memory()
+	if err != nil {
+		return atc.WorkerUsage{}, fmt.Errorf("memory usage: %w", err)
+	}
+
+	measured := atc.WorkerUsage{
+		CPU:            usage.cpuSinceLastMeasured(cpu, cores),
+		CPUCapacity:    cores * 1000,
+		Memory:         memory,
+		MemoryCapacity: memoryCapacity,
+	}
+
+	if usage.VolumesDir != "" {
+		measured.Disk, measured.DiskCapacity, err = diskUsage(usage.VolumesDir)
+		if err != nil {
+			return atc.WorkerUsage{}, fmt.Errorf("disk usage: %w", err)
+		}
+	}
+
+	return measured, nil
+}
+
+func (usage *HostUsage) cpuSinceLastMeasured(cpu cpuTimes, cores uint64) uint64 {
+	usage.lock.Lock()
+	defer usage.lock.Unlock()
+
+	last := usage.lastCPU
+	usage.lastCPU = cpu
+
+	if cpu.total <= last.total || cpu.busy < last.busy {
+		return 0
+	}
+
+	return (cpu.busy - last.busy) * cores * 1000 / (cpu.total - last.total)
+}
+
+// cpuTimes reads the time all CPUs have spent busy and in total from
+// /proc/stat, along with the number of CPUs.
+func (usage *HostUsage) cpuTimes() (cpuTimes, uint64, error) {
+	file, err := os.Open(filepath.Join(usage.procDir(), "stat"))
+	if err != nil {
+		return cpuTimes{}, 0, err
+	}
+
+	defer file.Close()
+
+	var times cpuTimes
+	var cores uint64
+	var found bool
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || !strings.HasPrefix(fields[0], "cpu") {
+			continue
+		}
+
+		if fields[0] != "cpu" {
+			cores++
+			continue
+		}
+
+		// user, nice, system, idle, iowait, irq, softirq and steal; guest time
+		// is already counted as user time
+		for i, field := range fields[1:] {
+			if i >= 8 {
+				break
+			}
+
+			value, err := strconv.ParseUint(field, 10, 64)
+			if err != nil {
+				return cpuTimes{}, 0, fmt.Errorf("parse %s: %w", fields[0], err)
+			}
+
+			times.total += value
+
+			if i != 3 && i != 4 {
+				times.busy += value
+			}
+		}
+
+		found = true
+	}
+
+	if err := scanner.Err(); err != nil {
+		return cpuTimes{}, 0, err
+	}
+
+	if !found {
+		return cpuTimes{}, 0, fmt.Errorf("no cpu line in %s", file.Name())
+	}
+
+	return times, cores, nil
+}
+
+// memory reads the memory in use and the total memory from /proc/meminfo.
+// Memory that the kernel can reclaim, such as the page cache, is not counted
+// as in use.
+func (usage *HostUsage) memory() (uint64, uint64, error) {
+	file, err := os.Open(filepath.Join(usage.procDir(), "meminfo"))
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer file.Close()
+
+	info := map[string]uint64{}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		// values are in kB, which the kernel means as KiB
+		info[strings.TrimSuffix(fields[0], ":")] = value * 1024
+	}
+
+	if err := scanner.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	total, found := info["MemTotal"]
+	if !found {
+		return 0, 0, fmt.Errorf("no MemTotal in %s", file.Name())
+	}
+
+	available, found := info["MemAvailable"]
+	if !found {
+		// kernels older than 3.14 don't estimate it
+		available = info["MemFree"] + info["Buffers"] + info["Cached"]
+	}
+
+	if available > total {
+		return 0, total, nil
+	}
+
+	return total - available, total, nil
+}
+
+func (usage *HostUsage) procDir() string {
+	if usage.ProcDir == "" {
+		return "/proc"
+	}
+
+	return usage.ProcDir
+}
@@ -0,0 +1,17 @@
+package worker
+
+import "syscall"
+
+// diskUsage returns the bytes used and the size of the filesystem at path.
+func diskUsage(path string) (uint64, uint64, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(path, &stat)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	size := stat.Blocks * uint64(stat.Bsize)
+	free := stat.Bfree * uint64(stat.Bsize)
+
+	return size - free, size, nil
+}
@@ -0,0 +1,9 @@
+// +build !linux
+
+package worker
+
+import "errors"
+
+func diskUsage(path string) (uint64, uint64, error) {
+	return 0, 0, errors.New("disk usage is only measured on linux")
+}
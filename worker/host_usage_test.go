@@ -0,0 +1,136 @@
+package worker_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/concourse/concourse/worker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HostUsage", func() {
+	var (
+		procDir   string
+		hostUsage *worker.HostUsage
+	)
+
+	writeProcFile := func(name, contents string) {
+		err := ioutil.WriteFile(filepath.Join(procDir, name), []byte(contents), 0644)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		var err error
+		procDir, err = ioutil.TempDir("", "host-usage")
+		Expect(err).NotTo(HaveOccurred())
+
+		writeProcFile("stat", `cpu  300 0 100 500 100 0 0 0 50 0
+cpu0 150 0 50 250 50 0 0 0 25 0
+cpu1 150 0 50 250 50 0 0 0 25 0
+intr 12345
+ctxt 67890
+`)
+
+		writeProcFile("meminfo", `MemTotal:        4096 kB
+MemFree:         1024 kB
+MemAvailable:    3072 kB
+Buffers:          256 kB
+Cached:           512 kB
+`)
+
+		hostUsage = &worker.HostUsage{ProcDir: procDir}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(procDir)).To(Succeed())
+	})
+
+	It("measures the CPU used since boot and the memory in use", func() {
+		usage, err := hostUsage.Measure()
+		Expect(err).NotTo(HaveOccurred())
+
+		// 400 of 1000 ticks were busy, on 2 cores
+		Expect(usage.CPU).To(Equal(uint64(800)))
+		Expect(usage.CPUCapacity).To(Equal(uint64(2000)))
+
+		Expect(usage.Memory).To(Equal(uint64(1024 * 1024)))
+		Expect(usage.MemoryCapacity).To(Equal(uint64(4096 * 1024)))
+
+		Expect(usage.Disk).To(BeZero())
+		Expect(usage.DiskCapacity).To(BeZero())
+	})
+
+	It("measures the CPU used since the previous measurement", func() {
+		_, err := hostUsage.Measure()
+		Expect(err).NotTo(HaveOccurred())
+
+		writeProcFile("stat", `cpu  350 0 150 850 150 0 0 0 50 0
+cpu0 175 0 75 425 75 0 0 0 25 0
+cpu1 175 0 75 425 75 0 0 0 25 0
+`)
+
+		usage, err := hostUsage.Measure()
+		Expect(err).NotTo(HaveOccurred())
+
+		// 100 of 500 ticks were busy, on 2 cores
+		Expect(usage.CPU).To(Equal(uint64(400)))
+	})
+
+	Context("when the kernel doesn't estimate the available memory", func() {
+		BeforeEach(func() {
+			writeProcFile("meminfo", `MemTotal:        4096 kB
+MemFree:         1024 kB
+Buffers:          256 kB
+Cached:           512 kB
+`)
+		})
+
+		It("counts free, buffer and cache memory as available", func() {
+			usage, err := hostUsage.Measure()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.Memory).To(Equal(uint64(2304 * 1024)))
+		})
+	})
+
+	Context("when /proc/stat can't be read", func() {
+		BeforeEach(func() {
+			Expect(os.Remove(filepath.Join(procDir, "stat"))).To(Succeed())
+		})
+
+		It("errors", func() {
+			_, err := hostUsage.Measure()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when the volumes directory is configured", func() {
+		BeforeEach(func() {
+			if runtime.GOOS != "linux" {
+				Skip("disk usage is only measured on linux")
+			}
+
+			hostUsage.VolumesDir = procDir
+		})
+
+		It("measures the filesystem it's on", func() {
+			usage, err := hostUsage.Measure()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.DiskCapacity).NotTo(BeZero())
+			Expect(usage.Disk).To(BeNumerically("<=", usage.DiskCapacity))
+		})
+
+		Context("when it doesn't exist", func() {
+			BeforeEach(func() {
+				hostUsage.VolumesDir = filepath.Join(procDir, "bogus")
+			})
+
+			It("errors", func() {
+				_, err := hostUsage.Measure()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
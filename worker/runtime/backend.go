@@ -28,6 +28,7 @@ type GardenBackend struct {
 	network       Network
 	rootfsManager RootfsManager
 	userNamespace UserNamespace
+	diskPath      string
 }
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . UserNamespace
@@ -71,6 +72,15 @@ func WithNetwork(n Network) GardenBackendOpt {
 	}
 }
 
+// WithDiskPath configures the path of the filesystem whose size is reported
+// as the disk capacity.
+//
+func WithDiskPath(path string) GardenBackendOpt {
+	return func(b *GardenBackend) {
+		b.diskPath = path
+	}
+}
+
 // NewGardenBackend instantiates a GardenBackend with tweakable configurations passed as Config.
 //
 func NewGardenBackend(client libcontainerd.Client, opts ...GardenBackendOpt) (b GardenBackend, err error) {
@@ -114,6 +124,11 @@ func (b *GardenBackend) Start() (err error) {
 		return fmt.Errorf("client init: %w", err)
 	}
 
+	err = b.network.SetupRestrictedNetworks()
+	if err != nil {
+		return fmt.Errorf("setup restricted networks: %w", err)
+	}
+
 	return
 }
 
@@ -167,11 +182,16 @@ func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container,
 		return nil, fmt.Errorf("new task: %w", err)
 	}
 
-	err = b.network.Add(ctx, task)
+	ip, err := b.network.Add(ctx, task)
 	if err != nil {
 		return nil, fmt.Errorf("network add: %w", err)
 	}
 
+	_, err = cont.SetLabels(ctx, map[string]string{ContainerIPKey: ip})
+	if err != nil {
+		return nil, fmt.Errorf("set container ip label: %w", err)
+	}
+
 	err = task.Start(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("task start: %w", err)
@@ -181,6 +201,7 @@ func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container,
 		cont,
 		b.killer,
 		b.rootfsManager,
+		b.network,
 	), nil
 }
 
@@ -217,7 +238,12 @@ func (b *GardenBackend) Destroy(handle string) error {
 		return fmt.Errorf("gracefully killing task: %w", err)
 	}
 
-	err = b.network.Remove(ctx, task)
+	labels, err := container.Labels(ctx)
+	if err != nil {
+		return fmt.Errorf("labels retrieval: %w", err)
+	}
+
+	err = b.network.Remove(ctx, task, labels[ContainerIPKey])
 	if err != nil {
 		return fmt.Errorf("network remove: %w", err)
 	}
@@ -256,6 +282,7 @@ func (b *GardenBackend) Containers(properties garden.Properties) (containers []g
 			containerdContainer,
 			b.killer,
 			b.rootfsManager,
+			b.network,
 		)
 	}
 
@@ -278,6 +305,7 @@ func (b *GardenBackend) Lookup(handle string) (garden.Container, error) {
 		containerdContainer,
 		b.killer,
 		b.rootfsManager,
+		b.network,
 	), nil
 }
 
@@ -297,23 +325,75 @@ func (b *GardenBackend) GraceTime(container garden.Container) (duration time.Dur
 	return duration
 }
 
-// Capacity - Not Implemented
+// Capacity returns the memory of the host and the size of the filesystem
+// configured with WithDiskPath.
 //
-func (b *GardenBackend) Capacity() (capacity garden.Capacity, err error) {
-	err = ErrNotImplemented
-	return
+func (b *GardenBackend) Capacity() (garden.Capacity, error) {
+	memory, err := hostMemory()
+	if err != nil {
+		return garden.Capacity{}, fmt.Errorf("host memory: %w", err)
+	}
+
+	capacity := garden.Capacity{
+		MemoryInBytes: memory,
+	}
+
+	if b.diskPath != "" {
+		capacity.DiskInBytes, err = diskSize(b.diskPath)
+		if err != nil {
+			return garden.Capacity{}, fmt.Errorf("disk size: %w", err)
+		}
+	}
+
+	return capacity, nil
 }
 
-// BulkInfo - Not Implemented
+// BulkInfo returns the info of each container, or the error encountered
+// while getting it.
 //
-func (b *GardenBackend) BulkInfo(handles []string) (info map[string]garden.ContainerInfoEntry, err error) {
-	err = ErrNotImplemented
-	return
+func (b *GardenBackend) BulkInfo(handles []string) (map[string]garden.ContainerInfoEntry, error) {
+	info := make(map[string]garden.ContainerInfoEntry, len(handles))
+
+	for _, handle := range handles {
+		container, err := b.Lookup(handle)
+		if err != nil {
+			info[handle] = garden.ContainerInfoEntry{Err: garden.NewError(err.Error())}
+			continue
+		}
+
+		containerInfo, err := container.Info()
+		if err != nil {
+			info[handle] = garden.ContainerInfoEntry{Err: garden.NewError(err.Error())}
+			continue
+		}
+
+		info[handle] = garden.ContainerInfoEntry{Info: containerInfo}
+	}
+
+	return info, nil
 }
 
-// BulkMetrics - Not Implemented
+// BulkMetrics returns the metrics of each container, or the error
+// encountered while getting them.
 //
-func (b *GardenBackend) BulkMetrics(handles []string) (metrics map[string]garden.ContainerMetricsEntry, err error) {
-	err = ErrNotImplemented
-	return
+func (b *GardenBackend) BulkMetrics(handles []string) (map[string]garden.ContainerMetricsEntry, error) {
+	metrics := make(map[string]garden.ContainerMetricsEntry, len(handles))
+
+	for _, handle := range handles {
+		container, err := b.Lookup(handle)
+		if err != nil {
+			metrics[handle] = garden.ContainerMetricsEntry{Err: garden.NewError(err.Error())}
+			continue
+		}
+
+		containerMetrics, err := container.Metrics()
+		if err != nil {
+			metrics[handle] = garden.ContainerMetricsEntry{Err: garden.NewError(err.Error())}
+			continue
+		}
+
+		metrics[handle] = garden.ContainerMetricsEntry{Metrics: containerMetrics}
+	}
+
+	return metrics, nil
 }
@@ -1,7 +1,9 @@
 package runtime_test
 
 import (
+	"context"
 	"errors"
+	"os"
 	"testing"
 	"time"
 
@@ -12,6 +14,7 @@ import (
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/errdefs"
+	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -144,6 +147,36 @@ func (s *BackendSuite) TestCreateContainerSetsHandle() {
 
 }
 
+func (s *BackendSuite) TestCreateContainerNetworkAddFailure() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	s.client.NewContainerReturns(fakeContainer, nil)
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.network.AddReturns("", errors.New("add-err"))
+
+	_, err := s.backend.Create(minimumValidGdnSpec)
+	s.EqualError(errors.Unwrap(err), "add-err")
+
+	s.Equal(0, fakeTask.StartCallCount())
+}
+
+func (s *BackendSuite) TestCreateContainerStoresIP() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	s.client.NewContainerReturns(fakeContainer, nil)
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.network.AddReturns("10.80.0.2", nil)
+
+	_, err := s.backend.Create(minimumValidGdnSpec)
+	s.NoError(err)
+
+	s.Equal(1, fakeContainer.SetLabelsCallCount())
+	_, labels := fakeContainer.SetLabelsArgsForCall(0)
+	s.Equal(map[string]string{"garden.network.container-ip": "10.80.0.2"}, labels)
+}
+
 func (s *BackendSuite) TestContainersWithContainerdFailure() {
 	s.client.ContainersReturns(nil, errors.New("err"))
 
@@ -333,6 +366,25 @@ func (s *BackendSuite) TestDestroyRemoveNetworkFails() {
 	s.True(errors.Is(err, expectedError))
 }
 
+func (s *BackendSuite) TestDestroyRemovesContainerIPFromNetwork() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeTask := new(libcontainerdfakes.FakeTask)
+
+	s.client.GetContainerReturns(fakeContainer, nil)
+	fakeContainer.TaskReturns(fakeTask, nil)
+	fakeContainer.LabelsReturns(map[string]string{
+		"garden.network.container-ip": "10.80.0.2",
+	}, nil)
+
+	err := s.backend.Destroy("some handle")
+	s.NoError(err)
+
+	s.Equal(1, s.network.RemoveCallCount())
+	_, task, ip := s.network.RemoveArgsForCall(0)
+	s.Equal(fakeTask, task)
+	s.Equal("10.80.0.2", ip)
+}
+
 func (s *BackendSuite) TestDestroyDeleteTaskFails() {
 	fakeContainer := new(libcontainerdfakes.FakeContainer)
 	fakeTask := new(libcontainerdfakes.FakeTask)
@@ -375,6 +427,13 @@ func (s *BackendSuite) TestStart() {
 	err := s.backend.Start()
 	s.NoError(err)
 	s.Equal(1, s.client.InitCallCount())
+	s.Equal(1, s.network.SetupRestrictedNetworksCallCount())
+}
+
+func (s *BackendSuite) TestStartSetupRestrictedNetworksError() {
+	s.network.SetupRestrictedNetworksReturns(errors.New("setup-err"))
+	err := s.backend.Start()
+	s.EqualError(errors.Unwrap(err), "setup-err")
 }
 
 func (s *BackendSuite) TestStartInitError() {
@@ -407,4 +466,65 @@ func (s *BackendSuite) TestGraceTimeReturnsDuration() {
 	fakeContainer.PropertyReturns("123", nil)
 	result := s.backend.GraceTime(fakeContainer)
 	s.Equal(time.Duration(123), result)
-}
\ No newline at end of file
+}
+func (s *BackendSuite) TestCapacity() {
+	backend, err := runtime.NewGardenBackend(s.client,
+		runtime.WithNetwork(s.network),
+		runtime.WithDiskPath(os.TempDir()),
+	)
+	s.NoError(err)
+
+	capacity, err := backend.Capacity()
+	s.NoError(err)
+	s.NotZero(capacity.MemoryInBytes)
+	s.NotZero(capacity.DiskInBytes)
+}
+
+func (s *BackendSuite) TestCapacityWithoutDiskPath() {
+	capacity, err := s.backend.Capacity()
+	s.NoError(err)
+	s.NotZero(capacity.MemoryInBytes)
+	s.Zero(capacity.DiskInBytes)
+}
+
+func (s *BackendSuite) TestBulkInfo() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeTask.StatusReturns(containerd.Status{Status: containerd.Running}, nil)
+
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.SpecReturns(&specs.Spec{}, nil)
+	fakeContainer.TaskReturns(fakeTask, nil)
+
+	s.client.GetContainerStub = func(_ context.Context, handle string) (containerd.Container, error) {
+		if handle == "missing" {
+			return nil, errors.New("not found")
+		}
+
+		return fakeContainer, nil
+	}
+
+	info, err := s.backend.BulkInfo([]string{"handle", "missing"})
+	s.NoError(err)
+	s.Len(info, 2)
+
+	s.Nil(info["handle"].Err)
+	s.Equal("running", info["handle"].Info.State)
+
+	s.NotNil(info["missing"].Err)
+	s.Contains(info["missing"].Err.Error(), "not found")
+}
+
+func (s *BackendSuite) TestBulkMetrics() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeTask.MetricsReturns(nil, errors.New("metrics-err"))
+
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.TaskReturns(fakeTask, nil)
+	s.client.GetContainerReturns(fakeContainer, nil)
+
+	metrics, err := s.backend.BulkMetrics([]string{"handle"})
+	s.NoError(err)
+	s.Len(metrics, 1)
+	s.NotNil(metrics["handle"].Err)
+	s.Contains(metrics["handle"].Err.Error(), "metrics-err")
+}
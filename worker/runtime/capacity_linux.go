@@ -0,0 +1,23 @@
+package runtime
+
+import "syscall"
+
+func hostMemory() (uint64, error) {
+	var info syscall.Sysinfo_t
+	err := syscall.Sysinfo(&info)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(info.Totalram) * uint64(info.Unit), nil
+}
+
+func diskSize(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(path, &stat)
+	if err != nil {
+		return 0, err
+	}
+
+	return stat.Blocks * uint64(stat.Bsize), nil
+}
@@ -0,0 +1,11 @@
+// +build !linux
+
+package runtime
+
+func hostMemory() (uint64, error) {
+	return 0, ErrNotImplemented
+}
+
+func diskSize(path string) (uint64, error) {
+	return 0, ErrNotImplemented
+}
@@ -2,9 +2,14 @@ package runtime
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net"
 	"path/filepath"
+	"strconv"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/containerd/containerd"
 	"github.com/containerd/go-cni"
 	"github.com/opencontainers/runtime-spec/specs-go"
@@ -41,6 +46,25 @@ const (
 	// binaries in.
 	//
 	binariesDir = "/usr/local/concourse/bin"
+
+	// operatorChain is the iptables chain holding the worker-wide rules
+	// that allow or restrict traffic from containers to networks.
+	//
+	operatorChain = "CONCOURSE-OPERATOR"
+
+	// containersChain is the iptables chain that jumps to the chains of
+	// each container, so that their rules get evaluated before the
+	// worker-wide restrictions.
+	//
+	containersChain = "CONCOURSE-CONTAINERS"
+
+	// adminChain is the iptables chain that the CNI firewall plugin jumps
+	// to before accepting the traffic of the containers it set up, so that
+	// the worker-wide rules take precedence over it. Jumping from FORWARD
+	// instead wouldn't do, as the plugin puts its own jump first when it
+	// sets up a container.
+	//
+	adminChain = "CNI-ADMIN"
 )
 
 var (
@@ -79,13 +103,14 @@ func (c CNINetworkConfig) ToJSON() string {
       }
     },
     {
-      "type": "firewall"
+      "type": "firewall",
+      "iptablesAdminChainName": "%s"
     }
   ]
 }`
 
 	return fmt.Sprintf(networksConfListFormat,
-		c.NetworkName, c.BridgeName, c.Subnet,
+		c.NetworkName, c.BridgeName, c.Subnet, adminChain,
 	)
 }
 
@@ -139,12 +164,43 @@ func WithCNIFileStore(f FileStore) CNINetworkOpt {
 	}
 }
 
+// WithFirewall changes the default Firewall used to manage the rules that
+// allow or restrict the traffic of containers.
+//
+func WithFirewall(f Firewall) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.firewall = f
+	}
+}
+
+// WithRestrictedNetworks sets the networks (in CIDR notation) that
+// containers must not be able to reach, unless allowed through a NetOut rule
+// or WithAllowedNetworks.
+//
+func WithRestrictedNetworks(networks []string) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.restrictedNetworks = networks
+	}
+}
+
+// WithAllowedNetworks sets the networks (in CIDR notation) that containers
+// are able to reach even if they're part of a restricted network.
+//
+func WithAllowedNetworks(networks []string) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.allowedNetworks = networks
+	}
+}
+
 type cniNetwork struct {
-	client      cni.CNI
-	store       FileStore
-	config      CNINetworkConfig
-	nameServers []string
-	binariesDir string
+	client             cni.CNI
+	store              FileStore
+	firewall           Firewall
+	config             CNINetworkConfig
+	nameServers        []string
+	binariesDir        string
+	restrictedNetworks []string
+	allowedNetworks    []string
 }
 
 var _ Network = (*cniNetwork)(nil)
@@ -162,10 +218,21 @@ func NewCNINetwork(opts ...CNINetworkOpt) (*cniNetwork, error) {
 		opt(n)
 	}
 
+	for _, network := range append(n.restrictedNetworks, n.allowedNetworks...) {
+		_, _, err = net.ParseCIDR(network)
+		if err != nil {
+			return nil, ErrInvalidInput(fmt.Sprintf("invalid network '%s': %s", network, err))
+		}
+	}
+
 	if n.store == nil {
 		n.store = NewFileStore(fileStoreWorkDir)
 	}
 
+	if n.firewall == nil {
+		n.firewall = NewIptables()
+	}
+
 	if n.client == nil {
 		n.client, err = cni.New(cni.WithPluginDir([]string{n.binariesDir}))
 		if err != nil {
@@ -229,26 +296,127 @@ func (n cniNetwork) generateResolvConfContents() []byte {
 	return []byte(contents)
 }
 
-func (n cniNetwork) Add(ctx context.Context, task containerd.Task) error {
+// SetupRestrictedNetworks (re)creates the chain that rejects traffic from
+// containers to the restricted networks and makes the packets forwarded for
+// containers go through it before the CNI firewall plugin accepts them.
+//
+// Containers' own chains are kept intact so that the rules of those that
+// survived a restart of the worker still apply.
+//
+func (n cniNetwork) SetupRestrictedNetworks() error {
+	for _, chain := range []string{containersChain, operatorChain, adminChain} {
+		err := n.firewall.CreateChain(FilterTable, chain)
+		if err != nil {
+			return fmt.Errorf("create chain %s: %w", chain, err)
+		}
+	}
+
+	err := n.firewall.FlushChain(FilterTable, operatorChain)
+	if err != nil {
+		return fmt.Errorf("flush chain %s: %w", operatorChain, err)
+	}
+
+	subnet := n.config.Subnet
+
+	rules := [][]string{
+		{"-s", subnet, "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"},
+		{"-d", subnet, "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"},
+		{"-j", containersChain},
+	}
+
+	for _, network := range n.allowedNetworks {
+		rules = append(rules, []string{"-s", subnet, "-d", network, "-j", "ACCEPT"})
+	}
+
+	for _, network := range n.restrictedNetworks {
+		rules = append(rules, []string{"-s", subnet, "-d", network, "-j", "REJECT"})
+	}
+
+	for _, rule := range rules {
+		err = n.firewall.AppendRule(FilterTable, operatorChain, rule...)
+		if err != nil {
+			return fmt.Errorf("append rule: %w", err)
+		}
+	}
+
+	jump := []string{"-j", operatorChain}
+
+	exists, err := n.firewall.RuleExists(FilterTable, adminChain, jump...)
+	if err != nil {
+		return fmt.Errorf("check admin rule: %w", err)
+	}
+
+	if exists {
+		return nil
+	}
+
+	err = n.firewall.InsertRule(FilterTable, adminChain, 1, jump...)
+	if err != nil {
+		return fmt.Errorf("insert admin rule: %w", err)
+	}
+
+	return nil
+}
+
+func (n cniNetwork) Add(ctx context.Context, task containerd.Task) (string, error) {
 	if task == nil {
-		return ErrInvalidInput("nil task")
+		return "", ErrInvalidInput("nil task")
 	}
 
 	id, netns := netId(task), netNsPath(task)
 
-	_, err := n.client.Setup(ctx, id, netns)
+	result, err := n.client.Setup(ctx, id, netns)
 	if err != nil {
-		return fmt.Errorf("cni net setup: %w", err)
+		return "", fmt.Errorf("cni net setup: %w", err)
 	}
 
-	return nil
+	var interfaces map[string]*cni.Config
+	if result != nil {
+		interfaces = result.Interfaces
+	}
+
+	ip, err := containerIP(interfaces)
+	if err != nil {
+		return "", err
+	}
+
+	chain := containerChain(task.ID())
+
+	err = n.firewall.CreateChain(FilterTable, chain)
+	if err != nil {
+		return "", fmt.Errorf("create chain %s: %w", chain, err)
+	}
+
+	err = n.firewall.FlushChain(FilterTable, chain)
+	if err != nil {
+		return "", fmt.Errorf("flush chain %s: %w", chain, err)
+	}
+
+	for _, jump := range containerJumps(chain, ip) {
+		err = n.firewall.AppendRule(FilterTable, containersChain, jump...)
+		if err != nil {
+			return "", fmt.Errorf("append rule: %w", err)
+		}
+	}
+
+	return ip, nil
 }
 
-func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
+// Remove removes the task from the network, as well as the rules of the
+// container if it got an IP address assigned.
+//
+func (n cniNetwork) Remove(ctx context.Context, task containerd.Task, ip string) error {
 	if task == nil {
 		return ErrInvalidInput("nil task")
 	}
 
+	if ip != "" {
+		err := n.removeRules(task.ID(), ip)
+		if err != nil {
+			return err
+		}
+	}
+
 	id, netns := netId(task), netNsPath(task)
 
 	err := n.client.Remove(ctx, id, netns)
@@ -259,6 +427,135 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 	return nil
 }
 
+func (n cniNetwork) removeRules(handle, ip string) error {
+	chain := containerChain(handle)
+
+	for _, jump := range containerJumps(chain, ip) {
+		err := n.firewall.DeleteRule(FilterTable, containersChain, jump...)
+		if err != nil {
+			return fmt.Errorf("delete rule: %w", err)
+		}
+	}
+
+	err := n.firewall.DeleteChain(FilterTable, chain)
+	if err != nil {
+		return fmt.Errorf("delete chain %s: %w", chain, err)
+	}
+
+	jump := natJump(chain)
+
+	exists, err := n.firewall.RuleExists(NatTable, "PREROUTING", jump...)
+	if err != nil {
+		return fmt.Errorf("check prerouting rule: %w", err)
+	}
+
+	if !exists {
+		return nil
+	}
+
+	err = n.firewall.DeleteRule(NatTable, "PREROUTING", jump...)
+	if err != nil {
+		return fmt.Errorf("delete prerouting rule: %w", err)
+	}
+
+	err = n.firewall.DeleteChain(NatTable, chain)
+	if err != nil {
+		return fmt.Errorf("delete nat chain %s: %w", chain, err)
+	}
+
+	return nil
+}
+
+// NetIn forwards TCP connections to a port on the host to a port of the
+// container.
+//
+// A free port is picked if `hostPort` is 0, and the container port defaults
+// to the host port.
+//
+func (n cniNetwork) NetIn(handle, ip string, hostPort, containerPort uint32) (uint32, uint32, error) {
+	if handle == "" || ip == "" {
+		return 0, 0, ErrInvalidInput("empty handle or ip")
+	}
+
+	if hostPort == 0 {
+		port, err := freePort()
+		if err != nil {
+			return 0, 0, fmt.Errorf("picking free port: %w", err)
+		}
+
+		hostPort = port
+	}
+
+	if containerPort == 0 {
+		containerPort = hostPort
+	}
+
+	chain := containerChain(handle)
+
+	err := n.firewall.CreateChain(NatTable, chain)
+	if err != nil {
+		return 0, 0, fmt.Errorf("create nat chain %s: %w", chain, err)
+	}
+
+	jump := natJump(chain)
+
+	exists, err := n.firewall.RuleExists(NatTable, "PREROUTING", jump...)
+	if err != nil {
+		return 0, 0, fmt.Errorf("check prerouting rule: %w", err)
+	}
+
+	if !exists {
+		err = n.firewall.AppendRule(NatTable, "PREROUTING", jump...)
+		if err != nil {
+			return 0, 0, fmt.Errorf("append prerouting rule: %w", err)
+		}
+	}
+
+	hp, cp := strconv.Itoa(int(hostPort)), strconv.Itoa(int(containerPort))
+
+	err = n.firewall.AppendRule(NatTable, chain,
+		"-p", "tcp", "--dport", hp,
+		"-j", "DNAT", "--to-destination", ip+":"+cp,
+	)
+	if err != nil {
+		return 0, 0, fmt.Errorf("append dnat rule: %w", err)
+	}
+
+	err = n.firewall.AppendRule(FilterTable, chain,
+		"-d", ip, "-p", "tcp", "--dport", cp, "-j", "ACCEPT",
+	)
+	if err != nil {
+		return 0, 0, fmt.Errorf("append accept rule: %w", err)
+	}
+
+	return hostPort, containerPort, nil
+}
+
+// NetOut accepts the traffic from the container that matches a rule, which
+// takes precedence over the worker-wide restrictions.
+//
+func (n cniNetwork) NetOut(handle, ip string, rule garden.NetOutRule) error {
+	if handle == "" || ip == "" {
+		return ErrInvalidInput("empty handle or ip")
+	}
+
+	rules, err := netOutRules(ip, rule)
+	if err != nil {
+		return err
+	}
+
+	chain := containerChain(handle)
+
+	for _, r := range rules {
+		err = n.firewall.AppendRule(FilterTable, chain, r...)
+		if err != nil {
+			return fmt.Errorf("append rule: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func netId(task containerd.Task) string {
 	return task.ID()
 }
@@ -266,3 +563,57 @@ func netId(task containerd.Task) string {
 func netNsPath(task containerd.Task) string {
 	return fmt.Sprintf("/proc/%d/ns/net", task.Pid())
 }
+
+// containerChain is the name of the chains holding the rules specific to a
+// container.
+//
+// As chain names are limited to 28 characters, it's derived from a hash of
+// the handle rather than the handle itself.
+//
+func containerChain(handle string) string {
+	sum := sha256.Sum256([]byte(handle))
+	return "CONCOURSE-" + hex.EncodeToString(sum[:])[:16]
+}
+
+func containerJumps(chain, ip string) [][]string {
+	return [][]string{
+		{"-s", ip, "-j", chain},
+		{"-d", ip, "-j", chain},
+	}
+}
+
+func natJump(chain string) []string {
+	return []string{"-m", "addrtype", "--dst-type", "LOCAL", "-j", chain}
+}
+
+// containerIP finds the IPv4 address assigned to the interface created in the
+// container's network namespace.
+//
+func containerIP(interfaces map[string]*cni.Config) (string, error) {
+	for _, iface := range interfaces {
+		if iface.Sandbox == "" {
+			continue
+		}
+
+		for _, config := range iface.IPConfigs {
+			if config.IP.IsLoopback() || config.IP.To4() == nil {
+				continue
+			}
+
+			return config.IP.String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("no ip address assigned to the container")
+}
+
+func freePort() (uint32, error) {
+	listener, err := net.Listen("tcp", ":0")
+	if err != nil {
+		return 0, err
+	}
+
+	defer listener.Close()
+
+	return uint32(listener.Addr().(*net.TCPAddr).Port), nil
+}
@@ -3,10 +3,14 @@ package runtime_test
 import (
 	"context"
 	"errors"
+	"net"
+	"strings"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/runtime"
-	"github.com/concourse/concourse/worker/runtime/runtimefakes"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
+	"github.com/concourse/concourse/worker/runtime/runtimefakes"
+	"github.com/containerd/go-cni"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -16,9 +20,10 @@ type CNINetworkSuite struct {
 	suite.Suite
 	*require.Assertions
 
-	network runtime.Network
-	cni     *runtimefakes.FakeCNI
-	store   *runtimefakes.FakeFileStore
+	network  runtime.Network
+	cni      *runtimefakes.FakeCNI
+	store    *runtimefakes.FakeFileStore
+	firewall *runtimefakes.FakeFirewall
 }
 
 func (s *CNINetworkSuite) SetupTest() {
@@ -26,13 +31,225 @@ func (s *CNINetworkSuite) SetupTest() {
 
 	s.store = new(runtimefakes.FakeFileStore)
 	s.cni = new(runtimefakes.FakeCNI)
+	s.firewall = new(runtimefakes.FakeFirewall)
 	s.network, err = runtime.NewCNINetwork(
 		runtime.WithCNIFileStore(s.store),
 		runtime.WithCNIClient(s.cni),
+		runtime.WithFirewall(s.firewall),
 	)
 	s.NoError(err)
 }
 
+// chainRule describes a call to one of the rule-related methods of a Firewall.
+//
+type chainRule struct {
+	table string
+	chain string
+	rule  []string
+}
+
+func (s *CNINetworkSuite) appendedRules() []chainRule {
+	rules := make([]chainRule, s.firewall.AppendRuleCallCount())
+	for i := range rules {
+		table, chain, rule := s.firewall.AppendRuleArgsForCall(i)
+		rules[i] = chainRule{table, chain, rule}
+	}
+
+	return rules
+}
+
+func (s *CNINetworkSuite) deletedRules() []chainRule {
+	rules := make([]chainRule, s.firewall.DeleteRuleCallCount())
+	for i := range rules {
+		table, chain, rule := s.firewall.DeleteRuleArgsForCall(i)
+		rules[i] = chainRule{table, chain, rule}
+	}
+
+	return rules
+}
+
+// memoryFirewall keeps the rules of each chain in the order iptables would.
+//
+type memoryFirewall struct {
+	chains map[string][]string
+}
+
+func newMemoryFirewall() *memoryFirewall {
+	return &memoryFirewall{
+		chains: map[string][]string{"filter/FORWARD": nil},
+	}
+}
+
+func (f *memoryFirewall) CreateChain(table, chain string) error {
+	if _, found := f.chains[table+"/"+chain]; !found {
+		f.chains[table+"/"+chain] = nil
+	}
+
+	return nil
+}
+
+func (f *memoryFirewall) FlushChain(table, chain string) error {
+	f.chains[table+"/"+chain] = nil
+	return nil
+}
+
+func (f *memoryFirewall) DeleteChain(table, chain string) error {
+	delete(f.chains, table+"/"+chain)
+	return nil
+}
+
+func (f *memoryFirewall) RuleExists(table, chain string, rule ...string) (bool, error) {
+	return indexOf(f.chains[table+"/"+chain], strings.Join(rule, " ")) != -1, nil
+}
+
+func (f *memoryFirewall) AppendRule(table, chain string, rule ...string) error {
+	key := table + "/" + chain
+	f.chains[key] = append(f.chains[key], strings.Join(rule, " "))
+	return nil
+}
+
+func (f *memoryFirewall) InsertRule(table, chain string, pos int, rule ...string) error {
+	key := table + "/" + chain
+	rules := append([]string{}, f.chains[key][:pos-1]...)
+	rules = append(rules, strings.Join(rule, " "))
+	f.chains[key] = append(rules, f.chains[key][pos-1:]...)
+	return nil
+}
+
+func (f *memoryFirewall) DeleteRule(table, chain string, rule ...string) error {
+	key := table + "/" + chain
+	if i := indexOf(f.chains[key], strings.Join(rule, " ")); i != -1 {
+		f.chains[key] = append(f.chains[key][:i], f.chains[key][i+1:]...)
+	}
+
+	return nil
+}
+
+// traverse lists the rules a packet goes through from a chain, following
+// jumps to other chains.
+//
+func (f *memoryFirewall) traverse(table, chain string) []string {
+	var rules []string
+	for _, rule := range f.chains[table+"/"+chain] {
+		rules = append(rules, rule)
+
+		if target := strings.TrimPrefix(rule, "-j "); target != rule {
+			if _, found := f.chains[table+"/"+target]; found {
+				rules = append(rules, f.traverse(table, target)...)
+			}
+		}
+	}
+
+	return rules
+}
+
+func indexOf(rules []string, rule string) int {
+	for i, r := range rules {
+		if r == rule {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (s *CNINetworkSuite) TestNewCNINetworkWithInvalidRestrictedNetwork() {
+	_, err := runtime.NewCNINetwork(
+		runtime.WithFirewall(s.firewall),
+		runtime.WithRestrictedNetworks([]string{"10.0.0.0/8", "metadata"}),
+	)
+	s.EqualError(err, "invalid network 'metadata': invalid CIDR address: metadata")
+}
+
+func (s *CNINetworkSuite) TestSetupRestrictedNetworks() {
+	network, err := runtime.NewCNINetwork(
+		runtime.WithCNIClient(s.cni),
+		runtime.WithFirewall(s.firewall),
+		runtime.WithRestrictedNetworks([]string{"169.254.169.254/32", "10.0.0.0/8"}),
+		runtime.WithAllowedNetworks([]string{"10.1.0.0/16"}),
+	)
+	s.NoError(err)
+
+	err = network.SetupRestrictedNetworks()
+	s.NoError(err)
+
+	s.Equal(3, s.firewall.CreateChainCallCount())
+	table, chain := s.firewall.CreateChainArgsForCall(0)
+	s.Equal([]string{"filter", "CONCOURSE-CONTAINERS"}, []string{table, chain})
+	table, chain = s.firewall.CreateChainArgsForCall(1)
+	s.Equal([]string{"filter", "CONCOURSE-OPERATOR"}, []string{table, chain})
+	table, chain = s.firewall.CreateChainArgsForCall(2)
+	s.Equal([]string{"filter", "CNI-ADMIN"}, []string{table, chain})
+
+	s.Equal(1, s.firewall.FlushChainCallCount())
+	table, chain = s.firewall.FlushChainArgsForCall(0)
+	s.Equal([]string{"filter", "CONCOURSE-OPERATOR"}, []string{table, chain})
+
+	s.Equal([]chainRule{
+		{"filter", "CONCOURSE-OPERATOR", []string{"-s", "10.80.0.0/16", "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"}},
+		{"filter", "CONCOURSE-OPERATOR", []string{"-d", "10.80.0.0/16", "-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "ACCEPT"}},
+		{"filter", "CONCOURSE-OPERATOR", []string{"-j", "CONCOURSE-CONTAINERS"}},
+		{"filter", "CONCOURSE-OPERATOR", []string{"-s", "10.80.0.0/16", "-d", "10.1.0.0/16", "-j", "ACCEPT"}},
+		{"filter", "CONCOURSE-OPERATOR", []string{"-s", "10.80.0.0/16", "-d", "169.254.169.254/32", "-j", "REJECT"}},
+		{"filter", "CONCOURSE-OPERATOR", []string{"-s", "10.80.0.0/16", "-d", "10.0.0.0/8", "-j", "REJECT"}},
+	}, s.appendedRules())
+
+	s.Equal(1, s.firewall.InsertRuleCallCount())
+	table, chain, pos, rule := s.firewall.InsertRuleArgsForCall(0)
+	s.Equal("filter", table)
+	s.Equal("CNI-ADMIN", chain)
+	s.Equal(1, pos)
+	s.Equal([]string{"-j", "CONCOURSE-OPERATOR"}, rule)
+}
+
+func (s *CNINetworkSuite) TestSetupRestrictedNetworksRejectsBeforeTheCNIFirewallAccepts() {
+	firewall := newMemoryFirewall()
+
+	network, err := runtime.NewCNINetwork(
+		runtime.WithCNIClient(s.cni),
+		runtime.WithFirewall(firewall),
+		runtime.WithRestrictedNetworks([]string{"10.0.0.0/8"}),
+	)
+	s.NoError(err)
+
+	err = network.SetupRestrictedNetworks()
+	s.NoError(err)
+
+	// what the CNI firewall plugin does when a container gets added to the
+	// network, after the restricted networks have been set up
+	//
+	s.NoError(firewall.CreateChain("filter", "CNI-FORWARD"))
+	s.NoError(firewall.InsertRule("filter", "FORWARD", 1, "-j", "CNI-FORWARD"))
+	s.NoError(firewall.CreateChain("filter", "CNI-ADMIN"))
+	s.NoError(firewall.InsertRule("filter", "CNI-FORWARD", 1, "-j", "CNI-ADMIN"))
+	s.NoError(firewall.AppendRule("filter", "CNI-FORWARD", "-d", "10.80.0.2", "-j", "ACCEPT"))
+	s.NoError(firewall.AppendRule("filter", "CNI-FORWARD", "-s", "10.80.0.2", "-j", "ACCEPT"))
+
+	rules := firewall.traverse("filter", "FORWARD")
+
+	reject := indexOf(rules, "-s 10.80.0.0/16 -d 10.0.0.0/8 -j REJECT")
+	accept := indexOf(rules, "-s 10.80.0.2 -j ACCEPT")
+	s.NotEqual(-1, reject)
+	s.NotEqual(-1, accept)
+	s.Less(reject, accept)
+}
+
+func (s *CNINetworkSuite) TestSetupRestrictedNetworksAlreadyJumpedTo() {
+	s.firewall.RuleExistsReturns(true, nil)
+
+	err := s.network.SetupRestrictedNetworks()
+	s.NoError(err)
+
+	s.Equal(0, s.firewall.InsertRuleCallCount())
+}
+
+func (s *CNINetworkSuite) TestSetupRestrictedNetworksFirewallErrors() {
+	s.firewall.CreateChainReturns(errors.New("create-chain-err"))
+
+	err := s.network.SetupRestrictedNetworks()
+	s.EqualError(errors.Unwrap(err), "create-chain-err")
+}
+
 func (s *CNINetworkSuite) TestNewCNINetworkWithInvalidConfigDoesntFail() {
 	// CNI defers the actual interpretation of the network configuration to
 	// the plugins.
@@ -126,7 +343,7 @@ func (s *CNINetworkSuite) TestSetupMountsCallsStoreWithOneNameServer() {
 }
 
 func (s *CNINetworkSuite) TestAddNilTask() {
-	err := s.network.Add(context.Background(), nil)
+	_, err := s.network.Add(context.Background(), nil)
 	s.EqualError(err, "nil task")
 }
 
@@ -134,26 +351,64 @@ func (s *CNINetworkSuite) TestAddSetupErrors() {
 	s.cni.SetupReturns(nil, errors.New("setup-err"))
 	task := new(libcontainerdfakes.FakeTask)
 
-	err := s.network.Add(context.Background(), task)
+	_, err := s.network.Add(context.Background(), task)
 	s.EqualError(errors.Unwrap(err), "setup-err")
 }
 
+func (s *CNINetworkSuite) TestAddWithoutIP() {
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"lo": {
+				Sandbox:   "/proc/123/ns/net",
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("127.0.0.1")}},
+			},
+		},
+	}, nil)
+	task := new(libcontainerdfakes.FakeTask)
+
+	_, err := s.network.Add(context.Background(), task)
+	s.EqualError(err, "no ip address assigned to the container")
+}
+
 func (s *CNINetworkSuite) TestAdd() {
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"concourse0": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("10.80.0.1")}},
+			},
+			"eth0": {
+				Sandbox:   "/proc/123/ns/net",
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("10.80.0.2")}},
+			},
+		},
+	}, nil)
+
 	task := new(libcontainerdfakes.FakeTask)
 	task.PidReturns(123)
 	task.IDReturns("id")
 
-	err := s.network.Add(context.Background(), task)
+	ip, err := s.network.Add(context.Background(), task)
 	s.NoError(err)
+	s.Equal("10.80.0.2", ip)
 
 	s.Equal(1, s.cni.SetupCallCount())
 	_, id, netns, _ := s.cni.SetupArgsForCall(0)
 	s.Equal("id", id)
 	s.Equal("/proc/123/ns/net", netns)
+
+	s.Equal(1, s.firewall.CreateChainCallCount())
+	table, chain := s.firewall.CreateChainArgsForCall(0)
+	s.Equal("filter", table)
+	s.Regexp("^CONCOURSE-[0-9a-f]{16}$", chain)
+
+	s.Equal([]chainRule{
+		{"filter", "CONCOURSE-CONTAINERS", []string{"-s", "10.80.0.2", "-j", chain}},
+		{"filter", "CONCOURSE-CONTAINERS", []string{"-d", "10.80.0.2", "-j", chain}},
+	}, s.appendedRules())
 }
 
 func (s *CNINetworkSuite) TestRemoveNilTask() {
-	err := s.network.Remove(context.Background(), nil)
+	err := s.network.Remove(context.Background(), nil, "")
 	s.EqualError(err, "nil task")
 }
 
@@ -161,7 +416,7 @@ func (s *CNINetworkSuite) TestRemoveSetupErrors() {
 	s.cni.RemoveReturns(errors.New("remove-err"))
 	task := new(libcontainerdfakes.FakeTask)
 
-	err := s.network.Remove(context.Background(), task)
+	err := s.network.Remove(context.Background(), task, "")
 	s.EqualError(errors.Unwrap(err), "remove-err")
 }
 
@@ -170,11 +425,188 @@ func (s *CNINetworkSuite) TestRemove() {
 	task.PidReturns(123)
 	task.IDReturns("id")
 
-	err := s.network.Remove(context.Background(), task)
+	err := s.network.Remove(context.Background(), task, "")
 	s.NoError(err)
 
 	s.Equal(1, s.cni.RemoveCallCount())
 	_, id, netns, _ := s.cni.RemoveArgsForCall(0)
 	s.Equal("id", id)
 	s.Equal("/proc/123/ns/net", netns)
+
+	s.Equal(0, s.firewall.DeleteRuleCallCount())
+	s.Equal(0, s.firewall.DeleteChainCallCount())
+}
+
+func (s *CNINetworkSuite) TestRemoveDeletesRules() {
+	s.firewall.RuleExistsReturns(true, nil)
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Remove(context.Background(), task, "10.80.0.2")
+	s.NoError(err)
+
+	s.Equal(2, s.firewall.DeleteChainCallCount())
+	table, chain := s.firewall.DeleteChainArgsForCall(0)
+	s.Equal("filter", table)
+	natTable, natChain := s.firewall.DeleteChainArgsForCall(1)
+	s.Equal("nat", natTable)
+	s.Equal(chain, natChain)
+
+	s.Equal([]chainRule{
+		{"filter", "CONCOURSE-CONTAINERS", []string{"-s", "10.80.0.2", "-j", chain}},
+		{"filter", "CONCOURSE-CONTAINERS", []string{"-d", "10.80.0.2", "-j", chain}},
+		{"nat", "PREROUTING", []string{"-m", "addrtype", "--dst-type", "LOCAL", "-j", chain}},
+	}, s.deletedRules())
+
+	s.Equal(1, s.cni.RemoveCallCount())
+}
+
+func (s *CNINetworkSuite) TestRemoveFirewallErrors() {
+	s.firewall.DeleteChainReturns(errors.New("delete-chain-err"))
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.Remove(context.Background(), task, "10.80.0.2")
+	s.EqualError(errors.Unwrap(err), "delete-chain-err")
+
+	s.Equal(0, s.cni.RemoveCallCount())
+}
+
+func (s *CNINetworkSuite) TestNetIn() {
+	hostPort, containerPort, err := s.network.NetIn("handle", "10.80.0.2", 8080, 0)
+	s.NoError(err)
+	s.Equal(uint32(8080), hostPort)
+	s.Equal(uint32(8080), containerPort)
+
+	table, chain := s.firewall.CreateChainArgsForCall(0)
+	s.Equal("nat", table)
+
+	s.Equal([]chainRule{
+		{"nat", "PREROUTING", []string{"-m", "addrtype", "--dst-type", "LOCAL", "-j", chain}},
+		{"nat", chain, []string{"-p", "tcp", "--dport", "8080", "-j", "DNAT", "--to-destination", "10.80.0.2:8080"}},
+		{"filter", chain, []string{"-d", "10.80.0.2", "-p", "tcp", "--dport", "8080", "-j", "ACCEPT"}},
+	}, s.appendedRules())
+}
+
+func (s *CNINetworkSuite) TestNetInPicksHostPort() {
+	s.firewall.RuleExistsReturns(true, nil)
+
+	hostPort, containerPort, err := s.network.NetIn("handle", "10.80.0.2", 0, 80)
+	s.NoError(err)
+	s.NotZero(hostPort)
+	s.Equal(uint32(80), containerPort)
+
+	s.Equal(2, s.firewall.AppendRuleCallCount())
+}
+
+func (s *CNINetworkSuite) TestNetInEmptyIP() {
+	_, _, err := s.network.NetIn("handle", "", 8080, 80)
+	s.EqualError(err, "empty handle or ip")
+}
+
+func (s *CNINetworkSuite) TestNetOutInvalidRule() {
+	for _, tc := range []struct {
+		rule garden.NetOutRule
+		err  string
+	}{
+		{
+			rule: garden.NetOutRule{Ports: []garden.PortRange{garden.PortRangeFromPort(80)}},
+			err:  "ports must only be specified for tcp or udp",
+		},
+		{
+			rule: garden.NetOutRule{
+				Protocol: garden.ProtocolTCP,
+				ICMPs:    &garden.ICMPControl{Type: 8},
+			},
+			err: "icmps must only be specified for icmp",
+		},
+		{
+			rule: garden.NetOutRule{
+				Protocol: garden.ProtocolTCP,
+				Ports:    []garden.PortRange{{Start: 90, End: 80}},
+			},
+			err: "invalid port range 90-80",
+		},
+		{
+			rule: garden.NetOutRule{
+				Networks: []garden.IPRange{garden.IPRangeFromIP(net.ParseIP("::1"))},
+			},
+			err: "networks must be ranges of ipv4 addresses",
+		},
+		{
+			rule: garden.NetOutRule{Protocol: 42},
+			err:  "invalid protocol 42",
+		},
+	} {
+		err := s.network.NetOut("handle", "10.80.0.2", tc.rule)
+		s.EqualError(err, tc.err)
+	}
+
+	s.Equal(0, s.firewall.AppendRuleCallCount())
+}
+
+func (s *CNINetworkSuite) TestNetOutAll() {
+	err := s.network.NetOut("handle", "10.80.0.2", garden.NetOutRule{})
+	s.NoError(err)
+
+	rules := s.appendedRules()
+	s.Len(rules, 1)
+	s.Equal("filter", rules[0].table)
+	s.Regexp("^CONCOURSE-[0-9a-f]{16}$", rules[0].chain)
+	s.Equal([]string{"-s", "10.80.0.2", "-j", "ACCEPT"}, rules[0].rule)
+}
+
+func (s *CNINetworkSuite) TestNetOutNetworksAndPorts() {
+	err := s.network.NetOut("handle", "10.80.0.2", garden.NetOutRule{
+		Protocol: garden.ProtocolTCP,
+		Networks: []garden.IPRange{
+			garden.IPRangeFromIP(net.ParseIP("169.254.169.254")),
+			{Start: net.ParseIP("10.0.0.1"), End: net.ParseIP("10.0.0.9")},
+		},
+		Ports: []garden.PortRange{
+			garden.PortRangeFromPort(80),
+			{Start: 8000, End: 8080},
+		},
+	})
+	s.NoError(err)
+
+	var rules [][]string
+	for _, r := range s.appendedRules() {
+		rules = append(rules, r.rule)
+	}
+
+	s.Equal([][]string{
+		{"-s", "10.80.0.2", "-d", "169.254.169.254", "-p", "tcp", "--dport", "80", "-j", "ACCEPT"},
+		{"-s", "10.80.0.2", "-d", "169.254.169.254", "-p", "tcp", "--dport", "8000:8080", "-j", "ACCEPT"},
+		{"-s", "10.80.0.2", "-m", "iprange", "--dst-range", "10.0.0.1-10.0.0.9", "-p", "tcp", "--dport", "80", "-j", "ACCEPT"},
+		{"-s", "10.80.0.2", "-m", "iprange", "--dst-range", "10.0.0.1-10.0.0.9", "-p", "tcp", "--dport", "8000:8080", "-j", "ACCEPT"},
+	}, rules)
+}
+
+func (s *CNINetworkSuite) TestNetOutICMPWithLogging() {
+	err := s.network.NetOut("handle", "10.80.0.2", garden.NetOutRule{
+		Protocol: garden.ProtocolICMP,
+		ICMPs: &garden.ICMPControl{
+			Type: 8,
+			Code: garden.ICMPControlCode(0),
+		},
+		Log: true,
+	})
+	s.NoError(err)
+
+	var rules [][]string
+	for _, r := range s.appendedRules() {
+		rules = append(rules, r.rule)
+	}
+
+	s.Equal([][]string{
+		{"-s", "10.80.0.2", "-p", "icmp", "--icmp-type", "8/0", "-m", "conntrack", "--ctstate", "NEW", "-j", "LOG", "--log-prefix", "10.80.0.2 "},
+		{"-s", "10.80.0.2", "-p", "icmp", "--icmp-type", "8/0", "-j", "ACCEPT"},
+	}, rules)
+}
+
+func (s *CNINetworkSuite) TestNetOutFirewallErrors() {
+	s.firewall.AppendRuleReturns(errors.New("append-rule-err"))
+
+	err := s.network.NetOut("handle", "10.80.0.2", garden.NetOutRule{})
+	s.EqualError(errors.Unwrap(err), "append-rule-err")
 }
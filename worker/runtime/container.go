@@ -1,35 +1,54 @@
 package runtime
 
 import (
+	"archive/tar"
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path/filepath"
+	"strconv"
 	"time"
 
 	"code.cloudfoundry.org/garden"
+	"github.com/concourse/go-archive/tarfs"
+	v1 "github.com/containerd/cgroups/stats/v1"
 	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/archive"
 	"github.com/containerd/containerd/cio"
+	"github.com/containerd/continuity/fs"
+	"github.com/containerd/typeurl"
 	uuid "github.com/nu7hatch/gouuid"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
 
-const GraceTimeKey = "garden.grace-time"
+const (
+	GraceTimeKey = "garden.grace-time"
+
+	// ContainerIPKey is the label holding the IP address the container got
+	// assigned when added to the network.
+	//
+	ContainerIPKey = "garden.network.container-ip"
+)
 
 type Container struct {
 	container     containerd.Container
 	killer        Killer
 	rootfsManager RootfsManager
+	network       Network
 }
 
 func NewContainer(
 	container containerd.Container,
 	killer Killer,
 	rootfsManager RootfsManager,
+	network Network,
 ) *Container {
 	return &Container{
 		container:     container,
 		killer:        killer,
 		rootfsManager: rootfsManager,
+		network:       network,
 	}
 }
 
@@ -208,28 +227,151 @@ func (c *Container) RemoveProperty(name string) (err error) {
 	return
 }
 
-// Info - Not Implemented
-func (c *Container) Info() (info garden.ContainerInfo, err error) {
-	err = ErrNotImplemented
-	return
+// Info returns the state of the container's task along with its processes
+// and properties.
+//
+func (c *Container) Info() (garden.ContainerInfo, error) {
+	ctx := context.Background()
+
+	properties, err := c.Properties()
+	if err != nil {
+		return garden.ContainerInfo{}, err
+	}
+
+	containerSpec, err := c.container.Spec(ctx)
+	if err != nil {
+		return garden.ContainerInfo{}, fmt.Errorf("container spec: %w", err)
+	}
+
+	task, err := c.container.Task(ctx, cio.Load)
+	if err != nil {
+		return garden.ContainerInfo{}, fmt.Errorf("task lookup: %w", err)
+	}
+
+	status, err := task.Status(ctx)
+	if err != nil {
+		return garden.ContainerInfo{}, fmt.Errorf("task status: %w", err)
+	}
+
+	pids, err := task.Pids(ctx)
+	if err != nil {
+		return garden.ContainerInfo{}, fmt.Errorf("task pids: %w", err)
+	}
+
+	processIDs := make([]string, len(pids))
+	for i, pid := range pids {
+		processIDs[i] = strconv.FormatUint(uint64(pid.Pid), 10)
+	}
+
+	var containerPath string
+	if containerSpec.Root != nil {
+		containerPath = containerSpec.Root.Path
+	}
+
+	return garden.ContainerInfo{
+		State:         string(status.Status),
+		Events:        []string{},
+		ContainerIP:   properties[ContainerIPKey],
+		ContainerPath: containerPath,
+		ProcessIDs:    processIDs,
+		Properties:    properties,
+	}, nil
 }
 
-// Metrics - Not Implemented
-func (c *Container) Metrics() (metrics garden.Metrics, err error) {
-	err = ErrNotImplemented
-	return
+// Metrics returns the usage of the container's cgroup.
+//
+func (c *Container) Metrics() (garden.Metrics, error) {
+	ctx := context.Background()
+
+	task, err := c.container.Task(ctx, cio.Load)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("task lookup: %w", err)
+	}
+
+	metric, err := task.Metrics(ctx)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("task metrics: %w", err)
+	}
+
+	data, err := typeurl.UnmarshalAny(metric.Data)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("unmarshal metrics: %w", err)
+	}
+
+	stats, ok := data.(*v1.Metrics)
+	if !ok {
+		return garden.Metrics{}, fmt.Errorf("unexpected metrics type %T", data)
+	}
+
+	return gardenMetrics(stats), nil
 }
 
-// StreamIn - Not Implemented
-func (c *Container) StreamIn(spec garden.StreamInSpec) (err error) {
-	err = ErrNotImplemented
-	return
+// StreamIn extracts a tar stream into a directory of the container's root
+// filesystem from the host, so the image doesn't need to provide `tar`.
+// Symlinks are resolved within the directory, so that the image can't have
+// the stream extracted elsewhere on the host.
+//
+func (c *Container) StreamIn(spec garden.StreamInSpec) error {
+	ctx := context.Background()
+
+	containerSpec, err := c.container.Spec(ctx)
+	if err != nil {
+		return fmt.Errorf("container spec: %w", err)
+	}
+
+	dest, err := fs.RootPath(containerSpec.Root.Path, spec.Path)
+	if err != nil {
+		return fmt.Errorf("resolve path: %w", err)
+	}
+
+	err = os.MkdirAll(dest, 0755)
+	if err != nil {
+		return fmt.Errorf("create destination: %w", err)
+	}
+
+	_, err = archive.Apply(ctx, dest, spec.TarStream,
+		archive.WithFilter(hostOwnership(containerSpec)),
+		archive.WithConvertWhiteout(keepWhiteouts),
+	)
+	if err != nil {
+		return fmt.Errorf("stream in: %w", err)
+	}
+
+	return nil
 }
 
-// StreamOut - Not Implemented
-func (c *Container) StreamOut(spec garden.StreamOutSpec) (readCloser io.ReadCloser, err error) {
-	err = ErrNotImplemented
-	return
+// StreamOut returns a tar stream of a path in the container's root filesystem,
+// produced from the host. A path ending with a slash streams the contents of
+// the directory rather than the directory itself.
+//
+func (c *Container) StreamOut(spec garden.StreamOutSpec) (io.ReadCloser, error) {
+	containerSpec, err := c.container.Spec(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("container spec: %w", err)
+	}
+
+	dir, name := filepath.Split(spec.Path)
+	if name == "" {
+		name = "."
+	}
+
+	dir, err = fs.RootPath(containerSpec.Root.Path, dir)
+	if err != nil {
+		return nil, fmt.Errorf("resolve path: %w", err)
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		err := tarfs.Compress(writer, dir, name)
+		if err != nil {
+			err = fmt.Errorf("stream out: %w", err)
+		}
+
+		writer.CloseWithError(err)
+	}()
+
+	return reader, nil
 }
 
 // SetGraceTime stores the grace time as a containerd label with key "garden.grace-time"
@@ -293,22 +435,134 @@ func (c *Container) CurrentMemoryLimits() (limits garden.MemoryLimits, err error
 	}, nil
 }
 
-// NetIn - Not Implemented
-func (c *Container) NetIn(hostPort, containerPort uint32) (a, b uint32, err error) {
-	err = ErrNotImplemented
-	return
+// NetIn forwards a port on the host to a port of the container.
+//
+func (c *Container) NetIn(hostPort, containerPort uint32) (uint32, uint32, error) {
+	ip, err := c.Property(ContainerIPKey)
+	if err != nil {
+		return 0, 0, fmt.Errorf("container ip: %w", err)
+	}
+
+	hostPort, containerPort, err = c.network.NetIn(c.Handle(), ip, hostPort, containerPort)
+	if err != nil {
+		return 0, 0, fmt.Errorf("net in: %w", err)
+	}
+
+	return hostPort, containerPort, nil
 }
 
-// NetOut - Not Implemented
-func (c *Container) NetOut(netOutRule garden.NetOutRule) (err error) {
-	err = ErrNotImplemented
-	return
+// NetOut allows traffic from the container to the destinations described by
+// the rule, even if they're part of the networks restricted by the worker.
+//
+func (c *Container) NetOut(netOutRule garden.NetOutRule) error {
+	return c.BulkNetOut([]garden.NetOutRule{netOutRule})
 }
 
-// BulkNetOut - Not Implemented
-func (c *Container) BulkNetOut(netOutRules []garden.NetOutRule) (err error) {
-	err = ErrNotImplemented
-	return
+// BulkNetOut applies a set of NetOut rules.
+//
+func (c *Container) BulkNetOut(netOutRules []garden.NetOutRule) error {
+	ip, err := c.Property(ContainerIPKey)
+	if err != nil {
+		return fmt.Errorf("container ip: %w", err)
+	}
+
+	for _, rule := range netOutRules {
+		err = c.network.NetOut(c.Handle(), ip, rule)
+		if err != nil {
+			return fmt.Errorf("net out: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// hostOwnership maps the owners of the entries of a tar stream in the
+// container's user namespace to their owners on the host.
+//
+func hostOwnership(containerSpec *specs.Spec) archive.Filter {
+	return func(hdr *tar.Header) (bool, error) {
+		if containerSpec.Linux == nil {
+			return true, nil
+		}
+
+		hdr.Uid = hostID(containerSpec.Linux.UIDMappings, hdr.Uid)
+		hdr.Gid = hostID(containerSpec.Linux.GIDMappings, hdr.Gid)
+
+		return true, nil
+	}
+}
+
+func hostID(mappings []specs.LinuxIDMapping, id int) int {
+	for _, mapping := range mappings {
+		if id >= int(mapping.ContainerID) && id < int(mapping.ContainerID+mapping.Size) {
+			return int(mapping.HostID) + id - int(mapping.ContainerID)
+		}
+	}
+
+	return id
+}
+
+// keepWhiteouts extracts the files which an image layer would treat as
+// deletions like any other file.
+//
+func keepWhiteouts(*tar.Header, string) (bool, error) {
+	return true, nil
+}
+
+func gardenMetrics(stats *v1.Metrics) garden.Metrics {
+	var metrics garden.Metrics
+
+	if stats.CPU != nil && stats.CPU.Usage != nil {
+		metrics.CPUStat = garden.ContainerCPUStat{
+			Usage:  stats.CPU.Usage.Total,
+			User:   stats.CPU.Usage.User,
+			System: stats.CPU.Usage.Kernel,
+		}
+	}
+
+	memory := stats.Memory
+	if memory != nil {
+		metrics.MemoryStat = garden.ContainerMemoryStat{
+			ActiveAnon:              memory.ActiveAnon,
+			ActiveFile:              memory.ActiveFile,
+			Cache:                   memory.Cache,
+			HierarchicalMemoryLimit: memory.HierarchicalMemoryLimit,
+			InactiveAnon:            memory.InactiveAnon,
+			InactiveFile:            memory.InactiveFile,
+			MappedFile:              memory.MappedFile,
+			Pgfault:                 memory.PgFault,
+			Pgmajfault:              memory.PgMajFault,
+			Pgpgin:                  memory.PgPgIn,
+			Pgpgout:                 memory.PgPgOut,
+			Rss:                     memory.RSS,
+			TotalActiveAnon:         memory.TotalActiveAnon,
+			TotalActiveFile:         memory.TotalActiveFile,
+			TotalCache:              memory.TotalCache,
+			TotalInactiveAnon:       memory.TotalInactiveAnon,
+			TotalInactiveFile:       memory.TotalInactiveFile,
+			TotalMappedFile:         memory.TotalMappedFile,
+			TotalPgfault:            memory.TotalPgFault,
+			TotalPgmajfault:         memory.TotalPgMajFault,
+			TotalPgpgin:             memory.TotalPgPgIn,
+			TotalPgpgout:            memory.TotalPgPgOut,
+			TotalRss:                memory.TotalRSS,
+			TotalUnevictable:        memory.TotalUnevictable,
+			Unevictable:             memory.Unevictable,
+			HierarchicalMemswLimit:  memory.HierarchicalSwapLimit,
+		}
+
+		if memory.Swap != nil {
+			metrics.MemoryStat.Swap = memory.Swap.Usage
+		}
+
+		// like the kernel's OOM killer, don't count the page cache which can
+		// be reclaimed
+		if memory.Usage != nil && memory.Usage.Usage > memory.TotalInactiveFile {
+			metrics.MemoryStat.TotalUsageTowardLimit = memory.Usage.Usage - memory.TotalInactiveFile
+		}
+	}
+
+	return metrics
 }
 
 func procID(gdnProcSpec garden.ProcessSpec) string {
@@ -1,13 +1,23 @@
 package runtime_test
 
 import (
+	"archive/tar"
+	"bytes"
 	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"syscall"
 
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/runtime"
-	"github.com/concourse/concourse/worker/runtime/runtimefakes"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
+	"github.com/concourse/concourse/worker/runtime/runtimefakes"
+	v1 "github.com/containerd/cgroups/stats/v1"
 	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/api/types"
+	"github.com/containerd/typeurl"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -23,6 +33,7 @@ type ContainerSuite struct {
 	containerdTask      *libcontainerdfakes.FakeTask
 	rootfsManager       *runtimefakes.FakeRootfsManager
 	killer              *runtimefakes.FakeKiller
+	network             *runtimefakes.FakeNetwork
 }
 
 func (s *ContainerSuite) SetupTest() {
@@ -31,11 +42,13 @@ func (s *ContainerSuite) SetupTest() {
 	s.containerdTask = new(libcontainerdfakes.FakeTask)
 	s.rootfsManager = new(runtimefakes.FakeRootfsManager)
 	s.killer = new(runtimefakes.FakeKiller)
+	s.network = new(runtimefakes.FakeNetwork)
 
 	s.container = runtime.NewContainer(
 		s.containerdContainer,
 		s.killer,
 		s.rootfsManager,
+		s.network,
 	)
 }
 
@@ -297,3 +310,329 @@ func (s *ContainerSuite) TestCurrentMemoryLimitsReturnsLimit() {
 	s.NoError(err)
 	s.Equal(garden.MemoryLimits{LimitInBytes: uint64(limitBytes)}, limits)
 }
+
+func (s *ContainerSuite) setupRootfs() string {
+	rootfs, err := ioutil.TempDir("", "container-rootfs")
+	s.NoError(err)
+
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Root:  &specs.Root{Path: rootfs},
+		Linux: &specs.Linux{},
+	}, nil)
+
+	return rootfs
+}
+
+func (s *ContainerSuite) tarStream(files map[string]string) io.Reader {
+	buf := new(bytes.Buffer)
+	writer := tar.NewWriter(buf)
+
+	for name, content := range files {
+		err := writer.WriteHeader(&tar.Header{
+			Name: name,
+			Mode: 0644,
+			Size: int64(len(content)),
+			Uid:  os.Getuid(),
+			Gid:  os.Getgid(),
+		})
+		s.NoError(err)
+
+		_, err = writer.Write([]byte(content))
+		s.NoError(err)
+	}
+
+	s.NoError(writer.Close())
+
+	return buf
+}
+
+func (s *ContainerSuite) TestStreamInExtractsIntoTheRootfs() {
+	rootfs := s.setupRootfs()
+	defer os.RemoveAll(rootfs)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/some/dir",
+		TarStream: s.tarStream(map[string]string{"some-file": "some-content"}),
+	})
+	s.NoError(err)
+
+	content, err := ioutil.ReadFile(filepath.Join(rootfs, "some", "dir", "some-file"))
+	s.NoError(err)
+	s.Equal("some-content", string(content))
+
+	s.Equal(0, s.containerdTask.ExecCallCount())
+}
+
+func (s *ContainerSuite) TestStreamInResolvesSymlinksWithinTheRootfs() {
+	rootfs := s.setupRootfs()
+	defer os.RemoveAll(rootfs)
+
+	outside, err := ioutil.TempDir("", "outside-rootfs")
+	s.NoError(err)
+	defer os.RemoveAll(outside)
+
+	s.NoError(os.Symlink(outside, filepath.Join(rootfs, "link")))
+
+	err = s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/link",
+		TarStream: s.tarStream(map[string]string{"some-file": "some-content"}),
+	})
+	s.NoError(err)
+
+	s.NoFileExists(filepath.Join(outside, "some-file"))
+	s.FileExists(filepath.Join(rootfs, outside, "some-file"))
+}
+
+func (s *ContainerSuite) TestStreamInMapsOwnersToTheHost() {
+	if os.Geteuid() != 0 {
+		s.T().Skip("changing owners requires root")
+	}
+
+	rootfs := s.setupRootfs()
+	defer os.RemoveAll(rootfs)
+
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Root: &specs.Root{Path: rootfs},
+		Linux: &specs.Linux{
+			UIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 1000, Size: 10}},
+			GIDMappings: []specs.LinuxIDMapping{{ContainerID: 0, HostID: 2000, Size: 10}},
+		},
+	}, nil)
+
+	buf := new(bytes.Buffer)
+	writer := tar.NewWriter(buf)
+	s.NoError(writer.WriteHeader(&tar.Header{Name: "some-file", Mode: 0644, Uid: 1, Gid: 2}))
+	s.NoError(writer.Close())
+
+	err := s.container.StreamIn(garden.StreamInSpec{Path: "/", TarStream: buf})
+	s.NoError(err)
+
+	info, err := os.Lstat(filepath.Join(rootfs, "some-file"))
+	s.NoError(err)
+
+	stat := info.Sys().(*syscall.Stat_t)
+	s.Equal(uint32(1001), stat.Uid)
+	s.Equal(uint32(2002), stat.Gid)
+}
+
+func (s *ContainerSuite) TestStreamInSpecError() {
+	expectedErr := errors.New("spec-err")
+	s.containerdContainer.SpecReturns(nil, expectedErr)
+
+	err := s.container.StreamIn(garden.StreamInSpec{Path: "/some/dir"})
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestStreamInInvalidTar() {
+	rootfs := s.setupRootfs()
+	defer os.RemoveAll(rootfs)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/some/dir",
+		TarStream: bytes.NewBufferString("not-a-tar"),
+	})
+	s.Error(err)
+}
+
+func (s *ContainerSuite) streamedFiles(reader io.Reader) map[string]string {
+	files := map[string]string{}
+
+	tarReader := tar.NewReader(reader)
+	for {
+		hdr, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		s.NoError(err)
+
+		content, err := ioutil.ReadAll(tarReader)
+		s.NoError(err)
+
+		files[hdr.Name] = string(content)
+	}
+
+	return files
+}
+
+func (s *ContainerSuite) TestStreamOutStreamsThePath() {
+	rootfs := s.setupRootfs()
+	defer os.RemoveAll(rootfs)
+
+	s.NoError(os.MkdirAll(filepath.Join(rootfs, "some", "dir"), 0755))
+	s.NoError(ioutil.WriteFile(filepath.Join(rootfs, "some", "dir", "file"), []byte("some-content"), 0644))
+
+	reader, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/some/dir/file"})
+	s.NoError(err)
+
+	s.Equal(map[string]string{"file": "some-content"}, s.streamedFiles(reader))
+	s.Equal(0, s.containerdTask.ExecCallCount())
+}
+
+func (s *ContainerSuite) TestStreamOutStreamsTheContentsOfADirectory() {
+	rootfs := s.setupRootfs()
+	defer os.RemoveAll(rootfs)
+
+	s.NoError(os.MkdirAll(filepath.Join(rootfs, "some", "dir"), 0755))
+	s.NoError(ioutil.WriteFile(filepath.Join(rootfs, "some", "dir", "file"), []byte("some-content"), 0644))
+
+	reader, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/some/dir/"})
+	s.NoError(err)
+
+	files := s.streamedFiles(reader)
+	s.Contains(files, "./file")
+	s.Equal("some-content", files["./file"])
+}
+
+func (s *ContainerSuite) TestStreamOutMissingPath() {
+	rootfs := s.setupRootfs()
+	defer os.RemoveAll(rootfs)
+
+	reader, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/missing"})
+	s.NoError(err)
+
+	_, err = ioutil.ReadAll(reader)
+	s.Error(err)
+}
+
+func (s *ContainerSuite) TestStreamOutSpecError() {
+	expectedErr := errors.New("spec-err")
+	s.containerdContainer.SpecReturns(nil, expectedErr)
+
+	_, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/some/dir"})
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestInfoTaskLookupFails() {
+	s.containerdContainer.SpecReturns(&specs.Spec{}, nil)
+
+	expectedErr := errors.New("task-err")
+	s.containerdContainer.TaskReturns(nil, expectedErr)
+
+	_, err := s.container.Info()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestInfoReturnsTheTaskState() {
+	s.containerdContainer.LabelsReturns(map[string]string{
+		"some":                        "property",
+		"garden.network.container-ip": "10.80.0.2",
+	}, nil)
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Root: &specs.Root{Path: "/rootfs"},
+	}, nil)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.StatusReturns(containerd.Status{Status: containerd.Running}, nil)
+	s.containerdTask.PidsReturns([]containerd.ProcessInfo{{Pid: 123}, {Pid: 456}}, nil)
+
+	info, err := s.container.Info()
+	s.NoError(err)
+	s.Equal(garden.ContainerInfo{
+		State:         "running",
+		Events:        []string{},
+		ContainerIP:   "10.80.0.2",
+		ContainerPath: "/rootfs",
+		ProcessIDs:    []string{"123", "456"},
+		Properties: garden.Properties{
+			"some":                        "property",
+			"garden.network.container-ip": "10.80.0.2",
+		},
+	}, info)
+}
+
+func (s *ContainerSuite) TestMetricsTaskMetricsFails() {
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+
+	expectedErr := errors.New("metrics-err")
+	s.containerdTask.MetricsReturns(nil, expectedErr)
+
+	_, err := s.container.Metrics()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestMetricsReturnsCgroupUsage() {
+	data, err := typeurl.MarshalAny(&v1.Metrics{
+		CPU: &v1.CPUStat{
+			Usage: &v1.CPUUsage{Total: 300, User: 200, Kernel: 100},
+		},
+		Memory: &v1.MemoryStat{
+			Cache:             10,
+			RSS:               20,
+			TotalInactiveFile: 5,
+			Usage:             &v1.MemoryEntry{Usage: 30},
+			Swap:              &v1.MemoryEntry{Usage: 1},
+		},
+	})
+	s.NoError(err)
+
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.MetricsReturns(&types.Metric{Data: data}, nil)
+
+	metrics, err := s.container.Metrics()
+	s.NoError(err)
+	s.Equal(garden.ContainerCPUStat{Usage: 300, User: 200, System: 100}, metrics.CPUStat)
+	s.Equal(uint64(10), metrics.MemoryStat.Cache)
+	s.Equal(uint64(20), metrics.MemoryStat.Rss)
+	s.Equal(uint64(1), metrics.MemoryStat.Swap)
+	s.Equal(uint64(25), metrics.MemoryStat.TotalUsageTowardLimit)
+}
+
+func (s *ContainerSuite) TestNetInWithoutIP() {
+	_, _, err := s.container.NetIn(8080, 80)
+	s.True(errors.Is(err, runtime.ErrNotFound("garden.network.container-ip")))
+
+	s.Equal(0, s.network.NetInCallCount())
+}
+
+func (s *ContainerSuite) TestNetIn() {
+	s.containerdContainer.IDReturns("handle")
+	s.containerdContainer.LabelsReturns(map[string]string{
+		"garden.network.container-ip": "10.80.0.2",
+	}, nil)
+	s.network.NetInReturns(8080, 80, nil)
+
+	hostPort, containerPort, err := s.container.NetIn(0, 80)
+	s.NoError(err)
+	s.Equal(uint32(8080), hostPort)
+	s.Equal(uint32(80), containerPort)
+
+	handle, ip, requestedHostPort, requestedContainerPort := s.network.NetInArgsForCall(0)
+	s.Equal("handle", handle)
+	s.Equal("10.80.0.2", ip)
+	s.Equal(uint32(0), requestedHostPort)
+	s.Equal(uint32(80), requestedContainerPort)
+}
+
+func (s *ContainerSuite) TestBulkNetOut() {
+	s.containerdContainer.IDReturns("handle")
+	s.containerdContainer.LabelsReturns(map[string]string{
+		"garden.network.container-ip": "10.80.0.2",
+	}, nil)
+
+	rules := []garden.NetOutRule{
+		{Protocol: garden.ProtocolTCP},
+		{Protocol: garden.ProtocolUDP},
+	}
+
+	err := s.container.BulkNetOut(rules)
+	s.NoError(err)
+
+	s.Equal(2, s.network.NetOutCallCount())
+	for i, rule := range rules {
+		handle, ip, netOutRule := s.network.NetOutArgsForCall(i)
+		s.Equal("handle", handle)
+		s.Equal("10.80.0.2", ip)
+		s.Equal(rule, netOutRule)
+	}
+}
+
+func (s *ContainerSuite) TestNetOutFails() {
+	s.containerdContainer.LabelsReturns(map[string]string{
+		"garden.network.container-ip": "10.80.0.2",
+	}, nil)
+
+	expectedErr := errors.New("net-out-err")
+	s.network.NetOutReturns(expectedErr)
+
+	err := s.container.NetOut(garden.NetOutRule{})
+	s.True(errors.Is(err, expectedErr))
+}
@@ -0,0 +1,291 @@
+package runtime
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+)
+
+const (
+	// FilterTable is the iptables table where packets are accepted or
+	// rejected.
+	//
+	FilterTable = "filter"
+
+	// NatTable is the iptables table where addresses are translated.
+	//
+	NatTable = "nat"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Firewall
+
+// Firewall manages chains of packet filtering rules.
+//
+// Rules are expressed as iptables rule specifications (e.g., `-d 10.0.0.0/8
+// -j REJECT`), without the table and chain they belong to.
+//
+type Firewall interface {
+	// CreateChain creates a chain in a table, unless it already exists.
+	//
+	CreateChain(table, chain string) error
+
+	// FlushChain deletes all of the rules of a chain.
+	//
+	FlushChain(table, chain string) error
+
+	// DeleteChain flushes and deletes a chain.
+	//
+	DeleteChain(table, chain string) error
+
+	// RuleExists checks whether a chain contains a rule.
+	//
+	RuleExists(table, chain string, rule ...string) (bool, error)
+
+	// AppendRule adds a rule to the end of a chain.
+	//
+	AppendRule(table, chain string, rule ...string) error
+
+	// InsertRule adds a rule to a chain at a given position, starting from
+	// 1.
+	//
+	InsertRule(table, chain string, pos int, rule ...string) error
+
+	// DeleteRule removes a rule from a chain.
+	//
+	DeleteRule(table, chain string, rule ...string) error
+}
+
+// iptables implements a Firewall by running the `iptables` binary.
+//
+type iptables struct {
+	path string
+}
+
+var _ Firewall = (*iptables)(nil)
+
+// NewIptables instantiates a Firewall backed by the `iptables` binary found
+// in $PATH.
+//
+func NewIptables() *iptables {
+	return &iptables{path: "iptables"}
+}
+
+func (i iptables) CreateChain(table, chain string) error {
+	exists, err := i.chainExists(table, chain)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	return i.run("-t", table, "-N", chain)
+}
+
+func (i iptables) FlushChain(table, chain string) error {
+	return i.run("-t", table, "-F", chain)
+}
+
+func (i iptables) DeleteChain(table, chain string) error {
+	err := i.FlushChain(table, chain)
+	if err != nil {
+		return err
+	}
+
+	return i.run("-t", table, "-X", chain)
+}
+
+func (i iptables) RuleExists(table, chain string, rule ...string) (bool, error) {
+	err := i.run(append([]string{"-t", table, "-C", chain}, rule...)...)
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (i iptables) AppendRule(table, chain string, rule ...string) error {
+	return i.run(append([]string{"-t", table, "-A", chain}, rule...)...)
+}
+
+func (i iptables) InsertRule(table, chain string, pos int, rule ...string) error {
+	return i.run(append([]string{"-t", table, "-I", chain, strconv.Itoa(pos)}, rule...)...)
+}
+
+func (i iptables) DeleteRule(table, chain string, rule ...string) error {
+	return i.run(append([]string{"-t", table, "-D", chain}, rule...)...)
+}
+
+func (i iptables) chainExists(table, chain string) (bool, error) {
+	err := i.run("-t", table, "-n", "-L", chain)
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (i iptables) run(args ...string) error {
+	// `-w` waits for the xtables lock rather than failing right away when
+	// another process (e.g., a CNI plugin) is modifying the rules.
+	//
+	cmd := exec.Command(i.path, append([]string{"-w"}, args...)...)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		err = fmt.Errorf("iptables %s: %w", strings.Join(args, " "), err)
+
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			err = fmt.Errorf("%w: %s", err, msg)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// netOutRules converts a garden NetOutRule allowing traffic from a container
+// with a given IP address into the iptables rules that accept it.
+//
+// A rule is generated for each combination of the networks and ports that
+// the NetOutRule specifies, preceded by a logging rule if logging is enabled.
+//
+func netOutRules(ip string, rule garden.NetOutRule) ([][]string, error) {
+	var protocol []string
+
+	switch rule.Protocol {
+	case garden.ProtocolAll:
+		if len(rule.Ports) > 0 {
+			return nil, ErrInvalidInput("ports must only be specified for tcp or udp")
+		}
+	case garden.ProtocolTCP:
+		protocol = []string{"-p", "tcp"}
+	case garden.ProtocolUDP:
+		protocol = []string{"-p", "udp"}
+	case garden.ProtocolICMP:
+		if len(rule.Ports) > 0 {
+			return nil, ErrInvalidInput("ports must only be specified for tcp or udp")
+		}
+
+		protocol = []string{"-p", "icmp"}
+	default:
+		return nil, ErrInvalidInput(fmt.Sprintf("invalid protocol %d", rule.Protocol))
+	}
+
+	if rule.ICMPs != nil && rule.Protocol != garden.ProtocolICMP {
+		return nil, ErrInvalidInput("icmps must only be specified for icmp")
+	}
+
+	destinations := [][]string{nil}
+	if len(rule.Networks) > 0 {
+		destinations = make([][]string, len(rule.Networks))
+
+		for i, network := range rule.Networks {
+			destination, err := netOutDestination(network)
+			if err != nil {
+				return nil, err
+			}
+
+			destinations[i] = destination
+		}
+	}
+
+	ports := [][]string{nil}
+	if len(rule.Ports) > 0 {
+		ports = make([][]string, len(rule.Ports))
+
+		for i, port := range rule.Ports {
+			if port.End != 0 && port.End < port.Start {
+				return nil, ErrInvalidInput(
+					fmt.Sprintf("invalid port range %d-%d", port.Start, port.End),
+				)
+			}
+
+			dport := strconv.Itoa(int(port.Start))
+			if port.End > port.Start {
+				dport += ":" + strconv.Itoa(int(port.End))
+			}
+
+			ports[i] = []string{"--dport", dport}
+		}
+	}
+
+	var icmp []string
+	if rule.ICMPs != nil {
+		icmpType := strconv.Itoa(int(rule.ICMPs.Type))
+		if rule.ICMPs.Code != nil {
+			icmpType += "/" + strconv.Itoa(int(*rule.ICMPs.Code))
+		}
+
+		icmp = []string{"--icmp-type", icmpType}
+	}
+
+	var rules [][]string
+	for _, destination := range destinations {
+		for _, port := range ports {
+			match := []string{"-s", ip}
+			match = append(match, destination...)
+			match = append(match, protocol...)
+			match = append(match, port...)
+			match = append(match, icmp...)
+
+			if rule.Log {
+				rules = append(rules, append(copyRule(match),
+					"-m", "conntrack", "--ctstate", "NEW",
+					"-j", "LOG", "--log-prefix", ip+" ",
+				))
+			}
+
+			rules = append(rules, append(match, "-j", "ACCEPT"))
+		}
+	}
+
+	return rules, nil
+}
+
+func netOutDestination(network garden.IPRange) ([]string, error) {
+	start, end := network.Start, network.End
+	if start == nil {
+		start = end
+	}
+
+	if end == nil {
+		end = start
+	}
+
+	if start == nil || start.To4() == nil || end.To4() == nil {
+		return nil, ErrInvalidInput("networks must be ranges of ipv4 addresses")
+	}
+
+	if start.Equal(end) {
+		return []string{"-d", start.String()}, nil
+	}
+
+	return []string{
+		"-m", "iprange", "--dst-range", start.String() + "-" + end.String(),
+	}, nil
+}
+
+func copyRule(rule []string) []string {
+	return append([]string{}, rule...)
+}
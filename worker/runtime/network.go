@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 
+	"code.cloudfoundry.org/garden"
 	"github.com/containerd/containerd"
 	"github.com/opencontainers/runtime-spec/specs-go"
 )
@@ -15,11 +16,27 @@ type Network interface {
 	//
 	SetupMounts(handle string) (mounts []specs.Mount, err error)
 
-	// Add adds a task to the network.
+	// SetupRestrictedNetworks sets up the worker-wide rules that restrict
+	// which networks containers are able to reach.
 	//
-	Add(ctx context.Context, task containerd.Task) (err error)
+	SetupRestrictedNetworks() (err error)
 
-	// Removes a task from the network.
+	// Add adds a task to the network, returning the IP address it got
+	// assigned.
 	//
-	Remove(ctx context.Context, task containerd.Task) (err error)
+	Add(ctx context.Context, task containerd.Task) (ip string, err error)
+
+	// Removes a task with a given IP address from the network.
+	//
+	Remove(ctx context.Context, task containerd.Task, ip string) (err error)
+
+	// NetIn forwards a port on the host to a port of the container with a
+	// given IP address, returning the ports that ended up being used.
+	//
+	NetIn(handle, ip string, hostPort, containerPort uint32) (uint32, uint32, error)
+
+	// NetOut allows traffic from the container with a given IP address to
+	// the destinations described by a rule, even when they're restricted.
+	//
+	NetOut(handle, ip string, rule garden.NetOutRule) (err error)
 }
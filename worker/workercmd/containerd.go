@@ -28,7 +28,10 @@ func containerdGardenServerRunner(
 	containerdAddr string,
 	requestTimeout time.Duration,
 	dnsServers []string,
+	deniedNetworks []string,
+	allowedNetworks []string,
 	networkPool string,
+	workDir string,
 ) (ifrit.Runner, error) {
 	const (
 		graceTime = 0
@@ -42,6 +45,11 @@ func containerdGardenServerRunner(
 		networkOpts = append(networkOpts, runtime.WithNameServers(dnsServers))
 	}
 
+	networkOpts = append(networkOpts,
+		runtime.WithRestrictedNetworks(deniedNetworks),
+		runtime.WithAllowedNetworks(allowedNetworks),
+	)
+
 	if networkPool != "" {
 		networkOpts = append(networkOpts, runtime.WithCNINetworkConfig(
 			runtime.CNINetworkConfig{
@@ -56,7 +64,10 @@ func containerdGardenServerRunner(
 		return nil, fmt.Errorf("new cni network: %w", err)
 	}
 
-	backendOpts = append(backendOpts, runtime.WithNetwork(cniNetwork))
+	backendOpts = append(backendOpts,
+		runtime.WithNetwork(cniNetwork),
+		runtime.WithDiskPath(workDir),
+	)
 
 	gardenBackend, err := runtime.NewGardenBackend(
 		libcontainerd.New(containerdAddr, namespace, requestTimeout),
@@ -176,7 +187,10 @@ func (cmd *WorkerCommand) containerdRunner(logger lager.Logger) (ifrit.Runner, e
 		sock,
 		cmd.Garden.RequestTimeout,
 		dnsServers,
+		cmd.Garden.DenyNetworks,
+		cmd.Garden.AllowNetworks,
 		cmd.ContainerNetworkPool,
+		cmd.WorkDir.Path(),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("containerd garden server runner: %w", err)
@@ -105,6 +105,7 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		cmd.ConnectionDrainTimeout,
 		cmd.gardenAddr(),
 		cmd.baggageclaimAddr(),
+		cmd.hostUsage(),
 	)
 
 	gardenClient := gclient.BasicGardenClientWithRequestTimeout(
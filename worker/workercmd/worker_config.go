@@ -1,6 +1,7 @@
 package workercmd
 
 import (
+	"runtime"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -17,6 +18,9 @@ type WorkerConfig struct {
 
 	Ephemeral bool `long:"ephemeral" description:"If set, the worker will be immediately removed upon stalling."`
 
+	AllocatableCPU    uint64     `long:"allocatable-cpu"    description:"CPU shares available to task containers, where 1024 shares is one core. Defaults to 1024 shares per core."`
+	AllocatableMemory MemoryFlag `long:"allocatable-memory" description:"Memory available to task containers, e.g. 16GB. Defaults to the memory reported by the container runtime."`
+
 	Version string `long:"version" hidden:"true" description:"Version of the worker. This is normally baked in to the binary, so this flag is hidden."`
 }
 
@@ -31,5 +35,30 @@ func (c WorkerConfig) Worker() atc.Worker {
 		HTTPSProxyURL: c.HTTPSProxy,
 		NoProxy:       c.NoProxy,
 		Ephemeral:     c.Ephemeral,
+
+		AllocatableCPU:    c.allocatableCPU(),
+		AllocatableMemory: uint64(c.AllocatableMemory),
+	}
+}
+
+func (c WorkerConfig) allocatableCPU() uint64 {
+	if c.AllocatableCPU != 0 {
+		return c.AllocatableCPU
+	}
+
+	return uint64(runtime.NumCPU()) * 1024
+}
+
+// MemoryFlag is a number of bytes, optionally with a KB, MB or GB unit.
+type MemoryFlag uint64
+
+func (m *MemoryFlag) UnmarshalFlag(value string) error {
+	bytes, err := atc.ParseMemoryLimit(value)
+	if err != nil {
+		return err
 	}
+
+	*m = MemoryFlag(bytes)
+
+	return nil
 }
@@ -13,6 +13,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	concourseCmd "github.com/concourse/concourse/cmd"
+	"github.com/concourse/concourse/worker"
 	"github.com/concourse/flag"
 	"github.com/jessevdk/go-flags"
 	"github.com/tedsuo/ifrit"
@@ -29,6 +30,10 @@ type GardenBackend struct {
 	Bin        string    `long:"bin"        description:"Path to a garden backend executable (non-absolute names get resolved from $PATH)."`
 	Config     flag.File `long:"config"     description:"Path to a config file to use for the Garden backend. Guardian flags as env vars, e.g. 'CONCOURSE_GARDEN_FOO_BAR=a,b' for '--foo-bar a --foo-bar b'."`
 	DNSServers []string  `long:"dns-server" description:"DNS server IP address to use instead of automatically determined servers. Can be specified multiple times."`
+
+	DenyNetworks  []string `long:"deny-network"  description:"Network range (in CIDR notation) that containers must not be able to reach, e.g. 169.254.169.254/32 for a cloud metadata endpoint. Can be specified multiple times."`
+	AllowNetworks []string `long:"allow-network" description:"Network range (in CIDR notation) that containers are able to reach even if it's part of a denied network. Can be specified multiple times. (containerd only)"`
+
 	DNS            DNSConfig     `group:"DNS Proxy Configuration" namespace:"dns-proxy"`
 
 	RequestTimeout time.Duration `long:"request-timeout" default:"5m" description:"How long to wait for requests to Garden to complete. 0 means no timeout."`
@@ -39,6 +44,14 @@ func (cmd WorkerCommand) LessenRequirements(prefix string, command *flags.Comman
 	command.FindOptionByLongName(prefix + "baggageclaim-volumes").Required = false
 }
 
+// hostUsage measures the host's usage from /proc, and the disk used by the
+// filesystem holding baggageclaim's volumes.
+func (cmd *WorkerCommand) hostUsage() *worker.HostUsage {
+	return &worker.HostUsage{
+		VolumesDir: cmd.Baggageclaim.VolumesDir.Path(),
+	}
+}
+
 func (cmd *WorkerCommand) gardenRunner(logger lager.Logger) (atc.Worker, ifrit.Runner, error) {
 	err := cmd.checkRoot()
 	if err != nil {